@@ -74,7 +74,8 @@ type EthAuthorizeReply struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Token        string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken string `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
 }
 
 func (x *EthAuthorizeReply) Reset() {
@@ -116,16 +117,23 @@ func (x *EthAuthorizeReply) GetToken() string {
 	return ""
 }
 
-type RecommendUpdateRequest struct {
+func (x *EthAuthorizeReply) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type GetAuthNonceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SendBody *RecommendUpdateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+	SendBody *GetAuthNonceRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *RecommendUpdateRequest) Reset() {
-	*x = RecommendUpdateRequest{}
+func (x *GetAuthNonceRequest) Reset() {
+	*x = GetAuthNonceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -133,13 +141,13 @@ func (x *RecommendUpdateRequest) Reset() {
 	}
 }
 
-func (x *RecommendUpdateRequest) String() string {
+func (x *GetAuthNonceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecommendUpdateRequest) ProtoMessage() {}
+func (*GetAuthNonceRequest) ProtoMessage() {}
 
-func (x *RecommendUpdateRequest) ProtoReflect() protoreflect.Message {
+func (x *GetAuthNonceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -151,28 +159,29 @@ func (x *RecommendUpdateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecommendUpdateRequest.ProtoReflect.Descriptor instead.
-func (*RecommendUpdateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAuthNonceRequest.ProtoReflect.Descriptor instead.
+func (*GetAuthNonceRequest) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *RecommendUpdateRequest) GetSendBody() *RecommendUpdateRequest_SendBody {
+func (x *GetAuthNonceRequest) GetSendBody() *GetAuthNonceRequest_SendBody {
 	if x != nil {
 		return x.SendBody
 	}
 	return nil
 }
 
-type RecommendUpdateReply struct {
+type GetAuthNonceReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InviteUserAddress string `protobuf:"bytes,1,opt,name=inviteUserAddress,proto3" json:"inviteUserAddress,omitempty"`
+	Nonce   string `protobuf:"bytes,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *RecommendUpdateReply) Reset() {
-	*x = RecommendUpdateReply{}
+func (x *GetAuthNonceReply) Reset() {
+	*x = GetAuthNonceReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -180,13 +189,13 @@ func (x *RecommendUpdateReply) Reset() {
 	}
 }
 
-func (x *RecommendUpdateReply) String() string {
+func (x *GetAuthNonceReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecommendUpdateReply) ProtoMessage() {}
+func (*GetAuthNonceReply) ProtoMessage() {}
 
-func (x *RecommendUpdateReply) ProtoReflect() protoreflect.Message {
+func (x *GetAuthNonceReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -198,26 +207,35 @@ func (x *RecommendUpdateReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecommendUpdateReply.ProtoReflect.Descriptor instead.
-func (*RecommendUpdateReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAuthNonceReply.ProtoReflect.Descriptor instead.
+func (*GetAuthNonceReply) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *RecommendUpdateReply) GetInviteUserAddress() string {
+func (x *GetAuthNonceReply) GetNonce() string {
 	if x != nil {
-		return x.InviteUserAddress
+		return x.Nonce
 	}
 	return ""
 }
 
-type DepositRequest struct {
+func (x *GetAuthNonceReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RefreshTokenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	SendBody *RefreshTokenRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *DepositRequest) Reset() {
-	*x = DepositRequest{}
+func (x *RefreshTokenRequest) Reset() {
+	*x = RefreshTokenRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -225,13 +243,13 @@ func (x *DepositRequest) Reset() {
 	}
 }
 
-func (x *DepositRequest) String() string {
+func (x *RefreshTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepositRequest) ProtoMessage() {}
+func (*RefreshTokenRequest) ProtoMessage() {}
 
-func (x *DepositRequest) ProtoReflect() protoreflect.Message {
+func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -243,19 +261,29 @@ func (x *DepositRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepositRequest.ProtoReflect.Descriptor instead.
-func (*DepositRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{4}
 }
 
-type DepositReply struct {
+func (x *RefreshTokenRequest) GetSendBody() *RefreshTokenRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type RefreshTokenReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Token        string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken string `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
 }
 
-func (x *DepositReply) Reset() {
-	*x = DepositReply{}
+func (x *RefreshTokenReply) Reset() {
+	*x = RefreshTokenReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -263,13 +291,13 @@ func (x *DepositReply) Reset() {
 	}
 }
 
-func (x *DepositReply) String() string {
+func (x *RefreshTokenReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepositReply) ProtoMessage() {}
+func (*RefreshTokenReply) ProtoMessage() {}
 
-func (x *DepositReply) ProtoReflect() protoreflect.Message {
+func (x *RefreshTokenReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -281,19 +309,33 @@ func (x *DepositReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepositReply.ProtoReflect.Descriptor instead.
-func (*DepositReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefreshTokenReply.ProtoReflect.Descriptor instead.
+func (*RefreshTokenReply) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{5}
 }
 
-type UserInfoRequest struct {
+func (x *RefreshTokenReply) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RefreshTokenReply) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type LogoutRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UserInfoRequest) Reset() {
-	*x = UserInfoRequest{}
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -301,13 +343,13 @@ func (x *UserInfoRequest) Reset() {
 	}
 }
 
-func (x *UserInfoRequest) String() string {
+func (x *LogoutRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfoRequest) ProtoMessage() {}
+func (*LogoutRequest) ProtoMessage() {}
 
-func (x *UserInfoRequest) ProtoReflect() protoreflect.Message {
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -319,73 +361,21 @@ func (x *UserInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfoRequest.ProtoReflect.Descriptor instead.
-func (*UserInfoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{6}
 }
 
-type UserInfoReply struct {
+type LogoutReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address                           string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Level                             int64                  `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
-	Status                            string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	Amount                            string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	BalanceUsdt                       string                 `protobuf:"bytes,5,opt,name=balanceUsdt,proto3" json:"balanceUsdt,omitempty"`
-	BalanceDhb                        string                 `protobuf:"bytes,6,opt,name=balanceDhb,proto3" json:"balanceDhb,omitempty"`
-	InviteUrl                         string                 `protobuf:"bytes,7,opt,name=inviteUrl,proto3" json:"inviteUrl,omitempty"`
-	InviteUserAddress                 string                 `protobuf:"bytes,8,opt,name=inviteUserAddress,proto3" json:"inviteUserAddress,omitempty"`
-	RecommendNum                      int64                  `protobuf:"varint,9,opt,name=recommendNum,proto3" json:"recommendNum,omitempty"`
-	RecommendTeamNum                  int64                  `protobuf:"varint,10,opt,name=recommendTeamNum,proto3" json:"recommendTeamNum,omitempty"`
-	Total                             string                 `protobuf:"bytes,11,opt,name=total,proto3" json:"total,omitempty"`
-	RecommendTotal                    string                 `protobuf:"bytes,15,opt,name=recommendTotal,proto3" json:"recommendTotal,omitempty"`
-	LocationTotal                     string                 `protobuf:"bytes,16,opt,name=locationTotal,proto3" json:"locationTotal,omitempty"`
-	Usdt                              string                 `protobuf:"bytes,20,opt,name=usdt,proto3" json:"usdt,omitempty"`
-	Account                           string                 `protobuf:"bytes,22,opt,name=account,proto3" json:"account,omitempty"`
-	AmountB                           string                 `protobuf:"bytes,27,opt,name=amountB,proto3" json:"amountB,omitempty"`
-	RecommendVipTotal                 string                 `protobuf:"bytes,28,opt,name=recommendVipTotal,proto3" json:"recommendVipTotal,omitempty"`
-	FeeDaily                          string                 `protobuf:"bytes,29,opt,name=feeDaily,proto3" json:"feeDaily,omitempty"`
-	WithdrawAmount                    string                 `protobuf:"bytes,30,opt,name=withdrawAmount,proto3" json:"withdrawAmount,omitempty"`
-	UserCount                         string                 `protobuf:"bytes,31,opt,name=userCount,proto3" json:"userCount,omitempty"`
-	TotalDeposit                      string                 `protobuf:"bytes,32,opt,name=totalDeposit,proto3" json:"totalDeposit,omitempty"`
-	PoolAmount                        string                 `protobuf:"bytes,34,opt,name=poolAmount,proto3" json:"poolAmount,omitempty"`
-	LocationCount                     int64                  `protobuf:"varint,35,opt,name=locationCount,proto3" json:"locationCount,omitempty"`
-	TodayReward                       string                 `protobuf:"bytes,36,opt,name=todayReward,proto3" json:"todayReward,omitempty"`
-	RecommendTop                      string                 `protobuf:"bytes,37,opt,name=recommendTop,proto3" json:"recommendTop,omitempty"`
-	FybPrice                          string                 `protobuf:"bytes,40,opt,name=fybPrice,proto3" json:"fybPrice,omitempty"`
-	FybRate                           string                 `protobuf:"bytes,41,opt,name=fybRate,proto3" json:"fybRate,omitempty"`
-	AreaAmount                        string                 `protobuf:"bytes,42,opt,name=areaAmount,proto3" json:"areaAmount,omitempty"`
-	RecommendAreaTotal                string                 `protobuf:"bytes,43,opt,name=recommendAreaTotal,proto3" json:"recommendAreaTotal,omitempty"`
-	AreaMaxAmount                     string                 `protobuf:"bytes,44,opt,name=areaMaxAmount,proto3" json:"areaMaxAmount,omitempty"`
-	Undo                              int64                  `protobuf:"varint,45,opt,name=undo,proto3" json:"undo,omitempty"`
-	AmountC                           string                 `protobuf:"bytes,46,opt,name=AmountC,proto3" json:"AmountC,omitempty"`
-	AmountBalanceReward               string                 `protobuf:"bytes,47,opt,name=AmountBalanceReward,proto3" json:"AmountBalanceReward,omitempty"`
-	AreaName                          string                 `protobuf:"bytes,48,opt,name=areaName,proto3" json:"areaName,omitempty"`
-	RecommendTeamTotal                string                 `protobuf:"bytes,49,opt,name=recommendTeamTotal,proto3" json:"recommendTeamTotal,omitempty"`
-	LocationDailyRewardTotal          string                 `protobuf:"bytes,50,opt,name=locationDailyRewardTotal,proto3" json:"locationDailyRewardTotal,omitempty"`
-	DailyBalanceRewardTotal           string                 `protobuf:"bytes,51,opt,name=dailyBalanceRewardTotal,proto3" json:"dailyBalanceRewardTotal,omitempty"`
-	YesterdayRecommendTeamTotal       string                 `protobuf:"bytes,58,opt,name=yesterdayRecommendTeamTotal,proto3" json:"yesterdayRecommendTeamTotal,omitempty"`
-	YesterdayLocationDailyRewardTotal string                 `protobuf:"bytes,59,opt,name=yesterdayLocationDailyRewardTotal,proto3" json:"yesterdayLocationDailyRewardTotal,omitempty"`
-	YesterdayDailyBalanceRewardTotal  string                 `protobuf:"bytes,60,opt,name=yesterdayDailyBalanceRewardTotal,proto3" json:"yesterdayDailyBalanceRewardTotal,omitempty"`
-	YesterdayRecommendAreaTotal       string                 `protobuf:"bytes,61,opt,name=yesterdayRecommendAreaTotal,proto3" json:"yesterdayRecommendAreaTotal,omitempty"`
-	YesterdayRecommendTotal           string                 `protobuf:"bytes,62,opt,name=yesterdayRecommendTotal,proto3" json:"yesterdayRecommendTotal,omitempty"`
-	TotalAreaAmount                   string                 `protobuf:"bytes,63,opt,name=totalAreaAmount,proto3" json:"totalAreaAmount,omitempty"`
-	LocationRunningAmount             string                 `protobuf:"bytes,64,opt,name=locationRunningAmount,proto3" json:"locationRunningAmount,omitempty"`
-	LocationList                      []*UserInfoReply_List  `protobuf:"bytes,52,rep,name=LocationList,proto3" json:"LocationList,omitempty"`
-	RecommendTeamList                 []*UserInfoReply_List2 `protobuf:"bytes,53,rep,name=recommendTeamList,proto3" json:"recommendTeamList,omitempty"`
-	RecommendAreaList                 []*UserInfoReply_List3 `protobuf:"bytes,54,rep,name=recommendAreaList,proto3" json:"recommendAreaList,omitempty"`
-	LocationDailyRewardList           []*UserInfoReply_List4 `protobuf:"bytes,55,rep,name=locationDailyRewardList,proto3" json:"locationDailyRewardList,omitempty"`
-	RecommendList                     []*UserInfoReply_List5 `protobuf:"bytes,56,rep,name=recommendList,proto3" json:"recommendList,omitempty"`
-	DailyBalanceRewardList            []*UserInfoReply_List6 `protobuf:"bytes,57,rep,name=dailyBalanceRewardList,proto3" json:"dailyBalanceRewardList,omitempty"`
-	TeamAddressList                   []*UserInfoReply_List7 `protobuf:"bytes,65,rep,name=teamAddressList,proto3" json:"teamAddressList,omitempty"`
-	MyRecommendAddressList            []*UserInfoReply_List8 `protobuf:"bytes,66,rep,name=myRecommendAddressList,proto3" json:"myRecommendAddressList,omitempty"`
-	AllRewardList                     []*UserInfoReply_List9 `protobuf:"bytes,67,rep,name=allRewardList,proto3" json:"allRewardList,omitempty"`
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *UserInfoReply) Reset() {
-	*x = UserInfoReply{}
+func (x *LogoutReply) Reset() {
+	*x = LogoutReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -393,13 +383,13 @@ func (x *UserInfoReply) Reset() {
 	}
 }
 
-func (x *UserInfoReply) String() string {
+func (x *LogoutReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfoReply) ProtoMessage() {}
+func (*LogoutReply) ProtoMessage() {}
 
-func (x *UserInfoReply) ProtoReflect() protoreflect.Message {
+func (x *LogoutReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -411,750 +401,789 @@ func (x *UserInfoReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfoReply.ProtoReflect.Descriptor instead.
-func (*UserInfoReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use LogoutReply.ProtoReflect.Descriptor instead.
+func (*LogoutReply) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *UserInfoReply) GetAddress() string {
+func (x *LogoutReply) GetStatus() string {
 	if x != nil {
-		return x.Address
+		return x.Status
 	}
 	return ""
 }
 
-func (x *UserInfoReply) GetLevel() int64 {
-	if x != nil {
-		return x.Level
-	}
-	return 0
+type LogoutAllSessionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UserInfoReply) GetStatus() string {
-	if x != nil {
-		return x.Status
+func (x *LogoutAllSessionsRequest) Reset() {
+	*x = LogoutAllSessionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *UserInfoReply) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
+func (x *LogoutAllSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UserInfoReply) GetBalanceUsdt() string {
-	if x != nil {
-		return x.BalanceUsdt
+func (*LogoutAllSessionsRequest) ProtoMessage() {}
+
+func (x *LogoutAllSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UserInfoReply) GetBalanceDhb() string {
-	if x != nil {
-		return x.BalanceDhb
-	}
-	return ""
+// Deprecated: Use LogoutAllSessionsRequest.ProtoReflect.Descriptor instead.
+func (*LogoutAllSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UserInfoReply) GetInviteUrl() string {
-	if x != nil {
-		return x.InviteUrl
-	}
-	return ""
+type LogoutAllSessionsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *UserInfoReply) GetInviteUserAddress() string {
-	if x != nil {
-		return x.InviteUserAddress
+func (x *LogoutAllSessionsReply) Reset() {
+	*x = LogoutAllSessionsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *UserInfoReply) GetRecommendNum() int64 {
-	if x != nil {
-		return x.RecommendNum
-	}
-	return 0
+func (x *LogoutAllSessionsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UserInfoReply) GetRecommendTeamNum() int64 {
-	if x != nil {
-		return x.RecommendTeamNum
+func (*LogoutAllSessionsReply) ProtoMessage() {}
+
+func (x *LogoutAllSessionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UserInfoReply) GetTotal() string {
-	if x != nil {
-		return x.Total
-	}
-	return ""
+// Deprecated: Use LogoutAllSessionsReply.ProtoReflect.Descriptor instead.
+func (*LogoutAllSessionsReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *UserInfoReply) GetRecommendTotal() string {
+func (x *LogoutAllSessionsReply) GetStatus() string {
 	if x != nil {
-		return x.RecommendTotal
+		return x.Status
 	}
 	return ""
 }
 
-func (x *UserInfoReply) GetLocationTotal() string {
-	if x != nil {
-		return x.LocationTotal
-	}
-	return ""
+type RecommendUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendBody *RecommendUpdateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *UserInfoReply) GetUsdt() string {
-	if x != nil {
-		return x.Usdt
+func (x *RecommendUpdateRequest) Reset() {
+	*x = RecommendUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *UserInfoReply) GetAccount() string {
-	if x != nil {
-		return x.Account
-	}
-	return ""
+func (x *RecommendUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UserInfoReply) GetAmountB() string {
-	if x != nil {
-		return x.AmountB
-	}
-	return ""
-}
+func (*RecommendUpdateRequest) ProtoMessage() {}
 
-func (x *UserInfoReply) GetRecommendVipTotal() string {
-	if x != nil {
-		return x.RecommendVipTotal
+func (x *RecommendUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UserInfoReply) GetFeeDaily() string {
-	if x != nil {
-		return x.FeeDaily
-	}
-	return ""
+// Deprecated: Use RecommendUpdateRequest.ProtoReflect.Descriptor instead.
+func (*RecommendUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *UserInfoReply) GetWithdrawAmount() string {
+func (x *RecommendUpdateRequest) GetSendBody() *RecommendUpdateRequest_SendBody {
 	if x != nil {
-		return x.WithdrawAmount
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-func (x *UserInfoReply) GetUserCount() string {
-	if x != nil {
-		return x.UserCount
-	}
-	return ""
-}
+type RecommendUpdateReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *UserInfoReply) GetTotalDeposit() string {
-	if x != nil {
-		return x.TotalDeposit
-	}
-	return ""
+	InviteUserAddress string `protobuf:"bytes,1,opt,name=inviteUserAddress,proto3" json:"inviteUserAddress,omitempty"`
 }
 
-func (x *UserInfoReply) GetPoolAmount() string {
-	if x != nil {
-		return x.PoolAmount
+func (x *RecommendUpdateReply) Reset() {
+	*x = RecommendUpdateReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *UserInfoReply) GetLocationCount() int64 {
-	if x != nil {
-		return x.LocationCount
-	}
-	return 0
+func (x *RecommendUpdateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UserInfoReply) GetTodayReward() string {
-	if x != nil {
-		return x.TodayReward
-	}
-	return ""
-}
+func (*RecommendUpdateReply) ProtoMessage() {}
 
-func (x *UserInfoReply) GetRecommendTop() string {
-	if x != nil {
-		return x.RecommendTop
+func (x *RecommendUpdateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UserInfoReply) GetFybPrice() string {
-	if x != nil {
-		return x.FybPrice
-	}
-	return ""
+// Deprecated: Use RecommendUpdateReply.ProtoReflect.Descriptor instead.
+func (*RecommendUpdateReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *UserInfoReply) GetFybRate() string {
+func (x *RecommendUpdateReply) GetInviteUserAddress() string {
 	if x != nil {
-		return x.FybRate
+		return x.InviteUserAddress
 	}
 	return ""
 }
 
-func (x *UserInfoReply) GetAreaAmount() string {
-	if x != nil {
-		return x.AreaAmount
-	}
-	return ""
+type DepositRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UserInfoReply) GetRecommendAreaTotal() string {
-	if x != nil {
-		return x.RecommendAreaTotal
+func (x *DepositRequest) Reset() {
+	*x = DepositRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *UserInfoReply) GetAreaMaxAmount() string {
-	if x != nil {
-		return x.AreaMaxAmount
-	}
-	return ""
+func (x *DepositRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UserInfoReply) GetUndo() int64 {
-	if x != nil {
-		return x.Undo
-	}
-	return 0
-}
+func (*DepositRequest) ProtoMessage() {}
 
-func (x *UserInfoReply) GetAmountC() string {
-	if x != nil {
-		return x.AmountC
+func (x *DepositRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UserInfoReply) GetAmountBalanceReward() string {
-	if x != nil {
-		return x.AmountBalanceReward
-	}
-	return ""
+// Deprecated: Use DepositRequest.ProtoReflect.Descriptor instead.
+func (*DepositRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *UserInfoReply) GetAreaName() string {
-	if x != nil {
-		return x.AreaName
-	}
-	return ""
+type DepositReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UserInfoReply) GetRecommendTeamTotal() string {
-	if x != nil {
-		return x.RecommendTeamTotal
+func (x *DepositReply) Reset() {
+	*x = DepositReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *UserInfoReply) GetLocationDailyRewardTotal() string {
-	if x != nil {
-		return x.LocationDailyRewardTotal
-	}
-	return ""
+func (x *DepositReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UserInfoReply) GetDailyBalanceRewardTotal() string {
-	if x != nil {
-		return x.DailyBalanceRewardTotal
-	}
-	return ""
-}
+func (*DepositReply) ProtoMessage() {}
 
-func (x *UserInfoReply) GetYesterdayRecommendTeamTotal() string {
-	if x != nil {
-		return x.YesterdayRecommendTeamTotal
+func (x *DepositReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UserInfoReply) GetYesterdayLocationDailyRewardTotal() string {
-	if x != nil {
-		return x.YesterdayLocationDailyRewardTotal
-	}
-	return ""
+// Deprecated: Use DepositReply.ProtoReflect.Descriptor instead.
+func (*DepositReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *UserInfoReply) GetYesterdayDailyBalanceRewardTotal() string {
-	if x != nil {
-		return x.YesterdayDailyBalanceRewardTotal
-	}
-	return ""
+type UserInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UserInfoReply) GetYesterdayRecommendAreaTotal() string {
-	if x != nil {
-		return x.YesterdayRecommendAreaTotal
+func (x *UserInfoRequest) Reset() {
+	*x = UserInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *UserInfoReply) GetYesterdayRecommendTotal() string {
-	if x != nil {
-		return x.YesterdayRecommendTotal
-	}
-	return ""
+func (x *UserInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UserInfoReply) GetTotalAreaAmount() string {
-	if x != nil {
-		return x.TotalAreaAmount
+func (*UserInfoRequest) ProtoMessage() {}
+
+func (x *UserInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UserInfoReply) GetLocationRunningAmount() string {
-	if x != nil {
-		return x.LocationRunningAmount
-	}
-	return ""
+// Deprecated: Use UserInfoRequest.ProtoReflect.Descriptor instead.
+func (*UserInfoRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *UserInfoReply) GetLocationList() []*UserInfoReply_List {
-	if x != nil {
-		return x.LocationList
+type UserInfoReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address                           string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Level                             int64                  `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
+	Status                            string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Amount                            string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	BalanceUsdt                       string                 `protobuf:"bytes,5,opt,name=balanceUsdt,proto3" json:"balanceUsdt,omitempty"`
+	BalanceDhb                        string                 `protobuf:"bytes,6,opt,name=balanceDhb,proto3" json:"balanceDhb,omitempty"`
+	InviteUrl                         string                 `protobuf:"bytes,7,opt,name=inviteUrl,proto3" json:"inviteUrl,omitempty"`
+	InviteUserAddress                 string                 `protobuf:"bytes,8,opt,name=inviteUserAddress,proto3" json:"inviteUserAddress,omitempty"`
+	RecommendNum                      int64                  `protobuf:"varint,9,opt,name=recommendNum,proto3" json:"recommendNum,omitempty"`
+	RecommendTeamNum                  int64                  `protobuf:"varint,10,opt,name=recommendTeamNum,proto3" json:"recommendTeamNum,omitempty"`
+	Total                             string                 `protobuf:"bytes,11,opt,name=total,proto3" json:"total,omitempty"`
+	RecommendTotal                    string                 `protobuf:"bytes,15,opt,name=recommendTotal,proto3" json:"recommendTotal,omitempty"`
+	LocationTotal                     string                 `protobuf:"bytes,16,opt,name=locationTotal,proto3" json:"locationTotal,omitempty"`
+	Usdt                              string                 `protobuf:"bytes,20,opt,name=usdt,proto3" json:"usdt,omitempty"`
+	Account                           string                 `protobuf:"bytes,22,opt,name=account,proto3" json:"account,omitempty"`
+	AmountB                           string                 `protobuf:"bytes,27,opt,name=amountB,proto3" json:"amountB,omitempty"`
+	RecommendVipTotal                 string                 `protobuf:"bytes,28,opt,name=recommendVipTotal,proto3" json:"recommendVipTotal,omitempty"`
+	FeeDaily                          string                 `protobuf:"bytes,29,opt,name=feeDaily,proto3" json:"feeDaily,omitempty"`
+	WithdrawAmount                    string                 `protobuf:"bytes,30,opt,name=withdrawAmount,proto3" json:"withdrawAmount,omitempty"`
+	UserCount                         string                 `protobuf:"bytes,31,opt,name=userCount,proto3" json:"userCount,omitempty"`
+	TotalDeposit                      string                 `protobuf:"bytes,32,opt,name=totalDeposit,proto3" json:"totalDeposit,omitempty"`
+	PoolAmount                        string                 `protobuf:"bytes,34,opt,name=poolAmount,proto3" json:"poolAmount,omitempty"`
+	LocationCount                     int64                  `protobuf:"varint,35,opt,name=locationCount,proto3" json:"locationCount,omitempty"`
+	TodayReward                       string                 `protobuf:"bytes,36,opt,name=todayReward,proto3" json:"todayReward,omitempty"`
+	RecommendTop                      string                 `protobuf:"bytes,37,opt,name=recommendTop,proto3" json:"recommendTop,omitempty"`
+	FybPrice                          string                 `protobuf:"bytes,40,opt,name=fybPrice,proto3" json:"fybPrice,omitempty"`
+	FybRate                           string                 `protobuf:"bytes,41,opt,name=fybRate,proto3" json:"fybRate,omitempty"`
+	AreaAmount                        string                 `protobuf:"bytes,42,opt,name=areaAmount,proto3" json:"areaAmount,omitempty"`
+	RecommendAreaTotal                string                 `protobuf:"bytes,43,opt,name=recommendAreaTotal,proto3" json:"recommendAreaTotal,omitempty"`
+	AreaMaxAmount                     string                 `protobuf:"bytes,44,opt,name=areaMaxAmount,proto3" json:"areaMaxAmount,omitempty"`
+	Undo                              int64                  `protobuf:"varint,45,opt,name=undo,proto3" json:"undo,omitempty"`
+	AmountC                           string                 `protobuf:"bytes,46,opt,name=AmountC,proto3" json:"AmountC,omitempty"`
+	AmountBalanceReward               string                 `protobuf:"bytes,47,opt,name=AmountBalanceReward,proto3" json:"AmountBalanceReward,omitempty"`
+	AreaName                          string                 `protobuf:"bytes,48,opt,name=areaName,proto3" json:"areaName,omitempty"`
+	RecommendTeamTotal                string                 `protobuf:"bytes,49,opt,name=recommendTeamTotal,proto3" json:"recommendTeamTotal,omitempty"`
+	LocationDailyRewardTotal          string                 `protobuf:"bytes,50,opt,name=locationDailyRewardTotal,proto3" json:"locationDailyRewardTotal,omitempty"`
+	DailyBalanceRewardTotal           string                 `protobuf:"bytes,51,opt,name=dailyBalanceRewardTotal,proto3" json:"dailyBalanceRewardTotal,omitempty"`
+	YesterdayRecommendTeamTotal       string                 `protobuf:"bytes,58,opt,name=yesterdayRecommendTeamTotal,proto3" json:"yesterdayRecommendTeamTotal,omitempty"`
+	YesterdayLocationDailyRewardTotal string                 `protobuf:"bytes,59,opt,name=yesterdayLocationDailyRewardTotal,proto3" json:"yesterdayLocationDailyRewardTotal,omitempty"`
+	YesterdayDailyBalanceRewardTotal  string                 `protobuf:"bytes,60,opt,name=yesterdayDailyBalanceRewardTotal,proto3" json:"yesterdayDailyBalanceRewardTotal,omitempty"`
+	YesterdayRecommendAreaTotal       string                 `protobuf:"bytes,61,opt,name=yesterdayRecommendAreaTotal,proto3" json:"yesterdayRecommendAreaTotal,omitempty"`
+	YesterdayRecommendTotal           string                 `protobuf:"bytes,62,opt,name=yesterdayRecommendTotal,proto3" json:"yesterdayRecommendTotal,omitempty"`
+	TotalAreaAmount                   string                 `protobuf:"bytes,63,opt,name=totalAreaAmount,proto3" json:"totalAreaAmount,omitempty"`
+	LocationRunningAmount             string                 `protobuf:"bytes,64,opt,name=locationRunningAmount,proto3" json:"locationRunningAmount,omitempty"`
+	LocationList                      []*UserInfoReply_List  `protobuf:"bytes,52,rep,name=LocationList,proto3" json:"LocationList,omitempty"`
+	RecommendTeamList                 []*UserInfoReply_List2 `protobuf:"bytes,53,rep,name=recommendTeamList,proto3" json:"recommendTeamList,omitempty"`
+	RecommendAreaList                 []*UserInfoReply_List3 `protobuf:"bytes,54,rep,name=recommendAreaList,proto3" json:"recommendAreaList,omitempty"`
+	LocationDailyRewardList           []*UserInfoReply_List4 `protobuf:"bytes,55,rep,name=locationDailyRewardList,proto3" json:"locationDailyRewardList,omitempty"`
+	RecommendList                     []*UserInfoReply_List5 `protobuf:"bytes,56,rep,name=recommendList,proto3" json:"recommendList,omitempty"`
+	DailyBalanceRewardList            []*UserInfoReply_List6 `protobuf:"bytes,57,rep,name=dailyBalanceRewardList,proto3" json:"dailyBalanceRewardList,omitempty"`
+	TeamAddressList                   []*UserInfoReply_List7 `protobuf:"bytes,65,rep,name=teamAddressList,proto3" json:"teamAddressList,omitempty"`
+	MyRecommendAddressList            []*UserInfoReply_List8 `protobuf:"bytes,66,rep,name=myRecommendAddressList,proto3" json:"myRecommendAddressList,omitempty"`
+	AllRewardList                     []*UserInfoReply_List9 `protobuf:"bytes,67,rep,name=allRewardList,proto3" json:"allRewardList,omitempty"`
+}
+
+func (x *UserInfoReply) Reset() {
+	*x = UserInfoReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *UserInfoReply) GetRecommendTeamList() []*UserInfoReply_List2 {
-	if x != nil {
-		return x.RecommendTeamList
+func (x *UserInfoReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfoReply) ProtoMessage() {}
+
+func (x *UserInfoReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *UserInfoReply) GetRecommendAreaList() []*UserInfoReply_List3 {
+// Deprecated: Use UserInfoReply.ProtoReflect.Descriptor instead.
+func (*UserInfoReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UserInfoReply) GetAddress() string {
 	if x != nil {
-		return x.RecommendAreaList
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-func (x *UserInfoReply) GetLocationDailyRewardList() []*UserInfoReply_List4 {
+func (x *UserInfoReply) GetLevel() int64 {
 	if x != nil {
-		return x.LocationDailyRewardList
+		return x.Level
 	}
-	return nil
+	return 0
 }
 
-func (x *UserInfoReply) GetRecommendList() []*UserInfoReply_List5 {
+func (x *UserInfoReply) GetStatus() string {
 	if x != nil {
-		return x.RecommendList
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-func (x *UserInfoReply) GetDailyBalanceRewardList() []*UserInfoReply_List6 {
+func (x *UserInfoReply) GetAmount() string {
 	if x != nil {
-		return x.DailyBalanceRewardList
+		return x.Amount
 	}
-	return nil
+	return ""
 }
 
-func (x *UserInfoReply) GetTeamAddressList() []*UserInfoReply_List7 {
+func (x *UserInfoReply) GetBalanceUsdt() string {
 	if x != nil {
-		return x.TeamAddressList
+		return x.BalanceUsdt
 	}
-	return nil
+	return ""
 }
 
-func (x *UserInfoReply) GetMyRecommendAddressList() []*UserInfoReply_List8 {
+func (x *UserInfoReply) GetBalanceDhb() string {
 	if x != nil {
-		return x.MyRecommendAddressList
+		return x.BalanceDhb
 	}
-	return nil
+	return ""
 }
 
-func (x *UserInfoReply) GetAllRewardList() []*UserInfoReply_List9 {
+func (x *UserInfoReply) GetInviteUrl() string {
 	if x != nil {
-		return x.AllRewardList
+		return x.InviteUrl
 	}
-	return nil
+	return ""
 }
 
-type RewardListRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+func (x *UserInfoReply) GetInviteUserAddress() string {
+	if x != nil {
+		return x.InviteUserAddress
+	}
+	return ""
 }
 
-func (x *RewardListRequest) Reset() {
-	*x = RewardListRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *UserInfoReply) GetRecommendNum() int64 {
+	if x != nil {
+		return x.RecommendNum
 	}
+	return 0
 }
 
-func (x *RewardListRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UserInfoReply) GetRecommendTeamNum() int64 {
+	if x != nil {
+		return x.RecommendTeamNum
+	}
+	return 0
 }
 
-func (*RewardListRequest) ProtoMessage() {}
-
-func (x *RewardListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *UserInfoReply) GetTotal() string {
+	if x != nil {
+		return x.Total
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use RewardListRequest.ProtoReflect.Descriptor instead.
-func (*RewardListRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{8}
+func (x *UserInfoReply) GetRecommendTotal() string {
+	if x != nil {
+		return x.RecommendTotal
+	}
+	return ""
 }
 
-func (x *RewardListRequest) GetType() string {
+func (x *UserInfoReply) GetLocationTotal() string {
 	if x != nil {
-		return x.Type
+		return x.LocationTotal
 	}
 	return ""
 }
 
-type RewardListReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Rewards []*RewardListReply_List `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+func (x *UserInfoReply) GetUsdt() string {
+	if x != nil {
+		return x.Usdt
+	}
+	return ""
 }
 
-func (x *RewardListReply) Reset() {
-	*x = RewardListReply{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *UserInfoReply) GetAccount() string {
+	if x != nil {
+		return x.Account
 	}
+	return ""
 }
 
-func (x *RewardListReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UserInfoReply) GetAmountB() string {
+	if x != nil {
+		return x.AmountB
+	}
+	return ""
 }
 
-func (*RewardListReply) ProtoMessage() {}
-
-func (x *RewardListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *UserInfoReply) GetRecommendVipTotal() string {
+	if x != nil {
+		return x.RecommendVipTotal
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use RewardListReply.ProtoReflect.Descriptor instead.
-func (*RewardListReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{9}
+func (x *UserInfoReply) GetFeeDaily() string {
+	if x != nil {
+		return x.FeeDaily
+	}
+	return ""
 }
 
-func (x *RewardListReply) GetRewards() []*RewardListReply_List {
+func (x *UserInfoReply) GetWithdrawAmount() string {
 	if x != nil {
-		return x.Rewards
+		return x.WithdrawAmount
 	}
-	return nil
+	return ""
 }
 
-type RecommendRewardListRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *UserInfoReply) GetUserCount() string {
+	if x != nil {
+		return x.UserCount
+	}
+	return ""
 }
 
-func (x *RecommendRewardListRequest) Reset() {
-	*x = RecommendRewardListRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *UserInfoReply) GetTotalDeposit() string {
+	if x != nil {
+		return x.TotalDeposit
 	}
+	return ""
 }
 
-func (x *RecommendRewardListRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UserInfoReply) GetPoolAmount() string {
+	if x != nil {
+		return x.PoolAmount
+	}
+	return ""
 }
 
-func (*RecommendRewardListRequest) ProtoMessage() {}
-
-func (x *RecommendRewardListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *UserInfoReply) GetLocationCount() int64 {
+	if x != nil {
+		return x.LocationCount
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use RecommendRewardListRequest.ProtoReflect.Descriptor instead.
-func (*RecommendRewardListRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{10}
+func (x *UserInfoReply) GetTodayReward() string {
+	if x != nil {
+		return x.TodayReward
+	}
+	return ""
 }
 
-type RecommendRewardListReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Rewards []*RecommendRewardListReply_List `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+func (x *UserInfoReply) GetRecommendTop() string {
+	if x != nil {
+		return x.RecommendTop
+	}
+	return ""
 }
 
-func (x *RecommendRewardListReply) Reset() {
-	*x = RecommendRewardListReply{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *UserInfoReply) GetFybPrice() string {
+	if x != nil {
+		return x.FybPrice
 	}
+	return ""
 }
 
-func (x *RecommendRewardListReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UserInfoReply) GetFybRate() string {
+	if x != nil {
+		return x.FybRate
+	}
+	return ""
 }
 
-func (*RecommendRewardListReply) ProtoMessage() {}
-
-func (x *RecommendRewardListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *UserInfoReply) GetAreaAmount() string {
+	if x != nil {
+		return x.AreaAmount
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use RecommendRewardListReply.ProtoReflect.Descriptor instead.
-func (*RecommendRewardListReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{11}
+func (x *UserInfoReply) GetRecommendAreaTotal() string {
+	if x != nil {
+		return x.RecommendAreaTotal
+	}
+	return ""
 }
 
-func (x *RecommendRewardListReply) GetRewards() []*RecommendRewardListReply_List {
+func (x *UserInfoReply) GetAreaMaxAmount() string {
 	if x != nil {
-		return x.Rewards
+		return x.AreaMaxAmount
 	}
-	return nil
+	return ""
 }
 
-type FeeRewardListRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *UserInfoReply) GetUndo() int64 {
+	if x != nil {
+		return x.Undo
+	}
+	return 0
 }
 
-func (x *FeeRewardListRequest) Reset() {
-	*x = FeeRewardListRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *UserInfoReply) GetAmountC() string {
+	if x != nil {
+		return x.AmountC
 	}
+	return ""
 }
 
-func (x *FeeRewardListRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UserInfoReply) GetAmountBalanceReward() string {
+	if x != nil {
+		return x.AmountBalanceReward
+	}
+	return ""
 }
 
-func (*FeeRewardListRequest) ProtoMessage() {}
-
-func (x *FeeRewardListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *UserInfoReply) GetAreaName() string {
+	if x != nil {
+		return x.AreaName
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use FeeRewardListRequest.ProtoReflect.Descriptor instead.
-func (*FeeRewardListRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{12}
+func (x *UserInfoReply) GetRecommendTeamTotal() string {
+	if x != nil {
+		return x.RecommendTeamTotal
+	}
+	return ""
 }
 
-type FeeRewardListReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Rewards []*FeeRewardListReply_List `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+func (x *UserInfoReply) GetLocationDailyRewardTotal() string {
+	if x != nil {
+		return x.LocationDailyRewardTotal
+	}
+	return ""
 }
 
-func (x *FeeRewardListReply) Reset() {
-	*x = FeeRewardListReply{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *UserInfoReply) GetDailyBalanceRewardTotal() string {
+	if x != nil {
+		return x.DailyBalanceRewardTotal
 	}
+	return ""
 }
 
-func (x *FeeRewardListReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UserInfoReply) GetYesterdayRecommendTeamTotal() string {
+	if x != nil {
+		return x.YesterdayRecommendTeamTotal
+	}
+	return ""
 }
 
-func (*FeeRewardListReply) ProtoMessage() {}
-
-func (x *FeeRewardListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *UserInfoReply) GetYesterdayLocationDailyRewardTotal() string {
+	if x != nil {
+		return x.YesterdayLocationDailyRewardTotal
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use FeeRewardListReply.ProtoReflect.Descriptor instead.
-func (*FeeRewardListReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{13}
+func (x *UserInfoReply) GetYesterdayDailyBalanceRewardTotal() string {
+	if x != nil {
+		return x.YesterdayDailyBalanceRewardTotal
+	}
+	return ""
 }
 
-func (x *FeeRewardListReply) GetRewards() []*FeeRewardListReply_List {
+func (x *UserInfoReply) GetYesterdayRecommendAreaTotal() string {
 	if x != nil {
-		return x.Rewards
+		return x.YesterdayRecommendAreaTotal
 	}
-	return nil
+	return ""
 }
 
-type WithdrawListRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+func (x *UserInfoReply) GetYesterdayRecommendTotal() string {
+	if x != nil {
+		return x.YesterdayRecommendTotal
+	}
+	return ""
 }
 
-func (x *WithdrawListRequest) Reset() {
-	*x = WithdrawListRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *UserInfoReply) GetTotalAreaAmount() string {
+	if x != nil {
+		return x.TotalAreaAmount
 	}
+	return ""
 }
 
-func (x *WithdrawListRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UserInfoReply) GetLocationRunningAmount() string {
+	if x != nil {
+		return x.LocationRunningAmount
+	}
+	return ""
 }
 
-func (*WithdrawListRequest) ProtoMessage() {}
-
-func (x *WithdrawListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *UserInfoReply) GetLocationList() []*UserInfoReply_List {
+	if x != nil {
+		return x.LocationList
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use WithdrawListRequest.ProtoReflect.Descriptor instead.
-func (*WithdrawListRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{14}
+func (x *UserInfoReply) GetRecommendTeamList() []*UserInfoReply_List2 {
+	if x != nil {
+		return x.RecommendTeamList
+	}
+	return nil
 }
 
-func (x *WithdrawListRequest) GetType() string {
+func (x *UserInfoReply) GetRecommendAreaList() []*UserInfoReply_List3 {
 	if x != nil {
-		return x.Type
+		return x.RecommendAreaList
 	}
-	return ""
+	return nil
 }
 
-type WithdrawListReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Withdraw []*WithdrawListReply_List `protobuf:"bytes,1,rep,name=withdraw,proto3" json:"withdraw,omitempty"`
+func (x *UserInfoReply) GetLocationDailyRewardList() []*UserInfoReply_List4 {
+	if x != nil {
+		return x.LocationDailyRewardList
+	}
+	return nil
 }
 
-func (x *WithdrawListReply) Reset() {
-	*x = WithdrawListReply{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *UserInfoReply) GetRecommendList() []*UserInfoReply_List5 {
+	if x != nil {
+		return x.RecommendList
 	}
+	return nil
 }
 
-func (x *WithdrawListReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UserInfoReply) GetDailyBalanceRewardList() []*UserInfoReply_List6 {
+	if x != nil {
+		return x.DailyBalanceRewardList
+	}
+	return nil
 }
 
-func (*WithdrawListReply) ProtoMessage() {}
-
-func (x *WithdrawListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *UserInfoReply) GetTeamAddressList() []*UserInfoReply_List7 {
+	if x != nil {
+		return x.TeamAddressList
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use WithdrawListReply.ProtoReflect.Descriptor instead.
-func (*WithdrawListReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{15}
+func (x *UserInfoReply) GetMyRecommendAddressList() []*UserInfoReply_List8 {
+	if x != nil {
+		return x.MyRecommendAddressList
+	}
+	return nil
 }
 
-func (x *WithdrawListReply) GetWithdraw() []*WithdrawListReply_List {
+func (x *UserInfoReply) GetAllRewardList() []*UserInfoReply_List9 {
 	if x != nil {
-		return x.Withdraw
+		return x.AllRewardList
 	}
 	return nil
 }
 
-type RecommendListRequest struct {
+type RewardListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Type      string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Reason    string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	StartDate string `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   string `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Cursor    string `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit     int64  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *RecommendListRequest) Reset() {
-	*x = RecommendListRequest{}
+func (x *RewardListRequest) Reset() {
+	*x = RewardListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1162,13 +1191,13 @@ func (x *RecommendListRequest) Reset() {
 	}
 }
 
-func (x *RecommendListRequest) String() string {
+func (x *RewardListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecommendListRequest) ProtoMessage() {}
+func (*RewardListRequest) ProtoMessage() {}
 
-func (x *RecommendListRequest) ProtoReflect() protoreflect.Message {
+func (x *RewardListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1180,28 +1209,64 @@ func (x *RecommendListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecommendListRequest.ProtoReflect.Descriptor instead.
-func (*RecommendListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RewardListRequest.ProtoReflect.Descriptor instead.
+func (*RewardListRequest) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *RecommendListRequest) GetType() string {
+func (x *RewardListRequest) GetType() string {
 	if x != nil {
 		return x.Type
 	}
 	return ""
 }
 
-type RecommendListReply struct {
+func (x *RewardListRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RewardListRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *RewardListRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *RewardListRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *RewardListRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type RewardListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Recommends []*RecommendListReply_List `protobuf:"bytes,1,rep,name=recommends,proto3" json:"recommends,omitempty"`
+	Rewards    []*RewardListReply_List `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	NextCursor string                  `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 }
 
-func (x *RecommendListReply) Reset() {
-	*x = RecommendListReply{}
+func (x *RewardListReply) Reset() {
+	*x = RewardListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1209,13 +1274,13 @@ func (x *RecommendListReply) Reset() {
 	}
 }
 
-func (x *RecommendListReply) String() string {
+func (x *RewardListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecommendListReply) ProtoMessage() {}
+func (*RewardListReply) ProtoMessage() {}
 
-func (x *RecommendListReply) ProtoReflect() protoreflect.Message {
+func (x *RewardListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1227,28 +1292,39 @@ func (x *RecommendListReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecommendListReply.ProtoReflect.Descriptor instead.
-func (*RecommendListReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use RewardListReply.ProtoReflect.Descriptor instead.
+func (*RewardListReply) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *RecommendListReply) GetRecommends() []*RecommendListReply_List {
+func (x *RewardListReply) GetRewards() []*RewardListReply_List {
 	if x != nil {
-		return x.Recommends
+		return x.Rewards
 	}
 	return nil
 }
 
-type WithdrawRequest struct {
+func (x *RewardListReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type RecommendRewardListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SendBody *WithdrawRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+	Reason    string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	StartDate string `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   string `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Cursor    string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit     int64  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *WithdrawRequest) Reset() {
-	*x = WithdrawRequest{}
+func (x *RecommendRewardListRequest) Reset() {
+	*x = RecommendRewardListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1256,13 +1332,13 @@ func (x *WithdrawRequest) Reset() {
 	}
 }
 
-func (x *WithdrawRequest) String() string {
+func (x *RecommendRewardListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawRequest) ProtoMessage() {}
+func (*RecommendRewardListRequest) ProtoMessage() {}
 
-func (x *WithdrawRequest) ProtoReflect() protoreflect.Message {
+func (x *RecommendRewardListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1274,28 +1350,57 @@ func (x *WithdrawRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawRequest.ProtoReflect.Descriptor instead.
-func (*WithdrawRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecommendRewardListRequest.ProtoReflect.Descriptor instead.
+func (*RecommendRewardListRequest) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *WithdrawRequest) GetSendBody() *WithdrawRequest_SendBody {
+func (x *RecommendRewardListRequest) GetReason() string {
 	if x != nil {
-		return x.SendBody
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-type WithdrawReply struct {
+func (x *RecommendRewardListRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *RecommendRewardListRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *RecommendRewardListRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *RecommendRewardListRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type RecommendRewardListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Rewards    []*RecommendRewardListReply_List `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	NextCursor string                           `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 }
 
-func (x *WithdrawReply) Reset() {
-	*x = WithdrawReply{}
+func (x *RecommendRewardListReply) Reset() {
+	*x = RecommendRewardListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1303,13 +1408,13 @@ func (x *WithdrawReply) Reset() {
 	}
 }
 
-func (x *WithdrawReply) String() string {
+func (x *RecommendRewardListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawReply) ProtoMessage() {}
+func (*RecommendRewardListReply) ProtoMessage() {}
 
-func (x *WithdrawReply) ProtoReflect() protoreflect.Message {
+func (x *RecommendRewardListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1321,28 +1426,38 @@ func (x *WithdrawReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawReply.ProtoReflect.Descriptor instead.
-func (*WithdrawReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecommendRewardListReply.ProtoReflect.Descriptor instead.
+func (*RecommendRewardListReply) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *WithdrawReply) GetStatus() string {
+func (x *RecommendRewardListReply) GetRewards() []*RecommendRewardListReply_List {
 	if x != nil {
-		return x.Status
+		return x.Rewards
+	}
+	return nil
+}
+
+func (x *RecommendRewardListReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
 	}
 	return ""
 }
 
-type SetBalanceRewardRequest struct {
+type FeeRewardListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SendBody *SetBalanceRewardRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+	StartDate string `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   string `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Cursor    string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit     int64  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *SetBalanceRewardRequest) Reset() {
-	*x = SetBalanceRewardRequest{}
+func (x *FeeRewardListRequest) Reset() {
+	*x = FeeRewardListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1350,13 +1465,13 @@ func (x *SetBalanceRewardRequest) Reset() {
 	}
 }
 
-func (x *SetBalanceRewardRequest) String() string {
+func (x *FeeRewardListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetBalanceRewardRequest) ProtoMessage() {}
+func (*FeeRewardListRequest) ProtoMessage() {}
 
-func (x *SetBalanceRewardRequest) ProtoReflect() protoreflect.Message {
+func (x *FeeRewardListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1368,28 +1483,50 @@ func (x *SetBalanceRewardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetBalanceRewardRequest.ProtoReflect.Descriptor instead.
-func (*SetBalanceRewardRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FeeRewardListRequest.ProtoReflect.Descriptor instead.
+func (*FeeRewardListRequest) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *SetBalanceRewardRequest) GetSendBody() *SetBalanceRewardRequest_SendBody {
+func (x *FeeRewardListRequest) GetStartDate() string {
 	if x != nil {
-		return x.SendBody
+		return x.StartDate
 	}
-	return nil
+	return ""
 }
 
-type SetBalanceRewardReply struct {
+func (x *FeeRewardListRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *FeeRewardListRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *FeeRewardListRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type FeeRewardListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Rewards    []*FeeRewardListReply_List `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	NextCursor string                     `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 }
 
-func (x *SetBalanceRewardReply) Reset() {
-	*x = SetBalanceRewardReply{}
+func (x *FeeRewardListReply) Reset() {
+	*x = FeeRewardListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1397,13 +1534,13 @@ func (x *SetBalanceRewardReply) Reset() {
 	}
 }
 
-func (x *SetBalanceRewardReply) String() string {
+func (x *FeeRewardListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetBalanceRewardReply) ProtoMessage() {}
+func (*FeeRewardListReply) ProtoMessage() {}
 
-func (x *SetBalanceRewardReply) ProtoReflect() protoreflect.Message {
+func (x *FeeRewardListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1415,28 +1552,35 @@ func (x *SetBalanceRewardReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetBalanceRewardReply.ProtoReflect.Descriptor instead.
-func (*SetBalanceRewardReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use FeeRewardListReply.ProtoReflect.Descriptor instead.
+func (*FeeRewardListReply) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *SetBalanceRewardReply) GetStatus() string {
+func (x *FeeRewardListReply) GetRewards() []*FeeRewardListReply_List {
 	if x != nil {
-		return x.Status
+		return x.Rewards
+	}
+	return nil
+}
+
+func (x *FeeRewardListReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
 	}
 	return ""
 }
 
-type DeleteBalanceRewardRequest struct {
+type WithdrawListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SendBody *DeleteBalanceRewardRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 }
 
-func (x *DeleteBalanceRewardRequest) Reset() {
-	*x = DeleteBalanceRewardRequest{}
+func (x *WithdrawListRequest) Reset() {
+	*x = WithdrawListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1444,13 +1588,13 @@ func (x *DeleteBalanceRewardRequest) Reset() {
 	}
 }
 
-func (x *DeleteBalanceRewardRequest) String() string {
+func (x *WithdrawListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBalanceRewardRequest) ProtoMessage() {}
+func (*WithdrawListRequest) ProtoMessage() {}
 
-func (x *DeleteBalanceRewardRequest) ProtoReflect() protoreflect.Message {
+func (x *WithdrawListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1462,28 +1606,28 @@ func (x *DeleteBalanceRewardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteBalanceRewardRequest.ProtoReflect.Descriptor instead.
-func (*DeleteBalanceRewardRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WithdrawListRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawListRequest) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *DeleteBalanceRewardRequest) GetSendBody() *DeleteBalanceRewardRequest_SendBody {
+func (x *WithdrawListRequest) GetType() string {
 	if x != nil {
-		return x.SendBody
+		return x.Type
 	}
-	return nil
+	return ""
 }
 
-type DeleteBalanceRewardReply struct {
+type WithdrawListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Withdraw []*WithdrawListReply_List `protobuf:"bytes,1,rep,name=withdraw,proto3" json:"withdraw,omitempty"`
 }
 
-func (x *DeleteBalanceRewardReply) Reset() {
-	*x = DeleteBalanceRewardReply{}
+func (x *WithdrawListReply) Reset() {
+	*x = WithdrawListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1491,13 +1635,13 @@ func (x *DeleteBalanceRewardReply) Reset() {
 	}
 }
 
-func (x *DeleteBalanceRewardReply) String() string {
+func (x *WithdrawListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBalanceRewardReply) ProtoMessage() {}
+func (*WithdrawListReply) ProtoMessage() {}
 
-func (x *DeleteBalanceRewardReply) ProtoReflect() protoreflect.Message {
+func (x *WithdrawListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1509,29 +1653,28 @@ func (x *DeleteBalanceRewardReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteBalanceRewardReply.ProtoReflect.Descriptor instead.
-func (*DeleteBalanceRewardReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use WithdrawListReply.ProtoReflect.Descriptor instead.
+func (*WithdrawListReply) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *DeleteBalanceRewardReply) GetStatus() string {
+func (x *WithdrawListReply) GetWithdraw() []*WithdrawListReply_List {
 	if x != nil {
-		return x.Status
+		return x.Withdraw
 	}
-	return ""
+	return nil
 }
 
-type AdminRewardListRequest struct {
+type RecommendListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 }
 
-func (x *AdminRewardListRequest) Reset() {
-	*x = AdminRewardListRequest{}
+func (x *RecommendListRequest) Reset() {
+	*x = RecommendListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1539,13 +1682,13 @@ func (x *AdminRewardListRequest) Reset() {
 	}
 }
 
-func (x *AdminRewardListRequest) String() string {
+func (x *RecommendListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminRewardListRequest) ProtoMessage() {}
+func (*RecommendListRequest) ProtoMessage() {}
 
-func (x *AdminRewardListRequest) ProtoReflect() protoreflect.Message {
+func (x *RecommendListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1557,36 +1700,28 @@ func (x *AdminRewardListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminRewardListRequest.ProtoReflect.Descriptor instead.
-func (*AdminRewardListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecommendListRequest.ProtoReflect.Descriptor instead.
+func (*RecommendListRequest) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *AdminRewardListRequest) GetPage() int64 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *AdminRewardListRequest) GetAddress() string {
+func (x *RecommendListRequest) GetType() string {
 	if x != nil {
-		return x.Address
+		return x.Type
 	}
 	return ""
 }
 
-type AdminRewardListReply struct {
+type RecommendListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rewards []*AdminRewardListReply_List `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
-	Count   int64                        `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Recommends []*RecommendListReply_List `protobuf:"bytes,1,rep,name=recommends,proto3" json:"recommends,omitempty"`
 }
 
-func (x *AdminRewardListReply) Reset() {
-	*x = AdminRewardListReply{}
+func (x *RecommendListReply) Reset() {
+	*x = RecommendListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1594,13 +1729,13 @@ func (x *AdminRewardListReply) Reset() {
 	}
 }
 
-func (x *AdminRewardListReply) String() string {
+func (x *RecommendListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminRewardListReply) ProtoMessage() {}
+func (*RecommendListReply) ProtoMessage() {}
 
-func (x *AdminRewardListReply) ProtoReflect() protoreflect.Message {
+func (x *RecommendListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1612,36 +1747,35 @@ func (x *AdminRewardListReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminRewardListReply.ProtoReflect.Descriptor instead.
-func (*AdminRewardListReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecommendListReply.ProtoReflect.Descriptor instead.
+func (*RecommendListReply) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *AdminRewardListReply) GetRewards() []*AdminRewardListReply_List {
+func (x *RecommendListReply) GetRecommends() []*RecommendListReply_List {
 	if x != nil {
-		return x.Rewards
+		return x.Recommends
 	}
 	return nil
 }
 
-func (x *AdminRewardListReply) GetCount() int64 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
-}
-
-type AdminUserListRequest struct {
+type RecommendTreeNode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	UserId          int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Address         string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	CreatedAt       string `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LocationAmount  string `protobuf:"bytes,4,opt,name=location_amount,json=locationAmount,proto3" json:"location_amount,omitempty"`
+	AreaAmount      string `protobuf:"bytes,5,opt,name=area_amount,json=areaAmount,proto3" json:"area_amount,omitempty"`
+	DescendantCount int64  `protobuf:"varint,6,opt,name=descendant_count,json=descendantCount,proto3" json:"descendant_count,omitempty"`
+	ParentId        int64  `protobuf:"varint,7,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Depth           int64  `protobuf:"varint,8,opt,name=depth,proto3" json:"depth,omitempty"`
 }
 
-func (x *AdminUserListRequest) Reset() {
-	*x = AdminUserListRequest{}
+func (x *RecommendTreeNode) Reset() {
+	*x = RecommendTreeNode{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1649,13 +1783,13 @@ func (x *AdminUserListRequest) Reset() {
 	}
 }
 
-func (x *AdminUserListRequest) String() string {
+func (x *RecommendTreeNode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUserListRequest) ProtoMessage() {}
+func (*RecommendTreeNode) ProtoMessage() {}
 
-func (x *AdminUserListRequest) ProtoReflect() protoreflect.Message {
+func (x *RecommendTreeNode) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1667,36 +1801,78 @@ func (x *AdminUserListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUserListRequest.ProtoReflect.Descriptor instead.
-func (*AdminUserListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecommendTreeNode.ProtoReflect.Descriptor instead.
+func (*RecommendTreeNode) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *AdminUserListRequest) GetPage() int64 {
+func (x *RecommendTreeNode) GetUserId() int64 {
 	if x != nil {
-		return x.Page
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *AdminUserListRequest) GetAddress() string {
+func (x *RecommendTreeNode) GetAddress() string {
 	if x != nil {
 		return x.Address
 	}
 	return ""
 }
 
-type AdminUserListReply struct {
+func (x *RecommendTreeNode) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *RecommendTreeNode) GetLocationAmount() string {
+	if x != nil {
+		return x.LocationAmount
+	}
+	return ""
+}
+
+func (x *RecommendTreeNode) GetAreaAmount() string {
+	if x != nil {
+		return x.AreaAmount
+	}
+	return ""
+}
+
+func (x *RecommendTreeNode) GetDescendantCount() int64 {
+	if x != nil {
+		return x.DescendantCount
+	}
+	return 0
+}
+
+func (x *RecommendTreeNode) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *RecommendTreeNode) GetDepth() int64 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+type RecommendTreeMatch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Users []*AdminUserListReply_UserList `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	Count int64                          `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Node *RecommendTreeNode `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Path []int64            `protobuf:"varint,2,rep,packed,name=path,proto3" json:"path,omitempty"`
 }
 
-func (x *AdminUserListReply) Reset() {
-	*x = AdminUserListReply{}
+func (x *RecommendTreeMatch) Reset() {
+	*x = RecommendTreeMatch{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1704,13 +1880,13 @@ func (x *AdminUserListReply) Reset() {
 	}
 }
 
-func (x *AdminUserListReply) String() string {
+func (x *RecommendTreeMatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUserListReply) ProtoMessage() {}
+func (*RecommendTreeMatch) ProtoMessage() {}
 
-func (x *AdminUserListReply) ProtoReflect() protoreflect.Message {
+func (x *RecommendTreeMatch) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1722,36 +1898,37 @@ func (x *AdminUserListReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUserListReply.ProtoReflect.Descriptor instead.
-func (*AdminUserListReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecommendTreeMatch.ProtoReflect.Descriptor instead.
+func (*RecommendTreeMatch) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *AdminUserListReply) GetUsers() []*AdminUserListReply_UserList {
+func (x *RecommendTreeMatch) GetNode() *RecommendTreeNode {
 	if x != nil {
-		return x.Users
+		return x.Node
 	}
 	return nil
 }
 
-func (x *AdminUserListReply) GetCount() int64 {
+func (x *RecommendTreeMatch) GetPath() []int64 {
 	if x != nil {
-		return x.Count
+		return x.Path
 	}
-	return 0
+	return nil
 }
 
-type AdminLocationListRequest struct {
+type RecommendTreeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	UserId  int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Depth   int64  `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *AdminLocationListRequest) Reset() {
-	*x = AdminLocationListRequest{}
+func (x *RecommendTreeRequest) Reset() {
+	*x = RecommendTreeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1759,13 +1936,13 @@ func (x *AdminLocationListRequest) Reset() {
 	}
 }
 
-func (x *AdminLocationListRequest) String() string {
+func (x *RecommendTreeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminLocationListRequest) ProtoMessage() {}
+func (*RecommendTreeRequest) ProtoMessage() {}
 
-func (x *AdminLocationListRequest) ProtoReflect() protoreflect.Message {
+func (x *RecommendTreeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1777,36 +1954,45 @@ func (x *AdminLocationListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminLocationListRequest.ProtoReflect.Descriptor instead.
-func (*AdminLocationListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecommendTreeRequest.ProtoReflect.Descriptor instead.
+func (*RecommendTreeRequest) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *AdminLocationListRequest) GetPage() int64 {
+func (x *RecommendTreeRequest) GetUserId() int64 {
 	if x != nil {
-		return x.Page
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *AdminLocationListRequest) GetAddress() string {
+func (x *RecommendTreeRequest) GetDepth() int64 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *RecommendTreeRequest) GetAddress() string {
 	if x != nil {
 		return x.Address
 	}
 	return ""
 }
 
-type AdminLocationListReply struct {
+type RecommendTreeReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Locations []*AdminLocationListReply_LocationList `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
-	Count     int64                                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Node     *RecommendTreeNode    `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Path     []*RecommendTreeNode  `protobuf:"bytes,2,rep,name=path,proto3" json:"path,omitempty"`
+	Children []*RecommendTreeNode  `protobuf:"bytes,3,rep,name=children,proto3" json:"children,omitempty"`
+	Matches  []*RecommendTreeMatch `protobuf:"bytes,4,rep,name=matches,proto3" json:"matches,omitempty"`
 }
 
-func (x *AdminLocationListReply) Reset() {
-	*x = AdminLocationListReply{}
+func (x *RecommendTreeReply) Reset() {
+	*x = RecommendTreeReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1814,13 +2000,13 @@ func (x *AdminLocationListReply) Reset() {
 	}
 }
 
-func (x *AdminLocationListReply) String() string {
+func (x *RecommendTreeReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminLocationListReply) ProtoMessage() {}
+func (*RecommendTreeReply) ProtoMessage() {}
 
-func (x *AdminLocationListReply) ProtoReflect() protoreflect.Message {
+func (x *RecommendTreeReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1832,36 +2018,49 @@ func (x *AdminLocationListReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminLocationListReply.ProtoReflect.Descriptor instead.
-func (*AdminLocationListReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecommendTreeReply.ProtoReflect.Descriptor instead.
+func (*RecommendTreeReply) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *AdminLocationListReply) GetLocations() []*AdminLocationListReply_LocationList {
+func (x *RecommendTreeReply) GetNode() *RecommendTreeNode {
 	if x != nil {
-		return x.Locations
+		return x.Node
 	}
 	return nil
 }
 
-func (x *AdminLocationListReply) GetCount() int64 {
+func (x *RecommendTreeReply) GetPath() []*RecommendTreeNode {
 	if x != nil {
-		return x.Count
+		return x.Path
 	}
-	return 0
+	return nil
 }
 
-type AdminWithdrawListRequest struct {
+func (x *RecommendTreeReply) GetChildren() []*RecommendTreeNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *RecommendTreeReply) GetMatches() []*RecommendTreeMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+type WithdrawRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	SendBody *WithdrawRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminWithdrawListRequest) Reset() {
-	*x = AdminWithdrawListRequest{}
+func (x *WithdrawRequest) Reset() {
+	*x = WithdrawRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1869,13 +2068,13 @@ func (x *AdminWithdrawListRequest) Reset() {
 	}
 }
 
-func (x *AdminWithdrawListRequest) String() string {
+func (x *WithdrawRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminWithdrawListRequest) ProtoMessage() {}
+func (*WithdrawRequest) ProtoMessage() {}
 
-func (x *AdminWithdrawListRequest) ProtoReflect() protoreflect.Message {
+func (x *WithdrawRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1887,36 +2086,32 @@ func (x *AdminWithdrawListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminWithdrawListRequest.ProtoReflect.Descriptor instead.
-func (*AdminWithdrawListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WithdrawRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawRequest) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *AdminWithdrawListRequest) GetPage() int64 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *AdminWithdrawListRequest) GetAddress() string {
+func (x *WithdrawRequest) GetSendBody() *WithdrawRequest_SendBody {
 	if x != nil {
-		return x.Address
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-type AdminWithdrawListReply struct {
+type WithdrawReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Withdraw []*AdminWithdrawListReply_List `protobuf:"bytes,1,rep,name=withdraw,proto3" json:"withdraw,omitempty"`
-	Count    int64                          `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Status    string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Reason    string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message   string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Fee       string `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	RelAmount string `protobuf:"bytes,5,opt,name=rel_amount,json=relAmount,proto3" json:"rel_amount,omitempty"`
 }
 
-func (x *AdminWithdrawListReply) Reset() {
-	*x = AdminWithdrawListReply{}
+func (x *WithdrawReply) Reset() {
+	*x = WithdrawReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1924,13 +2119,13 @@ func (x *AdminWithdrawListReply) Reset() {
 	}
 }
 
-func (x *AdminWithdrawListReply) String() string {
+func (x *WithdrawReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminWithdrawListReply) ProtoMessage() {}
+func (*WithdrawReply) ProtoMessage() {}
 
-func (x *AdminWithdrawListReply) ProtoReflect() protoreflect.Message {
+func (x *WithdrawReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1942,33 +2137,56 @@ func (x *AdminWithdrawListReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminWithdrawListReply.ProtoReflect.Descriptor instead.
-func (*AdminWithdrawListReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use WithdrawReply.ProtoReflect.Descriptor instead.
+func (*WithdrawReply) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *AdminWithdrawListReply) GetWithdraw() []*AdminWithdrawListReply_List {
+func (x *WithdrawReply) GetStatus() string {
 	if x != nil {
-		return x.Withdraw
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-func (x *AdminWithdrawListReply) GetCount() int64 {
+func (x *WithdrawReply) GetReason() string {
 	if x != nil {
-		return x.Count
+		return x.Reason
 	}
-	return 0
+	return ""
+}
+
+func (x *WithdrawReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *WithdrawReply) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+func (x *WithdrawReply) GetRelAmount() string {
+	if x != nil {
+		return x.RelAmount
+	}
+	return ""
 }
 
-type AdminWithdrawRequest struct {
+type CancelWithdrawRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	SendBody *CancelWithdrawRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminWithdrawRequest) Reset() {
-	*x = AdminWithdrawRequest{}
+func (x *CancelWithdrawRequest) Reset() {
+	*x = CancelWithdrawRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1976,13 +2194,13 @@ func (x *AdminWithdrawRequest) Reset() {
 	}
 }
 
-func (x *AdminWithdrawRequest) String() string {
+func (x *CancelWithdrawRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminWithdrawRequest) ProtoMessage() {}
+func (*CancelWithdrawRequest) ProtoMessage() {}
 
-func (x *AdminWithdrawRequest) ProtoReflect() protoreflect.Message {
+func (x *CancelWithdrawRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1994,19 +2212,28 @@ func (x *AdminWithdrawRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminWithdrawRequest.ProtoReflect.Descriptor instead.
-func (*AdminWithdrawRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelWithdrawRequest.ProtoReflect.Descriptor instead.
+func (*CancelWithdrawRequest) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{32}
 }
 
-type AdminWithdrawReply struct {
+func (x *CancelWithdrawRequest) GetSendBody() *CancelWithdrawRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type CancelWithdrawReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *AdminWithdrawReply) Reset() {
-	*x = AdminWithdrawReply{}
+func (x *CancelWithdrawReply) Reset() {
+	*x = CancelWithdrawReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2014,13 +2241,13 @@ func (x *AdminWithdrawReply) Reset() {
 	}
 }
 
-func (x *AdminWithdrawReply) String() string {
+func (x *CancelWithdrawReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminWithdrawReply) ProtoMessage() {}
+func (*CancelWithdrawReply) ProtoMessage() {}
 
-func (x *AdminWithdrawReply) ProtoReflect() protoreflect.Message {
+func (x *CancelWithdrawReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2032,19 +2259,26 @@ func (x *AdminWithdrawReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminWithdrawReply.ProtoReflect.Descriptor instead.
-func (*AdminWithdrawReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelWithdrawReply.ProtoReflect.Descriptor instead.
+func (*CancelWithdrawReply) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{33}
 }
 
-type AdminWithdrawEthRequest struct {
+func (x *CancelWithdrawReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type WithdrawAddressListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AdminWithdrawEthRequest) Reset() {
-	*x = AdminWithdrawEthRequest{}
+func (x *WithdrawAddressListRequest) Reset() {
+	*x = WithdrawAddressListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2052,13 +2286,13 @@ func (x *AdminWithdrawEthRequest) Reset() {
 	}
 }
 
-func (x *AdminWithdrawEthRequest) String() string {
+func (x *WithdrawAddressListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminWithdrawEthRequest) ProtoMessage() {}
+func (*WithdrawAddressListRequest) ProtoMessage() {}
 
-func (x *AdminWithdrawEthRequest) ProtoReflect() protoreflect.Message {
+func (x *WithdrawAddressListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2070,19 +2304,21 @@ func (x *AdminWithdrawEthRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminWithdrawEthRequest.ProtoReflect.Descriptor instead.
-func (*AdminWithdrawEthRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WithdrawAddressListRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawAddressListRequest) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{34}
 }
 
-type AdminWithdrawEthReply struct {
+type WithdrawAddressListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	List []*WithdrawAddressListReply_List `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
 }
 
-func (x *AdminWithdrawEthReply) Reset() {
-	*x = AdminWithdrawEthReply{}
+func (x *WithdrawAddressListReply) Reset() {
+	*x = WithdrawAddressListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2090,13 +2326,13 @@ func (x *AdminWithdrawEthReply) Reset() {
 	}
 }
 
-func (x *AdminWithdrawEthReply) String() string {
+func (x *WithdrawAddressListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminWithdrawEthReply) ProtoMessage() {}
+func (*WithdrawAddressListReply) ProtoMessage() {}
 
-func (x *AdminWithdrawEthReply) ProtoReflect() protoreflect.Message {
+func (x *WithdrawAddressListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2108,19 +2344,28 @@ func (x *AdminWithdrawEthReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminWithdrawEthReply.ProtoReflect.Descriptor instead.
-func (*AdminWithdrawEthReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use WithdrawAddressListReply.ProtoReflect.Descriptor instead.
+func (*WithdrawAddressListReply) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{35}
 }
 
-type AdminFeeRequest struct {
+func (x *WithdrawAddressListReply) GetList() []*WithdrawAddressListReply_List {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type AddWithdrawAddressRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	SendBody *AddWithdrawAddressRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminFeeRequest) Reset() {
-	*x = AdminFeeRequest{}
+func (x *AddWithdrawAddressRequest) Reset() {
+	*x = AddWithdrawAddressRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2128,13 +2373,13 @@ func (x *AdminFeeRequest) Reset() {
 	}
 }
 
-func (x *AdminFeeRequest) String() string {
+func (x *AddWithdrawAddressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminFeeRequest) ProtoMessage() {}
+func (*AddWithdrawAddressRequest) ProtoMessage() {}
 
-func (x *AdminFeeRequest) ProtoReflect() protoreflect.Message {
+func (x *AddWithdrawAddressRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2146,19 +2391,29 @@ func (x *AdminFeeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminFeeRequest.ProtoReflect.Descriptor instead.
-func (*AdminFeeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddWithdrawAddressRequest.ProtoReflect.Descriptor instead.
+func (*AddWithdrawAddressRequest) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{36}
 }
 
-type AdminFeeReply struct {
+func (x *AddWithdrawAddressRequest) GetSendBody() *AddWithdrawAddressRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type AddWithdrawAddressReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id         int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActivateAt string `protobuf:"bytes,2,opt,name=activate_at,json=activateAt,proto3" json:"activate_at,omitempty"`
 }
 
-func (x *AdminFeeReply) Reset() {
-	*x = AdminFeeReply{}
+func (x *AddWithdrawAddressReply) Reset() {
+	*x = AddWithdrawAddressReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2166,13 +2421,13 @@ func (x *AdminFeeReply) Reset() {
 	}
 }
 
-func (x *AdminFeeReply) String() string {
+func (x *AddWithdrawAddressReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminFeeReply) ProtoMessage() {}
+func (*AddWithdrawAddressReply) ProtoMessage() {}
 
-func (x *AdminFeeReply) ProtoReflect() protoreflect.Message {
+func (x *AddWithdrawAddressReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2184,19 +2439,35 @@ func (x *AdminFeeReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminFeeReply.ProtoReflect.Descriptor instead.
-func (*AdminFeeReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddWithdrawAddressReply.ProtoReflect.Descriptor instead.
+func (*AddWithdrawAddressReply) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{37}
 }
 
-type AdminAllRequest struct {
+func (x *AddWithdrawAddressReply) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AddWithdrawAddressReply) GetActivateAt() string {
+	if x != nil {
+		return x.ActivateAt
+	}
+	return ""
+}
+
+type DeleteWithdrawAddressRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	SendBody *DeleteWithdrawAddressRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminAllRequest) Reset() {
-	*x = AdminAllRequest{}
+func (x *DeleteWithdrawAddressRequest) Reset() {
+	*x = DeleteWithdrawAddressRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2204,13 +2475,13 @@ func (x *AdminAllRequest) Reset() {
 	}
 }
 
-func (x *AdminAllRequest) String() string {
+func (x *DeleteWithdrawAddressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminAllRequest) ProtoMessage() {}
+func (*DeleteWithdrawAddressRequest) ProtoMessage() {}
 
-func (x *AdminAllRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteWithdrawAddressRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2222,29 +2493,28 @@ func (x *AdminAllRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminAllRequest.ProtoReflect.Descriptor instead.
-func (*AdminAllRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteWithdrawAddressRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWithdrawAddressRequest) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{38}
 }
 
-type AdminAllReply struct {
+func (x *DeleteWithdrawAddressRequest) GetSendBody() *DeleteWithdrawAddressRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type DeleteWithdrawAddressReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TodayTotalUser        int64  `protobuf:"varint,1,opt,name=todayTotalUser,proto3" json:"todayTotalUser,omitempty"`
-	TotalUser             int64  `protobuf:"varint,2,opt,name=totalUser,proto3" json:"totalUser,omitempty"`
-	AllBalance            string `protobuf:"bytes,3,opt,name=allBalance,proto3" json:"allBalance,omitempty"`
-	TodayLocation         string `protobuf:"bytes,4,opt,name=todayLocation,proto3" json:"todayLocation,omitempty"`
-	AllLocation           string `protobuf:"bytes,5,opt,name=allLocation,proto3" json:"allLocation,omitempty"`
-	TodayWithdraw         string `protobuf:"bytes,6,opt,name=todayWithdraw,proto3" json:"todayWithdraw,omitempty"`
-	AllWithdraw           string `protobuf:"bytes,7,opt,name=allWithdraw,proto3" json:"allWithdraw,omitempty"`
-	AllReward             string `protobuf:"bytes,8,opt,name=allReward,proto3" json:"allReward,omitempty"`
-	AllSystemRewardAndFee string `protobuf:"bytes,9,opt,name=allSystemRewardAndFee,proto3" json:"allSystemRewardAndFee,omitempty"`
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *AdminAllReply) Reset() {
-	*x = AdminAllReply{}
+func (x *DeleteWithdrawAddressReply) Reset() {
+	*x = DeleteWithdrawAddressReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2252,13 +2522,13 @@ func (x *AdminAllReply) Reset() {
 	}
 }
 
-func (x *AdminAllReply) String() string {
+func (x *DeleteWithdrawAddressReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminAllReply) ProtoMessage() {}
+func (*DeleteWithdrawAddressReply) ProtoMessage() {}
 
-func (x *AdminAllReply) ProtoReflect() protoreflect.Message {
+func (x *DeleteWithdrawAddressReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2270,84 +2540,28 @@ func (x *AdminAllReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminAllReply.ProtoReflect.Descriptor instead.
-func (*AdminAllReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteWithdrawAddressReply.ProtoReflect.Descriptor instead.
+func (*DeleteWithdrawAddressReply) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *AdminAllReply) GetTodayTotalUser() int64 {
-	if x != nil {
-		return x.TodayTotalUser
-	}
-	return 0
-}
-
-func (x *AdminAllReply) GetTotalUser() int64 {
-	if x != nil {
-		return x.TotalUser
-	}
-	return 0
-}
-
-func (x *AdminAllReply) GetAllBalance() string {
-	if x != nil {
-		return x.AllBalance
-	}
-	return ""
-}
-
-func (x *AdminAllReply) GetTodayLocation() string {
-	if x != nil {
-		return x.TodayLocation
-	}
-	return ""
-}
-
-func (x *AdminAllReply) GetAllLocation() string {
-	if x != nil {
-		return x.AllLocation
-	}
-	return ""
-}
-
-func (x *AdminAllReply) GetTodayWithdraw() string {
-	if x != nil {
-		return x.TodayWithdraw
-	}
-	return ""
-}
-
-func (x *AdminAllReply) GetAllWithdraw() string {
-	if x != nil {
-		return x.AllWithdraw
-	}
-	return ""
-}
-
-func (x *AdminAllReply) GetAllReward() string {
-	if x != nil {
-		return x.AllReward
-	}
-	return ""
-}
-
-func (x *AdminAllReply) GetAllSystemRewardAndFee() string {
+func (x *DeleteWithdrawAddressReply) GetStatus() string {
 	if x != nil {
-		return x.AllSystemRewardAndFee
+		return x.Status
 	}
 	return ""
 }
 
-type AdminUserRecommendRequest struct {
+type SetBalanceRewardRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SendBody *SetBalanceRewardRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminUserRecommendRequest) Reset() {
-	*x = AdminUserRecommendRequest{}
+func (x *SetBalanceRewardRequest) Reset() {
+	*x = SetBalanceRewardRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2355,13 +2569,13 @@ func (x *AdminUserRecommendRequest) Reset() {
 	}
 }
 
-func (x *AdminUserRecommendRequest) String() string {
+func (x *SetBalanceRewardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUserRecommendRequest) ProtoMessage() {}
+func (*SetBalanceRewardRequest) ProtoMessage() {}
 
-func (x *AdminUserRecommendRequest) ProtoReflect() protoreflect.Message {
+func (x *SetBalanceRewardRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2373,28 +2587,28 @@ func (x *AdminUserRecommendRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUserRecommendRequest.ProtoReflect.Descriptor instead.
-func (*AdminUserRecommendRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetBalanceRewardRequest.ProtoReflect.Descriptor instead.
+func (*SetBalanceRewardRequest) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *AdminUserRecommendRequest) GetUserId() int64 {
+func (x *SetBalanceRewardRequest) GetSendBody() *SetBalanceRewardRequest_SendBody {
 	if x != nil {
-		return x.UserId
+		return x.SendBody
 	}
-	return 0
+	return nil
 }
 
-type AdminUserRecommendReply struct {
+type SetBalanceRewardReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Users []*AdminUserRecommendReply_List `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *AdminUserRecommendReply) Reset() {
-	*x = AdminUserRecommendReply{}
+func (x *SetBalanceRewardReply) Reset() {
+	*x = SetBalanceRewardReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2402,13 +2616,13 @@ func (x *AdminUserRecommendReply) Reset() {
 	}
 }
 
-func (x *AdminUserRecommendReply) String() string {
+func (x *SetBalanceRewardReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUserRecommendReply) ProtoMessage() {}
+func (*SetBalanceRewardReply) ProtoMessage() {}
 
-func (x *AdminUserRecommendReply) ProtoReflect() protoreflect.Message {
+func (x *SetBalanceRewardReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2420,43 +2634,42 @@ func (x *AdminUserRecommendReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUserRecommendReply.ProtoReflect.Descriptor instead.
-func (*AdminUserRecommendReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetBalanceRewardReply.ProtoReflect.Descriptor instead.
+func (*SetBalanceRewardReply) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *AdminUserRecommendReply) GetUsers() []*AdminUserRecommendReply_List {
+func (x *SetBalanceRewardReply) GetStatus() string {
 	if x != nil {
-		return x.Users
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-type AdminMonthRecommendRequest struct {
+type DeleteBalanceRewardRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Page    int64  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	SendBody *DeleteBalanceRewardRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminMonthRecommendRequest) Reset() {
-	*x = AdminMonthRecommendRequest{}
-	if protoimpl.UnsafeEnabled {
+func (x *DeleteBalanceRewardRequest) Reset() {
+	*x = DeleteBalanceRewardRequest{}
+	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminMonthRecommendRequest) String() string {
+func (x *DeleteBalanceRewardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminMonthRecommendRequest) ProtoMessage() {}
+func (*DeleteBalanceRewardRequest) ProtoMessage() {}
 
-func (x *AdminMonthRecommendRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteBalanceRewardRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2468,36 +2681,28 @@ func (x *AdminMonthRecommendRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminMonthRecommendRequest.ProtoReflect.Descriptor instead.
-func (*AdminMonthRecommendRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteBalanceRewardRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBalanceRewardRequest) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *AdminMonthRecommendRequest) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *AdminMonthRecommendRequest) GetPage() int64 {
+func (x *DeleteBalanceRewardRequest) GetSendBody() *DeleteBalanceRewardRequest_SendBody {
 	if x != nil {
-		return x.Page
+		return x.SendBody
 	}
-	return 0
+	return nil
 }
 
-type AdminMonthRecommendReply struct {
+type DeleteBalanceRewardReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Users []*AdminMonthRecommendReply_List `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	Count int64                            `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *AdminMonthRecommendReply) Reset() {
-	*x = AdminMonthRecommendReply{}
+func (x *DeleteBalanceRewardReply) Reset() {
+	*x = DeleteBalanceRewardReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2505,13 +2710,13 @@ func (x *AdminMonthRecommendReply) Reset() {
 	}
 }
 
-func (x *AdminMonthRecommendReply) String() string {
+func (x *DeleteBalanceRewardReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminMonthRecommendReply) ProtoMessage() {}
+func (*DeleteBalanceRewardReply) ProtoMessage() {}
 
-func (x *AdminMonthRecommendReply) ProtoReflect() protoreflect.Message {
+func (x *DeleteBalanceRewardReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2523,36 +2728,29 @@ func (x *AdminMonthRecommendReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminMonthRecommendReply.ProtoReflect.Descriptor instead.
-func (*AdminMonthRecommendReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteBalanceRewardReply.ProtoReflect.Descriptor instead.
+func (*DeleteBalanceRewardReply) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *AdminMonthRecommendReply) GetUsers() []*AdminMonthRecommendReply_List {
-	if x != nil {
-		return x.Users
-	}
-	return nil
-}
-
-func (x *AdminMonthRecommendReply) GetCount() int64 {
+func (x *DeleteBalanceRewardReply) GetStatus() string {
 	if x != nil {
-		return x.Count
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-type AdminConfigRequest struct {
+type AdminRewardListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int64 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	Page   int64 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *AdminConfigRequest) Reset() {
-	*x = AdminConfigRequest{}
+func (x *AdminRewardListRequest) Reset() {
+	*x = AdminRewardListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2560,13 +2758,13 @@ func (x *AdminConfigRequest) Reset() {
 	}
 }
 
-func (x *AdminConfigRequest) String() string {
+func (x *AdminRewardListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminConfigRequest) ProtoMessage() {}
+func (*AdminRewardListRequest) ProtoMessage() {}
 
-func (x *AdminConfigRequest) ProtoReflect() protoreflect.Message {
+func (x *AdminRewardListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2578,36 +2776,36 @@ func (x *AdminConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminConfigRequest.ProtoReflect.Descriptor instead.
-func (*AdminConfigRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminRewardListRequest.ProtoReflect.Descriptor instead.
+func (*AdminRewardListRequest) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *AdminConfigRequest) GetUserId() int64 {
+func (x *AdminRewardListRequest) GetPage() int64 {
 	if x != nil {
-		return x.UserId
+		return x.Page
 	}
 	return 0
 }
 
-func (x *AdminConfigRequest) GetPage() int64 {
+func (x *AdminRewardListRequest) GetAddress() string {
 	if x != nil {
-		return x.Page
+		return x.Address
 	}
-	return 0
+	return ""
 }
 
-type AdminConfigReply struct {
+type AdminRewardListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Config []*AdminConfigReply_List `protobuf:"bytes,1,rep,name=config,proto3" json:"config,omitempty"`
-	Count  int64                    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Rewards []*AdminRewardListReply_List `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	Count   int64                        `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *AdminConfigReply) Reset() {
-	*x = AdminConfigReply{}
+func (x *AdminRewardListReply) Reset() {
+	*x = AdminRewardListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2615,13 +2813,13 @@ func (x *AdminConfigReply) Reset() {
 	}
 }
 
-func (x *AdminConfigReply) String() string {
+func (x *AdminRewardListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminConfigReply) ProtoMessage() {}
+func (*AdminRewardListReply) ProtoMessage() {}
 
-func (x *AdminConfigReply) ProtoReflect() protoreflect.Message {
+func (x *AdminRewardListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2633,35 +2831,36 @@ func (x *AdminConfigReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminConfigReply.ProtoReflect.Descriptor instead.
-func (*AdminConfigReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminRewardListReply.ProtoReflect.Descriptor instead.
+func (*AdminRewardListReply) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *AdminConfigReply) GetConfig() []*AdminConfigReply_List {
+func (x *AdminRewardListReply) GetRewards() []*AdminRewardListReply_List {
 	if x != nil {
-		return x.Config
+		return x.Rewards
 	}
 	return nil
 }
 
-func (x *AdminConfigReply) GetCount() int64 {
+func (x *AdminRewardListReply) GetCount() int64 {
 	if x != nil {
 		return x.Count
 	}
 	return 0
 }
 
-type AdminConfigUpdateRequest struct {
+type AdminUserListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SendBody *AdminConfigUpdateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *AdminConfigUpdateRequest) Reset() {
-	*x = AdminConfigUpdateRequest{}
+func (x *AdminUserListRequest) Reset() {
+	*x = AdminUserListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2669,13 +2868,13 @@ func (x *AdminConfigUpdateRequest) Reset() {
 	}
 }
 
-func (x *AdminConfigUpdateRequest) String() string {
+func (x *AdminUserListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminConfigUpdateRequest) ProtoMessage() {}
+func (*AdminUserListRequest) ProtoMessage() {}
 
-func (x *AdminConfigUpdateRequest) ProtoReflect() protoreflect.Message {
+func (x *AdminUserListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2687,26 +2886,36 @@ func (x *AdminConfigUpdateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminConfigUpdateRequest.ProtoReflect.Descriptor instead.
-func (*AdminConfigUpdateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminUserListRequest.ProtoReflect.Descriptor instead.
+func (*AdminUserListRequest) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *AdminConfigUpdateRequest) GetSendBody() *AdminConfigUpdateRequest_SendBody {
+func (x *AdminUserListRequest) GetPage() int64 {
 	if x != nil {
-		return x.SendBody
+		return x.Page
 	}
-	return nil
+	return 0
 }
 
-type AdminConfigUpdateReply struct {
+func (x *AdminUserListRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type AdminUserListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Users []*AdminUserListReply_UserList `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Count int64                          `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *AdminConfigUpdateReply) Reset() {
-	*x = AdminConfigUpdateReply{}
+func (x *AdminUserListReply) Reset() {
+	*x = AdminUserListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2714,13 +2923,13 @@ func (x *AdminConfigUpdateReply) Reset() {
 	}
 }
 
-func (x *AdminConfigUpdateReply) String() string {
+func (x *AdminUserListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminConfigUpdateReply) ProtoMessage() {}
+func (*AdminUserListReply) ProtoMessage() {}
 
-func (x *AdminConfigUpdateReply) ProtoReflect() protoreflect.Message {
+func (x *AdminUserListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2732,22 +2941,36 @@ func (x *AdminConfigUpdateReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminConfigUpdateReply.ProtoReflect.Descriptor instead.
-func (*AdminConfigUpdateReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminUserListReply.ProtoReflect.Descriptor instead.
+func (*AdminUserListReply) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{47}
 }
 
-type EthAuthorizeRequest_SendBody struct {
+func (x *AdminUserListReply) GetUsers() []*AdminUserListReply_UserList {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *AdminUserListReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AdminLocationListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Code    string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *EthAuthorizeRequest_SendBody) Reset() {
-	*x = EthAuthorizeRequest_SendBody{}
+func (x *AdminLocationListRequest) Reset() {
+	*x = AdminLocationListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2755,13 +2978,13 @@ func (x *EthAuthorizeRequest_SendBody) Reset() {
 	}
 }
 
-func (x *EthAuthorizeRequest_SendBody) String() string {
+func (x *AdminLocationListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EthAuthorizeRequest_SendBody) ProtoMessage() {}
+func (*AdminLocationListRequest) ProtoMessage() {}
 
-func (x *EthAuthorizeRequest_SendBody) ProtoReflect() protoreflect.Message {
+func (x *AdminLocationListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2773,35 +2996,36 @@ func (x *EthAuthorizeRequest_SendBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EthAuthorizeRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*EthAuthorizeRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use AdminLocationListRequest.ProtoReflect.Descriptor instead.
+func (*AdminLocationListRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *EthAuthorizeRequest_SendBody) GetAddress() string {
+func (x *AdminLocationListRequest) GetPage() int64 {
 	if x != nil {
-		return x.Address
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *EthAuthorizeRequest_SendBody) GetCode() string {
+func (x *AdminLocationListRequest) GetAddress() string {
 	if x != nil {
-		return x.Code
+		return x.Address
 	}
 	return ""
 }
 
-type RecommendUpdateRequest_SendBody struct {
+type AdminLocationListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Locations []*AdminLocationListReply_LocationList `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
+	Count     int64                                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *RecommendUpdateRequest_SendBody) Reset() {
-	*x = RecommendUpdateRequest_SendBody{}
+func (x *AdminLocationListReply) Reset() {
+	*x = AdminLocationListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2809,13 +3033,13 @@ func (x *RecommendUpdateRequest_SendBody) Reset() {
 	}
 }
 
-func (x *RecommendUpdateRequest_SendBody) String() string {
+func (x *AdminLocationListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecommendUpdateRequest_SendBody) ProtoMessage() {}
+func (*AdminLocationListReply) ProtoMessage() {}
 
-func (x *RecommendUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
+func (x *AdminLocationListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2827,32 +3051,36 @@ func (x *RecommendUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecommendUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*RecommendUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{2, 0}
+// Deprecated: Use AdminLocationListReply.ProtoReflect.Descriptor instead.
+func (*AdminLocationListReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *RecommendUpdateRequest_SendBody) GetCode() string {
+func (x *AdminLocationListReply) GetLocations() []*AdminLocationListReply_LocationList {
 	if x != nil {
-		return x.Code
+		return x.Locations
 	}
-	return ""
+	return nil
 }
 
-type UserInfoReply_List struct {
+func (x *AdminLocationListReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AdminWithdrawListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt      string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount         string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	LocationStatus string `protobuf:"bytes,3,opt,name=locationStatus,proto3" json:"locationStatus,omitempty"`
-	AmountMax      string `protobuf:"bytes,4,opt,name=amountMax,proto3" json:"amountMax,omitempty"`
-	OutRate        string `protobuf:"bytes,5,opt,name=outRate,proto3" json:"outRate,omitempty"`
+	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *UserInfoReply_List) Reset() {
-	*x = UserInfoReply_List{}
+func (x *AdminWithdrawListRequest) Reset() {
+	*x = AdminWithdrawListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2860,13 +3088,13 @@ func (x *UserInfoReply_List) Reset() {
 	}
 }
 
-func (x *UserInfoReply_List) String() string {
+func (x *AdminWithdrawListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfoReply_List) ProtoMessage() {}
+func (*AdminWithdrawListRequest) ProtoMessage() {}
 
-func (x *UserInfoReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminWithdrawListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2878,58 +3106,36 @@ func (x *UserInfoReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfoReply_List.ProtoReflect.Descriptor instead.
-func (*UserInfoReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{7, 0}
-}
-
-func (x *UserInfoReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *UserInfoReply_List) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
-}
-
-func (x *UserInfoReply_List) GetLocationStatus() string {
-	if x != nil {
-		return x.LocationStatus
-	}
-	return ""
+// Deprecated: Use AdminWithdrawListRequest.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawListRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *UserInfoReply_List) GetAmountMax() string {
+func (x *AdminWithdrawListRequest) GetPage() int64 {
 	if x != nil {
-		return x.AmountMax
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *UserInfoReply_List) GetOutRate() string {
+func (x *AdminWithdrawListRequest) GetAddress() string {
 	if x != nil {
-		return x.OutRate
+		return x.Address
 	}
 	return ""
 }
 
-type UserInfoReply_List2 struct {
+type AdminWithdrawListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt    string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount       string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	RecommendNum int64  `protobuf:"varint,3,opt,name=recommend_num,json=recommendNum,proto3" json:"recommend_num,omitempty"`
+	Withdraw []*AdminWithdrawListReply_List `protobuf:"bytes,1,rep,name=withdraw,proto3" json:"withdraw,omitempty"`
+	Count    int64                          `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *UserInfoReply_List2) Reset() {
-	*x = UserInfoReply_List2{}
+func (x *AdminWithdrawListReply) Reset() {
+	*x = AdminWithdrawListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2937,13 +3143,13 @@ func (x *UserInfoReply_List2) Reset() {
 	}
 }
 
-func (x *UserInfoReply_List2) String() string {
+func (x *AdminWithdrawListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfoReply_List2) ProtoMessage() {}
+func (*AdminWithdrawListReply) ProtoMessage() {}
 
-func (x *UserInfoReply_List2) ProtoReflect() protoreflect.Message {
+func (x *AdminWithdrawListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2955,43 +3161,35 @@ func (x *UserInfoReply_List2) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfoReply_List2.ProtoReflect.Descriptor instead.
-func (*UserInfoReply_List2) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{7, 1}
-}
-
-func (x *UserInfoReply_List2) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
+// Deprecated: Use AdminWithdrawListReply.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawListReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *UserInfoReply_List2) GetAmount() string {
+func (x *AdminWithdrawListReply) GetWithdraw() []*AdminWithdrawListReply_List {
 	if x != nil {
-		return x.Amount
+		return x.Withdraw
 	}
-	return ""
+	return nil
 }
 
-func (x *UserInfoReply_List2) GetRecommendNum() int64 {
+func (x *AdminWithdrawListReply) GetCount() int64 {
 	if x != nil {
-		return x.RecommendNum
+		return x.Count
 	}
 	return 0
 }
 
-type UserInfoReply_List3 struct {
+type AdminLoginRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	SendBody *AdminLoginRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *UserInfoReply_List3) Reset() {
-	*x = UserInfoReply_List3{}
+func (x *AdminLoginRequest) Reset() {
+	*x = AdminLoginRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2999,13 +3197,13 @@ func (x *UserInfoReply_List3) Reset() {
 	}
 }
 
-func (x *UserInfoReply_List3) String() string {
+func (x *AdminLoginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfoReply_List3) ProtoMessage() {}
+func (*AdminLoginRequest) ProtoMessage() {}
 
-func (x *UserInfoReply_List3) ProtoReflect() protoreflect.Message {
+func (x *AdminLoginRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3017,36 +3215,30 @@ func (x *UserInfoReply_List3) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfoReply_List3.ProtoReflect.Descriptor instead.
-func (*UserInfoReply_List3) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{7, 2}
-}
-
-func (x *UserInfoReply_List3) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
+// Deprecated: Use AdminLoginRequest.ProtoReflect.Descriptor instead.
+func (*AdminLoginRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *UserInfoReply_List3) GetAmount() string {
+func (x *AdminLoginRequest) GetSendBody() *AdminLoginRequest_SendBody {
 	if x != nil {
-		return x.Amount
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-type UserInfoReply_List4 struct {
+type AdminLoginReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Token        string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken string `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	Role         string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
 }
 
-func (x *UserInfoReply_List4) Reset() {
-	*x = UserInfoReply_List4{}
+func (x *AdminLoginReply) Reset() {
+	*x = AdminLoginReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3054,13 +3246,13 @@ func (x *UserInfoReply_List4) Reset() {
 	}
 }
 
-func (x *UserInfoReply_List4) String() string {
+func (x *AdminLoginReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfoReply_List4) ProtoMessage() {}
+func (*AdminLoginReply) ProtoMessage() {}
 
-func (x *UserInfoReply_List4) ProtoReflect() protoreflect.Message {
+func (x *AdminLoginReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3072,36 +3264,42 @@ func (x *UserInfoReply_List4) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfoReply_List4.ProtoReflect.Descriptor instead.
-func (*UserInfoReply_List4) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{7, 3}
+// Deprecated: Use AdminLoginReply.ProtoReflect.Descriptor instead.
+func (*AdminLoginReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *UserInfoReply_List4) GetCreatedAt() string {
+func (x *AdminLoginReply) GetToken() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Token
 	}
 	return ""
 }
 
-func (x *UserInfoReply_List4) GetAmount() string {
+func (x *AdminLoginReply) GetRefreshToken() string {
 	if x != nil {
-		return x.Amount
+		return x.RefreshToken
 	}
 	return ""
 }
 
-type UserInfoReply_List5 struct {
+func (x *AdminLoginReply) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type AdminWithdrawApproveRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	SendBody *AdminWithdrawApproveRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *UserInfoReply_List5) Reset() {
-	*x = UserInfoReply_List5{}
+func (x *AdminWithdrawApproveRequest) Reset() {
+	*x = AdminWithdrawApproveRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3109,13 +3307,13 @@ func (x *UserInfoReply_List5) Reset() {
 	}
 }
 
-func (x *UserInfoReply_List5) String() string {
+func (x *AdminWithdrawApproveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfoReply_List5) ProtoMessage() {}
+func (*AdminWithdrawApproveRequest) ProtoMessage() {}
 
-func (x *UserInfoReply_List5) ProtoReflect() protoreflect.Message {
+func (x *AdminWithdrawApproveRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3127,36 +3325,28 @@ func (x *UserInfoReply_List5) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfoReply_List5.ProtoReflect.Descriptor instead.
-func (*UserInfoReply_List5) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{7, 4}
-}
-
-func (x *UserInfoReply_List5) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
+// Deprecated: Use AdminWithdrawApproveRequest.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawApproveRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *UserInfoReply_List5) GetAmount() string {
+func (x *AdminWithdrawApproveRequest) GetSendBody() *AdminWithdrawApproveRequest_SendBody {
 	if x != nil {
-		return x.Amount
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-type UserInfoReply_List6 struct {
+type AdminWithdrawApproveReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *UserInfoReply_List6) Reset() {
-	*x = UserInfoReply_List6{}
+func (x *AdminWithdrawApproveReply) Reset() {
+	*x = AdminWithdrawApproveReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3164,13 +3354,13 @@ func (x *UserInfoReply_List6) Reset() {
 	}
 }
 
-func (x *UserInfoReply_List6) String() string {
+func (x *AdminWithdrawApproveReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfoReply_List6) ProtoMessage() {}
+func (*AdminWithdrawApproveReply) ProtoMessage() {}
 
-func (x *UserInfoReply_List6) ProtoReflect() protoreflect.Message {
+func (x *AdminWithdrawApproveReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3182,36 +3372,28 @@ func (x *UserInfoReply_List6) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfoReply_List6.ProtoReflect.Descriptor instead.
-func (*UserInfoReply_List6) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{7, 5}
-}
-
-func (x *UserInfoReply_List6) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
+// Deprecated: Use AdminWithdrawApproveReply.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawApproveReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *UserInfoReply_List6) GetAmount() string {
+func (x *AdminWithdrawApproveReply) GetStatus() string {
 	if x != nil {
-		return x.Amount
+		return x.Status
 	}
 	return ""
 }
 
-type UserInfoReply_List7 struct {
+type AdminWithdrawRejectRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Amount  string `protobuf:"bytes,2,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	SendBody *AdminWithdrawRejectRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *UserInfoReply_List7) Reset() {
-	*x = UserInfoReply_List7{}
+func (x *AdminWithdrawRejectRequest) Reset() {
+	*x = AdminWithdrawRejectRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3219,13 +3401,13 @@ func (x *UserInfoReply_List7) Reset() {
 	}
 }
 
-func (x *UserInfoReply_List7) String() string {
+func (x *AdminWithdrawRejectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfoReply_List7) ProtoMessage() {}
+func (*AdminWithdrawRejectRequest) ProtoMessage() {}
 
-func (x *UserInfoReply_List7) ProtoReflect() protoreflect.Message {
+func (x *AdminWithdrawRejectRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3237,35 +3419,28 @@ func (x *UserInfoReply_List7) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfoReply_List7.ProtoReflect.Descriptor instead.
-func (*UserInfoReply_List7) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{7, 6}
-}
-
-func (x *UserInfoReply_List7) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+// Deprecated: Use AdminWithdrawRejectRequest.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawRejectRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *UserInfoReply_List7) GetAmount() string {
+func (x *AdminWithdrawRejectRequest) GetSendBody() *AdminWithdrawRejectRequest_SendBody {
 	if x != nil {
-		return x.Amount
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-type UserInfoReply_List8 struct {
+type AdminWithdrawRejectReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *UserInfoReply_List8) Reset() {
-	*x = UserInfoReply_List8{}
+func (x *AdminWithdrawRejectReply) Reset() {
+	*x = AdminWithdrawRejectReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3273,13 +3448,13 @@ func (x *UserInfoReply_List8) Reset() {
 	}
 }
 
-func (x *UserInfoReply_List8) String() string {
+func (x *AdminWithdrawRejectReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfoReply_List8) ProtoMessage() {}
+func (*AdminWithdrawRejectReply) ProtoMessage() {}
 
-func (x *UserInfoReply_List8) ProtoReflect() protoreflect.Message {
+func (x *AdminWithdrawRejectReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3291,29 +3466,28 @@ func (x *UserInfoReply_List8) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfoReply_List8.ProtoReflect.Descriptor instead.
-func (*UserInfoReply_List8) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{7, 7}
+// Deprecated: Use AdminWithdrawRejectReply.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawRejectReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *UserInfoReply_List8) GetAddress() string {
+func (x *AdminWithdrawRejectReply) GetStatus() string {
 	if x != nil {
-		return x.Address
+		return x.Status
 	}
 	return ""
 }
 
-type UserInfoReply_List9 struct {
+type AdminWithdrawRetryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	SendBody *AdminWithdrawRetryRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *UserInfoReply_List9) Reset() {
-	*x = UserInfoReply_List9{}
+func (x *AdminWithdrawRetryRequest) Reset() {
+	*x = AdminWithdrawRetryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3321,13 +3495,13 @@ func (x *UserInfoReply_List9) Reset() {
 	}
 }
 
-func (x *UserInfoReply_List9) String() string {
+func (x *AdminWithdrawRetryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfoReply_List9) ProtoMessage() {}
+func (*AdminWithdrawRetryRequest) ProtoMessage() {}
 
-func (x *UserInfoReply_List9) ProtoReflect() protoreflect.Message {
+func (x *AdminWithdrawRetryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3339,38 +3513,28 @@ func (x *UserInfoReply_List9) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfoReply_List9.ProtoReflect.Descriptor instead.
-func (*UserInfoReply_List9) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{7, 8}
-}
-
-func (x *UserInfoReply_List9) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
+// Deprecated: Use AdminWithdrawRetryRequest.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawRetryRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *UserInfoReply_List9) GetAmount() string {
+func (x *AdminWithdrawRetryRequest) GetSendBody() *AdminWithdrawRetryRequest_SendBody {
 	if x != nil {
-		return x.Amount
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-type RewardListReply_List struct {
+type AdminWithdrawRetryReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt      string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount         string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	LocationStatus string `protobuf:"bytes,3,opt,name=locationStatus,proto3" json:"locationStatus,omitempty"`
-	Type           string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *RewardListReply_List) Reset() {
-	*x = RewardListReply_List{}
+func (x *AdminWithdrawRetryReply) Reset() {
+	*x = AdminWithdrawRetryReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3378,13 +3542,13 @@ func (x *RewardListReply_List) Reset() {
 	}
 }
 
-func (x *RewardListReply_List) String() string {
+func (x *AdminWithdrawRetryReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RewardListReply_List) ProtoMessage() {}
+func (*AdminWithdrawRetryReply) ProtoMessage() {}
 
-func (x *RewardListReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminWithdrawRetryReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3396,66 +3560,42 @@ func (x *RewardListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RewardListReply_List.ProtoReflect.Descriptor instead.
-func (*RewardListReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{9, 0}
+// Deprecated: Use AdminWithdrawRetryReply.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawRetryReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *RewardListReply_List) GetCreatedAt() string {
+func (x *AdminWithdrawRetryReply) GetStatus() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Status
 	}
 	return ""
 }
 
-func (x *RewardListReply_List) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
+type AdminWithdrawRefundRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendBody *AdminWithdrawRefundRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *RewardListReply_List) GetLocationStatus() string {
-	if x != nil {
-		return x.LocationStatus
+func (x *AdminWithdrawRefundRequest) Reset() {
+	*x = AdminWithdrawRefundRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *RewardListReply_List) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-type RecommendRewardListReply_List struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Type      string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
-}
-
-func (x *RecommendRewardListReply_List) Reset() {
-	*x = RecommendRewardListReply_List{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[60]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *RecommendRewardListReply_List) String() string {
+func (x *AdminWithdrawRefundRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecommendRewardListReply_List) ProtoMessage() {}
+func (*AdminWithdrawRefundRequest) ProtoMessage() {}
 
-func (x *RecommendRewardListReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminWithdrawRefundRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3467,50 +3607,28 @@ func (x *RecommendRewardListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecommendRewardListReply_List.ProtoReflect.Descriptor instead.
-func (*RecommendRewardListReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{11, 0}
-}
-
-func (x *RecommendRewardListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *RecommendRewardListReply_List) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
-}
-
-func (x *RecommendRewardListReply_List) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
+// Deprecated: Use AdminWithdrawRefundRequest.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawRefundRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *RecommendRewardListReply_List) GetReason() string {
+func (x *AdminWithdrawRefundRequest) GetSendBody() *AdminWithdrawRefundRequest_SendBody {
 	if x != nil {
-		return x.Reason
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-type FeeRewardListReply_List struct {
+type AdminWithdrawRefundReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *FeeRewardListReply_List) Reset() {
-	*x = FeeRewardListReply_List{}
+func (x *AdminWithdrawRefundReply) Reset() {
+	*x = AdminWithdrawRefundReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3518,13 +3636,13 @@ func (x *FeeRewardListReply_List) Reset() {
 	}
 }
 
-func (x *FeeRewardListReply_List) String() string {
+func (x *AdminWithdrawRefundReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FeeRewardListReply_List) ProtoMessage() {}
+func (*AdminWithdrawRefundReply) ProtoMessage() {}
 
-func (x *FeeRewardListReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminWithdrawRefundReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3536,38 +3654,28 @@ func (x *FeeRewardListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FeeRewardListReply_List.ProtoReflect.Descriptor instead.
-func (*FeeRewardListReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{13, 0}
-}
-
-func (x *FeeRewardListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
+// Deprecated: Use AdminWithdrawRefundReply.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawRefundReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *FeeRewardListReply_List) GetAmount() string {
+func (x *AdminWithdrawRefundReply) GetStatus() string {
 	if x != nil {
-		return x.Amount
+		return x.Status
 	}
 	return ""
 }
 
-type WithdrawListReply_List struct {
+type AdminWithdrawLegacyMigrateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Type      string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	Status    string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	SendBody *AdminWithdrawLegacyMigrateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *WithdrawListReply_List) Reset() {
-	*x = WithdrawListReply_List{}
+func (x *AdminWithdrawLegacyMigrateRequest) Reset() {
+	*x = AdminWithdrawLegacyMigrateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3575,13 +3683,13 @@ func (x *WithdrawListReply_List) Reset() {
 	}
 }
 
-func (x *WithdrawListReply_List) String() string {
+func (x *AdminWithdrawLegacyMigrateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawListReply_List) ProtoMessage() {}
+func (*AdminWithdrawLegacyMigrateRequest) ProtoMessage() {}
 
-func (x *WithdrawListReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminWithdrawLegacyMigrateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3593,50 +3701,28 @@ func (x *WithdrawListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawListReply_List.ProtoReflect.Descriptor instead.
-func (*WithdrawListReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{15, 0}
-}
-
-func (x *WithdrawListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *WithdrawListReply_List) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
-}
-
-func (x *WithdrawListReply_List) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
+// Deprecated: Use AdminWithdrawLegacyMigrateRequest.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawLegacyMigrateRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *WithdrawListReply_List) GetStatus() string {
+func (x *AdminWithdrawLegacyMigrateRequest) GetSendBody() *AdminWithdrawLegacyMigrateRequest_SendBody {
 	if x != nil {
-		return x.Status
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-type RecommendListReply_List struct {
+type AdminWithdrawLegacyMigrateReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *RecommendListReply_List) Reset() {
-	*x = RecommendListReply_List{}
+func (x *AdminWithdrawLegacyMigrateReply) Reset() {
+	*x = AdminWithdrawLegacyMigrateReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3644,13 +3730,13 @@ func (x *RecommendListReply_List) Reset() {
 	}
 }
 
-func (x *RecommendListReply_List) String() string {
+func (x *AdminWithdrawLegacyMigrateReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecommendListReply_List) ProtoMessage() {}
+func (*AdminWithdrawLegacyMigrateReply) ProtoMessage() {}
 
-func (x *RecommendListReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminWithdrawLegacyMigrateReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3662,36 +3748,28 @@ func (x *RecommendListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecommendListReply_List.ProtoReflect.Descriptor instead.
-func (*RecommendListReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{17, 0}
-}
-
-func (x *RecommendListReply_List) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
+// Deprecated: Use AdminWithdrawLegacyMigrateReply.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawLegacyMigrateReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *RecommendListReply_List) GetStatus() string {
+func (x *AdminWithdrawLegacyMigrateReply) GetCount() int64 {
 	if x != nil {
-		return x.Status
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-type WithdrawRequest_SendBody struct {
+type AdminWithdrawBulkApproveRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type   string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	SendBody *AdminWithdrawBulkApproveRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *WithdrawRequest_SendBody) Reset() {
-	*x = WithdrawRequest_SendBody{}
+func (x *AdminWithdrawBulkApproveRequest) Reset() {
+	*x = AdminWithdrawBulkApproveRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3699,13 +3777,13 @@ func (x *WithdrawRequest_SendBody) Reset() {
 	}
 }
 
-func (x *WithdrawRequest_SendBody) String() string {
+func (x *AdminWithdrawBulkApproveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawRequest_SendBody) ProtoMessage() {}
+func (*AdminWithdrawBulkApproveRequest) ProtoMessage() {}
 
-func (x *WithdrawRequest_SendBody) ProtoReflect() protoreflect.Message {
+func (x *AdminWithdrawBulkApproveRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3717,35 +3795,29 @@ func (x *WithdrawRequest_SendBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*WithdrawRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{18, 0}
-}
-
-func (x *WithdrawRequest_SendBody) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
+// Deprecated: Use AdminWithdrawBulkApproveRequest.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawBulkApproveRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *WithdrawRequest_SendBody) GetAmount() string {
+func (x *AdminWithdrawBulkApproveRequest) GetSendBody() *AdminWithdrawBulkApproveRequest_SendBody {
 	if x != nil {
-		return x.Amount
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-type SetBalanceRewardRequest_SendBody struct {
+type AdminWithdrawBulkApproveReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Approved []int64                               `protobuf:"varint,1,rep,packed,name=approved,proto3" json:"approved,omitempty"`
+	Failed   []*AdminWithdrawBulkApproveReply_Fail `protobuf:"bytes,2,rep,name=failed,proto3" json:"failed,omitempty"`
 }
 
-func (x *SetBalanceRewardRequest_SendBody) Reset() {
-	*x = SetBalanceRewardRequest_SendBody{}
+func (x *AdminWithdrawBulkApproveReply) Reset() {
+	*x = AdminWithdrawBulkApproveReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3753,13 +3825,13 @@ func (x *SetBalanceRewardRequest_SendBody) Reset() {
 	}
 }
 
-func (x *SetBalanceRewardRequest_SendBody) String() string {
+func (x *AdminWithdrawBulkApproveReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetBalanceRewardRequest_SendBody) ProtoMessage() {}
+func (*AdminWithdrawBulkApproveReply) ProtoMessage() {}
 
-func (x *SetBalanceRewardRequest_SendBody) ProtoReflect() protoreflect.Message {
+func (x *AdminWithdrawBulkApproveReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3771,28 +3843,33 @@ func (x *SetBalanceRewardRequest_SendBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetBalanceRewardRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*SetBalanceRewardRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{20, 0}
+// Deprecated: Use AdminWithdrawBulkApproveReply.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawBulkApproveReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *SetBalanceRewardRequest_SendBody) GetAmount() string {
+func (x *AdminWithdrawBulkApproveReply) GetApproved() []int64 {
 	if x != nil {
-		return x.Amount
+		return x.Approved
 	}
-	return ""
+	return nil
 }
 
-type DeleteBalanceRewardRequest_SendBody struct {
+func (x *AdminWithdrawBulkApproveReply) GetFailed() []*AdminWithdrawBulkApproveReply_Fail {
+	if x != nil {
+		return x.Failed
+	}
+	return nil
+}
+
+type AdminLedgerBalanceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *DeleteBalanceRewardRequest_SendBody) Reset() {
-	*x = DeleteBalanceRewardRequest_SendBody{}
+func (x *AdminLedgerBalanceRequest) Reset() {
+	*x = AdminLedgerBalanceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3800,13 +3877,13 @@ func (x *DeleteBalanceRewardRequest_SendBody) Reset() {
 	}
 }
 
-func (x *DeleteBalanceRewardRequest_SendBody) String() string {
+func (x *AdminLedgerBalanceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBalanceRewardRequest_SendBody) ProtoMessage() {}
+func (*AdminLedgerBalanceRequest) ProtoMessage() {}
 
-func (x *DeleteBalanceRewardRequest_SendBody) ProtoReflect() protoreflect.Message {
+func (x *AdminLedgerBalanceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3818,32 +3895,21 @@ func (x *DeleteBalanceRewardRequest_SendBody) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteBalanceRewardRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*DeleteBalanceRewardRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{22, 0}
-}
-
-func (x *DeleteBalanceRewardRequest_SendBody) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
+// Deprecated: Use AdminLedgerBalanceRequest.ProtoReflect.Descriptor instead.
+func (*AdminLedgerBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{66}
 }
 
-type AdminRewardListReply_List struct {
+type AdminLedgerBalanceReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Type      string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	Address   string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
-	Reason    string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Coins []*AdminLedgerBalanceReply_Coin `protobuf:"bytes,1,rep,name=coins,proto3" json:"coins,omitempty"`
 }
 
-func (x *AdminRewardListReply_List) Reset() {
-	*x = AdminRewardListReply_List{}
+func (x *AdminLedgerBalanceReply) Reset() {
+	*x = AdminLedgerBalanceReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3851,13 +3917,13 @@ func (x *AdminRewardListReply_List) Reset() {
 	}
 }
 
-func (x *AdminRewardListReply_List) String() string {
+func (x *AdminLedgerBalanceReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminRewardListReply_List) ProtoMessage() {}
+func (*AdminLedgerBalanceReply) ProtoMessage() {}
 
-func (x *AdminRewardListReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminLedgerBalanceReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3869,63 +3935,28 @@ func (x *AdminRewardListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminRewardListReply_List.ProtoReflect.Descriptor instead.
-func (*AdminRewardListReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{25, 0}
-}
-
-func (x *AdminRewardListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *AdminRewardListReply_List) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
-}
-
-func (x *AdminRewardListReply_List) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-func (x *AdminRewardListReply_List) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+// Deprecated: Use AdminLedgerBalanceReply.ProtoReflect.Descriptor instead.
+func (*AdminLedgerBalanceReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *AdminRewardListReply_List) GetReason() string {
+func (x *AdminLedgerBalanceReply) GetCoins() []*AdminLedgerBalanceReply_Coin {
 	if x != nil {
-		return x.Reason
+		return x.Coins
 	}
-	return ""
+	return nil
 }
 
-type AdminUserListReply_UserList struct {
+type AdminRecommendClosureBackfillRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId           int64  `protobuf:"varint,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CreatedAt        string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Address          string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	BalanceUsdt      string `protobuf:"bytes,3,opt,name=balanceUsdt,proto3" json:"balanceUsdt,omitempty"`
-	BalanceDhb       string `protobuf:"bytes,4,opt,name=balanceDhb,proto3" json:"balanceDhb,omitempty"`
-	Vip              int64  `protobuf:"varint,5,opt,name=vip,proto3" json:"vip,omitempty"`
-	MonthRecommend   int64  `protobuf:"varint,7,opt,name=monthRecommend,proto3" json:"monthRecommend,omitempty"`
-	HistoryRecommend int64  `protobuf:"varint,6,opt,name=historyRecommend,proto3" json:"historyRecommend,omitempty"`
+	SendBody *AdminRecommendClosureBackfillRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminUserListReply_UserList) Reset() {
-	*x = AdminUserListReply_UserList{}
+func (x *AdminRecommendClosureBackfillRequest) Reset() {
+	*x = AdminRecommendClosureBackfillRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3933,13 +3964,13 @@ func (x *AdminUserListReply_UserList) Reset() {
 	}
 }
 
-func (x *AdminUserListReply_UserList) String() string {
+func (x *AdminRecommendClosureBackfillRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUserListReply_UserList) ProtoMessage() {}
+func (*AdminRecommendClosureBackfillRequest) ProtoMessage() {}
 
-func (x *AdminUserListReply_UserList) ProtoReflect() protoreflect.Message {
+func (x *AdminRecommendClosureBackfillRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3951,99 +3982,90 @@ func (x *AdminUserListReply_UserList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUserListReply_UserList.ProtoReflect.Descriptor instead.
-func (*AdminUserListReply_UserList) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{27, 0}
+// Deprecated: Use AdminRecommendClosureBackfillRequest.ProtoReflect.Descriptor instead.
+func (*AdminRecommendClosureBackfillRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *AdminUserListReply_UserList) GetUserId() int64 {
+func (x *AdminRecommendClosureBackfillRequest) GetSendBody() *AdminRecommendClosureBackfillRequest_SendBody {
 	if x != nil {
-		return x.UserId
+		return x.SendBody
 	}
-	return 0
+	return nil
 }
 
-func (x *AdminUserListReply_UserList) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
+type AdminRecommendClosureBackfillReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *AdminUserListReply_UserList) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *AdminUserListReply_UserList) GetBalanceUsdt() string {
-	if x != nil {
-		return x.BalanceUsdt
+func (x *AdminRecommendClosureBackfillReply) Reset() {
+	*x = AdminRecommendClosureBackfillReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *AdminUserListReply_UserList) GetBalanceDhb() string {
-	if x != nil {
-		return x.BalanceDhb
-	}
-	return ""
+func (x *AdminRecommendClosureBackfillReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AdminUserListReply_UserList) GetVip() int64 {
-	if x != nil {
-		return x.Vip
+func (*AdminRecommendClosureBackfillReply) ProtoMessage() {}
+
+func (x *AdminRecommendClosureBackfillReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *AdminUserListReply_UserList) GetMonthRecommend() int64 {
-	if x != nil {
-		return x.MonthRecommend
-	}
-	return 0
+// Deprecated: Use AdminRecommendClosureBackfillReply.ProtoReflect.Descriptor instead.
+func (*AdminRecommendClosureBackfillReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *AdminUserListReply_UserList) GetHistoryRecommend() int64 {
+func (x *AdminRecommendClosureBackfillReply) GetCount() int64 {
 	if x != nil {
-		return x.HistoryRecommend
+		return x.Count
 	}
 	return 0
 }
 
-type AdminLocationListReply_LocationList struct {
+type AdminLedgerOpenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt    string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Address      string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Row          int64  `protobuf:"varint,3,opt,name=row,proto3" json:"row,omitempty"`
-	Col          int64  `protobuf:"varint,4,opt,name=col,proto3" json:"col,omitempty"`
-	Status       string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	CurrentLevel int64  `protobuf:"varint,6,opt,name=currentLevel,proto3" json:"currentLevel,omitempty"`
-	Current      string `protobuf:"bytes,7,opt,name=current,proto3" json:"current,omitempty"`
-	CurrentMax   string `protobuf:"bytes,8,opt,name=currentMax,proto3" json:"currentMax,omitempty"`
+	SendBody *AdminLedgerOpenRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminLocationListReply_LocationList) Reset() {
-	*x = AdminLocationListReply_LocationList{}
+func (x *AdminLedgerOpenRequest) Reset() {
+	*x = AdminLedgerOpenRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[69]
+		mi := &file_app_app_api_app_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminLocationListReply_LocationList) String() string {
+func (x *AdminLedgerOpenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminLocationListReply_LocationList) ProtoMessage() {}
+func (*AdminLedgerOpenRequest) ProtoMessage() {}
 
-func (x *AdminLocationListReply_LocationList) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[69]
+func (x *AdminLedgerOpenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4054,98 +4076,90 @@ func (x *AdminLocationListReply_LocationList) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminLocationListReply_LocationList.ProtoReflect.Descriptor instead.
-func (*AdminLocationListReply_LocationList) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{29, 0}
+// Deprecated: Use AdminLedgerOpenRequest.ProtoReflect.Descriptor instead.
+func (*AdminLedgerOpenRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *AdminLocationListReply_LocationList) GetCreatedAt() string {
+func (x *AdminLedgerOpenRequest) GetSendBody() *AdminLedgerOpenRequest_SendBody {
 	if x != nil {
-		return x.CreatedAt
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-func (x *AdminLocationListReply_LocationList) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
+type AdminLedgerOpenReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *AdminLocationListReply_LocationList) GetRow() int64 {
-	if x != nil {
-		return x.Row
-	}
-	return 0
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *AdminLocationListReply_LocationList) GetCol() int64 {
-	if x != nil {
-		return x.Col
+func (x *AdminLedgerOpenReply) Reset() {
+	*x = AdminLedgerOpenReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *AdminLocationListReply_LocationList) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+func (x *AdminLedgerOpenReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AdminLocationListReply_LocationList) GetCurrentLevel() int64 {
-	if x != nil {
-		return x.CurrentLevel
+func (*AdminLedgerOpenReply) ProtoMessage() {}
+
+func (x *AdminLedgerOpenReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *AdminLocationListReply_LocationList) GetCurrent() string {
-	if x != nil {
-		return x.Current
-	}
-	return ""
+// Deprecated: Use AdminLedgerOpenReply.ProtoReflect.Descriptor instead.
+func (*AdminLedgerOpenReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *AdminLocationListReply_LocationList) GetCurrentMax() string {
+func (x *AdminLedgerOpenReply) GetCount() int64 {
 	if x != nil {
-		return x.CurrentMax
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-type AdminWithdrawListReply_List struct {
+type AdminReconcileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address   string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
-	Id        int64  `protobuf:"varint,7,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	RelAmount string `protobuf:"bytes,6,opt,name=relAmount,proto3" json:"relAmount,omitempty"`
-	Type      string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	Status    string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	SendBody *AdminReconcileRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminWithdrawListReply_List) Reset() {
-	*x = AdminWithdrawListReply_List{}
+func (x *AdminReconcileRequest) Reset() {
+	*x = AdminReconcileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[70]
+		mi := &file_app_app_api_app_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminWithdrawListReply_List) String() string {
+func (x *AdminReconcileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminWithdrawListReply_List) ProtoMessage() {}
+func (*AdminReconcileRequest) ProtoMessage() {}
 
-func (x *AdminWithdrawListReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[70]
+func (x *AdminReconcileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4156,88 +4170,96 @@ func (x *AdminWithdrawListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminWithdrawListReply_List.ProtoReflect.Descriptor instead.
-func (*AdminWithdrawListReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{31, 0}
+// Deprecated: Use AdminReconcileRequest.ProtoReflect.Descriptor instead.
+func (*AdminReconcileRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *AdminWithdrawListReply_List) GetAddress() string {
+func (x *AdminReconcileRequest) GetSendBody() *AdminReconcileRequest_SendBody {
 	if x != nil {
-		return x.Address
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-func (x *AdminWithdrawListReply_List) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+type AdminReconcileReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Checked       int64 `protobuf:"varint,1,opt,name=checked,proto3" json:"checked,omitempty"`
+	Discrepancies int64 `protobuf:"varint,2,opt,name=discrepancies,proto3" json:"discrepancies,omitempty"`
 }
 
-func (x *AdminWithdrawListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
+func (x *AdminReconcileReply) Reset() {
+	*x = AdminReconcileReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *AdminWithdrawListReply_List) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
+func (x *AdminReconcileReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AdminWithdrawListReply_List) GetRelAmount() string {
-	if x != nil {
-		return x.RelAmount
+func (*AdminReconcileReply) ProtoMessage() {}
+
+func (x *AdminReconcileReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AdminWithdrawListReply_List) GetType() string {
+// Deprecated: Use AdminReconcileReply.ProtoReflect.Descriptor instead.
+func (*AdminReconcileReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *AdminReconcileReply) GetChecked() int64 {
 	if x != nil {
-		return x.Type
+		return x.Checked
 	}
-	return ""
+	return 0
 }
 
-func (x *AdminWithdrawListReply_List) GetStatus() string {
+func (x *AdminReconcileReply) GetDiscrepancies() int64 {
 	if x != nil {
-		return x.Status
+		return x.Discrepancies
 	}
-	return ""
+	return 0
 }
 
-type AdminUserRecommendReply_List struct {
+type AdminDepositUnmatchedListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	UserId    int64  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Address   string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	Id        int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (x *AdminUserRecommendReply_List) Reset() {
-	*x = AdminUserRecommendReply_List{}
+func (x *AdminDepositUnmatchedListRequest) Reset() {
+	*x = AdminDepositUnmatchedListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[71]
+		mi := &file_app_app_api_app_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminUserRecommendReply_List) String() string {
+func (x *AdminDepositUnmatchedListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUserRecommendReply_List) ProtoMessage() {}
+func (*AdminDepositUnmatchedListRequest) ProtoMessage() {}
 
-func (x *AdminUserRecommendReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[71]
+func (x *AdminDepositUnmatchedListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4248,67 +4270,36 @@ func (x *AdminUserRecommendReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUserRecommendReply_List.ProtoReflect.Descriptor instead.
-func (*AdminUserRecommendReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{41, 0}
-}
-
-func (x *AdminUserRecommendReply_List) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *AdminUserRecommendReply_List) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *AdminUserRecommendReply_List) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *AdminUserRecommendReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
+// Deprecated: Use AdminDepositUnmatchedListRequest.ProtoReflect.Descriptor instead.
+func (*AdminDepositUnmatchedListRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{74}
 }
 
-type AdminMonthRecommendReply_List struct {
+type AdminDepositUnmatchedListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address          string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	RecommendAddress string `protobuf:"bytes,4,opt,name=recommendAddress,proto3" json:"recommendAddress,omitempty"`
-	Id               int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt        string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Deposits []*AdminDepositUnmatchedListReply_List `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits,omitempty"`
 }
 
-func (x *AdminMonthRecommendReply_List) Reset() {
-	*x = AdminMonthRecommendReply_List{}
+func (x *AdminDepositUnmatchedListReply) Reset() {
+	*x = AdminDepositUnmatchedListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[72]
+		mi := &file_app_app_api_app_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminMonthRecommendReply_List) String() string {
+func (x *AdminDepositUnmatchedListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminMonthRecommendReply_List) ProtoMessage() {}
+func (*AdminDepositUnmatchedListReply) ProtoMessage() {}
 
-func (x *AdminMonthRecommendReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[72]
+func (x *AdminDepositUnmatchedListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4319,66 +4310,129 @@ func (x *AdminMonthRecommendReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminMonthRecommendReply_List.ProtoReflect.Descriptor instead.
-func (*AdminMonthRecommendReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{43, 0}
+// Deprecated: Use AdminDepositUnmatchedListReply.ProtoReflect.Descriptor instead.
+func (*AdminDepositUnmatchedListReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *AdminMonthRecommendReply_List) GetAddress() string {
+func (x *AdminDepositUnmatchedListReply) GetDeposits() []*AdminDepositUnmatchedListReply_List {
 	if x != nil {
-		return x.Address
+		return x.Deposits
 	}
-	return ""
+	return nil
 }
 
-func (x *AdminMonthRecommendReply_List) GetRecommendAddress() string {
-	if x != nil {
-		return x.RecommendAddress
+type AdminDepositResolveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendBody *AdminDepositResolveRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+}
+
+func (x *AdminDepositResolveRequest) Reset() {
+	*x = AdminDepositResolveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *AdminMonthRecommendReply_List) GetId() int64 {
-	if x != nil {
-		return x.Id
+func (x *AdminDepositResolveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDepositResolveRequest) ProtoMessage() {}
+
+func (x *AdminDepositResolveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *AdminMonthRecommendReply_List) GetCreatedAt() string {
+// Deprecated: Use AdminDepositResolveRequest.ProtoReflect.Descriptor instead.
+func (*AdminDepositResolveRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *AdminDepositResolveRequest) GetSendBody() *AdminDepositResolveRequest_SendBody {
 	if x != nil {
-		return x.CreatedAt
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-type AdminConfigReply_List struct {
+type AdminDepositResolveReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	Id    int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name  string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+func (x *AdminDepositResolveReply) Reset() {
+	*x = AdminDepositResolveReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *AdminConfigReply_List) Reset() {
-	*x = AdminConfigReply_List{}
+func (x *AdminDepositResolveReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDepositResolveReply) ProtoMessage() {}
+
+func (x *AdminDepositResolveReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDepositResolveReply.ProtoReflect.Descriptor instead.
+func (*AdminDepositResolveReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{77}
+}
+
+type AdminDiscrepancyListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page   int64 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	UserId int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *AdminDiscrepancyListRequest) Reset() {
+	*x = AdminDiscrepancyListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[73]
+		mi := &file_app_app_api_app_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminConfigReply_List) String() string {
+func (x *AdminDiscrepancyListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminConfigReply_List) ProtoMessage() {}
+func (*AdminDiscrepancyListRequest) ProtoMessage() {}
 
-func (x *AdminConfigReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[73]
+func (x *AdminDiscrepancyListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4389,58 +4443,105 @@ func (x *AdminConfigReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminConfigReply_List.ProtoReflect.Descriptor instead.
-func (*AdminConfigReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{45, 0}
+// Deprecated: Use AdminDiscrepancyListRequest.ProtoReflect.Descriptor instead.
+func (*AdminDiscrepancyListRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *AdminConfigReply_List) GetId() int64 {
+func (x *AdminDiscrepancyListRequest) GetPage() int64 {
 	if x != nil {
-		return x.Id
+		return x.Page
 	}
 	return 0
 }
 
-func (x *AdminConfigReply_List) GetName() string {
+func (x *AdminDiscrepancyListRequest) GetUserId() int64 {
 	if x != nil {
-		return x.Name
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *AdminConfigReply_List) GetValue() string {
+type AdminDiscrepancyListReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Discrepancies []*AdminDiscrepancyListReply_List `protobuf:"bytes,1,rep,name=discrepancies,proto3" json:"discrepancies,omitempty"`
+	Count         int64                             `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *AdminDiscrepancyListReply) Reset() {
+	*x = AdminDiscrepancyListReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDiscrepancyListReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDiscrepancyListReply) ProtoMessage() {}
+
+func (x *AdminDiscrepancyListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDiscrepancyListReply.ProtoReflect.Descriptor instead.
+func (*AdminDiscrepancyListReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *AdminDiscrepancyListReply) GetDiscrepancies() []*AdminDiscrepancyListReply_List {
 	if x != nil {
-		return x.Value
+		return x.Discrepancies
 	}
-	return ""
+	return nil
 }
 
-type AdminConfigUpdateRequest_SendBody struct {
+func (x *AdminDiscrepancyListReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AdminLocationDailySettleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	SendBody *AdminLocationDailySettleRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminConfigUpdateRequest_SendBody) Reset() {
-	*x = AdminConfigUpdateRequest_SendBody{}
+func (x *AdminLocationDailySettleRequest) Reset() {
+	*x = AdminLocationDailySettleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[74]
+		mi := &file_app_app_api_app_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminConfigUpdateRequest_SendBody) String() string {
+func (x *AdminLocationDailySettleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminConfigUpdateRequest_SendBody) ProtoMessage() {}
+func (*AdminLocationDailySettleRequest) ProtoMessage() {}
 
-func (x *AdminConfigUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[74]
+func (x *AdminLocationDailySettleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4451,807 +4552,7175 @@ func (x *AdminConfigUpdateRequest_SendBody) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminConfigUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*AdminConfigUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{46, 0}
+// Deprecated: Use AdminLocationDailySettleRequest.ProtoReflect.Descriptor instead.
+func (*AdminLocationDailySettleRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *AdminConfigUpdateRequest_SendBody) GetId() int64 {
+func (x *AdminLocationDailySettleRequest) GetSendBody() *AdminLocationDailySettleRequest_SendBody {
 	if x != nil {
-		return x.Id
+		return x.SendBody
 	}
-	return 0
+	return nil
 }
 
-func (x *AdminConfigUpdateRequest_SendBody) GetValue() string {
-	if x != nil {
-		return x.Value
+type AdminLocationDailySettleReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date       string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Users      int64  `protobuf:"varint,2,opt,name=users,proto3" json:"users,omitempty"`
+	Count      int64  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Amount     string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Skipped    bool   `protobuf:"varint,5,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	TeamAmount string `protobuf:"bytes,6,opt,name=team_amount,json=teamAmount,proto3" json:"team_amount,omitempty"`
+	AreaAmount string `protobuf:"bytes,7,opt,name=area_amount,json=areaAmount,proto3" json:"area_amount,omitempty"`
+}
+
+func (x *AdminLocationDailySettleReply) Reset() {
+	*x = AdminLocationDailySettleReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-var File_app_app_api_app_proto protoreflect.FileDescriptor
+func (x *AdminLocationDailySettleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-var file_app_app_api_app_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x61, 0x70, 0x70, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70,
-	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x61, 0x70, 0x69, 0x1a, 0x1c, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x8f, 0x01, 0x0a, 0x13, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x09, 0x73,
-	0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
-	0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x38, 0x0a, 0x08, 0x53,
-	0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x29, 0x0a, 0x11, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x22, 0x7b, 0x0a, 0x16, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x09, 0x73, 0x65,
-	0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42,
-	0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x1e, 0x0a,
-	0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x44, 0x0a,
-	0x14, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x11, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x22, 0x10, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0e, 0x0a, 0x0c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xeb, 0x17, 0x0a, 0x0d, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
-	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x12, 0x1c, 0x0a, 0x09,
-	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e,
-	0x76, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
-	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x2a, 0x0a, 0x10,
-	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x4e, 0x75, 0x6d,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x64, 0x54, 0x65, 0x61, 0x6d, 0x4e, 0x75, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x26,
-	0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c,
-	0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04,
-	0x75, 0x73, 0x64, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x64, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x42, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x42, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x64, 0x56, 0x69, 0x70, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x11, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x56, 0x69, 0x70, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x18, 0x1d,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x65, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x12, 0x26,
-	0x0a, 0x0e, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x18, 0x20, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6f, 0x6f, 0x6c,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x22, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6f,
-	0x6f, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x23, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20,
-	0x0a, 0x0b, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x24, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x70,
-	0x18, 0x25, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x64, 0x54, 0x6f, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x79, 0x62, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x79, 0x62, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x66, 0x79, 0x62, 0x52, 0x61, 0x74, 0x65, 0x18, 0x29, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x66, 0x79, 0x62, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x72,
-	0x65, 0x61, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x61, 0x72, 0x65, 0x61, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x72, 0x65,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x65, 0x61, 0x54, 0x6f, 0x74, 0x61, 0x6c,
-	0x18, 0x2b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x64, 0x41, 0x72, 0x65, 0x61, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x72,
-	0x65, 0x61, 0x4d, 0x61, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x2c, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x61, 0x72, 0x65, 0x61, 0x4d, 0x61, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x64, 0x6f, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
-	0x75, 0x6e, 0x64, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x18,
-	0x2e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x12, 0x30,
-	0x0a, 0x13, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x41, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x65, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x30, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x65, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x12,
-	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x18, 0x31, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x3a, 0x0a, 0x18,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x32, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x38, 0x0a, 0x17, 0x64, 0x61, 0x69, 0x6c,
-	0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x6f,
-	0x74, 0x61, 0x6c, 0x18, 0x33, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x64, 0x61, 0x69, 0x6c, 0x79,
-	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x12, 0x40, 0x0a, 0x1b, 0x79, 0x65, 0x73, 0x74, 0x65, 0x72, 0x64, 0x61, 0x79, 0x52,
-	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x54, 0x6f, 0x74, 0x61,
-	0x6c, 0x18, 0x3a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x79, 0x65, 0x73, 0x74, 0x65, 0x72, 0x64,
-	0x61, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x54,
-	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x4c, 0x0a, 0x21, 0x79, 0x65, 0x73, 0x74, 0x65, 0x72, 0x64, 0x61,
-	0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x3b, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x21, 0x79, 0x65, 0x73, 0x74, 0x65, 0x72, 0x64, 0x61, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x12, 0x4a, 0x0a, 0x20, 0x79, 0x65, 0x73, 0x74, 0x65, 0x72, 0x64, 0x61, 0x79, 0x44,
-	0x61, 0x69, 0x6c, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x20, 0x79, 0x65,
-	0x73, 0x74, 0x65, 0x72, 0x64, 0x61, 0x79, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x40,
-	0x0a, 0x1b, 0x79, 0x65, 0x73, 0x74, 0x65, 0x72, 0x64, 0x61, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x65, 0x61, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x3d, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x1b, 0x79, 0x65, 0x73, 0x74, 0x65, 0x72, 0x64, 0x61, 0x79, 0x52, 0x65,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x65, 0x61, 0x54, 0x6f, 0x74, 0x61, 0x6c,
-	0x12, 0x38, 0x0a, 0x17, 0x79, 0x65, 0x73, 0x74, 0x65, 0x72, 0x64, 0x61, 0x79, 0x52, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x3e, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x17, 0x79, 0x65, 0x73, 0x74, 0x65, 0x72, 0x64, 0x61, 0x79, 0x52, 0x65, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x41, 0x72, 0x65, 0x61, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x3f, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x72, 0x65, 0x61, 0x41, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x15, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x40, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x15, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6e,
-	0x6e, 0x69, 0x6e, 0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x0c, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x34, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0c, 0x4c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x35, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x32, 0x52, 0x11, 0x72, 0x65,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12,
-	0x46, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x65, 0x61,
-	0x4c, 0x69, 0x73, 0x74, 0x18, 0x36, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x33, 0x52, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41,
-	0x72, 0x65, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x17, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69,
-	0x73, 0x74, 0x18, 0x37, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x34, 0x52, 0x17, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x69, 0x6c,
-	0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x0d, 0x72,
-	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x38, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x35, 0x52, 0x0d, 0x72, 0x65,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x50, 0x0a, 0x16, 0x64,
-	0x61, 0x69, 0x6c, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x39, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x36, 0x52, 0x16, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x42, 0x0a,
-	0x0f, 0x74, 0x65, 0x61, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74,
-	0x18, 0x41, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x37,
-	0x52, 0x0f, 0x74, 0x65, 0x61, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x50, 0x0a, 0x16, 0x6d, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x42, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x38, 0x52, 0x16, 0x6d, 0x79, 0x52,
-	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x4c, 0x69, 0x73, 0x74, 0x18, 0x43, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x39, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c,
-	0x69, 0x73, 0x74, 0x1a, 0x9d, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x75, 0x74,
-	0x52, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x52,
-	0x61, 0x74, 0x65, 0x1a, 0x63, 0x0a, 0x05, 0x4c, 0x69, 0x73, 0x74, 0x32, 0x12, 0x1d, 0x0a, 0x0a,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x1a, 0x3e, 0x0a, 0x05, 0x4c, 0x69, 0x73, 0x74,
-	0x33, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x3e, 0x0a, 0x05, 0x4c, 0x69, 0x73, 0x74,
-	0x34, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x3e, 0x0a, 0x05, 0x4c, 0x69, 0x73, 0x74,
-	0x35, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x3e, 0x0a, 0x05, 0x4c, 0x69, 0x73, 0x74,
-	0x36, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x39, 0x0a, 0x05, 0x4c, 0x69, 0x73, 0x74,
-	0x37, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x41,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x1a, 0x21, 0x0a, 0x05, 0x4c, 0x69, 0x73, 0x74, 0x38, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x3e, 0x0a, 0x05, 0x4c, 0x69, 0x73, 0x74, 0x39, 0x12,
-	0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x27, 0x0a, 0x11, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22,
-	0xc1, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x1a, 0x79, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0xc3, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c,
-	0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x1a, 0x69, 0x0a, 0x04,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x16, 0x0a, 0x14, 0x46, 0x65, 0x65, 0x52, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x8b, 0x01, 0x0a, 0x12, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x36, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x65,
-	0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x1a, 0x3d,
-	0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x29, 0x0a,
-	0x13, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x11, 0x57, 0x69, 0x74,
-	0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37,
-	0x0a, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x08, 0x77,
-	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x1a, 0x69, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12,
-	0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0x2a, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x8a,
-	0x01, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x64, 0x73, 0x1a, 0x36, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x0f,
-	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x3a, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
-	0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x36, 0x0a, 0x08, 0x53,
-	0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x22, 0x27, 0x0a, 0x0d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x81, 0x01, 0x0a,
-	0x17, 0x53, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64,
-	0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f,
-	0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x22, 0x0a, 0x08,
-	0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x22, 0x2f, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0x87, 0x01, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x45, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73,
-	0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x22, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42,
-	0x6f, 0x64, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x32, 0x0a, 0x18, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
-	0x46, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xec, 0x01, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x38, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x1a, 0x83, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16,
-	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x44, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55,
-	0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xe9, 0x02, 0x0a,
-	0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x12, 0x36, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73,
-	0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x1a, 0x84, 0x02, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x17,
-	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x20, 0x0a, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73,
-	0x64, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44,
-	0x68, 0x62, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x03, 0x76, 0x69, 0x70, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6d, 0x6f,
-	0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x12, 0x2a, 0x0a, 0x10,
-	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
-	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x22, 0x48, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x22, 0xda, 0x02, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x46, 0x0a,
-	0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xe1, 0x01, 0x0a, 0x0c,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6f, 0x6c, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x63, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x76, 0x65,
-	0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12,
-	0x1e, 0x0a, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x22,
-	0x48, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xa0, 0x02, 0x0a, 0x16, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
-	0x61, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xb1, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x16, 0x0a, 0x14,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74,
-	0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x19, 0x0a, 0x17, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x11,
-	0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x0f, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x6c, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd9, 0x02, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41,
-	0x6c, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x6f, 0x64, 0x61, 0x79,
-	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0e, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x12,
-	0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1e, 0x0a,
-	0x0a, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x0a,
-	0x0d, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x4c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x57, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6f,
-	0x64, 0x61, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x20, 0x0a, 0x0b, 0x61,
-	0x6c, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x1c, 0x0a,
-	0x09, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x34, 0x0a, 0x15, 0x61,
-	0x6c, 0x6c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x6e,
-	0x64, 0x46, 0x65, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x6c, 0x6c, 0x53,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x6e, 0x64, 0x46, 0x65,
-	0x65, 0x22, 0x34, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
-	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xbc, 0x01, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x1a, 0x68, 0x0a, 0x04,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x4a, 0x0a, 0x1a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d,
-	0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x22, 0xe7, 0x01, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x6e, 0x74,
-	0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
-	0x38, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52,
-	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a,
-	0x7b, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x40, 0x0a, 0x12,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x9e,
-	0x01, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x12, 0x32, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x40, 0x0a,
-	0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
-	0x91, 0x01, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x09,
-	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53,
-	0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
-	0x79, 0x1a, 0x30, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x32, 0xf7, 0x0c,
-	0x0a, 0x03, 0x41, 0x70, 0x70, 0x12, 0x72, 0x0a, 0x0c, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x74, 0x68, 0x41,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x7a, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x22,
-	0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2f, 0x65, 0x74, 0x68, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x3a, 0x09,
-	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x7e, 0x0a, 0x0f, 0x52, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x22, 0x20, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x65,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x09,
-	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x57, 0x0a, 0x08, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70,
-	0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x6e,
-	0x66, 0x6f, 0x12, 0x5f, 0x0a, 0x0a, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x23,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c,
-	0x69, 0x73, 0x74, 0x12, 0x84, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2d, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x72,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x6c, 0x0a, 0x0d, 0x46, 0x65,
-	0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x65, 0x65,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70,
-	0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x67, 0x0a, 0x0c, 0x57, 0x69, 0x74, 0x68,
-	0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57,
-	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x6c, 0x69, 0x73,
-	0x74, 0x12, 0x6b, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
-	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x61,
-	0x0a, 0x08, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x22, 0x18, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x77, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64,
-	0x79, 0x12, 0x83, 0x01, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x74,
-	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x22, 0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
-	0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x74, 0x5f, 0x62, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x3a, 0x09, 0x73, 0x65,
-	0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x8f, 0x01, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12,
-	0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x22, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70,
-	0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f,
-	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x3a, 0x09,
-	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x51, 0x0a, 0x07, 0x44, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1e, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x64, 0x0a, 0x0d,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x19, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
-	0x61, 0x77, 0x12, 0x71, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64,
-	0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x5f, 0x65, 0x74, 0x68, 0x12, 0x50, 0x0a, 0x08, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46, 0x65,
-	0x65, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1a, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
-	0x64, 0x68, 0x62, 0x2f, 0x66, 0x65, 0x65, 0x42, 0x11, 0x0a, 0x03, 0x61, 0x70, 0x69, 0x50, 0x01,
-	0x5a, 0x08, 0x2f, 0x61, 0x70, 0x69, 0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+func (*AdminLocationDailySettleReply) ProtoMessage() {}
+
+func (x *AdminLocationDailySettleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var (
-	file_app_app_api_app_proto_rawDescOnce sync.Once
-	file_app_app_api_app_proto_rawDescData = file_app_app_api_app_proto_rawDesc
-)
+// Deprecated: Use AdminLocationDailySettleReply.ProtoReflect.Descriptor instead.
+func (*AdminLocationDailySettleReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{81}
+}
 
-func file_app_app_api_app_proto_rawDescGZIP() []byte {
-	file_app_app_api_app_proto_rawDescOnce.Do(func() {
-		file_app_app_api_app_proto_rawDescData = protoimpl.X.CompressGZIP(file_app_app_api_app_proto_rawDescData)
-	})
-	return file_app_app_api_app_proto_rawDescData
+func (x *AdminLocationDailySettleReply) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
 }
 
-var file_app_app_api_app_proto_msgTypes = make([]protoimpl.MessageInfo, 75)
-var file_app_app_api_app_proto_goTypes = []interface{}{
-	(*EthAuthorizeRequest)(nil),                 // 0: api.EthAuthorizeRequest
-	(*EthAuthorizeReply)(nil),                   // 1: api.EthAuthorizeReply
-	(*RecommendUpdateRequest)(nil),              // 2: api.RecommendUpdateRequest
-	(*RecommendUpdateReply)(nil),                // 3: api.RecommendUpdateReply
-	(*DepositRequest)(nil),                      // 4: api.DepositRequest
-	(*DepositReply)(nil),                        // 5: api.DepositReply
-	(*UserInfoRequest)(nil),                     // 6: api.UserInfoRequest
-	(*UserInfoReply)(nil),                       // 7: api.UserInfoReply
-	(*RewardListRequest)(nil),                   // 8: api.RewardListRequest
-	(*RewardListReply)(nil),                     // 9: api.RewardListReply
-	(*RecommendRewardListRequest)(nil),          // 10: api.RecommendRewardListRequest
-	(*RecommendRewardListReply)(nil),            // 11: api.RecommendRewardListReply
-	(*FeeRewardListRequest)(nil),                // 12: api.FeeRewardListRequest
-	(*FeeRewardListReply)(nil),                  // 13: api.FeeRewardListReply
-	(*WithdrawListRequest)(nil),                 // 14: api.WithdrawListRequest
-	(*WithdrawListReply)(nil),                   // 15: api.WithdrawListReply
-	(*RecommendListRequest)(nil),                // 16: api.RecommendListRequest
-	(*RecommendListReply)(nil),                  // 17: api.RecommendListReply
-	(*WithdrawRequest)(nil),                     // 18: api.WithdrawRequest
-	(*WithdrawReply)(nil),                       // 19: api.WithdrawReply
-	(*SetBalanceRewardRequest)(nil),             // 20: api.SetBalanceRewardRequest
-	(*SetBalanceRewardReply)(nil),               // 21: api.SetBalanceRewardReply
-	(*DeleteBalanceRewardRequest)(nil),          // 22: api.DeleteBalanceRewardRequest
-	(*DeleteBalanceRewardReply)(nil),            // 23: api.DeleteBalanceRewardReply
-	(*AdminRewardListRequest)(nil),              // 24: api.AdminRewardListRequest
-	(*AdminRewardListReply)(nil),                // 25: api.AdminRewardListReply
-	(*AdminUserListRequest)(nil),                // 26: api.AdminUserListRequest
-	(*AdminUserListReply)(nil),                  // 27: api.AdminUserListReply
-	(*AdminLocationListRequest)(nil),            // 28: api.AdminLocationListRequest
-	(*AdminLocationListReply)(nil),              // 29: api.AdminLocationListReply
-	(*AdminWithdrawListRequest)(nil),            // 30: api.AdminWithdrawListRequest
-	(*AdminWithdrawListReply)(nil),              // 31: api.AdminWithdrawListReply
-	(*AdminWithdrawRequest)(nil),                // 32: api.AdminWithdrawRequest
-	(*AdminWithdrawReply)(nil),                  // 33: api.AdminWithdrawReply
-	(*AdminWithdrawEthRequest)(nil),             // 34: api.AdminWithdrawEthRequest
-	(*AdminWithdrawEthReply)(nil),               // 35: api.AdminWithdrawEthReply
-	(*AdminFeeRequest)(nil),                     // 36: api.AdminFeeRequest
-	(*AdminFeeReply)(nil),                       // 37: api.AdminFeeReply
-	(*AdminAllRequest)(nil),                     // 38: api.AdminAllRequest
-	(*AdminAllReply)(nil),                       // 39: api.AdminAllReply
-	(*AdminUserRecommendRequest)(nil),           // 40: api.AdminUserRecommendRequest
-	(*AdminUserRecommendReply)(nil),             // 41: api.AdminUserRecommendReply
-	(*AdminMonthRecommendRequest)(nil),          // 42: api.AdminMonthRecommendRequest
-	(*AdminMonthRecommendReply)(nil),            // 43: api.AdminMonthRecommendReply
-	(*AdminConfigRequest)(nil),                  // 44: api.AdminConfigRequest
-	(*AdminConfigReply)(nil),                    // 45: api.AdminConfigReply
-	(*AdminConfigUpdateRequest)(nil),            // 46: api.AdminConfigUpdateRequest
-	(*AdminConfigUpdateReply)(nil),              // 47: api.AdminConfigUpdateReply
-	(*EthAuthorizeRequest_SendBody)(nil),        // 48: api.EthAuthorizeRequest.SendBody
-	(*RecommendUpdateRequest_SendBody)(nil),     // 49: api.RecommendUpdateRequest.SendBody
-	(*UserInfoReply_List)(nil),                  // 50: api.UserInfoReply.List
-	(*UserInfoReply_List2)(nil),                 // 51: api.UserInfoReply.List2
-	(*UserInfoReply_List3)(nil),                 // 52: api.UserInfoReply.List3
-	(*UserInfoReply_List4)(nil),                 // 53: api.UserInfoReply.List4
-	(*UserInfoReply_List5)(nil),                 // 54: api.UserInfoReply.List5
-	(*UserInfoReply_List6)(nil),                 // 55: api.UserInfoReply.List6
-	(*UserInfoReply_List7)(nil),                 // 56: api.UserInfoReply.List7
-	(*UserInfoReply_List8)(nil),                 // 57: api.UserInfoReply.List8
-	(*UserInfoReply_List9)(nil),                 // 58: api.UserInfoReply.List9
-	(*RewardListReply_List)(nil),                // 59: api.RewardListReply.List
-	(*RecommendRewardListReply_List)(nil),       // 60: api.RecommendRewardListReply.List
-	(*FeeRewardListReply_List)(nil),             // 61: api.FeeRewardListReply.List
-	(*WithdrawListReply_List)(nil),              // 62: api.WithdrawListReply.List
-	(*RecommendListReply_List)(nil),             // 63: api.RecommendListReply.List
-	(*WithdrawRequest_SendBody)(nil),            // 64: api.WithdrawRequest.SendBody
-	(*SetBalanceRewardRequest_SendBody)(nil),    // 65: api.SetBalanceRewardRequest.SendBody
-	(*DeleteBalanceRewardRequest_SendBody)(nil), // 66: api.DeleteBalanceRewardRequest.SendBody
-	(*AdminRewardListReply_List)(nil),           // 67: api.AdminRewardListReply.List
-	(*AdminUserListReply_UserList)(nil),         // 68: api.AdminUserListReply.UserList
-	(*AdminLocationListReply_LocationList)(nil), // 69: api.AdminLocationListReply.LocationList
-	(*AdminWithdrawListReply_List)(nil),         // 70: api.AdminWithdrawListReply.List
-	(*AdminUserRecommendReply_List)(nil),        // 71: api.AdminUserRecommendReply.List
-	(*AdminMonthRecommendReply_List)(nil),       // 72: api.AdminMonthRecommendReply.List
-	(*AdminConfigReply_List)(nil),               // 73: api.AdminConfigReply.List
-	(*AdminConfigUpdateRequest_SendBody)(nil),   // 74: api.AdminConfigUpdateRequest.SendBody
+func (x *AdminLocationDailySettleReply) GetUsers() int64 {
+	if x != nil {
+		return x.Users
+	}
+	return 0
 }
-var file_app_app_api_app_proto_depIdxs = []int32{
-	48, // 0: api.EthAuthorizeRequest.send_body:type_name -> api.EthAuthorizeRequest.SendBody
-	49, // 1: api.RecommendUpdateRequest.send_body:type_name -> api.RecommendUpdateRequest.SendBody
-	50, // 2: api.UserInfoReply.LocationList:type_name -> api.UserInfoReply.List
-	51, // 3: api.UserInfoReply.recommendTeamList:type_name -> api.UserInfoReply.List2
-	52, // 4: api.UserInfoReply.recommendAreaList:type_name -> api.UserInfoReply.List3
-	53, // 5: api.UserInfoReply.locationDailyRewardList:type_name -> api.UserInfoReply.List4
-	54, // 6: api.UserInfoReply.recommendList:type_name -> api.UserInfoReply.List5
-	55, // 7: api.UserInfoReply.dailyBalanceRewardList:type_name -> api.UserInfoReply.List6
-	56, // 8: api.UserInfoReply.teamAddressList:type_name -> api.UserInfoReply.List7
-	57, // 9: api.UserInfoReply.myRecommendAddressList:type_name -> api.UserInfoReply.List8
-	58, // 10: api.UserInfoReply.allRewardList:type_name -> api.UserInfoReply.List9
-	59, // 11: api.RewardListReply.rewards:type_name -> api.RewardListReply.List
-	60, // 12: api.RecommendRewardListReply.rewards:type_name -> api.RecommendRewardListReply.List
-	61, // 13: api.FeeRewardListReply.rewards:type_name -> api.FeeRewardListReply.List
-	62, // 14: api.WithdrawListReply.withdraw:type_name -> api.WithdrawListReply.List
-	63, // 15: api.RecommendListReply.recommends:type_name -> api.RecommendListReply.List
-	64, // 16: api.WithdrawRequest.send_body:type_name -> api.WithdrawRequest.SendBody
-	65, // 17: api.SetBalanceRewardRequest.send_body:type_name -> api.SetBalanceRewardRequest.SendBody
-	66, // 18: api.DeleteBalanceRewardRequest.send_body:type_name -> api.DeleteBalanceRewardRequest.SendBody
-	67, // 19: api.AdminRewardListReply.rewards:type_name -> api.AdminRewardListReply.List
-	68, // 20: api.AdminUserListReply.users:type_name -> api.AdminUserListReply.UserList
-	69, // 21: api.AdminLocationListReply.locations:type_name -> api.AdminLocationListReply.LocationList
-	70, // 22: api.AdminWithdrawListReply.withdraw:type_name -> api.AdminWithdrawListReply.List
-	71, // 23: api.AdminUserRecommendReply.users:type_name -> api.AdminUserRecommendReply.List
-	72, // 24: api.AdminMonthRecommendReply.users:type_name -> api.AdminMonthRecommendReply.List
-	73, // 25: api.AdminConfigReply.config:type_name -> api.AdminConfigReply.List
-	74, // 26: api.AdminConfigUpdateRequest.send_body:type_name -> api.AdminConfigUpdateRequest.SendBody
-	0,  // 27: api.App.EthAuthorize:input_type -> api.EthAuthorizeRequest
-	2,  // 28: api.App.RecommendUpdate:input_type -> api.RecommendUpdateRequest
-	6,  // 29: api.App.UserInfo:input_type -> api.UserInfoRequest
-	8,  // 30: api.App.RewardList:input_type -> api.RewardListRequest
-	10, // 31: api.App.RecommendRewardList:input_type -> api.RecommendRewardListRequest
-	12, // 32: api.App.FeeRewardList:input_type -> api.FeeRewardListRequest
-	14, // 33: api.App.WithdrawList:input_type -> api.WithdrawListRequest
-	16, // 34: api.App.RecommendList:input_type -> api.RecommendListRequest
-	18, // 35: api.App.Withdraw:input_type -> api.WithdrawRequest
-	20, // 36: api.App.SetBalanceReward:input_type -> api.SetBalanceRewardRequest
-	22, // 37: api.App.DeleteBalanceReward:input_type -> api.DeleteBalanceRewardRequest
-	4,  // 38: api.App.Deposit:input_type -> api.DepositRequest
-	32, // 39: api.App.AdminWithdraw:input_type -> api.AdminWithdrawRequest
-	34, // 40: api.App.AdminWithdrawEth:input_type -> api.AdminWithdrawEthRequest
-	36, // 41: api.App.AdminFee:input_type -> api.AdminFeeRequest
-	1,  // 42: api.App.EthAuthorize:output_type -> api.EthAuthorizeReply
-	3,  // 43: api.App.RecommendUpdate:output_type -> api.RecommendUpdateReply
-	7,  // 44: api.App.UserInfo:output_type -> api.UserInfoReply
-	9,  // 45: api.App.RewardList:output_type -> api.RewardListReply
-	11, // 46: api.App.RecommendRewardList:output_type -> api.RecommendRewardListReply
-	13, // 47: api.App.FeeRewardList:output_type -> api.FeeRewardListReply
-	15, // 48: api.App.WithdrawList:output_type -> api.WithdrawListReply
-	17, // 49: api.App.RecommendList:output_type -> api.RecommendListReply
-	19, // 50: api.App.Withdraw:output_type -> api.WithdrawReply
-	21, // 51: api.App.SetBalanceReward:output_type -> api.SetBalanceRewardReply
-	23, // 52: api.App.DeleteBalanceReward:output_type -> api.DeleteBalanceRewardReply
-	5,  // 53: api.App.Deposit:output_type -> api.DepositReply
-	33, // 54: api.App.AdminWithdraw:output_type -> api.AdminWithdrawReply
-	35, // 55: api.App.AdminWithdrawEth:output_type -> api.AdminWithdrawEthReply
-	37, // 56: api.App.AdminFee:output_type -> api.AdminFeeReply
-	42, // [42:57] is the sub-list for method output_type
-	27, // [27:42] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+
+func (x *AdminLocationDailySettleReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
 }
 
-func init() { file_app_app_api_app_proto_init() }
-func file_app_app_api_app_proto_init() {
-	if File_app_app_api_app_proto != nil {
-		return
+func (x *AdminLocationDailySettleReply) GetAmount() string {
+	if x != nil {
+		return x.Amount
 	}
-	if !protoimpl.UnsafeEnabled {
+	return ""
+}
+
+func (x *AdminLocationDailySettleReply) GetSkipped() bool {
+	if x != nil {
+		return x.Skipped
+	}
+	return false
+}
+
+func (x *AdminLocationDailySettleReply) GetTeamAmount() string {
+	if x != nil {
+		return x.TeamAmount
+	}
+	return ""
+}
+
+func (x *AdminLocationDailySettleReply) GetAreaAmount() string {
+	if x != nil {
+		return x.AreaAmount
+	}
+	return ""
+}
+
+type AdminUserAreaRebuildRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendBody *AdminUserAreaRebuildRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+}
+
+func (x *AdminUserAreaRebuildRequest) Reset() {
+	*x = AdminUserAreaRebuildRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUserAreaRebuildRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserAreaRebuildRequest) ProtoMessage() {}
+
+func (x *AdminUserAreaRebuildRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserAreaRebuildRequest.ProtoReflect.Descriptor instead.
+func (*AdminUserAreaRebuildRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *AdminUserAreaRebuildRequest) GetSendBody() *AdminUserAreaRebuildRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type AdminUserAreaRebuildReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changed int64 `protobuf:"varint,1,opt,name=changed,proto3" json:"changed,omitempty"`
+}
+
+func (x *AdminUserAreaRebuildReply) Reset() {
+	*x = AdminUserAreaRebuildReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUserAreaRebuildReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserAreaRebuildReply) ProtoMessage() {}
+
+func (x *AdminUserAreaRebuildReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserAreaRebuildReply.ProtoReflect.Descriptor instead.
+func (*AdminUserAreaRebuildReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *AdminUserAreaRebuildReply) GetChanged() int64 {
+	if x != nil {
+		return x.Changed
+	}
+	return 0
+}
+
+type SettlementConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SettlementConfig) Reset() {
+	*x = SettlementConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SettlementConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettlementConfig) ProtoMessage() {}
+
+func (x *SettlementConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettlementConfig.ProtoReflect.Descriptor instead.
+func (*SettlementConfig) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SettlementConfig) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SettlementConfig) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type SettlementSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users      int64  `protobuf:"varint,1,opt,name=users,proto3" json:"users,omitempty"`
+	Count      int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Amount     string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	TeamAmount string `protobuf:"bytes,4,opt,name=team_amount,json=teamAmount,proto3" json:"team_amount,omitempty"`
+	AreaAmount string `protobuf:"bytes,5,opt,name=area_amount,json=areaAmount,proto3" json:"area_amount,omitempty"`
+}
+
+func (x *SettlementSummary) Reset() {
+	*x = SettlementSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SettlementSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettlementSummary) ProtoMessage() {}
+
+func (x *SettlementSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettlementSummary.ProtoReflect.Descriptor instead.
+func (*SettlementSummary) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *SettlementSummary) GetUsers() int64 {
+	if x != nil {
+		return x.Users
+	}
+	return 0
+}
+
+func (x *SettlementSummary) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *SettlementSummary) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *SettlementSummary) GetTeamAmount() string {
+	if x != nil {
+		return x.TeamAmount
+	}
+	return ""
+}
+
+func (x *SettlementSummary) GetAreaAmount() string {
+	if x != nil {
+		return x.AreaAmount
+	}
+	return ""
+}
+
+type SettlementRewardTotal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *SettlementRewardTotal) Reset() {
+	*x = SettlementRewardTotal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SettlementRewardTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettlementRewardTotal) ProtoMessage() {}
+
+func (x *SettlementRewardTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettlementRewardTotal.ProtoReflect.Descriptor instead.
+func (*SettlementRewardTotal) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *SettlementRewardTotal) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SettlementRewardTotal) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SettlementRewardTotal) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type SettlementRewardDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId        int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Amount        string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	CompareAmount string `protobuf:"bytes,4,opt,name=compare_amount,json=compareAmount,proto3" json:"compare_amount,omitempty"`
+	Diff          string `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+}
+
+func (x *SettlementRewardDiff) Reset() {
+	*x = SettlementRewardDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SettlementRewardDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettlementRewardDiff) ProtoMessage() {}
+
+func (x *SettlementRewardDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettlementRewardDiff.ProtoReflect.Descriptor instead.
+func (*SettlementRewardDiff) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SettlementRewardDiff) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SettlementRewardDiff) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SettlementRewardDiff) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *SettlementRewardDiff) GetCompareAmount() string {
+	if x != nil {
+		return x.CompareAmount
+	}
+	return ""
+}
+
+func (x *SettlementRewardDiff) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+type SettlementConfigDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key          string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value        string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	CompareValue string `protobuf:"bytes,3,opt,name=compare_value,json=compareValue,proto3" json:"compare_value,omitempty"`
+}
+
+func (x *SettlementConfigDiff) Reset() {
+	*x = SettlementConfigDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SettlementConfigDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettlementConfigDiff) ProtoMessage() {}
+
+func (x *SettlementConfigDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettlementConfigDiff.ProtoReflect.Descriptor instead.
+func (*SettlementConfigDiff) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SettlementConfigDiff) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SettlementConfigDiff) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SettlementConfigDiff) GetCompareValue() string {
+	if x != nil {
+		return x.CompareValue
+	}
+	return ""
+}
+
+type AdminSettlementDryRunRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendBody *AdminSettlementDryRunRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+}
+
+func (x *AdminSettlementDryRunRequest) Reset() {
+	*x = AdminSettlementDryRunRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminSettlementDryRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSettlementDryRunRequest) ProtoMessage() {}
+
+func (x *AdminSettlementDryRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSettlementDryRunRequest.ProtoReflect.Descriptor instead.
+func (*AdminSettlementDryRunRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *AdminSettlementDryRunRequest) GetSendBody() *AdminSettlementDryRunRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type AdminSettlementDryRunReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date           string                   `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Summary        *SettlementSummary       `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	Rewards        []*SettlementRewardTotal `protobuf:"bytes,3,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	CompareSummary *SettlementSummary       `protobuf:"bytes,4,opt,name=compare_summary,json=compareSummary,proto3" json:"compare_summary,omitempty"`
+	Diffs          []*SettlementRewardDiff  `protobuf:"bytes,5,rep,name=diffs,proto3" json:"diffs,omitempty"`
+	ConfigDiffs    []*SettlementConfigDiff  `protobuf:"bytes,6,rep,name=config_diffs,json=configDiffs,proto3" json:"config_diffs,omitempty"`
+}
+
+func (x *AdminSettlementDryRunReply) Reset() {
+	*x = AdminSettlementDryRunReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminSettlementDryRunReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSettlementDryRunReply) ProtoMessage() {}
+
+func (x *AdminSettlementDryRunReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSettlementDryRunReply.ProtoReflect.Descriptor instead.
+func (*AdminSettlementDryRunReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *AdminSettlementDryRunReply) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *AdminSettlementDryRunReply) GetSummary() *SettlementSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *AdminSettlementDryRunReply) GetRewards() []*SettlementRewardTotal {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+func (x *AdminSettlementDryRunReply) GetCompareSummary() *SettlementSummary {
+	if x != nil {
+		return x.CompareSummary
+	}
+	return nil
+}
+
+func (x *AdminSettlementDryRunReply) GetDiffs() []*SettlementRewardDiff {
+	if x != nil {
+		return x.Diffs
+	}
+	return nil
+}
+
+func (x *AdminSettlementDryRunReply) GetConfigDiffs() []*SettlementConfigDiff {
+	if x != nil {
+		return x.ConfigDiffs
+	}
+	return nil
+}
+
+type AdminSettlementReplayRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendBody *AdminSettlementReplayRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+}
+
+func (x *AdminSettlementReplayRequest) Reset() {
+	*x = AdminSettlementReplayRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminSettlementReplayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSettlementReplayRequest) ProtoMessage() {}
+
+func (x *AdminSettlementReplayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSettlementReplayRequest.ProtoReflect.Descriptor instead.
+func (*AdminSettlementReplayRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *AdminSettlementReplayRequest) GetSendBody() *AdminSettlementReplayRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type AdminSettlementReplayReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date     string                  `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Rate     string                  `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	Expected *SettlementSummary      `protobuf:"bytes,3,opt,name=expected,proto3" json:"expected,omitempty"`
+	Actual   *SettlementSummary      `protobuf:"bytes,4,opt,name=actual,proto3" json:"actual,omitempty"`
+	Matched  bool                    `protobuf:"varint,5,opt,name=matched,proto3" json:"matched,omitempty"`
+	Diffs    []*SettlementRewardDiff `protobuf:"bytes,6,rep,name=diffs,proto3" json:"diffs,omitempty"`
+}
+
+func (x *AdminSettlementReplayReply) Reset() {
+	*x = AdminSettlementReplayReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminSettlementReplayReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSettlementReplayReply) ProtoMessage() {}
+
+func (x *AdminSettlementReplayReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSettlementReplayReply.ProtoReflect.Descriptor instead.
+func (*AdminSettlementReplayReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *AdminSettlementReplayReply) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *AdminSettlementReplayReply) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *AdminSettlementReplayReply) GetExpected() *SettlementSummary {
+	if x != nil {
+		return x.Expected
+	}
+	return nil
+}
+
+func (x *AdminSettlementReplayReply) GetActual() *SettlementSummary {
+	if x != nil {
+		return x.Actual
+	}
+	return nil
+}
+
+func (x *AdminSettlementReplayReply) GetMatched() bool {
+	if x != nil {
+		return x.Matched
+	}
+	return false
+}
+
+func (x *AdminSettlementReplayReply) GetDiffs() []*SettlementRewardDiff {
+	if x != nil {
+		return x.Diffs
+	}
+	return nil
+}
+
+type AdminWithdrawEthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminWithdrawEthRequest) Reset() {
+	*x = AdminWithdrawEthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWithdrawEthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWithdrawEthRequest) ProtoMessage() {}
+
+func (x *AdminWithdrawEthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWithdrawEthRequest.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawEthRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{93}
+}
+
+type AdminWithdrawEthReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Broadcast int64 `protobuf:"varint,1,opt,name=broadcast,proto3" json:"broadcast,omitempty"`
+	Confirmed int64 `protobuf:"varint,2,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	Failed    int64 `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (x *AdminWithdrawEthReply) Reset() {
+	*x = AdminWithdrawEthReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWithdrawEthReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWithdrawEthReply) ProtoMessage() {}
+
+func (x *AdminWithdrawEthReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWithdrawEthReply.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawEthReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *AdminWithdrawEthReply) GetBroadcast() int64 {
+	if x != nil {
+		return x.Broadcast
+	}
+	return 0
+}
+
+func (x *AdminWithdrawEthReply) GetConfirmed() int64 {
+	if x != nil {
+		return x.Confirmed
+	}
+	return 0
+}
+
+func (x *AdminWithdrawEthReply) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type AdminFeeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminFeeRequest) Reset() {
+	*x = AdminFeeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminFeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminFeeRequest) ProtoMessage() {}
+
+func (x *AdminFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminFeeRequest.ProtoReflect.Descriptor instead.
+func (*AdminFeeRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{95}
+}
+
+type AdminFeeReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminFeeReply) Reset() {
+	*x = AdminFeeReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminFeeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminFeeReply) ProtoMessage() {}
+
+func (x *AdminFeeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminFeeReply.ProtoReflect.Descriptor instead.
+func (*AdminFeeReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{96}
+}
+
+type AdminAllRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminAllRequest) Reset() {
+	*x = AdminAllRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminAllRequest) ProtoMessage() {}
+
+func (x *AdminAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminAllRequest.ProtoReflect.Descriptor instead.
+func (*AdminAllRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{97}
+}
+
+type AdminAllReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TodayTotalUser        int64  `protobuf:"varint,1,opt,name=todayTotalUser,proto3" json:"todayTotalUser,omitempty"`
+	TotalUser             int64  `protobuf:"varint,2,opt,name=totalUser,proto3" json:"totalUser,omitempty"`
+	AllBalance            string `protobuf:"bytes,3,opt,name=allBalance,proto3" json:"allBalance,omitempty"`
+	TodayLocation         string `protobuf:"bytes,4,opt,name=todayLocation,proto3" json:"todayLocation,omitempty"`
+	AllLocation           string `protobuf:"bytes,5,opt,name=allLocation,proto3" json:"allLocation,omitempty"`
+	TodayWithdraw         string `protobuf:"bytes,6,opt,name=todayWithdraw,proto3" json:"todayWithdraw,omitempty"`
+	AllWithdraw           string `protobuf:"bytes,7,opt,name=allWithdraw,proto3" json:"allWithdraw,omitempty"`
+	AllReward             string `protobuf:"bytes,8,opt,name=allReward,proto3" json:"allReward,omitempty"`
+	AllSystemRewardAndFee string `protobuf:"bytes,9,opt,name=allSystemRewardAndFee,proto3" json:"allSystemRewardAndFee,omitempty"`
+}
+
+func (x *AdminAllReply) Reset() {
+	*x = AdminAllReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminAllReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminAllReply) ProtoMessage() {}
+
+func (x *AdminAllReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminAllReply.ProtoReflect.Descriptor instead.
+func (*AdminAllReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *AdminAllReply) GetTodayTotalUser() int64 {
+	if x != nil {
+		return x.TodayTotalUser
+	}
+	return 0
+}
+
+func (x *AdminAllReply) GetTotalUser() int64 {
+	if x != nil {
+		return x.TotalUser
+	}
+	return 0
+}
+
+func (x *AdminAllReply) GetAllBalance() string {
+	if x != nil {
+		return x.AllBalance
+	}
+	return ""
+}
+
+func (x *AdminAllReply) GetTodayLocation() string {
+	if x != nil {
+		return x.TodayLocation
+	}
+	return ""
+}
+
+func (x *AdminAllReply) GetAllLocation() string {
+	if x != nil {
+		return x.AllLocation
+	}
+	return ""
+}
+
+func (x *AdminAllReply) GetTodayWithdraw() string {
+	if x != nil {
+		return x.TodayWithdraw
+	}
+	return ""
+}
+
+func (x *AdminAllReply) GetAllWithdraw() string {
+	if x != nil {
+		return x.AllWithdraw
+	}
+	return ""
+}
+
+func (x *AdminAllReply) GetAllReward() string {
+	if x != nil {
+		return x.AllReward
+	}
+	return ""
+}
+
+func (x *AdminAllReply) GetAllSystemRewardAndFee() string {
+	if x != nil {
+		return x.AllSystemRewardAndFee
+	}
+	return ""
+}
+
+type AdminUserRecommendRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *AdminUserRecommendRequest) Reset() {
+	*x = AdminUserRecommendRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUserRecommendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserRecommendRequest) ProtoMessage() {}
+
+func (x *AdminUserRecommendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserRecommendRequest.ProtoReflect.Descriptor instead.
+func (*AdminUserRecommendRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *AdminUserRecommendRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type AdminUserRecommendReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users []*AdminUserRecommendReply_List `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *AdminUserRecommendReply) Reset() {
+	*x = AdminUserRecommendReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUserRecommendReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserRecommendReply) ProtoMessage() {}
+
+func (x *AdminUserRecommendReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserRecommendReply.ProtoReflect.Descriptor instead.
+func (*AdminUserRecommendReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *AdminUserRecommendReply) GetUsers() []*AdminUserRecommendReply_List {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type AdminRecommendTreeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId  int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Depth   int64  `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *AdminRecommendTreeRequest) Reset() {
+	*x = AdminRecommendTreeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminRecommendTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRecommendTreeRequest) ProtoMessage() {}
+
+func (x *AdminRecommendTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRecommendTreeRequest.ProtoReflect.Descriptor instead.
+func (*AdminRecommendTreeRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *AdminRecommendTreeRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminRecommendTreeRequest) GetDepth() int64 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *AdminRecommendTreeRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type AdminRecommendTreeReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node     *RecommendTreeNode    `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Path     []*RecommendTreeNode  `protobuf:"bytes,2,rep,name=path,proto3" json:"path,omitempty"`
+	Children []*RecommendTreeNode  `protobuf:"bytes,3,rep,name=children,proto3" json:"children,omitempty"`
+	Matches  []*RecommendTreeMatch `protobuf:"bytes,4,rep,name=matches,proto3" json:"matches,omitempty"`
+}
+
+func (x *AdminRecommendTreeReply) Reset() {
+	*x = AdminRecommendTreeReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminRecommendTreeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRecommendTreeReply) ProtoMessage() {}
+
+func (x *AdminRecommendTreeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRecommendTreeReply.ProtoReflect.Descriptor instead.
+func (*AdminRecommendTreeReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *AdminRecommendTreeReply) GetNode() *RecommendTreeNode {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *AdminRecommendTreeReply) GetPath() []*RecommendTreeNode {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *AdminRecommendTreeReply) GetChildren() []*RecommendTreeNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *AdminRecommendTreeReply) GetMatches() []*RecommendTreeMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+type AdminUserLevelOverrideRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendBody *AdminUserLevelOverrideRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+}
+
+func (x *AdminUserLevelOverrideRequest) Reset() {
+	*x = AdminUserLevelOverrideRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUserLevelOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserLevelOverrideRequest) ProtoMessage() {}
+
+func (x *AdminUserLevelOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserLevelOverrideRequest.ProtoReflect.Descriptor instead.
+func (*AdminUserLevelOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *AdminUserLevelOverrideRequest) GetSendBody() *AdminUserLevelOverrideRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type AdminUserLevelOverrideReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level int64 `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+}
+
+func (x *AdminUserLevelOverrideReply) Reset() {
+	*x = AdminUserLevelOverrideReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUserLevelOverrideReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserLevelOverrideReply) ProtoMessage() {}
+
+func (x *AdminUserLevelOverrideReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserLevelOverrideReply.ProtoReflect.Descriptor instead.
+func (*AdminUserLevelOverrideReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *AdminUserLevelOverrideReply) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+type AdminUserLevelHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId  int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AfterId int64 `protobuf:"varint,2,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	Limit   int64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *AdminUserLevelHistoryRequest) Reset() {
+	*x = AdminUserLevelHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUserLevelHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserLevelHistoryRequest) ProtoMessage() {}
+
+func (x *AdminUserLevelHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserLevelHistoryRequest.ProtoReflect.Descriptor instead.
+func (*AdminUserLevelHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *AdminUserLevelHistoryRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminUserLevelHistoryRequest) GetAfterId() int64 {
+	if x != nil {
+		return x.AfterId
+	}
+	return 0
+}
+
+func (x *AdminUserLevelHistoryRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type AdminUserLevelHistoryReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Histories []*AdminUserLevelHistoryReply_List `protobuf:"bytes,1,rep,name=histories,proto3" json:"histories,omitempty"`
+	LastId    int64                              `protobuf:"varint,2,opt,name=last_id,json=lastId,proto3" json:"last_id,omitempty"`
+}
+
+func (x *AdminUserLevelHistoryReply) Reset() {
+	*x = AdminUserLevelHistoryReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUserLevelHistoryReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserLevelHistoryReply) ProtoMessage() {}
+
+func (x *AdminUserLevelHistoryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserLevelHistoryReply.ProtoReflect.Descriptor instead.
+func (*AdminUserLevelHistoryReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *AdminUserLevelHistoryReply) GetHistories() []*AdminUserLevelHistoryReply_List {
+	if x != nil {
+		return x.Histories
+	}
+	return nil
+}
+
+func (x *AdminUserLevelHistoryReply) GetLastId() int64 {
+	if x != nil {
+		return x.LastId
+	}
+	return 0
+}
+
+type AdminMonthRecommendRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Page    int64  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *AdminMonthRecommendRequest) Reset() {
+	*x = AdminMonthRecommendRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminMonthRecommendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminMonthRecommendRequest) ProtoMessage() {}
+
+func (x *AdminMonthRecommendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminMonthRecommendRequest.ProtoReflect.Descriptor instead.
+func (*AdminMonthRecommendRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *AdminMonthRecommendRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminMonthRecommendRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type AdminMonthRecommendReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users []*AdminMonthRecommendReply_List `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Count int64                            `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *AdminMonthRecommendReply) Reset() {
+	*x = AdminMonthRecommendReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminMonthRecommendReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminMonthRecommendReply) ProtoMessage() {}
+
+func (x *AdminMonthRecommendReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminMonthRecommendReply.ProtoReflect.Descriptor instead.
+func (*AdminMonthRecommendReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *AdminMonthRecommendReply) GetUsers() []*AdminMonthRecommendReply_List {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *AdminMonthRecommendReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AdminConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Page   int64 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *AdminConfigRequest) Reset() {
+	*x = AdminConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminConfigRequest) ProtoMessage() {}
+
+func (x *AdminConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminConfigRequest.ProtoReflect.Descriptor instead.
+func (*AdminConfigRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *AdminConfigRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminConfigRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type AdminConfigReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config []*AdminConfigReply_List `protobuf:"bytes,1,rep,name=config,proto3" json:"config,omitempty"`
+	Count  int64                    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *AdminConfigReply) Reset() {
+	*x = AdminConfigReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminConfigReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminConfigReply) ProtoMessage() {}
+
+func (x *AdminConfigReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminConfigReply.ProtoReflect.Descriptor instead.
+func (*AdminConfigReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *AdminConfigReply) GetConfig() []*AdminConfigReply_List {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *AdminConfigReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AdminConfigUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendBody *AdminConfigUpdateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+}
+
+func (x *AdminConfigUpdateRequest) Reset() {
+	*x = AdminConfigUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminConfigUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminConfigUpdateRequest) ProtoMessage() {}
+
+func (x *AdminConfigUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminConfigUpdateRequest.ProtoReflect.Descriptor instead.
+func (*AdminConfigUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *AdminConfigUpdateRequest) GetSendBody() *AdminConfigUpdateRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type AdminConfigUpdateReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminConfigUpdateReply) Reset() {
+	*x = AdminConfigUpdateReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminConfigUpdateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminConfigUpdateReply) ProtoMessage() {}
+
+func (x *AdminConfigUpdateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminConfigUpdateReply.ProtoReflect.Descriptor instead.
+func (*AdminConfigUpdateReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{112}
+}
+
+type EthAuthorizeRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Code    string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Sign    string `protobuf:"bytes,3,opt,name=sign,proto3" json:"sign,omitempty"`
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *EthAuthorizeRequest_SendBody) Reset() {
+	*x = EthAuthorizeRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EthAuthorizeRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EthAuthorizeRequest_SendBody) ProtoMessage() {}
+
+func (x *EthAuthorizeRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EthAuthorizeRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*EthAuthorizeRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *EthAuthorizeRequest_SendBody) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *EthAuthorizeRequest_SendBody) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *EthAuthorizeRequest_SendBody) GetSign() string {
+	if x != nil {
+		return x.Sign
+	}
+	return ""
+}
+
+func (x *EthAuthorizeRequest_SendBody) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetAuthNonceRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *GetAuthNonceRequest_SendBody) Reset() {
+	*x = GetAuthNonceRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAuthNonceRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuthNonceRequest_SendBody) ProtoMessage() {}
+
+func (x *GetAuthNonceRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuthNonceRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*GetAuthNonceRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *GetAuthNonceRequest_SendBody) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type RefreshTokenRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefreshToken string `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+}
+
+func (x *RefreshTokenRequest_SendBody) Reset() {
+	*x = RefreshTokenRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshTokenRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenRequest_SendBody) ProtoMessage() {}
+
+func (x *RefreshTokenRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *RefreshTokenRequest_SendBody) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type RecommendUpdateRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *RecommendUpdateRequest_SendBody) Reset() {
+	*x = RecommendUpdateRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecommendUpdateRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendUpdateRequest_SendBody) ProtoMessage() {}
+
+func (x *RecommendUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*RecommendUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *RecommendUpdateRequest_SendBody) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type UserInfoReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt      string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount         string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	LocationStatus string `protobuf:"bytes,3,opt,name=locationStatus,proto3" json:"locationStatus,omitempty"`
+	AmountMax      string `protobuf:"bytes,4,opt,name=amountMax,proto3" json:"amountMax,omitempty"`
+	OutRate        string `protobuf:"bytes,5,opt,name=outRate,proto3" json:"outRate,omitempty"`
+}
+
+func (x *UserInfoReply_List) Reset() {
+	*x = UserInfoReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserInfoReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfoReply_List) ProtoMessage() {}
+
+func (x *UserInfoReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfoReply_List.ProtoReflect.Descriptor instead.
+func (*UserInfoReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *UserInfoReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *UserInfoReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *UserInfoReply_List) GetLocationStatus() string {
+	if x != nil {
+		return x.LocationStatus
+	}
+	return ""
+}
+
+func (x *UserInfoReply_List) GetAmountMax() string {
+	if x != nil {
+		return x.AmountMax
+	}
+	return ""
+}
+
+func (x *UserInfoReply_List) GetOutRate() string {
+	if x != nil {
+		return x.OutRate
+	}
+	return ""
+}
+
+type UserInfoReply_List2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt    string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount       string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	RecommendNum int64  `protobuf:"varint,3,opt,name=recommend_num,json=recommendNum,proto3" json:"recommend_num,omitempty"`
+}
+
+func (x *UserInfoReply_List2) Reset() {
+	*x = UserInfoReply_List2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserInfoReply_List2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfoReply_List2) ProtoMessage() {}
+
+func (x *UserInfoReply_List2) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfoReply_List2.ProtoReflect.Descriptor instead.
+func (*UserInfoReply_List2) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{15, 1}
+}
+
+func (x *UserInfoReply_List2) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *UserInfoReply_List2) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *UserInfoReply_List2) GetRecommendNum() int64 {
+	if x != nil {
+		return x.RecommendNum
+	}
+	return 0
+}
+
+type UserInfoReply_List3 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *UserInfoReply_List3) Reset() {
+	*x = UserInfoReply_List3{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserInfoReply_List3) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfoReply_List3) ProtoMessage() {}
+
+func (x *UserInfoReply_List3) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfoReply_List3.ProtoReflect.Descriptor instead.
+func (*UserInfoReply_List3) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{15, 2}
+}
+
+func (x *UserInfoReply_List3) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *UserInfoReply_List3) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type UserInfoReply_List4 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *UserInfoReply_List4) Reset() {
+	*x = UserInfoReply_List4{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserInfoReply_List4) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfoReply_List4) ProtoMessage() {}
+
+func (x *UserInfoReply_List4) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfoReply_List4.ProtoReflect.Descriptor instead.
+func (*UserInfoReply_List4) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{15, 3}
+}
+
+func (x *UserInfoReply_List4) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *UserInfoReply_List4) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type UserInfoReply_List5 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *UserInfoReply_List5) Reset() {
+	*x = UserInfoReply_List5{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserInfoReply_List5) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfoReply_List5) ProtoMessage() {}
+
+func (x *UserInfoReply_List5) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfoReply_List5.ProtoReflect.Descriptor instead.
+func (*UserInfoReply_List5) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{15, 4}
+}
+
+func (x *UserInfoReply_List5) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *UserInfoReply_List5) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type UserInfoReply_List6 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *UserInfoReply_List6) Reset() {
+	*x = UserInfoReply_List6{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserInfoReply_List6) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfoReply_List6) ProtoMessage() {}
+
+func (x *UserInfoReply_List6) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfoReply_List6.ProtoReflect.Descriptor instead.
+func (*UserInfoReply_List6) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{15, 5}
+}
+
+func (x *UserInfoReply_List6) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *UserInfoReply_List6) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type UserInfoReply_List7 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  string `protobuf:"bytes,2,opt,name=Amount,proto3" json:"Amount,omitempty"`
+}
+
+func (x *UserInfoReply_List7) Reset() {
+	*x = UserInfoReply_List7{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserInfoReply_List7) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfoReply_List7) ProtoMessage() {}
+
+func (x *UserInfoReply_List7) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfoReply_List7.ProtoReflect.Descriptor instead.
+func (*UserInfoReply_List7) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{15, 6}
+}
+
+func (x *UserInfoReply_List7) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *UserInfoReply_List7) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type UserInfoReply_List8 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *UserInfoReply_List8) Reset() {
+	*x = UserInfoReply_List8{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserInfoReply_List8) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfoReply_List8) ProtoMessage() {}
+
+func (x *UserInfoReply_List8) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfoReply_List8.ProtoReflect.Descriptor instead.
+func (*UserInfoReply_List8) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{15, 7}
+}
+
+func (x *UserInfoReply_List8) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type UserInfoReply_List9 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *UserInfoReply_List9) Reset() {
+	*x = UserInfoReply_List9{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserInfoReply_List9) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfoReply_List9) ProtoMessage() {}
+
+func (x *UserInfoReply_List9) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfoReply_List9.ProtoReflect.Descriptor instead.
+func (*UserInfoReply_List9) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{15, 8}
+}
+
+func (x *UserInfoReply_List9) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *UserInfoReply_List9) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type RewardListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt      string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount         string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	LocationStatus string `protobuf:"bytes,3,opt,name=locationStatus,proto3" json:"locationStatus,omitempty"`
+	Type           string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Reason         string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Id             int64  `protobuf:"varint,6,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RewardListReply_List) Reset() {
+	*x = RewardListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewardListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardListReply_List) ProtoMessage() {}
+
+func (x *RewardListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardListReply_List.ProtoReflect.Descriptor instead.
+func (*RewardListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{17, 0}
+}
+
+func (x *RewardListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *RewardListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RewardListReply_List) GetLocationStatus() string {
+	if x != nil {
+		return x.LocationStatus
+	}
+	return ""
+}
+
+func (x *RewardListReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RewardListReply_List) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RewardListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RecommendRewardListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Type      string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Id        int64  `protobuf:"varint,5,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RecommendRewardListReply_List) Reset() {
+	*x = RecommendRewardListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecommendRewardListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendRewardListReply_List) ProtoMessage() {}
+
+func (x *RecommendRewardListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendRewardListReply_List.ProtoReflect.Descriptor instead.
+func (*RecommendRewardListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{19, 0}
+}
+
+func (x *RecommendRewardListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *RecommendRewardListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RecommendRewardListReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RecommendRewardListReply_List) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RecommendRewardListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type FeeRewardListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Id        int64  `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *FeeRewardListReply_List) Reset() {
+	*x = FeeRewardListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeRewardListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeRewardListReply_List) ProtoMessage() {}
+
+func (x *FeeRewardListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeRewardListReply_List.ProtoReflect.Descriptor instead.
+func (*FeeRewardListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{21, 0}
+}
+
+func (x *FeeRewardListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *FeeRewardListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *FeeRewardListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type WithdrawListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Type      string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Status    string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Reason    string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Id        int64  `protobuf:"varint,6,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *WithdrawListReply_List) Reset() {
+	*x = WithdrawListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawListReply_List) ProtoMessage() {}
+
+func (x *WithdrawListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawListReply_List.ProtoReflect.Descriptor instead.
+func (*WithdrawListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{23, 0}
+}
+
+func (x *WithdrawListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *WithdrawListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *WithdrawListReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *WithdrawListReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WithdrawListReply_List) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *WithdrawListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RecommendListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status    string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt string `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UserId    int64  `protobuf:"varint,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *RecommendListReply_List) Reset() {
+	*x = RecommendListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecommendListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendListReply_List) ProtoMessage() {}
+
+func (x *RecommendListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendListReply_List.ProtoReflect.Descriptor instead.
+func (*RecommendListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{25, 0}
+}
+
+func (x *RecommendListReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *RecommendListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RecommendListReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RecommendListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *RecommendListReply_List) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type WithdrawRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type      string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Amount    string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	AddressId int64  `protobuf:"varint,3,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+}
+
+func (x *WithdrawRequest_SendBody) Reset() {
+	*x = WithdrawRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawRequest_SendBody) ProtoMessage() {}
+
+func (x *WithdrawRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*WithdrawRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{30, 0}
+}
+
+func (x *WithdrawRequest_SendBody) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *WithdrawRequest_SendBody) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *WithdrawRequest_SendBody) GetAddressId() int64 {
+	if x != nil {
+		return x.AddressId
+	}
+	return 0
+}
+
+type CancelWithdrawRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CancelWithdrawRequest_SendBody) Reset() {
+	*x = CancelWithdrawRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelWithdrawRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelWithdrawRequest_SendBody) ProtoMessage() {}
+
+func (x *CancelWithdrawRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelWithdrawRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*CancelWithdrawRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{32, 0}
+}
+
+func (x *CancelWithdrawRequest_SendBody) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type WithdrawAddressListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address    string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Label      string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Status     string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	ActivateAt string `protobuf:"bytes,5,opt,name=activate_at,json=activateAt,proto3" json:"activate_at,omitempty"`
+	CreatedAt  string `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *WithdrawAddressListReply_List) Reset() {
+	*x = WithdrawAddressListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawAddressListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawAddressListReply_List) ProtoMessage() {}
+
+func (x *WithdrawAddressListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawAddressListReply_List.ProtoReflect.Descriptor instead.
+func (*WithdrawAddressListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{35, 0}
+}
+
+func (x *WithdrawAddressListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WithdrawAddressListReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *WithdrawAddressListReply_List) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *WithdrawAddressListReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WithdrawAddressListReply_List) GetActivateAt() string {
+	if x != nil {
+		return x.ActivateAt
+	}
+	return ""
+}
+
+func (x *WithdrawAddressListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AddWithdrawAddressRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Label   string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Sign    string `protobuf:"bytes,3,opt,name=sign,proto3" json:"sign,omitempty"`
+}
+
+func (x *AddWithdrawAddressRequest_SendBody) Reset() {
+	*x = AddWithdrawAddressRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddWithdrawAddressRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWithdrawAddressRequest_SendBody) ProtoMessage() {}
+
+func (x *AddWithdrawAddressRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWithdrawAddressRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AddWithdrawAddressRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{36, 0}
+}
+
+func (x *AddWithdrawAddressRequest_SendBody) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AddWithdrawAddressRequest_SendBody) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *AddWithdrawAddressRequest_SendBody) GetSign() string {
+	if x != nil {
+		return x.Sign
+	}
+	return ""
+}
+
+type DeleteWithdrawAddressRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteWithdrawAddressRequest_SendBody) Reset() {
+	*x = DeleteWithdrawAddressRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWithdrawAddressRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWithdrawAddressRequest_SendBody) ProtoMessage() {}
+
+func (x *DeleteWithdrawAddressRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWithdrawAddressRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*DeleteWithdrawAddressRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{38, 0}
+}
+
+func (x *DeleteWithdrawAddressRequest_SendBody) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type SetBalanceRewardRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *SetBalanceRewardRequest_SendBody) Reset() {
+	*x = SetBalanceRewardRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetBalanceRewardRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBalanceRewardRequest_SendBody) ProtoMessage() {}
+
+func (x *SetBalanceRewardRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBalanceRewardRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*SetBalanceRewardRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{40, 0}
+}
+
+func (x *SetBalanceRewardRequest_SendBody) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type DeleteBalanceRewardRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *DeleteBalanceRewardRequest_SendBody) Reset() {
+	*x = DeleteBalanceRewardRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBalanceRewardRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBalanceRewardRequest_SendBody) ProtoMessage() {}
+
+func (x *DeleteBalanceRewardRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBalanceRewardRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*DeleteBalanceRewardRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{42, 0}
+}
+
+func (x *DeleteBalanceRewardRequest_SendBody) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type AdminRewardListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Type      string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Address   string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Reason    string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *AdminRewardListReply_List) Reset() {
+	*x = AdminRewardListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminRewardListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRewardListReply_List) ProtoMessage() {}
+
+func (x *AdminRewardListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRewardListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminRewardListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{45, 0}
+}
+
+func (x *AdminRewardListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminRewardListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AdminRewardListReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AdminRewardListReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminRewardListReply_List) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdminUserListReply_UserList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId           int64  `protobuf:"varint,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedAt        string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Address          string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	BalanceUsdt      string `protobuf:"bytes,3,opt,name=balanceUsdt,proto3" json:"balanceUsdt,omitempty"`
+	BalanceDhb       string `protobuf:"bytes,4,opt,name=balanceDhb,proto3" json:"balanceDhb,omitempty"`
+	Vip              int64  `protobuf:"varint,5,opt,name=vip,proto3" json:"vip,omitempty"`
+	MonthRecommend   int64  `protobuf:"varint,7,opt,name=monthRecommend,proto3" json:"monthRecommend,omitempty"`
+	HistoryRecommend int64  `protobuf:"varint,6,opt,name=historyRecommend,proto3" json:"historyRecommend,omitempty"`
+}
+
+func (x *AdminUserListReply_UserList) Reset() {
+	*x = AdminUserListReply_UserList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUserListReply_UserList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserListReply_UserList) ProtoMessage() {}
+
+func (x *AdminUserListReply_UserList) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserListReply_UserList.ProtoReflect.Descriptor instead.
+func (*AdminUserListReply_UserList) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{47, 0}
+}
+
+func (x *AdminUserListReply_UserList) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminUserListReply_UserList) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminUserListReply_UserList) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminUserListReply_UserList) GetBalanceUsdt() string {
+	if x != nil {
+		return x.BalanceUsdt
+	}
+	return ""
+}
+
+func (x *AdminUserListReply_UserList) GetBalanceDhb() string {
+	if x != nil {
+		return x.BalanceDhb
+	}
+	return ""
+}
+
+func (x *AdminUserListReply_UserList) GetVip() int64 {
+	if x != nil {
+		return x.Vip
+	}
+	return 0
+}
+
+func (x *AdminUserListReply_UserList) GetMonthRecommend() int64 {
+	if x != nil {
+		return x.MonthRecommend
+	}
+	return 0
+}
+
+func (x *AdminUserListReply_UserList) GetHistoryRecommend() int64 {
+	if x != nil {
+		return x.HistoryRecommend
+	}
+	return 0
+}
+
+type AdminLocationListReply_LocationList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt    string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Address      string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Row          int64  `protobuf:"varint,3,opt,name=row,proto3" json:"row,omitempty"`
+	Col          int64  `protobuf:"varint,4,opt,name=col,proto3" json:"col,omitempty"`
+	Status       string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	CurrentLevel int64  `protobuf:"varint,6,opt,name=currentLevel,proto3" json:"currentLevel,omitempty"`
+	Current      string `protobuf:"bytes,7,opt,name=current,proto3" json:"current,omitempty"`
+	CurrentMax   string `protobuf:"bytes,8,opt,name=currentMax,proto3" json:"currentMax,omitempty"`
+}
+
+func (x *AdminLocationListReply_LocationList) Reset() {
+	*x = AdminLocationListReply_LocationList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminLocationListReply_LocationList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLocationListReply_LocationList) ProtoMessage() {}
+
+func (x *AdminLocationListReply_LocationList) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLocationListReply_LocationList.ProtoReflect.Descriptor instead.
+func (*AdminLocationListReply_LocationList) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{49, 0}
+}
+
+func (x *AdminLocationListReply_LocationList) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminLocationListReply_LocationList) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminLocationListReply_LocationList) GetRow() int64 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *AdminLocationListReply_LocationList) GetCol() int64 {
+	if x != nil {
+		return x.Col
+	}
+	return 0
+}
+
+func (x *AdminLocationListReply_LocationList) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminLocationListReply_LocationList) GetCurrentLevel() int64 {
+	if x != nil {
+		return x.CurrentLevel
+	}
+	return 0
+}
+
+func (x *AdminLocationListReply_LocationList) GetCurrent() string {
+	if x != nil {
+		return x.Current
+	}
+	return ""
+}
+
+func (x *AdminLocationListReply_LocationList) GetCurrentMax() string {
+	if x != nil {
+		return x.CurrentMax
+	}
+	return ""
+}
+
+type AdminWithdrawListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address   string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Id        int64  `protobuf:"varint,7,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	RelAmount string `protobuf:"bytes,6,opt,name=relAmount,proto3" json:"relAmount,omitempty"`
+	Type      string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Status    string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *AdminWithdrawListReply_List) Reset() {
+	*x = AdminWithdrawListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWithdrawListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWithdrawListReply_List) ProtoMessage() {}
+
+func (x *AdminWithdrawListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWithdrawListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{51, 0}
+}
+
+func (x *AdminWithdrawListReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminWithdrawListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminWithdrawListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminWithdrawListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AdminWithdrawListReply_List) GetRelAmount() string {
+	if x != nil {
+		return x.RelAmount
+	}
+	return ""
+}
+
+func (x *AdminWithdrawListReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AdminWithdrawListReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type AdminLoginRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account  string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *AdminLoginRequest_SendBody) Reset() {
+	*x = AdminLoginRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminLoginRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLoginRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminLoginRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLoginRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminLoginRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{52, 0}
+}
+
+func (x *AdminLoginRequest_SendBody) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *AdminLoginRequest_SendBody) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type AdminWithdrawApproveRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AdminWithdrawApproveRequest_SendBody) Reset() {
+	*x = AdminWithdrawApproveRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWithdrawApproveRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWithdrawApproveRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminWithdrawApproveRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWithdrawApproveRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawApproveRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{54, 0}
+}
+
+func (x *AdminWithdrawApproveRequest_SendBody) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AdminWithdrawRejectRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *AdminWithdrawRejectRequest_SendBody) Reset() {
+	*x = AdminWithdrawRejectRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWithdrawRejectRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWithdrawRejectRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminWithdrawRejectRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWithdrawRejectRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawRejectRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{56, 0}
+}
+
+func (x *AdminWithdrawRejectRequest_SendBody) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminWithdrawRejectRequest_SendBody) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdminWithdrawRetryRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AdminWithdrawRetryRequest_SendBody) Reset() {
+	*x = AdminWithdrawRetryRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWithdrawRetryRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWithdrawRetryRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminWithdrawRetryRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWithdrawRetryRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawRetryRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{58, 0}
+}
+
+func (x *AdminWithdrawRetryRequest_SendBody) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AdminWithdrawRefundRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AdminWithdrawRefundRequest_SendBody) Reset() {
+	*x = AdminWithdrawRefundRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWithdrawRefundRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWithdrawRefundRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminWithdrawRefundRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWithdrawRefundRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawRefundRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{60, 0}
+}
+
+func (x *AdminWithdrawRefundRequest_SendBody) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AdminWithdrawLegacyMigrateRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminWithdrawLegacyMigrateRequest_SendBody) Reset() {
+	*x = AdminWithdrawLegacyMigrateRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWithdrawLegacyMigrateRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWithdrawLegacyMigrateRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminWithdrawLegacyMigrateRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWithdrawLegacyMigrateRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawLegacyMigrateRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{62, 0}
+}
+
+type AdminWithdrawBulkApproveRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *AdminWithdrawBulkApproveRequest_SendBody) Reset() {
+	*x = AdminWithdrawBulkApproveRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWithdrawBulkApproveRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWithdrawBulkApproveRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminWithdrawBulkApproveRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWithdrawBulkApproveRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawBulkApproveRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{64, 0}
+}
+
+func (x *AdminWithdrawBulkApproveRequest_SendBody) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type AdminWithdrawBulkApproveReply_Fail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *AdminWithdrawBulkApproveReply_Fail) Reset() {
+	*x = AdminWithdrawBulkApproveReply_Fail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWithdrawBulkApproveReply_Fail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWithdrawBulkApproveReply_Fail) ProtoMessage() {}
+
+func (x *AdminWithdrawBulkApproveReply_Fail) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWithdrawBulkApproveReply_Fail.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawBulkApproveReply_Fail) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{65, 0}
+}
+
+func (x *AdminWithdrawBulkApproveReply_Fail) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminWithdrawBulkApproveReply_Fail) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdminLedgerBalanceReply_Coin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoinType        string                             `protobuf:"bytes,1,opt,name=coin_type,json=coinType,proto3" json:"coin_type,omitempty"`
+	Accounts        []*AdminLedgerBalanceReply_Account `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	UserLiabilities string                             `protobuf:"bytes,3,opt,name=user_liabilities,json=userLiabilities,proto3" json:"user_liabilities,omitempty"`
+	SystemTotal     string                             `protobuf:"bytes,4,opt,name=system_total,json=systemTotal,proto3" json:"system_total,omitempty"`
+	ChainHoldings   string                             `protobuf:"bytes,5,opt,name=chain_holdings,json=chainHoldings,proto3" json:"chain_holdings,omitempty"`
+	UserBalance     string                             `protobuf:"bytes,6,opt,name=user_balance,json=userBalance,proto3" json:"user_balance,omitempty"`
+	Balanced        bool                               `protobuf:"varint,7,opt,name=balanced,proto3" json:"balanced,omitempty"`
+}
+
+func (x *AdminLedgerBalanceReply_Coin) Reset() {
+	*x = AdminLedgerBalanceReply_Coin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminLedgerBalanceReply_Coin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLedgerBalanceReply_Coin) ProtoMessage() {}
+
+func (x *AdminLedgerBalanceReply_Coin) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLedgerBalanceReply_Coin.ProtoReflect.Descriptor instead.
+func (*AdminLedgerBalanceReply_Coin) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{67, 0}
+}
+
+func (x *AdminLedgerBalanceReply_Coin) GetCoinType() string {
+	if x != nil {
+		return x.CoinType
+	}
+	return ""
+}
+
+func (x *AdminLedgerBalanceReply_Coin) GetAccounts() []*AdminLedgerBalanceReply_Account {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *AdminLedgerBalanceReply_Coin) GetUserLiabilities() string {
+	if x != nil {
+		return x.UserLiabilities
+	}
+	return ""
+}
+
+func (x *AdminLedgerBalanceReply_Coin) GetSystemTotal() string {
+	if x != nil {
+		return x.SystemTotal
+	}
+	return ""
+}
+
+func (x *AdminLedgerBalanceReply_Coin) GetChainHoldings() string {
+	if x != nil {
+		return x.ChainHoldings
+	}
+	return ""
+}
+
+func (x *AdminLedgerBalanceReply_Coin) GetUserBalance() string {
+	if x != nil {
+		return x.UserBalance
+	}
+	return ""
+}
+
+func (x *AdminLedgerBalanceReply_Coin) GetBalanced() bool {
+	if x != nil {
+		return x.Balanced
+	}
+	return false
+}
+
+type AdminLedgerBalanceReply_Account struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Amount  string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *AdminLedgerBalanceReply_Account) Reset() {
+	*x = AdminLedgerBalanceReply_Account{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminLedgerBalanceReply_Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLedgerBalanceReply_Account) ProtoMessage() {}
+
+func (x *AdminLedgerBalanceReply_Account) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLedgerBalanceReply_Account.ProtoReflect.Descriptor instead.
+func (*AdminLedgerBalanceReply_Account) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{67, 1}
+}
+
+func (x *AdminLedgerBalanceReply_Account) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *AdminLedgerBalanceReply_Account) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type AdminRecommendClosureBackfillRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminRecommendClosureBackfillRequest_SendBody) Reset() {
+	*x = AdminRecommendClosureBackfillRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminRecommendClosureBackfillRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRecommendClosureBackfillRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminRecommendClosureBackfillRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRecommendClosureBackfillRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminRecommendClosureBackfillRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{68, 0}
+}
+
+type AdminLedgerOpenRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminLedgerOpenRequest_SendBody) Reset() {
+	*x = AdminLedgerOpenRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminLedgerOpenRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLedgerOpenRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminLedgerOpenRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLedgerOpenRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminLedgerOpenRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{70, 0}
+}
+
+type AdminReconcileRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminReconcileRequest_SendBody) Reset() {
+	*x = AdminReconcileRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminReconcileRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminReconcileRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminReconcileRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminReconcileRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminReconcileRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{72, 0}
+}
+
+type AdminDepositUnmatchedListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Hash        string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	From        string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	Amount      string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	BlockNumber int64  `protobuf:"varint,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+}
+
+func (x *AdminDepositUnmatchedListReply_List) Reset() {
+	*x = AdminDepositUnmatchedListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDepositUnmatchedListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDepositUnmatchedListReply_List) ProtoMessage() {}
+
+func (x *AdminDepositUnmatchedListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDepositUnmatchedListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminDepositUnmatchedListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{75, 0}
+}
+
+func (x *AdminDepositUnmatchedListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminDepositUnmatchedListReply_List) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *AdminDepositUnmatchedListReply_List) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *AdminDepositUnmatchedListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AdminDepositUnmatchedListReply_List) GetBlockNumber() int64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+type AdminDepositResolveRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *AdminDepositResolveRequest_SendBody) Reset() {
+	*x = AdminDepositResolveRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDepositResolveRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDepositResolveRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminDepositResolveRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDepositResolveRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminDepositResolveRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{76, 0}
+}
+
+func (x *AdminDepositResolveRequest_SendBody) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminDepositResolveRequest_SendBody) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type AdminDiscrepancyListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId    int64  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CoinType  string `protobuf:"bytes,3,opt,name=coin_type,json=coinType,proto3" json:"coin_type,omitempty"`
+	Source    string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	Expected  string `protobuf:"bytes,5,opt,name=expected,proto3" json:"expected,omitempty"`
+	Actual    string `protobuf:"bytes,6,opt,name=actual,proto3" json:"actual,omitempty"`
+	Diff      string `protobuf:"bytes,7,opt,name=diff,proto3" json:"diff,omitempty"`
+	CreatedAt string `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt string `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *AdminDiscrepancyListReply_List) Reset() {
+	*x = AdminDiscrepancyListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminDiscrepancyListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDiscrepancyListReply_List) ProtoMessage() {}
+
+func (x *AdminDiscrepancyListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDiscrepancyListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminDiscrepancyListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{79, 0}
+}
+
+func (x *AdminDiscrepancyListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminDiscrepancyListReply_List) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminDiscrepancyListReply_List) GetCoinType() string {
+	if x != nil {
+		return x.CoinType
+	}
+	return ""
+}
+
+func (x *AdminDiscrepancyListReply_List) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *AdminDiscrepancyListReply_List) GetExpected() string {
+	if x != nil {
+		return x.Expected
+	}
+	return ""
+}
+
+func (x *AdminDiscrepancyListReply_List) GetActual() string {
+	if x != nil {
+		return x.Actual
+	}
+	return ""
+}
+
+func (x *AdminDiscrepancyListReply_List) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+func (x *AdminDiscrepancyListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminDiscrepancyListReply_List) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type AdminLocationDailySettleRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminLocationDailySettleRequest_SendBody) Reset() {
+	*x = AdminLocationDailySettleRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminLocationDailySettleRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLocationDailySettleRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminLocationDailySettleRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLocationDailySettleRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminLocationDailySettleRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{80, 0}
+}
+
+type AdminUserAreaRebuildRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminUserAreaRebuildRequest_SendBody) Reset() {
+	*x = AdminUserAreaRebuildRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUserAreaRebuildRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserAreaRebuildRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminUserAreaRebuildRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserAreaRebuildRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminUserAreaRebuildRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{82, 0}
+}
+
+type AdminSettlementDryRunRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Configs        []*SettlementConfig `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
+	CompareConfigs []*SettlementConfig `protobuf:"bytes,2,rep,name=compare_configs,json=compareConfigs,proto3" json:"compare_configs,omitempty"`
+	Compare        bool                `protobuf:"varint,3,opt,name=compare,proto3" json:"compare,omitempty"`
+}
+
+func (x *AdminSettlementDryRunRequest_SendBody) Reset() {
+	*x = AdminSettlementDryRunRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminSettlementDryRunRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSettlementDryRunRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminSettlementDryRunRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSettlementDryRunRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminSettlementDryRunRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{89, 0}
+}
+
+func (x *AdminSettlementDryRunRequest_SendBody) GetConfigs() []*SettlementConfig {
+	if x != nil {
+		return x.Configs
+	}
+	return nil
+}
+
+func (x *AdminSettlementDryRunRequest_SendBody) GetCompareConfigs() []*SettlementConfig {
+	if x != nil {
+		return x.CompareConfigs
+	}
+	return nil
+}
+
+func (x *AdminSettlementDryRunRequest_SendBody) GetCompare() bool {
+	if x != nil {
+		return x.Compare
+	}
+	return false
+}
+
+type AdminSettlementReplayRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date    string              `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Configs []*SettlementConfig `protobuf:"bytes,2,rep,name=configs,proto3" json:"configs,omitempty"`
+}
+
+func (x *AdminSettlementReplayRequest_SendBody) Reset() {
+	*x = AdminSettlementReplayRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminSettlementReplayRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSettlementReplayRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminSettlementReplayRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSettlementReplayRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminSettlementReplayRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{91, 0}
+}
+
+func (x *AdminSettlementReplayRequest_SendBody) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *AdminSettlementReplayRequest_SendBody) GetConfigs() []*SettlementConfig {
+	if x != nil {
+		return x.Configs
+	}
+	return nil
+}
+
+type AdminUserRecommendReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    int64  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Address   string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Id        int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AdminUserRecommendReply_List) Reset() {
+	*x = AdminUserRecommendReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[162]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUserRecommendReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserRecommendReply_List) ProtoMessage() {}
+
+func (x *AdminUserRecommendReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[162]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserRecommendReply_List.ProtoReflect.Descriptor instead.
+func (*AdminUserRecommendReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{100, 0}
+}
+
+func (x *AdminUserRecommendReply_List) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminUserRecommendReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminUserRecommendReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminUserRecommendReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdminUserLevelOverrideRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Level    int64  `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
+	ExpireAt string `protobuf:"bytes,3,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+	Reason   string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *AdminUserLevelOverrideRequest_SendBody) Reset() {
+	*x = AdminUserLevelOverrideRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUserLevelOverrideRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserLevelOverrideRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminUserLevelOverrideRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserLevelOverrideRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminUserLevelOverrideRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{103, 0}
+}
+
+func (x *AdminUserLevelOverrideRequest_SendBody) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminUserLevelOverrideRequest_SendBody) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *AdminUserLevelOverrideRequest_SendBody) GetExpireAt() string {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return ""
+}
+
+func (x *AdminUserLevelOverrideRequest_SendBody) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdminUserLevelHistoryReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OldLevel      int64  `protobuf:"varint,3,opt,name=old_level,json=oldLevel,proto3" json:"old_level,omitempty"`
+	NewLevel      int64  `protobuf:"varint,4,opt,name=new_level,json=newLevel,proto3" json:"new_level,omitempty"`
+	AutoLevel     int64  `protobuf:"varint,5,opt,name=auto_level,json=autoLevel,proto3" json:"auto_level,omitempty"`
+	OverrideLevel int64  `protobuf:"varint,6,opt,name=override_level,json=overrideLevel,proto3" json:"override_level,omitempty"`
+	AreaAmount    string `protobuf:"bytes,7,opt,name=area_amount,json=areaAmount,proto3" json:"area_amount,omitempty"`
+	RecommendNum  int64  `protobuf:"varint,8,opt,name=recommend_num,json=recommendNum,proto3" json:"recommend_num,omitempty"`
+	Source        string `protobuf:"bytes,9,opt,name=source,proto3" json:"source,omitempty"`
+	CreatedAt     string `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AdminUserLevelHistoryReply_List) Reset() {
+	*x = AdminUserLevelHistoryReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[164]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUserLevelHistoryReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserLevelHistoryReply_List) ProtoMessage() {}
+
+func (x *AdminUserLevelHistoryReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[164]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserLevelHistoryReply_List.ProtoReflect.Descriptor instead.
+func (*AdminUserLevelHistoryReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{106, 0}
+}
+
+func (x *AdminUserLevelHistoryReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminUserLevelHistoryReply_List) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminUserLevelHistoryReply_List) GetOldLevel() int64 {
+	if x != nil {
+		return x.OldLevel
+	}
+	return 0
+}
+
+func (x *AdminUserLevelHistoryReply_List) GetNewLevel() int64 {
+	if x != nil {
+		return x.NewLevel
+	}
+	return 0
+}
+
+func (x *AdminUserLevelHistoryReply_List) GetAutoLevel() int64 {
+	if x != nil {
+		return x.AutoLevel
+	}
+	return 0
+}
+
+func (x *AdminUserLevelHistoryReply_List) GetOverrideLevel() int64 {
+	if x != nil {
+		return x.OverrideLevel
+	}
+	return 0
+}
+
+func (x *AdminUserLevelHistoryReply_List) GetAreaAmount() string {
+	if x != nil {
+		return x.AreaAmount
+	}
+	return ""
+}
+
+func (x *AdminUserLevelHistoryReply_List) GetRecommendNum() int64 {
+	if x != nil {
+		return x.RecommendNum
+	}
+	return 0
+}
+
+func (x *AdminUserLevelHistoryReply_List) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *AdminUserLevelHistoryReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdminMonthRecommendReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address          string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	RecommendAddress string `protobuf:"bytes,4,opt,name=recommendAddress,proto3" json:"recommendAddress,omitempty"`
+	Id               int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt        string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AdminMonthRecommendReply_List) Reset() {
+	*x = AdminMonthRecommendReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminMonthRecommendReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminMonthRecommendReply_List) ProtoMessage() {}
+
+func (x *AdminMonthRecommendReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminMonthRecommendReply_List.ProtoReflect.Descriptor instead.
+func (*AdminMonthRecommendReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{108, 0}
+}
+
+func (x *AdminMonthRecommendReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminMonthRecommendReply_List) GetRecommendAddress() string {
+	if x != nil {
+		return x.RecommendAddress
+	}
+	return ""
+}
+
+func (x *AdminMonthRecommendReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminMonthRecommendReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdminConfigReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *AdminConfigReply_List) Reset() {
+	*x = AdminConfigReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminConfigReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminConfigReply_List) ProtoMessage() {}
+
+func (x *AdminConfigReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminConfigReply_List.ProtoReflect.Descriptor instead.
+func (*AdminConfigReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{110, 0}
+}
+
+func (x *AdminConfigReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminConfigReply_List) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdminConfigReply_List) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type AdminConfigUpdateRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *AdminConfigUpdateRequest_SendBody) Reset() {
+	*x = AdminConfigUpdateRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[167]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminConfigUpdateRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminConfigUpdateRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminConfigUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[167]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminConfigUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminConfigUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{111, 0}
+}
+
+func (x *AdminConfigUpdateRequest_SendBody) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminConfigUpdateRequest_SendBody) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+var File_app_app_api_app_proto protoreflect.FileDescriptor
+
+var file_app_app_api_app_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x61, 0x70, 0x70, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x61, 0x70, 0x69, 0x1a, 0x1c, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xbd, 0x01, 0x0a, 0x13, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x09, 0x73,
+	0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
+	0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x66, 0x0a, 0x08, 0x53,
+	0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x4e, 0x0a, 0x11, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23,
+	0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x7b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x4e, 0x6f,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x09, 0x73, 0x65,
+	0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x4e, 0x6f, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79,
+	0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x24, 0x0a, 0x08, 0x53, 0x65,
+	0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x22, 0x43, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x4e, 0x6f, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a,
+	0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42,
+	0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x2f, 0x0a,
+	0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4e,
+	0x0a, 0x11, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x0f,
+	0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x25, 0x0a, 0x0b, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74,
+	0x41, 0x6c, 0x6c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x30, 0x0a, 0x16, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x41, 0x6c, 0x6c, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x7b, 0x0a, 0x16, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41,
+	0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53,
+	0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
+	0x79, 0x1a, 0x1e, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x22, 0x44, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x10, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0e, 0x0a, 0x0c, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xeb, 0x17, 0x0a,
+	0x0d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62,
+	0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x2c,
+	0x0a, 0x11, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0c,
+	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d,
+	0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x61,
+	0x6d, 0x4e, 0x75, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x4e, 0x75, 0x6d, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x64, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x73, 0x64, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x56, 0x69, 0x70, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x1c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x56, 0x69,
+	0x70, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x44, 0x61, 0x69,
+	0x6c, 0x79, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x65, 0x44, 0x61, 0x69,
+	0x6c, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x77, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73,
+	0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75,
+	0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x20, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x70, 0x6f, 0x6f, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x22, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x6f, 0x6f, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x23, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x18, 0x24, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x54, 0x6f, 0x70, 0x18, 0x25, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x79, 0x62, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x79, 0x62, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x79, 0x62, 0x52, 0x61, 0x74, 0x65, 0x18,
+	0x29, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x79, 0x62, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x61, 0x72, 0x65, 0x61, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x2a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x61, 0x72, 0x65, 0x61, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e,
+	0x0a, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x65, 0x61, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x65, 0x61, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x24,
+	0x0a, 0x0d, 0x61, 0x72, 0x65, 0x61, 0x4d, 0x61, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x2c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x72, 0x65, 0x61, 0x4d, 0x61, 0x78, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x64, 0x6f, 0x18, 0x2d, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x75, 0x6e, 0x64, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x43, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x43, 0x12, 0x30, 0x0a, 0x13, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x13, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x65, 0x61, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x30, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x65, 0x61, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x2e, 0x0a, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x61,
+	0x6d, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x31, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x3a, 0x0a, 0x18, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x69, 0x6c,
+	0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x32, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x18, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x69, 0x6c,
+	0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x38, 0x0a, 0x17,
+	0x64, 0x61, 0x69, 0x6c, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x33, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x64,
+	0x61, 0x69, 0x6c, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x40, 0x0a, 0x1b, 0x79, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x64, 0x61, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x3a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x79, 0x65, 0x73,
+	0x74, 0x65, 0x72, 0x64, 0x61, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54,
+	0x65, 0x61, 0x6d, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x4c, 0x0a, 0x21, 0x79, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x64, 0x61, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x69,
+	0x6c, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x3b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x21, 0x79, 0x65, 0x73, 0x74, 0x65, 0x72, 0x64, 0x61, 0x79, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x4a, 0x0a, 0x20, 0x79, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x64, 0x61, 0x79, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x20, 0x79, 0x65, 0x73, 0x74, 0x65, 0x72, 0x64, 0x61, 0x79, 0x44, 0x61, 0x69, 0x6c, 0x79,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x40, 0x0a, 0x1b, 0x79, 0x65, 0x73, 0x74, 0x65, 0x72, 0x64, 0x61, 0x79, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x65, 0x61, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x79, 0x65, 0x73, 0x74, 0x65, 0x72, 0x64,
+	0x61, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x65, 0x61, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x38, 0x0a, 0x17, 0x79, 0x65, 0x73, 0x74, 0x65, 0x72, 0x64, 0x61,
+	0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x3e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x79, 0x65, 0x73, 0x74, 0x65, 0x72, 0x64, 0x61, 0x79,
+	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x28,
+	0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x72, 0x65, 0x61, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x3f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x72,
+	0x65, 0x61, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x15, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x40, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3b,
+	0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x34,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0c, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x11, 0x72,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x4c, 0x69, 0x73, 0x74,
+	0x18, 0x35, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x32,
+	0x52, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x41, 0x72, 0x65, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x36, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x33, 0x52, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x41, 0x72, 0x65, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x17, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x37, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x34, 0x52, 0x17, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x3e, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74,
+	0x18, 0x38, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x35,
+	0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x50, 0x0a, 0x16, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x39, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x36, 0x52, 0x16, 0x64, 0x61, 0x69, 0x6c, 0x79,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x42, 0x0a, 0x0f, 0x74, 0x65, 0x61, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x4c, 0x69, 0x73, 0x74, 0x18, 0x41, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x37, 0x52, 0x0f, 0x74, 0x65, 0x61, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x50, 0x0a, 0x16, 0x6d, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18,
+	0x42, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x38, 0x52,
+	0x16, 0x6d, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x43, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x39, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x9d, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x12, 0x18, 0x0a,
+	0x07, 0x6f, 0x75, 0x74, 0x52, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6f, 0x75, 0x74, 0x52, 0x61, 0x74, 0x65, 0x1a, 0x63, 0x0a, 0x05, 0x4c, 0x69, 0x73, 0x74, 0x32,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x1a, 0x3e, 0x0a, 0x05,
+	0x4c, 0x69, 0x73, 0x74, 0x33, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x3e, 0x0a, 0x05,
+	0x4c, 0x69, 0x73, 0x74, 0x34, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x3e, 0x0a, 0x05,
+	0x4c, 0x69, 0x73, 0x74, 0x35, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x3e, 0x0a, 0x05,
+	0x4c, 0x69, 0x73, 0x74, 0x36, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x39, 0x0a, 0x05,
+	0x4c, 0x69, 0x73, 0x74, 0x37, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x21, 0x0a, 0x05, 0x4c, 0x69, 0x73, 0x74, 0x38,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x3e, 0x0a, 0x05, 0x4c, 0x69,
+	0x73, 0x74, 0x39, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa7, 0x01, 0x0a, 0x11, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65,
+	0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x22, 0x8b, 0x02, 0x0a, 0x0f, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x1a, 0xa1,
+	0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26,
+	0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x9c, 0x01, 0x0a, 0x1a, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x22, 0xf4, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c,
+	0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x1a, 0x79, 0x0a,
+	0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x7e, 0x0a, 0x14, 0x46, 0x65, 0x65, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xbc, 0x01, 0x0a, 0x12, 0x46, 0x65, 0x65,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x36, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65,
+	0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x1a, 0x4d, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x29, 0x0a, 0x13, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x22, 0xe0, 0x01, 0x0a, 0x11, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x08, 0x77, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x1a, 0x91, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2a, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x22, 0xdd, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x73, 0x1a, 0x88, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x8d, 0x02, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54,
+	0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x65, 0x61, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x72, 0x65, 0x61, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x61, 0x6e,
+	0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x64,
+	0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64,
+	0x65, 0x70, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74,
+	0x68, 0x22, 0x54, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x72,
+	0x65, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2a, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6e,
+	0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x5f, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xd3, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x2a, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x65,
+	0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x32, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x72, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x31, 0x0a, 0x07, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x65, 0x65,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x22, 0xa4,
+	0x01, 0x0a, 0x0f, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64,
+	0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x55,
+	0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x49, 0x64, 0x22, 0x8a, 0x01, 0x0a, 0x0d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x66, 0x65, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x75, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x09, 0x73,
+	0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42,
+	0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x1a, 0x0a,
+	0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2d, 0x0a, 0x13, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x1c, 0x0a, 0x1a, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xf3, 0x01, 0x0a, 0x18, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x36, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x1a, 0x9e, 0x01, 0x0a, 0x04,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x41, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xb1, 0x01, 0x0a,
+	0x19, 0x41, 0x64, 0x64, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x09, 0x73, 0x65,
+	0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x64, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65,
+	0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79,
+	0x1a, 0x4e, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x69, 0x67, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x67, 0x6e,
+	0x22, 0x4a, 0x0a, 0x17, 0x41, 0x64, 0x64, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x41, 0x74, 0x22, 0x83, 0x01, 0x0a,
+	0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a,
+	0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65,
+	0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x1a, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f,
+	0x64, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x34, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x74,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65,
+	0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08,
+	0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x22, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64,
+	0x42, 0x6f, 0x64, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2f, 0x0a, 0x15,
+	0x53, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x87, 0x01,
+	0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x09,
+	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42,
+	0x6f, 0x64, 0x79, 0x1a, 0x22, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x32, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x46, 0x0a, 0x16, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x22, 0xec, 0x01, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x07,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x83, 0x01, 0x0a,
+	0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x22, 0x44, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xe9, 0x02, 0x0a, 0x12, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x36, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x84, 0x02,
+	0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x12, 0x10,
+	0x0a, 0x03, 0x76, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x76, 0x69, 0x70,
+	0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x10, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x22, 0x48, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xda,
+	0x02, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x46, 0x0a, 0x09, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xe1, 0x01, 0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+	0x72, 0x6f, 0x77, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x63, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a,
+	0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x22, 0x48, 0x0a, 0x18, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xa0, 0x02, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x3c, 0x0a, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xb1, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x65, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x11, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c,
+	0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f,
+	0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x40, 0x0a, 0x08,
+	0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x60,
+	0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x22, 0x81, 0x01, 0x0a, 0x1b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x46, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08,
+	0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x1a, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64,
+	0x42, 0x6f, 0x64, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x33, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x1a, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64,
+	0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e,
+	0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a,
+	0x32, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x32, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x7d, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x74, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79,
+	0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x1a, 0x0a, 0x08, 0x53, 0x65,
+	0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x31, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x7f, 0x0a, 0x1a, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f,
+	0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64,
+	0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x1a,
+	0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x32, 0x0a, 0x18, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x7d,
+	0x0a, 0x21, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53,
+	0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
+	0x79, 0x1a, 0x0a, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x22, 0x37, 0x0a,
+	0x1f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x1f, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x42, 0x75, 0x6c, 0x6b, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4a, 0x0a, 0x09, 0x73, 0x65,
+	0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x42, 0x75, 0x6c, 0x6b, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65,
+	0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x1c, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f,
+	0x64, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52,
+	0x03, 0x69, 0x64, 0x73, 0x22, 0xac, 0x01, 0x0a, 0x1d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x42, 0x75, 0x6c, 0x6b, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x64, 0x12, 0x3f, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x42, 0x75, 0x6c, 0x6b, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x52, 0x06, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x1a, 0x2e, 0x0a, 0x04, 0x46, 0x61, 0x69, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x1b, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0xab, 0x03, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x05,
+	0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x05,
+	0x63, 0x6f, 0x69, 0x6e, 0x73, 0x1a, 0x99, 0x02, 0x0a, 0x04, 0x43, 0x6f, 0x69, 0x6e, 0x12, 0x1b,
+	0x0a, 0x09, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x29, 0x0a,
+	0x10, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x68, 0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x48, 0x6f, 0x6c, 0x64, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x64, 0x1a, 0x3b, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x83,
+	0x01, 0x0a, 0x24, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x43, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f,
+	0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x43,
+	0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08,
+	0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x0a, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64,
+	0x42, 0x6f, 0x64, 0x79, 0x22, 0x3a, 0x0a, 0x22, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x43, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x42, 0x61, 0x63,
+	0x6b, 0x66, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x67, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x4f,
+	0x70, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x09, 0x73, 0x65,
+	0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x4f,
+	0x70, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42,
+	0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x0a, 0x0a,
+	0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x22, 0x2c, 0x0a, 0x14, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x65, 0x0a, 0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x40, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f,
+	0x64, 0x79, 0x1a, 0x0a, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x22, 0x55,
+	0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x12,
+	0x24, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61,
+	0x6e, 0x63, 0x69, 0x65, 0x73, 0x22, 0x22, 0x0a, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x55, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xe1, 0x01, 0x0a, 0x1e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x55, 0x6e, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x44, 0x0a, 0x08,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x55, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x08, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x73, 0x1a, 0x79, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x12,
+	0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x98, 0x01,
+	0x0a, 0x1a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x09,
+	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42,
+	0x6f, 0x64, 0x79, 0x1a, 0x33, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x4a, 0x0a, 0x1b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x69, 0x73,
+	0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0xe9, 0x02, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x69, 0x73, 0x63, 0x72, 0x65,
+	0x70, 0x61, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x49,
+	0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x44, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x63,
+	0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a,
+	0xea, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69,
+	0x66, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x79, 0x0a, 0x1f,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x69,
+	0x6c, 0x79, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x4a, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x53, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
+	0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x0a, 0x0a, 0x08, 0x53,
+	0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x22, 0xd3, 0x01, 0x0a, 0x1d, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x53, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x65, 0x61, 0x6d, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x74, 0x65, 0x61, 0x6d, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x61, 0x72, 0x65, 0x61, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x61, 0x72, 0x65, 0x61, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x71, 0x0a,
+	0x1b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x09,
+	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x41,
+	0x72, 0x65, 0x61, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64,
+	0x42, 0x6f, 0x64, 0x79, 0x1a, 0x0a, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79,
+	0x22, 0x35, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x65,
+	0x61, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x22, 0x3a, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x99, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x61, 0x6d, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x61, 0x72, 0x65, 0x61, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x72, 0x65, 0x61, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x60, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x9a, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44, 0x69, 0x66, 0x66, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x72, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69,
+	0x66, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x22, 0x63,
+	0x0a, 0x14, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x44, 0x69, 0x66, 0x66, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0xff, 0x01, 0x0a, 0x1c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x72, 0x79,
+	0x52, 0x75, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42,
+	0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x95, 0x01,
+	0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x2f, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x3e, 0x0a, 0x0f, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0e, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x72, 0x65, 0x22, 0xc8, 0x02, 0x0a, 0x1a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53,
+	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x34, 0x0a, 0x07, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x12, 0x3f, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x5f, 0x73, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x12, 0x2f, 0x0a, 0x05, 0x64, 0x69, 0x66, 0x66, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44, 0x69, 0x66, 0x66, 0x52, 0x05, 0x64, 0x69, 0x66,
+	0x66, 0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x64, 0x69, 0x66,
+	0x66, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53,
+	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44,
+	0x69, 0x66, 0x66, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x69, 0x66, 0x66, 0x73,
+	0x22, 0xb8, 0x01, 0x0a, 0x1c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x47, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79,
+	0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x4f, 0x0a, 0x08, 0x53, 0x65,
+	0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0xf3, 0x01, 0x0a, 0x1a,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x61,
+	0x74, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x08, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x12, 0x2f, 0x0a, 0x05, 0x64, 0x69, 0x66, 0x66, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44, 0x69, 0x66, 0x66, 0x52, 0x05, 0x64, 0x69, 0x66, 0x66,
+	0x73, 0x22, 0x19, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x45, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6b, 0x0a, 0x15,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63,
+	0x61, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0f, 0x0a, 0x0d,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x11, 0x0a,
+	0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0xd9, 0x02, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x55, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x6f, 0x64, 0x61,
+	0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6c,
+	0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x64, 0x61,
+	0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x6c, 0x6c,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x6c,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x34, 0x0a, 0x15, 0x61, 0x6c, 0x6c, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x6c, 0x6c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x22, 0x34, 0x0a, 0x19,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0xbc, 0x01, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37,
+	0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x1a, 0x68, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x64, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xd8, 0x01, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12,
+	0x2a, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x65,
+	0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x32, 0x0a, 0x08, 0x63,
+	0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x65,
+	0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12,
+	0x31, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x54, 0x72, 0x65, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x22, 0xd9, 0x01, 0x0a, 0x1d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x4f, 0x76, 0x65, 0x72,
+	0x72, 0x69, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64,
+	0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x6e,
+	0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x33,
+	0x0a, 0x1b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x22, 0x68, 0x0a, 0x1c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xa8, 0x03,
+	0x0a, 0x1a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x42, 0x0a, 0x09,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x17, 0x0a, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x64, 0x1a, 0xac, 0x02, 0x0a, 0x04, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6f,
+	0x6c, 0x64, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x6f, 0x6c, 0x64, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6e, 0x65, 0x77,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
+	0x72, 0x65, 0x61, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x61, 0x72, 0x65, 0x61, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75,
+	0x6d, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x4a, 0x0a, 0x1a, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x22, 0xe7, 0x01, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f,
+	0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x38, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x6e, 0x74,
+	0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x1a, 0x7b, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x40,
+	0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x22, 0x9e, 0x01, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x32, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a,
+	0x40, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0x91, 0x01, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43,
+	0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42,
+	0x6f, 0x64, 0x79, 0x1a, 0x30, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x32,
+	0xea, 0x2c, 0x0a, 0x03, 0x41, 0x70, 0x70, 0x12, 0x72, 0x0a, 0x0c, 0x45, 0x74, 0x68, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x74,
+	0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2a, 0x22, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2f, 0x65, 0x74, 0x68, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
+	0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x6f, 0x0a, 0x0c, 0x47,
+	0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x75, 0x74, 0x68, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2d, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x27, 0x22, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x72, 0x0a, 0x0c,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x30,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x22, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79,
+	0x12, 0x51, 0x0a, 0x06, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x12, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x6c, 0x6f, 0x67, 0x6f, 0x75, 0x74,
+	0x3a, 0x01, 0x2a, 0x12, 0x7f, 0x0a, 0x11, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x41, 0x6c, 0x6c,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c,
+	0x6f, 0x67, 0x6f, 0x75, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f,
+	0x67, 0x6f, 0x75, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x22, 0x23, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x6c, 0x6f,
+	0x67, 0x6f, 0x75, 0x74, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x3a, 0x01, 0x2a, 0x12, 0x7e, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x22, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70,
+	0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f,
+	0x62, 0x6f, 0x64, 0x79, 0x12, 0x57, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x5f, 0x0a,
+	0x0a, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x84,
+	0x01, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
+	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x6c, 0x0a, 0x0d, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x65, 0x65,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x21, 0x12, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x12, 0x67, 0x0a, 0x0c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x77,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x6b, 0x0a, 0x0d,
+	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x6b, 0x0a, 0x0d, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x65, 0x65, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x26,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x12, 0x61, 0x0a, 0x08, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2b, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x25, 0x22, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x3a, 0x09,
+	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x7a, 0x0a, 0x0e, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x1a, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x22, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x63, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64,
+	0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x84, 0x01, 0x0a, 0x13, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2d, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x8b, 0x01, 0x0a,
+	0x12, 0x41, 0x64, 0x64, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x64, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x64, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x22, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x64, 0x64, 0x5f, 0x77,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x3a,
+	0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x97, 0x01, 0x0a, 0x15, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34,
+	0x22, 0x27, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f,
+	0x62, 0x6f, 0x64, 0x79, 0x12, 0x83, 0x01, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x53, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65,
+	0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x22, 0x22, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x74,
+	0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x3a,
+	0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x8f, 0x01, 0x0a, 0x13, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x22, 0x25, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x51, 0x0a, 0x07,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12,
+	0x63, 0x0a, 0x0a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x21, 0x22, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
+	0x64, 0x68, 0x62, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f,
+	0x62, 0x6f, 0x64, 0x79, 0x12, 0x8c, 0x01, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x12, 0x20, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x22, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62,
+	0x6f, 0x64, 0x79, 0x12, 0x88, 0x01, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x31, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x2b, 0x22, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
+	0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x72, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x84,
+	0x01, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x52, 0x65, 0x74, 0x72, 0x79, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x22, 0x1d, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64,
+	0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x88, 0x01, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x1f, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x31, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x22, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x72,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79,
+	0x12, 0xa5, 0x01, 0x0a, 0x1a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x12,
+	0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x39, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x33, 0x22, 0x26, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x6c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x3a, 0x09, 0x73,
+	0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x9d, 0x01, 0x0a, 0x18, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x42, 0x75, 0x6c, 0x6b, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x42, 0x75, 0x6c, 0x6b, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x42,
+	0x75, 0x6c, 0x6b, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x22, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x5f, 0x62, 0x75, 0x6c, 0x6b, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x3a, 0x09, 0x73,
+	0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x79, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x25, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x5f, 0x64, 0x68, 0x62, 0x2f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0xb1, 0x01, 0x0a, 0x1d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x43, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x42, 0x61, 0x63,
+	0x6b, 0x66, 0x69, 0x6c, 0x6c, 0x12, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x43, 0x6c, 0x6f, 0x73, 0x75, 0x72,
+	0x65, 0x42, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x43, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x42, 0x61, 0x63, 0x6b,
+	0x66, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x36, 0x22, 0x29, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68,
+	0x62, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x63, 0x6c, 0x6f, 0x73,
+	0x75, 0x72, 0x65, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x6c, 0x3a, 0x09, 0x73, 0x65,
+	0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x78, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x6e, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x22, 0x1a, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64,
+	0x79, 0x12, 0x73, 0x0a, 0x0e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x65, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x25, 0x22, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68,
+	0x62, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x3a, 0x09, 0x73, 0x65, 0x6e,
+	0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x91, 0x01, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x55, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x55, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x55, 0x6e,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x5f, 0x75, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x88, 0x01, 0x0a, 0x13, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x22, 0x1e, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64,
+	0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x81, 0x01, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44,
+	0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x69, 0x73, 0x63, 0x72, 0x65,
+	0x70, 0x61, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x69, 0x73, 0x63,
+	0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70,
+	0x61, 0x6e, 0x63, 0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x9d, 0x01, 0x0a, 0x18, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79,
+	0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x53,
+	0x65, 0x74, 0x74, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x61, 0x69, 0x6c, 0x79, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x22, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x3a, 0x09,
+	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x8d, 0x01, 0x0a, 0x14, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73,
+	0x65, 0x72, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x22, 0x20, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x5f, 0x72, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x3a, 0x09,
+	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x91, 0x01, 0x0a, 0x15, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x72, 0x79,
+	0x52, 0x75, 0x6e, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53,
+	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x72, 0x79, 0x52,
+	0x75, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x22,
+	0x21, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f,
+	0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x72, 0x79, 0x5f, 0x72,
+	0x75, 0x6e, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x90, 0x01,
+	0x0a, 0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x33, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x2d, 0x22, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
+	0x64, 0x68, 0x62, 0x2f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79,
+	0x12, 0x79, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x12, 0x79, 0x0a, 0x12, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x65,
+	0x65, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x12, 0x95, 0x01, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x55, 0x73, 0x65, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+	0x65, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65,
+	0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x22,
+	0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72,
+	0x69, 0x64, 0x65, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x86,
+	0x01, 0x0a, 0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x29, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x5f, 0x64, 0x68, 0x62, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x71, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x12, 0x1c, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45,
+	0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x65, 0x74, 0x68, 0x12, 0x50, 0x0a, 0x08, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x66, 0x65, 0x65, 0x42, 0x11, 0x0a, 0x03,
+	0x61, 0x70, 0x69, 0x50, 0x01, 0x5a, 0x08, 0x2f, 0x61, 0x70, 0x69, 0x3b, 0x61, 0x70, 0x69, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_app_app_api_app_proto_rawDescOnce sync.Once
+	file_app_app_api_app_proto_rawDescData = file_app_app_api_app_proto_rawDesc
+)
+
+func file_app_app_api_app_proto_rawDescGZIP() []byte {
+	file_app_app_api_app_proto_rawDescOnce.Do(func() {
+		file_app_app_api_app_proto_rawDescData = protoimpl.X.CompressGZIP(file_app_app_api_app_proto_rawDescData)
+	})
+	return file_app_app_api_app_proto_rawDescData
+}
+
+var file_app_app_api_app_proto_msgTypes = make([]protoimpl.MessageInfo, 168)
+var file_app_app_api_app_proto_goTypes = []interface{}{
+	(*EthAuthorizeRequest)(nil),                           // 0: api.EthAuthorizeRequest
+	(*EthAuthorizeReply)(nil),                             // 1: api.EthAuthorizeReply
+	(*GetAuthNonceRequest)(nil),                           // 2: api.GetAuthNonceRequest
+	(*GetAuthNonceReply)(nil),                             // 3: api.GetAuthNonceReply
+	(*RefreshTokenRequest)(nil),                           // 4: api.RefreshTokenRequest
+	(*RefreshTokenReply)(nil),                             // 5: api.RefreshTokenReply
+	(*LogoutRequest)(nil),                                 // 6: api.LogoutRequest
+	(*LogoutReply)(nil),                                   // 7: api.LogoutReply
+	(*LogoutAllSessionsRequest)(nil),                      // 8: api.LogoutAllSessionsRequest
+	(*LogoutAllSessionsReply)(nil),                        // 9: api.LogoutAllSessionsReply
+	(*RecommendUpdateRequest)(nil),                        // 10: api.RecommendUpdateRequest
+	(*RecommendUpdateReply)(nil),                          // 11: api.RecommendUpdateReply
+	(*DepositRequest)(nil),                                // 12: api.DepositRequest
+	(*DepositReply)(nil),                                  // 13: api.DepositReply
+	(*UserInfoRequest)(nil),                               // 14: api.UserInfoRequest
+	(*UserInfoReply)(nil),                                 // 15: api.UserInfoReply
+	(*RewardListRequest)(nil),                             // 16: api.RewardListRequest
+	(*RewardListReply)(nil),                               // 17: api.RewardListReply
+	(*RecommendRewardListRequest)(nil),                    // 18: api.RecommendRewardListRequest
+	(*RecommendRewardListReply)(nil),                      // 19: api.RecommendRewardListReply
+	(*FeeRewardListRequest)(nil),                          // 20: api.FeeRewardListRequest
+	(*FeeRewardListReply)(nil),                            // 21: api.FeeRewardListReply
+	(*WithdrawListRequest)(nil),                           // 22: api.WithdrawListRequest
+	(*WithdrawListReply)(nil),                             // 23: api.WithdrawListReply
+	(*RecommendListRequest)(nil),                          // 24: api.RecommendListRequest
+	(*RecommendListReply)(nil),                            // 25: api.RecommendListReply
+	(*RecommendTreeNode)(nil),                             // 26: api.RecommendTreeNode
+	(*RecommendTreeMatch)(nil),                            // 27: api.RecommendTreeMatch
+	(*RecommendTreeRequest)(nil),                          // 28: api.RecommendTreeRequest
+	(*RecommendTreeReply)(nil),                            // 29: api.RecommendTreeReply
+	(*WithdrawRequest)(nil),                               // 30: api.WithdrawRequest
+	(*WithdrawReply)(nil),                                 // 31: api.WithdrawReply
+	(*CancelWithdrawRequest)(nil),                         // 32: api.CancelWithdrawRequest
+	(*CancelWithdrawReply)(nil),                           // 33: api.CancelWithdrawReply
+	(*WithdrawAddressListRequest)(nil),                    // 34: api.WithdrawAddressListRequest
+	(*WithdrawAddressListReply)(nil),                      // 35: api.WithdrawAddressListReply
+	(*AddWithdrawAddressRequest)(nil),                     // 36: api.AddWithdrawAddressRequest
+	(*AddWithdrawAddressReply)(nil),                       // 37: api.AddWithdrawAddressReply
+	(*DeleteWithdrawAddressRequest)(nil),                  // 38: api.DeleteWithdrawAddressRequest
+	(*DeleteWithdrawAddressReply)(nil),                    // 39: api.DeleteWithdrawAddressReply
+	(*SetBalanceRewardRequest)(nil),                       // 40: api.SetBalanceRewardRequest
+	(*SetBalanceRewardReply)(nil),                         // 41: api.SetBalanceRewardReply
+	(*DeleteBalanceRewardRequest)(nil),                    // 42: api.DeleteBalanceRewardRequest
+	(*DeleteBalanceRewardReply)(nil),                      // 43: api.DeleteBalanceRewardReply
+	(*AdminRewardListRequest)(nil),                        // 44: api.AdminRewardListRequest
+	(*AdminRewardListReply)(nil),                          // 45: api.AdminRewardListReply
+	(*AdminUserListRequest)(nil),                          // 46: api.AdminUserListRequest
+	(*AdminUserListReply)(nil),                            // 47: api.AdminUserListReply
+	(*AdminLocationListRequest)(nil),                      // 48: api.AdminLocationListRequest
+	(*AdminLocationListReply)(nil),                        // 49: api.AdminLocationListReply
+	(*AdminWithdrawListRequest)(nil),                      // 50: api.AdminWithdrawListRequest
+	(*AdminWithdrawListReply)(nil),                        // 51: api.AdminWithdrawListReply
+	(*AdminLoginRequest)(nil),                             // 52: api.AdminLoginRequest
+	(*AdminLoginReply)(nil),                               // 53: api.AdminLoginReply
+	(*AdminWithdrawApproveRequest)(nil),                   // 54: api.AdminWithdrawApproveRequest
+	(*AdminWithdrawApproveReply)(nil),                     // 55: api.AdminWithdrawApproveReply
+	(*AdminWithdrawRejectRequest)(nil),                    // 56: api.AdminWithdrawRejectRequest
+	(*AdminWithdrawRejectReply)(nil),                      // 57: api.AdminWithdrawRejectReply
+	(*AdminWithdrawRetryRequest)(nil),                     // 58: api.AdminWithdrawRetryRequest
+	(*AdminWithdrawRetryReply)(nil),                       // 59: api.AdminWithdrawRetryReply
+	(*AdminWithdrawRefundRequest)(nil),                    // 60: api.AdminWithdrawRefundRequest
+	(*AdminWithdrawRefundReply)(nil),                      // 61: api.AdminWithdrawRefundReply
+	(*AdminWithdrawLegacyMigrateRequest)(nil),             // 62: api.AdminWithdrawLegacyMigrateRequest
+	(*AdminWithdrawLegacyMigrateReply)(nil),               // 63: api.AdminWithdrawLegacyMigrateReply
+	(*AdminWithdrawBulkApproveRequest)(nil),               // 64: api.AdminWithdrawBulkApproveRequest
+	(*AdminWithdrawBulkApproveReply)(nil),                 // 65: api.AdminWithdrawBulkApproveReply
+	(*AdminLedgerBalanceRequest)(nil),                     // 66: api.AdminLedgerBalanceRequest
+	(*AdminLedgerBalanceReply)(nil),                       // 67: api.AdminLedgerBalanceReply
+	(*AdminRecommendClosureBackfillRequest)(nil),          // 68: api.AdminRecommendClosureBackfillRequest
+	(*AdminRecommendClosureBackfillReply)(nil),            // 69: api.AdminRecommendClosureBackfillReply
+	(*AdminLedgerOpenRequest)(nil),                        // 70: api.AdminLedgerOpenRequest
+	(*AdminLedgerOpenReply)(nil),                          // 71: api.AdminLedgerOpenReply
+	(*AdminReconcileRequest)(nil),                         // 72: api.AdminReconcileRequest
+	(*AdminReconcileReply)(nil),                           // 73: api.AdminReconcileReply
+	(*AdminDepositUnmatchedListRequest)(nil),              // 74: api.AdminDepositUnmatchedListRequest
+	(*AdminDepositUnmatchedListReply)(nil),                // 75: api.AdminDepositUnmatchedListReply
+	(*AdminDepositResolveRequest)(nil),                    // 76: api.AdminDepositResolveRequest
+	(*AdminDepositResolveReply)(nil),                      // 77: api.AdminDepositResolveReply
+	(*AdminDiscrepancyListRequest)(nil),                   // 78: api.AdminDiscrepancyListRequest
+	(*AdminDiscrepancyListReply)(nil),                     // 79: api.AdminDiscrepancyListReply
+	(*AdminLocationDailySettleRequest)(nil),               // 80: api.AdminLocationDailySettleRequest
+	(*AdminLocationDailySettleReply)(nil),                 // 81: api.AdminLocationDailySettleReply
+	(*AdminUserAreaRebuildRequest)(nil),                   // 82: api.AdminUserAreaRebuildRequest
+	(*AdminUserAreaRebuildReply)(nil),                     // 83: api.AdminUserAreaRebuildReply
+	(*SettlementConfig)(nil),                              // 84: api.SettlementConfig
+	(*SettlementSummary)(nil),                             // 85: api.SettlementSummary
+	(*SettlementRewardTotal)(nil),                         // 86: api.SettlementRewardTotal
+	(*SettlementRewardDiff)(nil),                          // 87: api.SettlementRewardDiff
+	(*SettlementConfigDiff)(nil),                          // 88: api.SettlementConfigDiff
+	(*AdminSettlementDryRunRequest)(nil),                  // 89: api.AdminSettlementDryRunRequest
+	(*AdminSettlementDryRunReply)(nil),                    // 90: api.AdminSettlementDryRunReply
+	(*AdminSettlementReplayRequest)(nil),                  // 91: api.AdminSettlementReplayRequest
+	(*AdminSettlementReplayReply)(nil),                    // 92: api.AdminSettlementReplayReply
+	(*AdminWithdrawEthRequest)(nil),                       // 93: api.AdminWithdrawEthRequest
+	(*AdminWithdrawEthReply)(nil),                         // 94: api.AdminWithdrawEthReply
+	(*AdminFeeRequest)(nil),                               // 95: api.AdminFeeRequest
+	(*AdminFeeReply)(nil),                                 // 96: api.AdminFeeReply
+	(*AdminAllRequest)(nil),                               // 97: api.AdminAllRequest
+	(*AdminAllReply)(nil),                                 // 98: api.AdminAllReply
+	(*AdminUserRecommendRequest)(nil),                     // 99: api.AdminUserRecommendRequest
+	(*AdminUserRecommendReply)(nil),                       // 100: api.AdminUserRecommendReply
+	(*AdminRecommendTreeRequest)(nil),                     // 101: api.AdminRecommendTreeRequest
+	(*AdminRecommendTreeReply)(nil),                       // 102: api.AdminRecommendTreeReply
+	(*AdminUserLevelOverrideRequest)(nil),                 // 103: api.AdminUserLevelOverrideRequest
+	(*AdminUserLevelOverrideReply)(nil),                   // 104: api.AdminUserLevelOverrideReply
+	(*AdminUserLevelHistoryRequest)(nil),                  // 105: api.AdminUserLevelHistoryRequest
+	(*AdminUserLevelHistoryReply)(nil),                    // 106: api.AdminUserLevelHistoryReply
+	(*AdminMonthRecommendRequest)(nil),                    // 107: api.AdminMonthRecommendRequest
+	(*AdminMonthRecommendReply)(nil),                      // 108: api.AdminMonthRecommendReply
+	(*AdminConfigRequest)(nil),                            // 109: api.AdminConfigRequest
+	(*AdminConfigReply)(nil),                              // 110: api.AdminConfigReply
+	(*AdminConfigUpdateRequest)(nil),                      // 111: api.AdminConfigUpdateRequest
+	(*AdminConfigUpdateReply)(nil),                        // 112: api.AdminConfigUpdateReply
+	(*EthAuthorizeRequest_SendBody)(nil),                  // 113: api.EthAuthorizeRequest.SendBody
+	(*GetAuthNonceRequest_SendBody)(nil),                  // 114: api.GetAuthNonceRequest.SendBody
+	(*RefreshTokenRequest_SendBody)(nil),                  // 115: api.RefreshTokenRequest.SendBody
+	(*RecommendUpdateRequest_SendBody)(nil),               // 116: api.RecommendUpdateRequest.SendBody
+	(*UserInfoReply_List)(nil),                            // 117: api.UserInfoReply.List
+	(*UserInfoReply_List2)(nil),                           // 118: api.UserInfoReply.List2
+	(*UserInfoReply_List3)(nil),                           // 119: api.UserInfoReply.List3
+	(*UserInfoReply_List4)(nil),                           // 120: api.UserInfoReply.List4
+	(*UserInfoReply_List5)(nil),                           // 121: api.UserInfoReply.List5
+	(*UserInfoReply_List6)(nil),                           // 122: api.UserInfoReply.List6
+	(*UserInfoReply_List7)(nil),                           // 123: api.UserInfoReply.List7
+	(*UserInfoReply_List8)(nil),                           // 124: api.UserInfoReply.List8
+	(*UserInfoReply_List9)(nil),                           // 125: api.UserInfoReply.List9
+	(*RewardListReply_List)(nil),                          // 126: api.RewardListReply.List
+	(*RecommendRewardListReply_List)(nil),                 // 127: api.RecommendRewardListReply.List
+	(*FeeRewardListReply_List)(nil),                       // 128: api.FeeRewardListReply.List
+	(*WithdrawListReply_List)(nil),                        // 129: api.WithdrawListReply.List
+	(*RecommendListReply_List)(nil),                       // 130: api.RecommendListReply.List
+	(*WithdrawRequest_SendBody)(nil),                      // 131: api.WithdrawRequest.SendBody
+	(*CancelWithdrawRequest_SendBody)(nil),                // 132: api.CancelWithdrawRequest.SendBody
+	(*WithdrawAddressListReply_List)(nil),                 // 133: api.WithdrawAddressListReply.List
+	(*AddWithdrawAddressRequest_SendBody)(nil),            // 134: api.AddWithdrawAddressRequest.SendBody
+	(*DeleteWithdrawAddressRequest_SendBody)(nil),         // 135: api.DeleteWithdrawAddressRequest.SendBody
+	(*SetBalanceRewardRequest_SendBody)(nil),              // 136: api.SetBalanceRewardRequest.SendBody
+	(*DeleteBalanceRewardRequest_SendBody)(nil),           // 137: api.DeleteBalanceRewardRequest.SendBody
+	(*AdminRewardListReply_List)(nil),                     // 138: api.AdminRewardListReply.List
+	(*AdminUserListReply_UserList)(nil),                   // 139: api.AdminUserListReply.UserList
+	(*AdminLocationListReply_LocationList)(nil),           // 140: api.AdminLocationListReply.LocationList
+	(*AdminWithdrawListReply_List)(nil),                   // 141: api.AdminWithdrawListReply.List
+	(*AdminLoginRequest_SendBody)(nil),                    // 142: api.AdminLoginRequest.SendBody
+	(*AdminWithdrawApproveRequest_SendBody)(nil),          // 143: api.AdminWithdrawApproveRequest.SendBody
+	(*AdminWithdrawRejectRequest_SendBody)(nil),           // 144: api.AdminWithdrawRejectRequest.SendBody
+	(*AdminWithdrawRetryRequest_SendBody)(nil),            // 145: api.AdminWithdrawRetryRequest.SendBody
+	(*AdminWithdrawRefundRequest_SendBody)(nil),           // 146: api.AdminWithdrawRefundRequest.SendBody
+	(*AdminWithdrawLegacyMigrateRequest_SendBody)(nil),    // 147: api.AdminWithdrawLegacyMigrateRequest.SendBody
+	(*AdminWithdrawBulkApproveRequest_SendBody)(nil),      // 148: api.AdminWithdrawBulkApproveRequest.SendBody
+	(*AdminWithdrawBulkApproveReply_Fail)(nil),            // 149: api.AdminWithdrawBulkApproveReply.Fail
+	(*AdminLedgerBalanceReply_Coin)(nil),                  // 150: api.AdminLedgerBalanceReply.Coin
+	(*AdminLedgerBalanceReply_Account)(nil),               // 151: api.AdminLedgerBalanceReply.Account
+	(*AdminRecommendClosureBackfillRequest_SendBody)(nil), // 152: api.AdminRecommendClosureBackfillRequest.SendBody
+	(*AdminLedgerOpenRequest_SendBody)(nil),               // 153: api.AdminLedgerOpenRequest.SendBody
+	(*AdminReconcileRequest_SendBody)(nil),                // 154: api.AdminReconcileRequest.SendBody
+	(*AdminDepositUnmatchedListReply_List)(nil),           // 155: api.AdminDepositUnmatchedListReply.List
+	(*AdminDepositResolveRequest_SendBody)(nil),           // 156: api.AdminDepositResolveRequest.SendBody
+	(*AdminDiscrepancyListReply_List)(nil),                // 157: api.AdminDiscrepancyListReply.List
+	(*AdminLocationDailySettleRequest_SendBody)(nil),      // 158: api.AdminLocationDailySettleRequest.SendBody
+	(*AdminUserAreaRebuildRequest_SendBody)(nil),          // 159: api.AdminUserAreaRebuildRequest.SendBody
+	(*AdminSettlementDryRunRequest_SendBody)(nil),         // 160: api.AdminSettlementDryRunRequest.SendBody
+	(*AdminSettlementReplayRequest_SendBody)(nil),         // 161: api.AdminSettlementReplayRequest.SendBody
+	(*AdminUserRecommendReply_List)(nil),                  // 162: api.AdminUserRecommendReply.List
+	(*AdminUserLevelOverrideRequest_SendBody)(nil),        // 163: api.AdminUserLevelOverrideRequest.SendBody
+	(*AdminUserLevelHistoryReply_List)(nil),               // 164: api.AdminUserLevelHistoryReply.List
+	(*AdminMonthRecommendReply_List)(nil),                 // 165: api.AdminMonthRecommendReply.List
+	(*AdminConfigReply_List)(nil),                         // 166: api.AdminConfigReply.List
+	(*AdminConfigUpdateRequest_SendBody)(nil),             // 167: api.AdminConfigUpdateRequest.SendBody
+}
+var file_app_app_api_app_proto_depIdxs = []int32{
+	113, // 0: api.EthAuthorizeRequest.send_body:type_name -> api.EthAuthorizeRequest.SendBody
+	114, // 1: api.GetAuthNonceRequest.send_body:type_name -> api.GetAuthNonceRequest.SendBody
+	115, // 2: api.RefreshTokenRequest.send_body:type_name -> api.RefreshTokenRequest.SendBody
+	116, // 3: api.RecommendUpdateRequest.send_body:type_name -> api.RecommendUpdateRequest.SendBody
+	117, // 4: api.UserInfoReply.LocationList:type_name -> api.UserInfoReply.List
+	118, // 5: api.UserInfoReply.recommendTeamList:type_name -> api.UserInfoReply.List2
+	119, // 6: api.UserInfoReply.recommendAreaList:type_name -> api.UserInfoReply.List3
+	120, // 7: api.UserInfoReply.locationDailyRewardList:type_name -> api.UserInfoReply.List4
+	121, // 8: api.UserInfoReply.recommendList:type_name -> api.UserInfoReply.List5
+	122, // 9: api.UserInfoReply.dailyBalanceRewardList:type_name -> api.UserInfoReply.List6
+	123, // 10: api.UserInfoReply.teamAddressList:type_name -> api.UserInfoReply.List7
+	124, // 11: api.UserInfoReply.myRecommendAddressList:type_name -> api.UserInfoReply.List8
+	125, // 12: api.UserInfoReply.allRewardList:type_name -> api.UserInfoReply.List9
+	126, // 13: api.RewardListReply.rewards:type_name -> api.RewardListReply.List
+	127, // 14: api.RecommendRewardListReply.rewards:type_name -> api.RecommendRewardListReply.List
+	128, // 15: api.FeeRewardListReply.rewards:type_name -> api.FeeRewardListReply.List
+	129, // 16: api.WithdrawListReply.withdraw:type_name -> api.WithdrawListReply.List
+	130, // 17: api.RecommendListReply.recommends:type_name -> api.RecommendListReply.List
+	26,  // 18: api.RecommendTreeMatch.node:type_name -> api.RecommendTreeNode
+	26,  // 19: api.RecommendTreeReply.node:type_name -> api.RecommendTreeNode
+	26,  // 20: api.RecommendTreeReply.path:type_name -> api.RecommendTreeNode
+	26,  // 21: api.RecommendTreeReply.children:type_name -> api.RecommendTreeNode
+	27,  // 22: api.RecommendTreeReply.matches:type_name -> api.RecommendTreeMatch
+	131, // 23: api.WithdrawRequest.send_body:type_name -> api.WithdrawRequest.SendBody
+	132, // 24: api.CancelWithdrawRequest.send_body:type_name -> api.CancelWithdrawRequest.SendBody
+	133, // 25: api.WithdrawAddressListReply.list:type_name -> api.WithdrawAddressListReply.List
+	134, // 26: api.AddWithdrawAddressRequest.send_body:type_name -> api.AddWithdrawAddressRequest.SendBody
+	135, // 27: api.DeleteWithdrawAddressRequest.send_body:type_name -> api.DeleteWithdrawAddressRequest.SendBody
+	136, // 28: api.SetBalanceRewardRequest.send_body:type_name -> api.SetBalanceRewardRequest.SendBody
+	137, // 29: api.DeleteBalanceRewardRequest.send_body:type_name -> api.DeleteBalanceRewardRequest.SendBody
+	138, // 30: api.AdminRewardListReply.rewards:type_name -> api.AdminRewardListReply.List
+	139, // 31: api.AdminUserListReply.users:type_name -> api.AdminUserListReply.UserList
+	140, // 32: api.AdminLocationListReply.locations:type_name -> api.AdminLocationListReply.LocationList
+	141, // 33: api.AdminWithdrawListReply.withdraw:type_name -> api.AdminWithdrawListReply.List
+	142, // 34: api.AdminLoginRequest.send_body:type_name -> api.AdminLoginRequest.SendBody
+	143, // 35: api.AdminWithdrawApproveRequest.send_body:type_name -> api.AdminWithdrawApproveRequest.SendBody
+	144, // 36: api.AdminWithdrawRejectRequest.send_body:type_name -> api.AdminWithdrawRejectRequest.SendBody
+	145, // 37: api.AdminWithdrawRetryRequest.send_body:type_name -> api.AdminWithdrawRetryRequest.SendBody
+	146, // 38: api.AdminWithdrawRefundRequest.send_body:type_name -> api.AdminWithdrawRefundRequest.SendBody
+	147, // 39: api.AdminWithdrawLegacyMigrateRequest.send_body:type_name -> api.AdminWithdrawLegacyMigrateRequest.SendBody
+	148, // 40: api.AdminWithdrawBulkApproveRequest.send_body:type_name -> api.AdminWithdrawBulkApproveRequest.SendBody
+	149, // 41: api.AdminWithdrawBulkApproveReply.failed:type_name -> api.AdminWithdrawBulkApproveReply.Fail
+	150, // 42: api.AdminLedgerBalanceReply.coins:type_name -> api.AdminLedgerBalanceReply.Coin
+	152, // 43: api.AdminRecommendClosureBackfillRequest.send_body:type_name -> api.AdminRecommendClosureBackfillRequest.SendBody
+	153, // 44: api.AdminLedgerOpenRequest.send_body:type_name -> api.AdminLedgerOpenRequest.SendBody
+	154, // 45: api.AdminReconcileRequest.send_body:type_name -> api.AdminReconcileRequest.SendBody
+	155, // 46: api.AdminDepositUnmatchedListReply.deposits:type_name -> api.AdminDepositUnmatchedListReply.List
+	156, // 47: api.AdminDepositResolveRequest.send_body:type_name -> api.AdminDepositResolveRequest.SendBody
+	157, // 48: api.AdminDiscrepancyListReply.discrepancies:type_name -> api.AdminDiscrepancyListReply.List
+	158, // 49: api.AdminLocationDailySettleRequest.send_body:type_name -> api.AdminLocationDailySettleRequest.SendBody
+	159, // 50: api.AdminUserAreaRebuildRequest.send_body:type_name -> api.AdminUserAreaRebuildRequest.SendBody
+	160, // 51: api.AdminSettlementDryRunRequest.send_body:type_name -> api.AdminSettlementDryRunRequest.SendBody
+	85,  // 52: api.AdminSettlementDryRunReply.summary:type_name -> api.SettlementSummary
+	86,  // 53: api.AdminSettlementDryRunReply.rewards:type_name -> api.SettlementRewardTotal
+	85,  // 54: api.AdminSettlementDryRunReply.compare_summary:type_name -> api.SettlementSummary
+	87,  // 55: api.AdminSettlementDryRunReply.diffs:type_name -> api.SettlementRewardDiff
+	88,  // 56: api.AdminSettlementDryRunReply.config_diffs:type_name -> api.SettlementConfigDiff
+	161, // 57: api.AdminSettlementReplayRequest.send_body:type_name -> api.AdminSettlementReplayRequest.SendBody
+	85,  // 58: api.AdminSettlementReplayReply.expected:type_name -> api.SettlementSummary
+	85,  // 59: api.AdminSettlementReplayReply.actual:type_name -> api.SettlementSummary
+	87,  // 60: api.AdminSettlementReplayReply.diffs:type_name -> api.SettlementRewardDiff
+	162, // 61: api.AdminUserRecommendReply.users:type_name -> api.AdminUserRecommendReply.List
+	26,  // 62: api.AdminRecommendTreeReply.node:type_name -> api.RecommendTreeNode
+	26,  // 63: api.AdminRecommendTreeReply.path:type_name -> api.RecommendTreeNode
+	26,  // 64: api.AdminRecommendTreeReply.children:type_name -> api.RecommendTreeNode
+	27,  // 65: api.AdminRecommendTreeReply.matches:type_name -> api.RecommendTreeMatch
+	163, // 66: api.AdminUserLevelOverrideRequest.send_body:type_name -> api.AdminUserLevelOverrideRequest.SendBody
+	164, // 67: api.AdminUserLevelHistoryReply.histories:type_name -> api.AdminUserLevelHistoryReply.List
+	165, // 68: api.AdminMonthRecommendReply.users:type_name -> api.AdminMonthRecommendReply.List
+	166, // 69: api.AdminConfigReply.config:type_name -> api.AdminConfigReply.List
+	167, // 70: api.AdminConfigUpdateRequest.send_body:type_name -> api.AdminConfigUpdateRequest.SendBody
+	151, // 71: api.AdminLedgerBalanceReply.Coin.accounts:type_name -> api.AdminLedgerBalanceReply.Account
+	84,  // 72: api.AdminSettlementDryRunRequest.SendBody.configs:type_name -> api.SettlementConfig
+	84,  // 73: api.AdminSettlementDryRunRequest.SendBody.compare_configs:type_name -> api.SettlementConfig
+	84,  // 74: api.AdminSettlementReplayRequest.SendBody.configs:type_name -> api.SettlementConfig
+	0,   // 75: api.App.EthAuthorize:input_type -> api.EthAuthorizeRequest
+	2,   // 76: api.App.GetAuthNonce:input_type -> api.GetAuthNonceRequest
+	4,   // 77: api.App.RefreshToken:input_type -> api.RefreshTokenRequest
+	6,   // 78: api.App.Logout:input_type -> api.LogoutRequest
+	8,   // 79: api.App.LogoutAllSessions:input_type -> api.LogoutAllSessionsRequest
+	10,  // 80: api.App.RecommendUpdate:input_type -> api.RecommendUpdateRequest
+	14,  // 81: api.App.UserInfo:input_type -> api.UserInfoRequest
+	16,  // 82: api.App.RewardList:input_type -> api.RewardListRequest
+	18,  // 83: api.App.RecommendRewardList:input_type -> api.RecommendRewardListRequest
+	20,  // 84: api.App.FeeRewardList:input_type -> api.FeeRewardListRequest
+	22,  // 85: api.App.WithdrawList:input_type -> api.WithdrawListRequest
+	24,  // 86: api.App.RecommendList:input_type -> api.RecommendListRequest
+	28,  // 87: api.App.RecommendTree:input_type -> api.RecommendTreeRequest
+	30,  // 88: api.App.Withdraw:input_type -> api.WithdrawRequest
+	32,  // 89: api.App.CancelWithdraw:input_type -> api.CancelWithdrawRequest
+	34,  // 90: api.App.WithdrawAddressList:input_type -> api.WithdrawAddressListRequest
+	36,  // 91: api.App.AddWithdrawAddress:input_type -> api.AddWithdrawAddressRequest
+	38,  // 92: api.App.DeleteWithdrawAddress:input_type -> api.DeleteWithdrawAddressRequest
+	40,  // 93: api.App.SetBalanceReward:input_type -> api.SetBalanceRewardRequest
+	42,  // 94: api.App.DeleteBalanceReward:input_type -> api.DeleteBalanceRewardRequest
+	12,  // 95: api.App.Deposit:input_type -> api.DepositRequest
+	52,  // 96: api.App.AdminLogin:input_type -> api.AdminLoginRequest
+	54,  // 97: api.App.AdminWithdrawApprove:input_type -> api.AdminWithdrawApproveRequest
+	56,  // 98: api.App.AdminWithdrawReject:input_type -> api.AdminWithdrawRejectRequest
+	58,  // 99: api.App.AdminWithdrawRetry:input_type -> api.AdminWithdrawRetryRequest
+	60,  // 100: api.App.AdminWithdrawRefund:input_type -> api.AdminWithdrawRefundRequest
+	62,  // 101: api.App.AdminWithdrawLegacyMigrate:input_type -> api.AdminWithdrawLegacyMigrateRequest
+	64,  // 102: api.App.AdminWithdrawBulkApprove:input_type -> api.AdminWithdrawBulkApproveRequest
+	66,  // 103: api.App.AdminLedgerBalance:input_type -> api.AdminLedgerBalanceRequest
+	68,  // 104: api.App.AdminRecommendClosureBackfill:input_type -> api.AdminRecommendClosureBackfillRequest
+	70,  // 105: api.App.AdminLedgerOpen:input_type -> api.AdminLedgerOpenRequest
+	72,  // 106: api.App.AdminReconcile:input_type -> api.AdminReconcileRequest
+	74,  // 107: api.App.AdminDepositUnmatchedList:input_type -> api.AdminDepositUnmatchedListRequest
+	76,  // 108: api.App.AdminDepositResolve:input_type -> api.AdminDepositResolveRequest
+	78,  // 109: api.App.AdminDiscrepancyList:input_type -> api.AdminDiscrepancyListRequest
+	80,  // 110: api.App.AdminLocationDailySettle:input_type -> api.AdminLocationDailySettleRequest
+	82,  // 111: api.App.AdminUserAreaRebuild:input_type -> api.AdminUserAreaRebuildRequest
+	89,  // 112: api.App.AdminSettlementDryRun:input_type -> api.AdminSettlementDryRunRequest
+	91,  // 113: api.App.AdminSettlementReplay:input_type -> api.AdminSettlementReplayRequest
+	99,  // 114: api.App.AdminUserRecommend:input_type -> api.AdminUserRecommendRequest
+	101, // 115: api.App.AdminRecommendTree:input_type -> api.AdminRecommendTreeRequest
+	103, // 116: api.App.AdminUserLevelOverride:input_type -> api.AdminUserLevelOverrideRequest
+	105, // 117: api.App.AdminUserLevelHistory:input_type -> api.AdminUserLevelHistoryRequest
+	93,  // 118: api.App.AdminWithdrawEth:input_type -> api.AdminWithdrawEthRequest
+	95,  // 119: api.App.AdminFee:input_type -> api.AdminFeeRequest
+	1,   // 120: api.App.EthAuthorize:output_type -> api.EthAuthorizeReply
+	3,   // 121: api.App.GetAuthNonce:output_type -> api.GetAuthNonceReply
+	5,   // 122: api.App.RefreshToken:output_type -> api.RefreshTokenReply
+	7,   // 123: api.App.Logout:output_type -> api.LogoutReply
+	9,   // 124: api.App.LogoutAllSessions:output_type -> api.LogoutAllSessionsReply
+	11,  // 125: api.App.RecommendUpdate:output_type -> api.RecommendUpdateReply
+	15,  // 126: api.App.UserInfo:output_type -> api.UserInfoReply
+	17,  // 127: api.App.RewardList:output_type -> api.RewardListReply
+	19,  // 128: api.App.RecommendRewardList:output_type -> api.RecommendRewardListReply
+	21,  // 129: api.App.FeeRewardList:output_type -> api.FeeRewardListReply
+	23,  // 130: api.App.WithdrawList:output_type -> api.WithdrawListReply
+	25,  // 131: api.App.RecommendList:output_type -> api.RecommendListReply
+	29,  // 132: api.App.RecommendTree:output_type -> api.RecommendTreeReply
+	31,  // 133: api.App.Withdraw:output_type -> api.WithdrawReply
+	33,  // 134: api.App.CancelWithdraw:output_type -> api.CancelWithdrawReply
+	35,  // 135: api.App.WithdrawAddressList:output_type -> api.WithdrawAddressListReply
+	37,  // 136: api.App.AddWithdrawAddress:output_type -> api.AddWithdrawAddressReply
+	39,  // 137: api.App.DeleteWithdrawAddress:output_type -> api.DeleteWithdrawAddressReply
+	41,  // 138: api.App.SetBalanceReward:output_type -> api.SetBalanceRewardReply
+	43,  // 139: api.App.DeleteBalanceReward:output_type -> api.DeleteBalanceRewardReply
+	13,  // 140: api.App.Deposit:output_type -> api.DepositReply
+	53,  // 141: api.App.AdminLogin:output_type -> api.AdminLoginReply
+	55,  // 142: api.App.AdminWithdrawApprove:output_type -> api.AdminWithdrawApproveReply
+	57,  // 143: api.App.AdminWithdrawReject:output_type -> api.AdminWithdrawRejectReply
+	59,  // 144: api.App.AdminWithdrawRetry:output_type -> api.AdminWithdrawRetryReply
+	61,  // 145: api.App.AdminWithdrawRefund:output_type -> api.AdminWithdrawRefundReply
+	63,  // 146: api.App.AdminWithdrawLegacyMigrate:output_type -> api.AdminWithdrawLegacyMigrateReply
+	65,  // 147: api.App.AdminWithdrawBulkApprove:output_type -> api.AdminWithdrawBulkApproveReply
+	67,  // 148: api.App.AdminLedgerBalance:output_type -> api.AdminLedgerBalanceReply
+	69,  // 149: api.App.AdminRecommendClosureBackfill:output_type -> api.AdminRecommendClosureBackfillReply
+	71,  // 150: api.App.AdminLedgerOpen:output_type -> api.AdminLedgerOpenReply
+	73,  // 151: api.App.AdminReconcile:output_type -> api.AdminReconcileReply
+	75,  // 152: api.App.AdminDepositUnmatchedList:output_type -> api.AdminDepositUnmatchedListReply
+	77,  // 153: api.App.AdminDepositResolve:output_type -> api.AdminDepositResolveReply
+	79,  // 154: api.App.AdminDiscrepancyList:output_type -> api.AdminDiscrepancyListReply
+	81,  // 155: api.App.AdminLocationDailySettle:output_type -> api.AdminLocationDailySettleReply
+	83,  // 156: api.App.AdminUserAreaRebuild:output_type -> api.AdminUserAreaRebuildReply
+	90,  // 157: api.App.AdminSettlementDryRun:output_type -> api.AdminSettlementDryRunReply
+	92,  // 158: api.App.AdminSettlementReplay:output_type -> api.AdminSettlementReplayReply
+	100, // 159: api.App.AdminUserRecommend:output_type -> api.AdminUserRecommendReply
+	102, // 160: api.App.AdminRecommendTree:output_type -> api.AdminRecommendTreeReply
+	104, // 161: api.App.AdminUserLevelOverride:output_type -> api.AdminUserLevelOverrideReply
+	106, // 162: api.App.AdminUserLevelHistory:output_type -> api.AdminUserLevelHistoryReply
+	94,  // 163: api.App.AdminWithdrawEth:output_type -> api.AdminWithdrawEthReply
+	96,  // 164: api.App.AdminFee:output_type -> api.AdminFeeReply
+	120, // [120:165] is the sub-list for method output_type
+	75,  // [75:120] is the sub-list for method input_type
+	75,  // [75:75] is the sub-list for extension type_name
+	75,  // [75:75] is the sub-list for extension extendee
+	0,   // [0:75] is the sub-list for field type_name
+}
+
+func init() { file_app_app_api_app_proto_init() }
+func file_app_app_api_app_proto_init() {
+	if File_app_app_api_app_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
 		file_app_app_api_app_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EthAuthorizeRequest); i {
 			case 0:
@@ -5264,8 +11733,1040 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EthAuthorizeReply); i {
+		file_app_app_api_app_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthAuthorizeReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAuthNonceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAuthNonceReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshTokenReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogoutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogoutReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogoutAllSessionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogoutAllSessionsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendUpdateReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfoReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendRewardListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendRewardListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeRewardListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeRewardListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendTreeNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendTreeMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendTreeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendTreeReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelWithdrawRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelWithdrawReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawAddressListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawAddressListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddWithdrawAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddWithdrawAddressReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWithdrawAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWithdrawAddressReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetBalanceRewardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetBalanceRewardReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBalanceRewardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBalanceRewardReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRewardListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRewardListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLoginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLoginReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawApproveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawApproveReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawRejectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawRejectReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawRetryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawRetryReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawRefundRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawRefundReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawLegacyMigrateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawLegacyMigrateReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawBulkApproveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawBulkApproveReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLedgerBalanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLedgerBalanceReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRecommendClosureBackfillRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRecommendClosureBackfillReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLedgerOpenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLedgerOpenReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminReconcileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminReconcileReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDepositUnmatchedListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDepositUnmatchedListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDepositResolveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDepositResolveReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDiscrepancyListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDiscrepancyListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationDailySettleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationDailySettleReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserAreaRebuildRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserAreaRebuildReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SettlementConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SettlementSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SettlementRewardTotal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SettlementRewardDiff); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5276,8 +12777,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendUpdateRequest); i {
+		file_app_app_api_app_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SettlementConfigDiff); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5288,8 +12789,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendUpdateReply); i {
+		file_app_app_api_app_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminSettlementDryRunRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5300,8 +12801,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DepositRequest); i {
+		file_app_app_api_app_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminSettlementDryRunReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5312,8 +12813,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DepositReply); i {
+		file_app_app_api_app_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminSettlementReplayRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5324,8 +12825,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfoRequest); i {
+		file_app_app_api_app_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminSettlementReplayReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5336,8 +12837,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfoReply); i {
+		file_app_app_api_app_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawEthRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5348,8 +12849,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RewardListRequest); i {
+		file_app_app_api_app_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawEthReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5360,8 +12861,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RewardListReply); i {
+		file_app_app_api_app_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminFeeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5372,8 +12873,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendRewardListRequest); i {
+		file_app_app_api_app_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminFeeReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5384,8 +12885,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendRewardListReply); i {
+		file_app_app_api_app_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminAllRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5396,8 +12897,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FeeRewardListRequest); i {
+		file_app_app_api_app_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminAllReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5408,8 +12909,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FeeRewardListReply); i {
+		file_app_app_api_app_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserRecommendRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5420,8 +12921,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawListRequest); i {
+		file_app_app_api_app_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserRecommendReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5432,8 +12933,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawListReply); i {
+		file_app_app_api_app_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRecommendTreeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5444,8 +12945,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendListRequest); i {
+		file_app_app_api_app_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRecommendTreeReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5456,8 +12957,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendListReply); i {
+		file_app_app_api_app_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserLevelOverrideRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5468,8 +12969,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawRequest); i {
+		file_app_app_api_app_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserLevelOverrideReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5480,8 +12981,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawReply); i {
+		file_app_app_api_app_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserLevelHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5492,8 +12993,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetBalanceRewardRequest); i {
+		file_app_app_api_app_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserLevelHistoryReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5504,8 +13005,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetBalanceRewardReply); i {
+		file_app_app_api_app_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminMonthRecommendRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5516,8 +13017,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteBalanceRewardRequest); i {
+		file_app_app_api_app_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminMonthRecommendReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5528,8 +13029,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteBalanceRewardReply); i {
+		file_app_app_api_app_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5540,8 +13041,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminRewardListRequest); i {
+		file_app_app_api_app_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5552,8 +13053,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminRewardListReply); i {
+		file_app_app_api_app_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigUpdateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5564,8 +13065,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUserListRequest); i {
+		file_app_app_api_app_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigUpdateReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5576,8 +13077,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUserListReply); i {
+		file_app_app_api_app_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthAuthorizeRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5588,8 +13089,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLocationListRequest); i {
+		file_app_app_api_app_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAuthNonceRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5600,8 +13101,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLocationListReply); i {
+		file_app_app_api_app_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshTokenRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5612,8 +13113,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawListRequest); i {
+		file_app_app_api_app_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendUpdateRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5624,8 +13125,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawListReply); i {
+		file_app_app_api_app_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfoReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5636,8 +13137,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawRequest); i {
+		file_app_app_api_app_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfoReply_List2); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5648,8 +13149,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawReply); i {
+		file_app_app_api_app_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfoReply_List3); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5660,8 +13161,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawEthRequest); i {
+		file_app_app_api_app_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfoReply_List4); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5672,8 +13173,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawEthReply); i {
+		file_app_app_api_app_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfoReply_List5); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5684,8 +13185,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminFeeRequest); i {
+		file_app_app_api_app_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfoReply_List6); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5696,8 +13197,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminFeeReply); i {
+		file_app_app_api_app_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfoReply_List7); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5708,8 +13209,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminAllRequest); i {
+		file_app_app_api_app_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfoReply_List8); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5720,8 +13221,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminAllReply); i {
+		file_app_app_api_app_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfoReply_List9); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5732,8 +13233,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUserRecommendRequest); i {
+		file_app_app_api_app_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5744,8 +13245,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUserRecommendReply); i {
+		file_app_app_api_app_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendRewardListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5756,8 +13257,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminMonthRecommendRequest); i {
+		file_app_app_api_app_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeRewardListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5768,8 +13269,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminMonthRecommendReply); i {
+		file_app_app_api_app_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5780,8 +13281,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminConfigRequest); i {
+		file_app_app_api_app_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5792,8 +13293,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminConfigReply); i {
+		file_app_app_api_app_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5804,8 +13305,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminConfigUpdateRequest); i {
+		file_app_app_api_app_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelWithdrawRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5816,8 +13317,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminConfigUpdateReply); i {
+		file_app_app_api_app_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawAddressListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5828,8 +13329,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EthAuthorizeRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddWithdrawAddressRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5840,8 +13341,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendUpdateRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWithdrawAddressRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5852,8 +13353,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfoReply_List); i {
+		file_app_app_api_app_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetBalanceRewardRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5864,8 +13365,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfoReply_List2); i {
+		file_app_app_api_app_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBalanceRewardRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5876,8 +13377,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfoReply_List3); i {
+		file_app_app_api_app_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRewardListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5888,8 +13389,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfoReply_List4); i {
+		file_app_app_api_app_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserListReply_UserList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5900,8 +13401,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfoReply_List5); i {
+		file_app_app_api_app_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationListReply_LocationList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5912,8 +13413,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfoReply_List6); i {
+		file_app_app_api_app_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5924,8 +13425,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfoReply_List7); i {
+		file_app_app_api_app_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLoginRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5936,8 +13437,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfoReply_List8); i {
+		file_app_app_api_app_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawApproveRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5948,8 +13449,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfoReply_List9); i {
+		file_app_app_api_app_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawRejectRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5960,8 +13461,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RewardListReply_List); i {
+		file_app_app_api_app_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawRetryRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5972,8 +13473,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendRewardListReply_List); i {
+		file_app_app_api_app_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawRefundRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5984,8 +13485,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FeeRewardListReply_List); i {
+		file_app_app_api_app_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawLegacyMigrateRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5996,8 +13497,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawListReply_List); i {
+		file_app_app_api_app_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawBulkApproveRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6008,8 +13509,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendListReply_List); i {
+		file_app_app_api_app_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawBulkApproveReply_Fail); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6020,8 +13521,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLedgerBalanceReply_Coin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6032,8 +13533,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetBalanceRewardRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLedgerBalanceReply_Account); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6044,8 +13545,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteBalanceRewardRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRecommendClosureBackfillRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6056,8 +13557,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminRewardListReply_List); i {
+		file_app_app_api_app_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLedgerOpenRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6068,8 +13569,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUserListReply_UserList); i {
+		file_app_app_api_app_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminReconcileRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6080,8 +13581,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLocationListReply_LocationList); i {
+		file_app_app_api_app_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDepositUnmatchedListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6092,8 +13593,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawListReply_List); i {
+		file_app_app_api_app_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDepositResolveRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6104,7 +13605,67 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+		file_app_app_api_app_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDiscrepancyListReply_List); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationDailySettleRequest_SendBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserAreaRebuildRequest_SendBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminSettlementDryRunRequest_SendBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminSettlementReplayRequest_SendBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AdminUserRecommendReply_List); i {
 			case 0:
 				return &v.state
@@ -6116,7 +13677,31 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+		file_app_app_api_app_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserLevelOverrideRequest_SendBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserLevelHistoryReply_List); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AdminMonthRecommendReply_List); i {
 			case 0:
 				return &v.state
@@ -6128,7 +13713,7 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+		file_app_app_api_app_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AdminConfigReply_List); i {
 			case 0:
 				return &v.state
@@ -6140,7 +13725,7 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+		file_app_app_api_app_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AdminConfigUpdateRequest_SendBody); i {
 			case 0:
 				return &v.state
@@ -6159,7 +13744,7 @@ func file_app_app_api_app_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_app_app_api_app_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   75,
+			NumMessages:   168,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
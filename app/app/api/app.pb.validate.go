@@ -190,6 +190,8 @@ func (m *EthAuthorizeReply) validate(all bool) error {
 
 	// no validation rules for Token
 
+	// no validation rules for RefreshToken
+
 	if len(errors) > 0 {
 		return EthAuthorizeReplyMultiError(errors)
 	}
@@ -270,22 +272,22 @@ var _ interface {
 	ErrorName() string
 } = EthAuthorizeReplyValidationError{}
 
-// Validate checks the field values on RecommendUpdateRequest with the rules
+// Validate checks the field values on GetAuthNonceRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RecommendUpdateRequest) Validate() error {
+func (m *GetAuthNonceRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RecommendUpdateRequest with the rules
+// ValidateAll checks the field values on GetAuthNonceRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RecommendUpdateRequestMultiError, or nil if none found.
-func (m *RecommendUpdateRequest) ValidateAll() error {
+// GetAuthNonceRequestMultiError, or nil if none found.
+func (m *GetAuthNonceRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecommendUpdateRequest) validate(all bool) error {
+func (m *GetAuthNonceRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -296,7 +298,7 @@ func (m *RecommendUpdateRequest) validate(all bool) error {
 		switch v := interface{}(m.GetSendBody()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RecommendUpdateRequestValidationError{
+				errors = append(errors, GetAuthNonceRequestValidationError{
 					field:  "SendBody",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -304,7 +306,7 @@ func (m *RecommendUpdateRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, RecommendUpdateRequestValidationError{
+				errors = append(errors, GetAuthNonceRequestValidationError{
 					field:  "SendBody",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -313,7 +315,7 @@ func (m *RecommendUpdateRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return RecommendUpdateRequestValidationError{
+			return GetAuthNonceRequestValidationError{
 				field:  "SendBody",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -322,19 +324,19 @@ func (m *RecommendUpdateRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return RecommendUpdateRequestMultiError(errors)
+		return GetAuthNonceRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RecommendUpdateRequestMultiError is an error wrapping multiple validation
-// errors returned by RecommendUpdateRequest.ValidateAll() if the designated
+// GetAuthNonceRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAuthNonceRequest.ValidateAll() if the designated
 // constraints aren't met.
-type RecommendUpdateRequestMultiError []error
+type GetAuthNonceRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecommendUpdateRequestMultiError) Error() string {
+func (m GetAuthNonceRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -343,11 +345,11 @@ func (m RecommendUpdateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecommendUpdateRequestMultiError) AllErrors() []error { return m }
+func (m GetAuthNonceRequestMultiError) AllErrors() []error { return m }
 
-// RecommendUpdateRequestValidationError is the validation error returned by
-// RecommendUpdateRequest.Validate if the designated constraints aren't met.
-type RecommendUpdateRequestValidationError struct {
+// GetAuthNonceRequestValidationError is the validation error returned by
+// GetAuthNonceRequest.Validate if the designated constraints aren't met.
+type GetAuthNonceRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -355,24 +357,24 @@ type RecommendUpdateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecommendUpdateRequestValidationError) Field() string { return e.field }
+func (e GetAuthNonceRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecommendUpdateRequestValidationError) Reason() string { return e.reason }
+func (e GetAuthNonceRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecommendUpdateRequestValidationError) Cause() error { return e.cause }
+func (e GetAuthNonceRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecommendUpdateRequestValidationError) Key() bool { return e.key }
+func (e GetAuthNonceRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RecommendUpdateRequestValidationError) ErrorName() string {
-	return "RecommendUpdateRequestValidationError"
+func (e GetAuthNonceRequestValidationError) ErrorName() string {
+	return "GetAuthNonceRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RecommendUpdateRequestValidationError) Error() string {
+func (e GetAuthNonceRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -384,14 +386,14 @@ func (e RecommendUpdateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecommendUpdateRequest.%s: %s%s",
+		"invalid %sGetAuthNonceRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecommendUpdateRequestValidationError{}
+var _ error = GetAuthNonceRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -399,46 +401,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecommendUpdateRequestValidationError{}
+} = GetAuthNonceRequestValidationError{}
 
-// Validate checks the field values on RecommendUpdateReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RecommendUpdateReply) Validate() error {
+// Validate checks the field values on GetAuthNonceReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetAuthNonceReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RecommendUpdateReply with the rules
+// ValidateAll checks the field values on GetAuthNonceReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RecommendUpdateReplyMultiError, or nil if none found.
-func (m *RecommendUpdateReply) ValidateAll() error {
+// GetAuthNonceReplyMultiError, or nil if none found.
+func (m *GetAuthNonceReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecommendUpdateReply) validate(all bool) error {
+func (m *GetAuthNonceReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InviteUserAddress
+	// no validation rules for Nonce
+
+	// no validation rules for Message
 
 	if len(errors) > 0 {
-		return RecommendUpdateReplyMultiError(errors)
+		return GetAuthNonceReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// RecommendUpdateReplyMultiError is an error wrapping multiple validation
-// errors returned by RecommendUpdateReply.ValidateAll() if the designated
-// constraints aren't met.
-type RecommendUpdateReplyMultiError []error
+// GetAuthNonceReplyMultiError is an error wrapping multiple validation errors
+// returned by GetAuthNonceReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetAuthNonceReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecommendUpdateReplyMultiError) Error() string {
+func (m GetAuthNonceReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -447,11 +451,11 @@ func (m RecommendUpdateReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecommendUpdateReplyMultiError) AllErrors() []error { return m }
+func (m GetAuthNonceReplyMultiError) AllErrors() []error { return m }
 
-// RecommendUpdateReplyValidationError is the validation error returned by
-// RecommendUpdateReply.Validate if the designated constraints aren't met.
-type RecommendUpdateReplyValidationError struct {
+// GetAuthNonceReplyValidationError is the validation error returned by
+// GetAuthNonceReply.Validate if the designated constraints aren't met.
+type GetAuthNonceReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -459,24 +463,24 @@ type RecommendUpdateReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecommendUpdateReplyValidationError) Field() string { return e.field }
+func (e GetAuthNonceReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecommendUpdateReplyValidationError) Reason() string { return e.reason }
+func (e GetAuthNonceReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecommendUpdateReplyValidationError) Cause() error { return e.cause }
+func (e GetAuthNonceReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecommendUpdateReplyValidationError) Key() bool { return e.key }
+func (e GetAuthNonceReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RecommendUpdateReplyValidationError) ErrorName() string {
-	return "RecommendUpdateReplyValidationError"
+func (e GetAuthNonceReplyValidationError) ErrorName() string {
+	return "GetAuthNonceReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RecommendUpdateReplyValidationError) Error() string {
+func (e GetAuthNonceReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -488,14 +492,14 @@ func (e RecommendUpdateReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecommendUpdateReply.%s: %s%s",
+		"invalid %sGetAuthNonceReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecommendUpdateReplyValidationError{}
+var _ error = GetAuthNonceReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -503,44 +507,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecommendUpdateReplyValidationError{}
+} = GetAuthNonceReplyValidationError{}
 
-// Validate checks the field values on DepositRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *DepositRequest) Validate() error {
+// Validate checks the field values on RefreshTokenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefreshTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DepositRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DepositRequestMultiError,
-// or nil if none found.
-func (m *DepositRequest) ValidateAll() error {
+// ValidateAll checks the field values on RefreshTokenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefreshTokenRequestMultiError, or nil if none found.
+func (m *RefreshTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DepositRequest) validate(all bool) error {
+func (m *RefreshTokenRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RefreshTokenRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RefreshTokenRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RefreshTokenRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return DepositRequestMultiError(errors)
+		return RefreshTokenRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DepositRequestMultiError is an error wrapping multiple validation errors
-// returned by DepositRequest.ValidateAll() if the designated constraints
-// aren't met.
-type DepositRequestMultiError []error
+// RefreshTokenRequestMultiError is an error wrapping multiple validation
+// errors returned by RefreshTokenRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RefreshTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DepositRequestMultiError) Error() string {
+func (m RefreshTokenRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -549,11 +582,11 @@ func (m DepositRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DepositRequestMultiError) AllErrors() []error { return m }
+func (m RefreshTokenRequestMultiError) AllErrors() []error { return m }
 
-// DepositRequestValidationError is the validation error returned by
-// DepositRequest.Validate if the designated constraints aren't met.
-type DepositRequestValidationError struct {
+// RefreshTokenRequestValidationError is the validation error returned by
+// RefreshTokenRequest.Validate if the designated constraints aren't met.
+type RefreshTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -561,22 +594,24 @@ type DepositRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DepositRequestValidationError) Field() string { return e.field }
+func (e RefreshTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DepositRequestValidationError) Reason() string { return e.reason }
+func (e RefreshTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DepositRequestValidationError) Cause() error { return e.cause }
+func (e RefreshTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DepositRequestValidationError) Key() bool { return e.key }
+func (e RefreshTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DepositRequestValidationError) ErrorName() string { return "DepositRequestValidationError" }
+func (e RefreshTokenRequestValidationError) ErrorName() string {
+	return "RefreshTokenRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DepositRequestValidationError) Error() string {
+func (e RefreshTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -588,14 +623,14 @@ func (e DepositRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDepositRequest.%s: %s%s",
+		"invalid %sRefreshTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DepositRequestValidationError{}
+var _ error = RefreshTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -603,43 +638,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DepositRequestValidationError{}
+} = RefreshTokenRequestValidationError{}
 
-// Validate checks the field values on DepositReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *DepositReply) Validate() error {
+// Validate checks the field values on RefreshTokenReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RefreshTokenReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DepositReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DepositReplyMultiError, or
-// nil if none found.
-func (m *DepositReply) ValidateAll() error {
+// ValidateAll checks the field values on RefreshTokenReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefreshTokenReplyMultiError, or nil if none found.
+func (m *RefreshTokenReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DepositReply) validate(all bool) error {
+func (m *RefreshTokenReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Token
+
+	// no validation rules for RefreshToken
+
 	if len(errors) > 0 {
-		return DepositReplyMultiError(errors)
+		return RefreshTokenReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// DepositReplyMultiError is an error wrapping multiple validation errors
-// returned by DepositReply.ValidateAll() if the designated constraints aren't met.
-type DepositReplyMultiError []error
+// RefreshTokenReplyMultiError is an error wrapping multiple validation errors
+// returned by RefreshTokenReply.ValidateAll() if the designated constraints
+// aren't met.
+type RefreshTokenReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DepositReplyMultiError) Error() string {
+func (m RefreshTokenReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -648,11 +688,11 @@ func (m DepositReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DepositReplyMultiError) AllErrors() []error { return m }
+func (m RefreshTokenReplyMultiError) AllErrors() []error { return m }
 
-// DepositReplyValidationError is the validation error returned by
-// DepositReply.Validate if the designated constraints aren't met.
-type DepositReplyValidationError struct {
+// RefreshTokenReplyValidationError is the validation error returned by
+// RefreshTokenReply.Validate if the designated constraints aren't met.
+type RefreshTokenReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -660,22 +700,24 @@ type DepositReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e DepositReplyValidationError) Field() string { return e.field }
+func (e RefreshTokenReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DepositReplyValidationError) Reason() string { return e.reason }
+func (e RefreshTokenReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DepositReplyValidationError) Cause() error { return e.cause }
+func (e RefreshTokenReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DepositReplyValidationError) Key() bool { return e.key }
+func (e RefreshTokenReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DepositReplyValidationError) ErrorName() string { return "DepositReplyValidationError" }
+func (e RefreshTokenReplyValidationError) ErrorName() string {
+	return "RefreshTokenReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DepositReplyValidationError) Error() string {
+func (e RefreshTokenReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -687,14 +729,14 @@ func (e DepositReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDepositReply.%s: %s%s",
+		"invalid %sRefreshTokenReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DepositReplyValidationError{}
+var _ error = RefreshTokenReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -702,24 +744,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DepositReplyValidationError{}
+} = RefreshTokenReplyValidationError{}
 
-// Validate checks the field values on UserInfoRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *UserInfoRequest) Validate() error {
+// Validate checks the field values on LogoutRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LogoutRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserInfoRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UserInfoRequestMultiError, or nil if none found.
-func (m *UserInfoRequest) ValidateAll() error {
+// ValidateAll checks the field values on LogoutRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LogoutRequestMultiError, or
+// nil if none found.
+func (m *LogoutRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserInfoRequest) validate(all bool) error {
+func (m *LogoutRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -727,19 +769,19 @@ func (m *UserInfoRequest) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return UserInfoRequestMultiError(errors)
+		return LogoutRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserInfoRequestMultiError is an error wrapping multiple validation errors
-// returned by UserInfoRequest.ValidateAll() if the designated constraints
+// LogoutRequestMultiError is an error wrapping multiple validation errors
+// returned by LogoutRequest.ValidateAll() if the designated constraints
 // aren't met.
-type UserInfoRequestMultiError []error
+type LogoutRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserInfoRequestMultiError) Error() string {
+func (m LogoutRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -748,11 +790,11 @@ func (m UserInfoRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserInfoRequestMultiError) AllErrors() []error { return m }
+func (m LogoutRequestMultiError) AllErrors() []error { return m }
 
-// UserInfoRequestValidationError is the validation error returned by
-// UserInfoRequest.Validate if the designated constraints aren't met.
-type UserInfoRequestValidationError struct {
+// LogoutRequestValidationError is the validation error returned by
+// LogoutRequest.Validate if the designated constraints aren't met.
+type LogoutRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -760,22 +802,22 @@ type UserInfoRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserInfoRequestValidationError) Field() string { return e.field }
+func (e LogoutRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserInfoRequestValidationError) Reason() string { return e.reason }
+func (e LogoutRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserInfoRequestValidationError) Cause() error { return e.cause }
+func (e LogoutRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserInfoRequestValidationError) Key() bool { return e.key }
+func (e LogoutRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserInfoRequestValidationError) ErrorName() string { return "UserInfoRequestValidationError" }
+func (e LogoutRequestValidationError) ErrorName() string { return "LogoutRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UserInfoRequestValidationError) Error() string {
+func (e LogoutRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -787,14 +829,14 @@ func (e UserInfoRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserInfoRequest.%s: %s%s",
+		"invalid %sLogoutRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserInfoRequestValidationError{}
+var _ error = LogoutRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -802,407 +844,11677 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserInfoRequestValidationError{}
+} = LogoutRequestValidationError{}
 
-// Validate checks the field values on UserInfoReply with the rules defined in
+// Validate checks the field values on LogoutReply with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *UserInfoReply) Validate() error {
+func (m *LogoutReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserInfoReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in UserInfoReplyMultiError, or
+// ValidateAll checks the field values on LogoutReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LogoutReplyMultiError, or
 // nil if none found.
-func (m *UserInfoReply) ValidateAll() error {
+func (m *LogoutReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserInfoReply) validate(all bool) error {
+func (m *LogoutReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Address
-
-	// no validation rules for Level
-
 	// no validation rules for Status
 
-	// no validation rules for Amount
+	if len(errors) > 0 {
+		return LogoutReplyMultiError(errors)
+	}
 
-	// no validation rules for BalanceUsdt
+	return nil
+}
 
-	// no validation rules for BalanceDhb
+// LogoutReplyMultiError is an error wrapping multiple validation errors
+// returned by LogoutReply.ValidateAll() if the designated constraints aren't met.
+type LogoutReplyMultiError []error
 
-	// no validation rules for InviteUrl
+// Error returns a concatenation of all the error messages it wraps.
+func (m LogoutReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
 
-	// no validation rules for InviteUserAddress
+// AllErrors returns a list of validation violation errors.
+func (m LogoutReplyMultiError) AllErrors() []error { return m }
 
-	// no validation rules for RecommendNum
+// LogoutReplyValidationError is the validation error returned by
+// LogoutReply.Validate if the designated constraints aren't met.
+type LogoutReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
 
-	// no validation rules for RecommendTeamNum
+// Field function returns field value.
+func (e LogoutReplyValidationError) Field() string { return e.field }
 
-	// no validation rules for Total
+// Reason function returns reason value.
+func (e LogoutReplyValidationError) Reason() string { return e.reason }
 
-	// no validation rules for RecommendTotal
+// Cause function returns cause value.
+func (e LogoutReplyValidationError) Cause() error { return e.cause }
 
-	// no validation rules for LocationTotal
+// Key function returns key value.
+func (e LogoutReplyValidationError) Key() bool { return e.key }
 
-	// no validation rules for Usdt
+// ErrorName returns error name.
+func (e LogoutReplyValidationError) ErrorName() string { return "LogoutReplyValidationError" }
 
-	// no validation rules for Account
+// Error satisfies the builtin error interface
+func (e LogoutReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
 
-	// no validation rules for AmountB
+	key := ""
+	if e.key {
+		key = "key for "
+	}
 
-	// no validation rules for RecommendVipTotal
+	return fmt.Sprintf(
+		"invalid %sLogoutReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
 
-	// no validation rules for FeeDaily
+var _ error = LogoutReplyValidationError{}
 
-	// no validation rules for WithdrawAmount
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LogoutReplyValidationError{}
 
-	// no validation rules for UserCount
+// Validate checks the field values on LogoutAllSessionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LogoutAllSessionsRequest) Validate() error {
+	return m.validate(false)
+}
 
-	// no validation rules for TotalDeposit
+// ValidateAll checks the field values on LogoutAllSessionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LogoutAllSessionsRequestMultiError, or nil if none found.
+func (m *LogoutAllSessionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
 
-	// no validation rules for PoolAmount
+func (m *LogoutAllSessionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
 
-	// no validation rules for LocationCount
+	var errors []error
 
-	// no validation rules for TodayReward
+	if len(errors) > 0 {
+		return LogoutAllSessionsRequestMultiError(errors)
+	}
 
-	// no validation rules for RecommendTop
+	return nil
+}
 
-	// no validation rules for FybPrice
+// LogoutAllSessionsRequestMultiError is an error wrapping multiple validation
+// errors returned by LogoutAllSessionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type LogoutAllSessionsRequestMultiError []error
 
-	// no validation rules for FybRate
+// Error returns a concatenation of all the error messages it wraps.
+func (m LogoutAllSessionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
 
-	// no validation rules for AreaAmount
+// AllErrors returns a list of validation violation errors.
+func (m LogoutAllSessionsRequestMultiError) AllErrors() []error { return m }
 
-	// no validation rules for RecommendAreaTotal
+// LogoutAllSessionsRequestValidationError is the validation error returned by
+// LogoutAllSessionsRequest.Validate if the designated constraints aren't met.
+type LogoutAllSessionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
 
-	// no validation rules for AreaMaxAmount
+// Field function returns field value.
+func (e LogoutAllSessionsRequestValidationError) Field() string { return e.field }
 
-	// no validation rules for Undo
+// Reason function returns reason value.
+func (e LogoutAllSessionsRequestValidationError) Reason() string { return e.reason }
 
-	// no validation rules for AmountC
+// Cause function returns cause value.
+func (e LogoutAllSessionsRequestValidationError) Cause() error { return e.cause }
 
-	// no validation rules for AmountBalanceReward
+// Key function returns key value.
+func (e LogoutAllSessionsRequestValidationError) Key() bool { return e.key }
 
-	// no validation rules for AreaName
+// ErrorName returns error name.
+func (e LogoutAllSessionsRequestValidationError) ErrorName() string {
+	return "LogoutAllSessionsRequestValidationError"
+}
 
-	// no validation rules for RecommendTeamTotal
+// Error satisfies the builtin error interface
+func (e LogoutAllSessionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
 
-	// no validation rules for LocationDailyRewardTotal
+	key := ""
+	if e.key {
+		key = "key for "
+	}
 
-	// no validation rules for DailyBalanceRewardTotal
+	return fmt.Sprintf(
+		"invalid %sLogoutAllSessionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
 
-	// no validation rules for YesterdayRecommendTeamTotal
+var _ error = LogoutAllSessionsRequestValidationError{}
 
-	// no validation rules for YesterdayLocationDailyRewardTotal
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LogoutAllSessionsRequestValidationError{}
 
-	// no validation rules for YesterdayDailyBalanceRewardTotal
+// Validate checks the field values on LogoutAllSessionsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LogoutAllSessionsReply) Validate() error {
+	return m.validate(false)
+}
 
-	// no validation rules for YesterdayRecommendAreaTotal
+// ValidateAll checks the field values on LogoutAllSessionsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LogoutAllSessionsReplyMultiError, or nil if none found.
+func (m *LogoutAllSessionsReply) ValidateAll() error {
+	return m.validate(true)
+}
 
-	// no validation rules for YesterdayRecommendTotal
+func (m *LogoutAllSessionsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
 
-	// no validation rules for TotalAreaAmount
+	var errors []error
 
-	// no validation rules for LocationRunningAmount
+	// no validation rules for Status
 
-	for idx, item := range m.GetLocationList() {
-		_, _ = idx, item
+	if len(errors) > 0 {
+		return LogoutAllSessionsReplyMultiError(errors)
+	}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UserInfoReplyValidationError{
-						field:  fmt.Sprintf("LocationList[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, UserInfoReplyValidationError{
-						field:  fmt.Sprintf("LocationList[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return UserInfoReplyValidationError{
-					field:  fmt.Sprintf("LocationList[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	return nil
+}
+
+// LogoutAllSessionsReplyMultiError is an error wrapping multiple validation
+// errors returned by LogoutAllSessionsReply.ValidateAll() if the designated
+// constraints aren't met.
+type LogoutAllSessionsReplyMultiError []error
 
+// Error returns a concatenation of all the error messages it wraps.
+func (m LogoutAllSessionsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
 
-	for idx, item := range m.GetRecommendTeamList() {
-		_, _ = idx, item
+// AllErrors returns a list of validation violation errors.
+func (m LogoutAllSessionsReplyMultiError) AllErrors() []error { return m }
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UserInfoReplyValidationError{
-						field:  fmt.Sprintf("RecommendTeamList[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, UserInfoReplyValidationError{
-						field:  fmt.Sprintf("RecommendTeamList[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return UserInfoReplyValidationError{
-					field:  fmt.Sprintf("RecommendTeamList[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// LogoutAllSessionsReplyValidationError is the validation error returned by
+// LogoutAllSessionsReply.Validate if the designated constraints aren't met.
+type LogoutAllSessionsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
 
-	}
+// Field function returns field value.
+func (e LogoutAllSessionsReplyValidationError) Field() string { return e.field }
 
-	for idx, item := range m.GetRecommendAreaList() {
-		_, _ = idx, item
+// Reason function returns reason value.
+func (e LogoutAllSessionsReplyValidationError) Reason() string { return e.reason }
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UserInfoReplyValidationError{
-						field:  fmt.Sprintf("RecommendAreaList[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, UserInfoReplyValidationError{
-						field:  fmt.Sprintf("RecommendAreaList[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return UserInfoReplyValidationError{
-					field:  fmt.Sprintf("RecommendAreaList[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// Cause function returns cause value.
+func (e LogoutAllSessionsReplyValidationError) Cause() error { return e.cause }
 
-	}
+// Key function returns key value.
+func (e LogoutAllSessionsReplyValidationError) Key() bool { return e.key }
 
-	for idx, item := range m.GetLocationDailyRewardList() {
-		_, _ = idx, item
+// ErrorName returns error name.
+func (e LogoutAllSessionsReplyValidationError) ErrorName() string {
+	return "LogoutAllSessionsReplyValidationError"
+}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UserInfoReplyValidationError{
-						field:  fmt.Sprintf("LocationDailyRewardList[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, UserInfoReplyValidationError{
-						field:  fmt.Sprintf("LocationDailyRewardList[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return UserInfoReplyValidationError{
-					field:  fmt.Sprintf("LocationDailyRewardList[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// Error satisfies the builtin error interface
+func (e LogoutAllSessionsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
 
+	key := ""
+	if e.key {
+		key = "key for "
 	}
 
-	for idx, item := range m.GetRecommendList() {
-		_, _ = idx, item
+	return fmt.Sprintf(
+		"invalid %sLogoutAllSessionsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UserInfoReplyValidationError{
-						field:  fmt.Sprintf("RecommendList[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, UserInfoReplyValidationError{
-						field:  fmt.Sprintf("RecommendList[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return UserInfoReplyValidationError{
-					field:  fmt.Sprintf("RecommendList[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+var _ error = LogoutAllSessionsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LogoutAllSessionsReplyValidationError{}
+
+// Validate checks the field values on RecommendUpdateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecommendUpdateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecommendUpdateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecommendUpdateRequestMultiError, or nil if none found.
+func (m *RecommendUpdateRequest) ValidateAll() error {
+	return m.validate(true)
+}
 
+func (m *RecommendUpdateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	for idx, item := range m.GetDailyBalanceRewardList() {
-		_, _ = idx, item
+	var errors []error
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UserInfoReplyValidationError{
-						field:  fmt.Sprintf("DailyBalanceRewardList[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, UserInfoReplyValidationError{
-						field:  fmt.Sprintf("DailyBalanceRewardList[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RecommendUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return UserInfoReplyValidationError{
-					field:  fmt.Sprintf("DailyBalanceRewardList[%v]", idx),
+				errors = append(errors, RecommendUpdateRequestValidationError{
+					field:  "SendBody",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RecommendUpdateRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
+	}
 
+	if len(errors) > 0 {
+		return RecommendUpdateRequestMultiError(errors)
 	}
 
-	for idx, item := range m.GetTeamAddressList() {
-		_, _ = idx, item
+	return nil
+}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UserInfoReplyValidationError{
-						field:  fmt.Sprintf("TeamAddressList[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, UserInfoReplyValidationError{
-						field:  fmt.Sprintf("TeamAddressList[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return UserInfoReplyValidationError{
-					field:  fmt.Sprintf("TeamAddressList[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// RecommendUpdateRequestMultiError is an error wrapping multiple validation
+// errors returned by RecommendUpdateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RecommendUpdateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecommendUpdateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecommendUpdateRequestMultiError) AllErrors() []error { return m }
+
+// RecommendUpdateRequestValidationError is the validation error returned by
+// RecommendUpdateRequest.Validate if the designated constraints aren't met.
+type RecommendUpdateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecommendUpdateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecommendUpdateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecommendUpdateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecommendUpdateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecommendUpdateRequestValidationError) ErrorName() string {
+	return "RecommendUpdateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecommendUpdateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecommendUpdateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecommendUpdateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecommendUpdateRequestValidationError{}
+
+// Validate checks the field values on RecommendUpdateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecommendUpdateReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecommendUpdateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecommendUpdateReplyMultiError, or nil if none found.
+func (m *RecommendUpdateReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecommendUpdateReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InviteUserAddress
+
+	if len(errors) > 0 {
+		return RecommendUpdateReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecommendUpdateReplyMultiError is an error wrapping multiple validation
+// errors returned by RecommendUpdateReply.ValidateAll() if the designated
+// constraints aren't met.
+type RecommendUpdateReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecommendUpdateReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecommendUpdateReplyMultiError) AllErrors() []error { return m }
+
+// RecommendUpdateReplyValidationError is the validation error returned by
+// RecommendUpdateReply.Validate if the designated constraints aren't met.
+type RecommendUpdateReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecommendUpdateReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecommendUpdateReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecommendUpdateReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecommendUpdateReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecommendUpdateReplyValidationError) ErrorName() string {
+	return "RecommendUpdateReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecommendUpdateReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecommendUpdateReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecommendUpdateReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecommendUpdateReplyValidationError{}
+
+// Validate checks the field values on DepositRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DepositRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DepositRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DepositRequestMultiError,
+// or nil if none found.
+func (m *DepositRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DepositRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DepositRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DepositRequestMultiError is an error wrapping multiple validation errors
+// returned by DepositRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DepositRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DepositRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DepositRequestMultiError) AllErrors() []error { return m }
+
+// DepositRequestValidationError is the validation error returned by
+// DepositRequest.Validate if the designated constraints aren't met.
+type DepositRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DepositRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DepositRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DepositRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DepositRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DepositRequestValidationError) ErrorName() string { return "DepositRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DepositRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDepositRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DepositRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DepositRequestValidationError{}
+
+// Validate checks the field values on DepositReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DepositReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DepositReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DepositReplyMultiError, or
+// nil if none found.
+func (m *DepositReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DepositReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DepositReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DepositReplyMultiError is an error wrapping multiple validation errors
+// returned by DepositReply.ValidateAll() if the designated constraints aren't met.
+type DepositReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DepositReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DepositReplyMultiError) AllErrors() []error { return m }
+
+// DepositReplyValidationError is the validation error returned by
+// DepositReply.Validate if the designated constraints aren't met.
+type DepositReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DepositReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DepositReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DepositReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DepositReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DepositReplyValidationError) ErrorName() string { return "DepositReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DepositReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDepositReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DepositReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DepositReplyValidationError{}
+
+// Validate checks the field values on UserInfoRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserInfoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserInfoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserInfoRequestMultiError, or nil if none found.
+func (m *UserInfoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserInfoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UserInfoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserInfoRequestMultiError is an error wrapping multiple validation errors
+// returned by UserInfoRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UserInfoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserInfoRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserInfoRequestMultiError) AllErrors() []error { return m }
+
+// UserInfoRequestValidationError is the validation error returned by
+// UserInfoRequest.Validate if the designated constraints aren't met.
+type UserInfoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserInfoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserInfoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserInfoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserInfoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserInfoRequestValidationError) ErrorName() string { return "UserInfoRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserInfoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserInfoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserInfoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserInfoRequestValidationError{}
+
+// Validate checks the field values on UserInfoReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserInfoReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserInfoReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserInfoReplyMultiError, or
+// nil if none found.
+func (m *UserInfoReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserInfoReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for Level
+
+	// no validation rules for Status
+
+	// no validation rules for Amount
+
+	// no validation rules for BalanceUsdt
+
+	// no validation rules for BalanceDhb
+
+	// no validation rules for InviteUrl
+
+	// no validation rules for InviteUserAddress
+
+	// no validation rules for RecommendNum
+
+	// no validation rules for RecommendTeamNum
+
+	// no validation rules for Total
+
+	// no validation rules for RecommendTotal
+
+	// no validation rules for LocationTotal
+
+	// no validation rules for Usdt
+
+	// no validation rules for Account
+
+	// no validation rules for AmountB
+
+	// no validation rules for RecommendVipTotal
+
+	// no validation rules for FeeDaily
+
+	// no validation rules for WithdrawAmount
+
+	// no validation rules for UserCount
+
+	// no validation rules for TotalDeposit
+
+	// no validation rules for PoolAmount
+
+	// no validation rules for LocationCount
+
+	// no validation rules for TodayReward
+
+	// no validation rules for RecommendTop
+
+	// no validation rules for FybPrice
+
+	// no validation rules for FybRate
+
+	// no validation rules for AreaAmount
+
+	// no validation rules for RecommendAreaTotal
+
+	// no validation rules for AreaMaxAmount
+
+	// no validation rules for Undo
+
+	// no validation rules for AmountC
+
+	// no validation rules for AmountBalanceReward
+
+	// no validation rules for AreaName
+
+	// no validation rules for RecommendTeamTotal
+
+	// no validation rules for LocationDailyRewardTotal
+
+	// no validation rules for DailyBalanceRewardTotal
+
+	// no validation rules for YesterdayRecommendTeamTotal
+
+	// no validation rules for YesterdayLocationDailyRewardTotal
+
+	// no validation rules for YesterdayDailyBalanceRewardTotal
+
+	// no validation rules for YesterdayRecommendAreaTotal
+
+	// no validation rules for YesterdayRecommendTotal
+
+	// no validation rules for TotalAreaAmount
+
+	// no validation rules for LocationRunningAmount
+
+	for idx, item := range m.GetLocationList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserInfoReplyValidationError{
+						field:  fmt.Sprintf("LocationList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserInfoReplyValidationError{
+						field:  fmt.Sprintf("LocationList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserInfoReplyValidationError{
+					field:  fmt.Sprintf("LocationList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetRecommendTeamList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserInfoReplyValidationError{
+						field:  fmt.Sprintf("RecommendTeamList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserInfoReplyValidationError{
+						field:  fmt.Sprintf("RecommendTeamList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserInfoReplyValidationError{
+					field:  fmt.Sprintf("RecommendTeamList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetRecommendAreaList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserInfoReplyValidationError{
+						field:  fmt.Sprintf("RecommendAreaList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserInfoReplyValidationError{
+						field:  fmt.Sprintf("RecommendAreaList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserInfoReplyValidationError{
+					field:  fmt.Sprintf("RecommendAreaList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetLocationDailyRewardList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserInfoReplyValidationError{
+						field:  fmt.Sprintf("LocationDailyRewardList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserInfoReplyValidationError{
+						field:  fmt.Sprintf("LocationDailyRewardList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserInfoReplyValidationError{
+					field:  fmt.Sprintf("LocationDailyRewardList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetRecommendList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserInfoReplyValidationError{
+						field:  fmt.Sprintf("RecommendList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserInfoReplyValidationError{
+						field:  fmt.Sprintf("RecommendList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserInfoReplyValidationError{
+					field:  fmt.Sprintf("RecommendList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetDailyBalanceRewardList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserInfoReplyValidationError{
+						field:  fmt.Sprintf("DailyBalanceRewardList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserInfoReplyValidationError{
+						field:  fmt.Sprintf("DailyBalanceRewardList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserInfoReplyValidationError{
+					field:  fmt.Sprintf("DailyBalanceRewardList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetTeamAddressList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserInfoReplyValidationError{
+						field:  fmt.Sprintf("TeamAddressList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserInfoReplyValidationError{
+						field:  fmt.Sprintf("TeamAddressList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserInfoReplyValidationError{
+					field:  fmt.Sprintf("TeamAddressList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetMyRecommendAddressList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserInfoReplyValidationError{
+						field:  fmt.Sprintf("MyRecommendAddressList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserInfoReplyValidationError{
+						field:  fmt.Sprintf("MyRecommendAddressList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserInfoReplyValidationError{
+					field:  fmt.Sprintf("MyRecommendAddressList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetAllRewardList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserInfoReplyValidationError{
+						field:  fmt.Sprintf("AllRewardList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserInfoReplyValidationError{
+						field:  fmt.Sprintf("AllRewardList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserInfoReplyValidationError{
+					field:  fmt.Sprintf("AllRewardList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UserInfoReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserInfoReplyMultiError is an error wrapping multiple validation errors
+// returned by UserInfoReply.ValidateAll() if the designated constraints
+// aren't met.
+type UserInfoReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserInfoReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserInfoReplyMultiError) AllErrors() []error { return m }
+
+// UserInfoReplyValidationError is the validation error returned by
+// UserInfoReply.Validate if the designated constraints aren't met.
+type UserInfoReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserInfoReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserInfoReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserInfoReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserInfoReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserInfoReplyValidationError) ErrorName() string { return "UserInfoReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserInfoReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserInfoReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserInfoReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserInfoReplyValidationError{}
+
+// Validate checks the field values on RewardListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RewardListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RewardListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RewardListRequestMultiError, or nil if none found.
+func (m *RewardListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RewardListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Reason
+
+	// no validation rules for StartDate
+
+	// no validation rules for EndDate
+
+	// no validation rules for Cursor
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return RewardListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RewardListRequestMultiError is an error wrapping multiple validation errors
+// returned by RewardListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RewardListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RewardListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RewardListRequestMultiError) AllErrors() []error { return m }
+
+// RewardListRequestValidationError is the validation error returned by
+// RewardListRequest.Validate if the designated constraints aren't met.
+type RewardListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RewardListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RewardListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RewardListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RewardListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RewardListRequestValidationError) ErrorName() string {
+	return "RewardListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RewardListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRewardListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RewardListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RewardListRequestValidationError{}
+
+// Validate checks the field values on RewardListReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RewardListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RewardListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RewardListReplyMultiError, or nil if none found.
+func (m *RewardListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RewardListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRewards() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RewardListReplyValidationError{
+						field:  fmt.Sprintf("Rewards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RewardListReplyValidationError{
+						field:  fmt.Sprintf("Rewards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RewardListReplyValidationError{
+					field:  fmt.Sprintf("Rewards[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return RewardListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RewardListReplyMultiError is an error wrapping multiple validation errors
+// returned by RewardListReply.ValidateAll() if the designated constraints
+// aren't met.
+type RewardListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RewardListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RewardListReplyMultiError) AllErrors() []error { return m }
+
+// RewardListReplyValidationError is the validation error returned by
+// RewardListReply.Validate if the designated constraints aren't met.
+type RewardListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RewardListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RewardListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RewardListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RewardListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RewardListReplyValidationError) ErrorName() string { return "RewardListReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RewardListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRewardListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RewardListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RewardListReplyValidationError{}
+
+// Validate checks the field values on RecommendRewardListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecommendRewardListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecommendRewardListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecommendRewardListRequestMultiError, or nil if none found.
+func (m *RecommendRewardListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecommendRewardListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Reason
+
+	// no validation rules for StartDate
+
+	// no validation rules for EndDate
+
+	// no validation rules for Cursor
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return RecommendRewardListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecommendRewardListRequestMultiError is an error wrapping multiple
+// validation errors returned by RecommendRewardListRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RecommendRewardListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecommendRewardListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecommendRewardListRequestMultiError) AllErrors() []error { return m }
+
+// RecommendRewardListRequestValidationError is the validation error returned
+// by RecommendRewardListRequest.Validate if the designated constraints aren't met.
+type RecommendRewardListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecommendRewardListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecommendRewardListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecommendRewardListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecommendRewardListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecommendRewardListRequestValidationError) ErrorName() string {
+	return "RecommendRewardListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecommendRewardListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecommendRewardListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecommendRewardListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecommendRewardListRequestValidationError{}
+
+// Validate checks the field values on RecommendRewardListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecommendRewardListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecommendRewardListReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecommendRewardListReplyMultiError, or nil if none found.
+func (m *RecommendRewardListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecommendRewardListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRewards() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RecommendRewardListReplyValidationError{
+						field:  fmt.Sprintf("Rewards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RecommendRewardListReplyValidationError{
+						field:  fmt.Sprintf("Rewards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RecommendRewardListReplyValidationError{
+					field:  fmt.Sprintf("Rewards[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return RecommendRewardListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecommendRewardListReplyMultiError is an error wrapping multiple validation
+// errors returned by RecommendRewardListReply.ValidateAll() if the designated
+// constraints aren't met.
+type RecommendRewardListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecommendRewardListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecommendRewardListReplyMultiError) AllErrors() []error { return m }
+
+// RecommendRewardListReplyValidationError is the validation error returned by
+// RecommendRewardListReply.Validate if the designated constraints aren't met.
+type RecommendRewardListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecommendRewardListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecommendRewardListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecommendRewardListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecommendRewardListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecommendRewardListReplyValidationError) ErrorName() string {
+	return "RecommendRewardListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecommendRewardListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecommendRewardListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecommendRewardListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecommendRewardListReplyValidationError{}
+
+// Validate checks the field values on FeeRewardListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FeeRewardListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FeeRewardListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FeeRewardListRequestMultiError, or nil if none found.
+func (m *FeeRewardListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FeeRewardListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StartDate
+
+	// no validation rules for EndDate
+
+	// no validation rules for Cursor
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return FeeRewardListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FeeRewardListRequestMultiError is an error wrapping multiple validation
+// errors returned by FeeRewardListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type FeeRewardListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FeeRewardListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FeeRewardListRequestMultiError) AllErrors() []error { return m }
+
+// FeeRewardListRequestValidationError is the validation error returned by
+// FeeRewardListRequest.Validate if the designated constraints aren't met.
+type FeeRewardListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FeeRewardListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FeeRewardListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FeeRewardListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FeeRewardListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FeeRewardListRequestValidationError) ErrorName() string {
+	return "FeeRewardListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FeeRewardListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFeeRewardListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FeeRewardListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FeeRewardListRequestValidationError{}
+
+// Validate checks the field values on FeeRewardListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FeeRewardListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FeeRewardListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FeeRewardListReplyMultiError, or nil if none found.
+func (m *FeeRewardListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FeeRewardListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRewards() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FeeRewardListReplyValidationError{
+						field:  fmt.Sprintf("Rewards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FeeRewardListReplyValidationError{
+						field:  fmt.Sprintf("Rewards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FeeRewardListReplyValidationError{
+					field:  fmt.Sprintf("Rewards[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return FeeRewardListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// FeeRewardListReplyMultiError is an error wrapping multiple validation errors
+// returned by FeeRewardListReply.ValidateAll() if the designated constraints
+// aren't met.
+type FeeRewardListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FeeRewardListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FeeRewardListReplyMultiError) AllErrors() []error { return m }
+
+// FeeRewardListReplyValidationError is the validation error returned by
+// FeeRewardListReply.Validate if the designated constraints aren't met.
+type FeeRewardListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FeeRewardListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FeeRewardListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FeeRewardListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FeeRewardListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FeeRewardListReplyValidationError) ErrorName() string {
+	return "FeeRewardListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FeeRewardListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFeeRewardListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FeeRewardListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FeeRewardListReplyValidationError{}
+
+// Validate checks the field values on WithdrawListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WithdrawListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WithdrawListRequestMultiError, or nil if none found.
+func (m *WithdrawListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WithdrawListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	if len(errors) > 0 {
+		return WithdrawListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WithdrawListRequestMultiError is an error wrapping multiple validation
+// errors returned by WithdrawListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WithdrawListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WithdrawListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WithdrawListRequestMultiError) AllErrors() []error { return m }
+
+// WithdrawListRequestValidationError is the validation error returned by
+// WithdrawListRequest.Validate if the designated constraints aren't met.
+type WithdrawListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WithdrawListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WithdrawListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WithdrawListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WithdrawListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WithdrawListRequestValidationError) ErrorName() string {
+	return "WithdrawListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WithdrawListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWithdrawListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WithdrawListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WithdrawListRequestValidationError{}
+
+// Validate checks the field values on WithdrawListReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WithdrawListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WithdrawListReplyMultiError, or nil if none found.
+func (m *WithdrawListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WithdrawListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetWithdraw() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WithdrawListReplyValidationError{
+						field:  fmt.Sprintf("Withdraw[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WithdrawListReplyValidationError{
+						field:  fmt.Sprintf("Withdraw[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WithdrawListReplyValidationError{
+					field:  fmt.Sprintf("Withdraw[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return WithdrawListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// WithdrawListReplyMultiError is an error wrapping multiple validation errors
+// returned by WithdrawListReply.ValidateAll() if the designated constraints
+// aren't met.
+type WithdrawListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WithdrawListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WithdrawListReplyMultiError) AllErrors() []error { return m }
+
+// WithdrawListReplyValidationError is the validation error returned by
+// WithdrawListReply.Validate if the designated constraints aren't met.
+type WithdrawListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WithdrawListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WithdrawListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WithdrawListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WithdrawListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WithdrawListReplyValidationError) ErrorName() string {
+	return "WithdrawListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WithdrawListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWithdrawListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WithdrawListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WithdrawListReplyValidationError{}
+
+// Validate checks the field values on RecommendListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecommendListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecommendListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecommendListRequestMultiError, or nil if none found.
+func (m *RecommendListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecommendListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	if len(errors) > 0 {
+		return RecommendListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecommendListRequestMultiError is an error wrapping multiple validation
+// errors returned by RecommendListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RecommendListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecommendListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecommendListRequestMultiError) AllErrors() []error { return m }
+
+// RecommendListRequestValidationError is the validation error returned by
+// RecommendListRequest.Validate if the designated constraints aren't met.
+type RecommendListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecommendListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecommendListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecommendListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecommendListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecommendListRequestValidationError) ErrorName() string {
+	return "RecommendListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecommendListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecommendListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecommendListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecommendListRequestValidationError{}
+
+// Validate checks the field values on RecommendListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecommendListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecommendListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecommendListReplyMultiError, or nil if none found.
+func (m *RecommendListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecommendListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRecommends() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RecommendListReplyValidationError{
+						field:  fmt.Sprintf("Recommends[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RecommendListReplyValidationError{
+						field:  fmt.Sprintf("Recommends[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RecommendListReplyValidationError{
+					field:  fmt.Sprintf("Recommends[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RecommendListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecommendListReplyMultiError is an error wrapping multiple validation errors
+// returned by RecommendListReply.ValidateAll() if the designated constraints
+// aren't met.
+type RecommendListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecommendListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecommendListReplyMultiError) AllErrors() []error { return m }
+
+// RecommendListReplyValidationError is the validation error returned by
+// RecommendListReply.Validate if the designated constraints aren't met.
+type RecommendListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecommendListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecommendListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecommendListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecommendListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecommendListReplyValidationError) ErrorName() string {
+	return "RecommendListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecommendListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecommendListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecommendListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecommendListReplyValidationError{}
+
+// Validate checks the field values on RecommendTreeNode with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RecommendTreeNode) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecommendTreeNode with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecommendTreeNodeMultiError, or nil if none found.
+func (m *RecommendTreeNode) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecommendTreeNode) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Address
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for LocationAmount
+
+	// no validation rules for AreaAmount
+
+	// no validation rules for DescendantCount
+
+	// no validation rules for ParentId
+
+	// no validation rules for Depth
+
+	if len(errors) > 0 {
+		return RecommendTreeNodeMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecommendTreeNodeMultiError is an error wrapping multiple validation errors
+// returned by RecommendTreeNode.ValidateAll() if the designated constraints
+// aren't met.
+type RecommendTreeNodeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecommendTreeNodeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecommendTreeNodeMultiError) AllErrors() []error { return m }
+
+// RecommendTreeNodeValidationError is the validation error returned by
+// RecommendTreeNode.Validate if the designated constraints aren't met.
+type RecommendTreeNodeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecommendTreeNodeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecommendTreeNodeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecommendTreeNodeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecommendTreeNodeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecommendTreeNodeValidationError) ErrorName() string {
+	return "RecommendTreeNodeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecommendTreeNodeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecommendTreeNode.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecommendTreeNodeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecommendTreeNodeValidationError{}
+
+// Validate checks the field values on RecommendTreeMatch with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecommendTreeMatch) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecommendTreeMatch with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecommendTreeMatchMultiError, or nil if none found.
+func (m *RecommendTreeMatch) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecommendTreeMatch) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetNode()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RecommendTreeMatchValidationError{
+					field:  "Node",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RecommendTreeMatchValidationError{
+					field:  "Node",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNode()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RecommendTreeMatchValidationError{
+				field:  "Node",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RecommendTreeMatchMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecommendTreeMatchMultiError is an error wrapping multiple validation errors
+// returned by RecommendTreeMatch.ValidateAll() if the designated constraints
+// aren't met.
+type RecommendTreeMatchMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecommendTreeMatchMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecommendTreeMatchMultiError) AllErrors() []error { return m }
+
+// RecommendTreeMatchValidationError is the validation error returned by
+// RecommendTreeMatch.Validate if the designated constraints aren't met.
+type RecommendTreeMatchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecommendTreeMatchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecommendTreeMatchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecommendTreeMatchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecommendTreeMatchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecommendTreeMatchValidationError) ErrorName() string {
+	return "RecommendTreeMatchValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecommendTreeMatchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecommendTreeMatch.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecommendTreeMatchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecommendTreeMatchValidationError{}
+
+// Validate checks the field values on RecommendTreeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecommendTreeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecommendTreeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecommendTreeRequestMultiError, or nil if none found.
+func (m *RecommendTreeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecommendTreeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Depth
+
+	// no validation rules for Address
+
+	if len(errors) > 0 {
+		return RecommendTreeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecommendTreeRequestMultiError is an error wrapping multiple validation
+// errors returned by RecommendTreeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RecommendTreeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecommendTreeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecommendTreeRequestMultiError) AllErrors() []error { return m }
+
+// RecommendTreeRequestValidationError is the validation error returned by
+// RecommendTreeRequest.Validate if the designated constraints aren't met.
+type RecommendTreeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecommendTreeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecommendTreeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecommendTreeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecommendTreeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecommendTreeRequestValidationError) ErrorName() string {
+	return "RecommendTreeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecommendTreeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecommendTreeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecommendTreeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecommendTreeRequestValidationError{}
+
+// Validate checks the field values on RecommendTreeReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecommendTreeReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecommendTreeReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecommendTreeReplyMultiError, or nil if none found.
+func (m *RecommendTreeReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecommendTreeReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetNode()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RecommendTreeReplyValidationError{
+					field:  "Node",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RecommendTreeReplyValidationError{
+					field:  "Node",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNode()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RecommendTreeReplyValidationError{
+				field:  "Node",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetPath() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RecommendTreeReplyValidationError{
+						field:  fmt.Sprintf("Path[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RecommendTreeReplyValidationError{
+						field:  fmt.Sprintf("Path[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RecommendTreeReplyValidationError{
+					field:  fmt.Sprintf("Path[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetChildren() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RecommendTreeReplyValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RecommendTreeReplyValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RecommendTreeReplyValidationError{
+					field:  fmt.Sprintf("Children[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetMatches() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RecommendTreeReplyValidationError{
+						field:  fmt.Sprintf("Matches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RecommendTreeReplyValidationError{
+						field:  fmt.Sprintf("Matches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RecommendTreeReplyValidationError{
+					field:  fmt.Sprintf("Matches[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RecommendTreeReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecommendTreeReplyMultiError is an error wrapping multiple validation errors
+// returned by RecommendTreeReply.ValidateAll() if the designated constraints
+// aren't met.
+type RecommendTreeReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecommendTreeReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecommendTreeReplyMultiError) AllErrors() []error { return m }
+
+// RecommendTreeReplyValidationError is the validation error returned by
+// RecommendTreeReply.Validate if the designated constraints aren't met.
+type RecommendTreeReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecommendTreeReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecommendTreeReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecommendTreeReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecommendTreeReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecommendTreeReplyValidationError) ErrorName() string {
+	return "RecommendTreeReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecommendTreeReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecommendTreeReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecommendTreeReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecommendTreeReplyValidationError{}
+
+// Validate checks the field values on WithdrawRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WithdrawRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WithdrawRequestMultiError, or nil if none found.
+func (m *WithdrawRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WithdrawRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WithdrawRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WithdrawRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WithdrawRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WithdrawRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WithdrawRequestMultiError is an error wrapping multiple validation errors
+// returned by WithdrawRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WithdrawRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WithdrawRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WithdrawRequestMultiError) AllErrors() []error { return m }
+
+// WithdrawRequestValidationError is the validation error returned by
+// WithdrawRequest.Validate if the designated constraints aren't met.
+type WithdrawRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WithdrawRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WithdrawRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WithdrawRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WithdrawRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WithdrawRequestValidationError) ErrorName() string { return "WithdrawRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WithdrawRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWithdrawRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WithdrawRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WithdrawRequestValidationError{}
+
+// Validate checks the field values on WithdrawReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WithdrawReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WithdrawReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WithdrawReplyMultiError, or
+// nil if none found.
+func (m *WithdrawReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WithdrawReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	// no validation rules for Reason
+
+	// no validation rules for Message
+
+	// no validation rules for Fee
+
+	// no validation rules for RelAmount
+
+	if len(errors) > 0 {
+		return WithdrawReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// WithdrawReplyMultiError is an error wrapping multiple validation errors
+// returned by WithdrawReply.ValidateAll() if the designated constraints
+// aren't met.
+type WithdrawReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WithdrawReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WithdrawReplyMultiError) AllErrors() []error { return m }
+
+// WithdrawReplyValidationError is the validation error returned by
+// WithdrawReply.Validate if the designated constraints aren't met.
+type WithdrawReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WithdrawReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WithdrawReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WithdrawReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WithdrawReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WithdrawReplyValidationError) ErrorName() string { return "WithdrawReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WithdrawReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWithdrawReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WithdrawReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WithdrawReplyValidationError{}
+
+// Validate checks the field values on CancelWithdrawRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelWithdrawRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelWithdrawRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelWithdrawRequestMultiError, or nil if none found.
+func (m *CancelWithdrawRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelWithdrawRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CancelWithdrawRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CancelWithdrawRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CancelWithdrawRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CancelWithdrawRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelWithdrawRequestMultiError is an error wrapping multiple validation
+// errors returned by CancelWithdrawRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CancelWithdrawRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelWithdrawRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelWithdrawRequestMultiError) AllErrors() []error { return m }
+
+// CancelWithdrawRequestValidationError is the validation error returned by
+// CancelWithdrawRequest.Validate if the designated constraints aren't met.
+type CancelWithdrawRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelWithdrawRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelWithdrawRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelWithdrawRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelWithdrawRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelWithdrawRequestValidationError) ErrorName() string {
+	return "CancelWithdrawRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelWithdrawRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelWithdrawRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelWithdrawRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelWithdrawRequestValidationError{}
+
+// Validate checks the field values on CancelWithdrawReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelWithdrawReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelWithdrawReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelWithdrawReplyMultiError, or nil if none found.
+func (m *CancelWithdrawReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelWithdrawReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return CancelWithdrawReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelWithdrawReplyMultiError is an error wrapping multiple validation
+// errors returned by CancelWithdrawReply.ValidateAll() if the designated
+// constraints aren't met.
+type CancelWithdrawReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelWithdrawReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelWithdrawReplyMultiError) AllErrors() []error { return m }
+
+// CancelWithdrawReplyValidationError is the validation error returned by
+// CancelWithdrawReply.Validate if the designated constraints aren't met.
+type CancelWithdrawReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelWithdrawReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelWithdrawReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelWithdrawReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelWithdrawReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelWithdrawReplyValidationError) ErrorName() string {
+	return "CancelWithdrawReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelWithdrawReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelWithdrawReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelWithdrawReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelWithdrawReplyValidationError{}
+
+// Validate checks the field values on WithdrawAddressListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawAddressListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WithdrawAddressListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WithdrawAddressListRequestMultiError, or nil if none found.
+func (m *WithdrawAddressListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WithdrawAddressListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return WithdrawAddressListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WithdrawAddressListRequestMultiError is an error wrapping multiple
+// validation errors returned by WithdrawAddressListRequest.ValidateAll() if
+// the designated constraints aren't met.
+type WithdrawAddressListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WithdrawAddressListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WithdrawAddressListRequestMultiError) AllErrors() []error { return m }
+
+// WithdrawAddressListRequestValidationError is the validation error returned
+// by WithdrawAddressListRequest.Validate if the designated constraints aren't met.
+type WithdrawAddressListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WithdrawAddressListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WithdrawAddressListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WithdrawAddressListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WithdrawAddressListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WithdrawAddressListRequestValidationError) ErrorName() string {
+	return "WithdrawAddressListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WithdrawAddressListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWithdrawAddressListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WithdrawAddressListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WithdrawAddressListRequestValidationError{}
+
+// Validate checks the field values on WithdrawAddressListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawAddressListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WithdrawAddressListReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WithdrawAddressListReplyMultiError, or nil if none found.
+func (m *WithdrawAddressListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WithdrawAddressListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WithdrawAddressListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WithdrawAddressListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WithdrawAddressListReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return WithdrawAddressListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// WithdrawAddressListReplyMultiError is an error wrapping multiple validation
+// errors returned by WithdrawAddressListReply.ValidateAll() if the designated
+// constraints aren't met.
+type WithdrawAddressListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WithdrawAddressListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WithdrawAddressListReplyMultiError) AllErrors() []error { return m }
+
+// WithdrawAddressListReplyValidationError is the validation error returned by
+// WithdrawAddressListReply.Validate if the designated constraints aren't met.
+type WithdrawAddressListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WithdrawAddressListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WithdrawAddressListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WithdrawAddressListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WithdrawAddressListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WithdrawAddressListReplyValidationError) ErrorName() string {
+	return "WithdrawAddressListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WithdrawAddressListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWithdrawAddressListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WithdrawAddressListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WithdrawAddressListReplyValidationError{}
+
+// Validate checks the field values on AddWithdrawAddressRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddWithdrawAddressRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddWithdrawAddressRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddWithdrawAddressRequestMultiError, or nil if none found.
+func (m *AddWithdrawAddressRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddWithdrawAddressRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddWithdrawAddressRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddWithdrawAddressRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddWithdrawAddressRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AddWithdrawAddressRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddWithdrawAddressRequestMultiError is an error wrapping multiple validation
+// errors returned by AddWithdrawAddressRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AddWithdrawAddressRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddWithdrawAddressRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddWithdrawAddressRequestMultiError) AllErrors() []error { return m }
+
+// AddWithdrawAddressRequestValidationError is the validation error returned by
+// AddWithdrawAddressRequest.Validate if the designated constraints aren't met.
+type AddWithdrawAddressRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddWithdrawAddressRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddWithdrawAddressRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddWithdrawAddressRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddWithdrawAddressRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddWithdrawAddressRequestValidationError) ErrorName() string {
+	return "AddWithdrawAddressRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddWithdrawAddressRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddWithdrawAddressRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddWithdrawAddressRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddWithdrawAddressRequestValidationError{}
+
+// Validate checks the field values on AddWithdrawAddressReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddWithdrawAddressReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddWithdrawAddressReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddWithdrawAddressReplyMultiError, or nil if none found.
+func (m *AddWithdrawAddressReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddWithdrawAddressReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ActivateAt
+
+	if len(errors) > 0 {
+		return AddWithdrawAddressReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddWithdrawAddressReplyMultiError is an error wrapping multiple validation
+// errors returned by AddWithdrawAddressReply.ValidateAll() if the designated
+// constraints aren't met.
+type AddWithdrawAddressReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddWithdrawAddressReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddWithdrawAddressReplyMultiError) AllErrors() []error { return m }
+
+// AddWithdrawAddressReplyValidationError is the validation error returned by
+// AddWithdrawAddressReply.Validate if the designated constraints aren't met.
+type AddWithdrawAddressReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddWithdrawAddressReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddWithdrawAddressReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddWithdrawAddressReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddWithdrawAddressReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddWithdrawAddressReplyValidationError) ErrorName() string {
+	return "AddWithdrawAddressReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddWithdrawAddressReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddWithdrawAddressReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddWithdrawAddressReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddWithdrawAddressReplyValidationError{}
+
+// Validate checks the field values on DeleteWithdrawAddressRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteWithdrawAddressRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteWithdrawAddressRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteWithdrawAddressRequestMultiError, or nil if none found.
+func (m *DeleteWithdrawAddressRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteWithdrawAddressRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteWithdrawAddressRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteWithdrawAddressRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteWithdrawAddressRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeleteWithdrawAddressRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteWithdrawAddressRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteWithdrawAddressRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteWithdrawAddressRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteWithdrawAddressRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteWithdrawAddressRequestMultiError) AllErrors() []error { return m }
+
+// DeleteWithdrawAddressRequestValidationError is the validation error returned
+// by DeleteWithdrawAddressRequest.Validate if the designated constraints
+// aren't met.
+type DeleteWithdrawAddressRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteWithdrawAddressRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteWithdrawAddressRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteWithdrawAddressRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteWithdrawAddressRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteWithdrawAddressRequestValidationError) ErrorName() string {
+	return "DeleteWithdrawAddressRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteWithdrawAddressRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteWithdrawAddressRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteWithdrawAddressRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteWithdrawAddressRequestValidationError{}
+
+// Validate checks the field values on DeleteWithdrawAddressReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteWithdrawAddressReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteWithdrawAddressReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteWithdrawAddressReplyMultiError, or nil if none found.
+func (m *DeleteWithdrawAddressReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteWithdrawAddressReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return DeleteWithdrawAddressReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteWithdrawAddressReplyMultiError is an error wrapping multiple
+// validation errors returned by DeleteWithdrawAddressReply.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteWithdrawAddressReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteWithdrawAddressReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteWithdrawAddressReplyMultiError) AllErrors() []error { return m }
+
+// DeleteWithdrawAddressReplyValidationError is the validation error returned
+// by DeleteWithdrawAddressReply.Validate if the designated constraints aren't met.
+type DeleteWithdrawAddressReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteWithdrawAddressReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteWithdrawAddressReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteWithdrawAddressReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteWithdrawAddressReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteWithdrawAddressReplyValidationError) ErrorName() string {
+	return "DeleteWithdrawAddressReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteWithdrawAddressReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteWithdrawAddressReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteWithdrawAddressReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteWithdrawAddressReplyValidationError{}
+
+// Validate checks the field values on SetBalanceRewardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetBalanceRewardRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetBalanceRewardRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetBalanceRewardRequestMultiError, or nil if none found.
+func (m *SetBalanceRewardRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetBalanceRewardRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetBalanceRewardRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetBalanceRewardRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetBalanceRewardRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetBalanceRewardRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetBalanceRewardRequestMultiError is an error wrapping multiple validation
+// errors returned by SetBalanceRewardRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetBalanceRewardRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetBalanceRewardRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetBalanceRewardRequestMultiError) AllErrors() []error { return m }
+
+// SetBalanceRewardRequestValidationError is the validation error returned by
+// SetBalanceRewardRequest.Validate if the designated constraints aren't met.
+type SetBalanceRewardRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetBalanceRewardRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetBalanceRewardRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetBalanceRewardRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetBalanceRewardRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetBalanceRewardRequestValidationError) ErrorName() string {
+	return "SetBalanceRewardRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetBalanceRewardRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetBalanceRewardRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetBalanceRewardRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetBalanceRewardRequestValidationError{}
+
+// Validate checks the field values on SetBalanceRewardReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetBalanceRewardReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetBalanceRewardReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetBalanceRewardReplyMultiError, or nil if none found.
+func (m *SetBalanceRewardReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetBalanceRewardReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return SetBalanceRewardReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetBalanceRewardReplyMultiError is an error wrapping multiple validation
+// errors returned by SetBalanceRewardReply.ValidateAll() if the designated
+// constraints aren't met.
+type SetBalanceRewardReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetBalanceRewardReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetBalanceRewardReplyMultiError) AllErrors() []error { return m }
+
+// SetBalanceRewardReplyValidationError is the validation error returned by
+// SetBalanceRewardReply.Validate if the designated constraints aren't met.
+type SetBalanceRewardReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetBalanceRewardReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetBalanceRewardReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetBalanceRewardReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetBalanceRewardReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetBalanceRewardReplyValidationError) ErrorName() string {
+	return "SetBalanceRewardReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetBalanceRewardReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetBalanceRewardReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetBalanceRewardReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetBalanceRewardReplyValidationError{}
+
+// Validate checks the field values on DeleteBalanceRewardRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBalanceRewardRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBalanceRewardRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteBalanceRewardRequestMultiError, or nil if none found.
+func (m *DeleteBalanceRewardRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBalanceRewardRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteBalanceRewardRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteBalanceRewardRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteBalanceRewardRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeleteBalanceRewardRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBalanceRewardRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteBalanceRewardRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteBalanceRewardRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBalanceRewardRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBalanceRewardRequestMultiError) AllErrors() []error { return m }
+
+// DeleteBalanceRewardRequestValidationError is the validation error returned
+// by DeleteBalanceRewardRequest.Validate if the designated constraints aren't met.
+type DeleteBalanceRewardRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBalanceRewardRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBalanceRewardRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBalanceRewardRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBalanceRewardRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBalanceRewardRequestValidationError) ErrorName() string {
+	return "DeleteBalanceRewardRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBalanceRewardRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBalanceRewardRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBalanceRewardRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBalanceRewardRequestValidationError{}
+
+// Validate checks the field values on DeleteBalanceRewardReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBalanceRewardReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBalanceRewardReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteBalanceRewardReplyMultiError, or nil if none found.
+func (m *DeleteBalanceRewardReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBalanceRewardReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return DeleteBalanceRewardReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBalanceRewardReplyMultiError is an error wrapping multiple validation
+// errors returned by DeleteBalanceRewardReply.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteBalanceRewardReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBalanceRewardReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBalanceRewardReplyMultiError) AllErrors() []error { return m }
+
+// DeleteBalanceRewardReplyValidationError is the validation error returned by
+// DeleteBalanceRewardReply.Validate if the designated constraints aren't met.
+type DeleteBalanceRewardReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBalanceRewardReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBalanceRewardReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBalanceRewardReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBalanceRewardReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBalanceRewardReplyValidationError) ErrorName() string {
+	return "DeleteBalanceRewardReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBalanceRewardReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBalanceRewardReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBalanceRewardReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBalanceRewardReplyValidationError{}
+
+// Validate checks the field values on AdminRewardListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminRewardListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminRewardListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminRewardListRequestMultiError, or nil if none found.
+func (m *AdminRewardListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminRewardListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for Address
+
+	if len(errors) > 0 {
+		return AdminRewardListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminRewardListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminRewardListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminRewardListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminRewardListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminRewardListRequestMultiError) AllErrors() []error { return m }
+
+// AdminRewardListRequestValidationError is the validation error returned by
+// AdminRewardListRequest.Validate if the designated constraints aren't met.
+type AdminRewardListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminRewardListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminRewardListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminRewardListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminRewardListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminRewardListRequestValidationError) ErrorName() string {
+	return "AdminRewardListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminRewardListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminRewardListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminRewardListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminRewardListRequestValidationError{}
+
+// Validate checks the field values on AdminRewardListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminRewardListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminRewardListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminRewardListReplyMultiError, or nil if none found.
+func (m *AdminRewardListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminRewardListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRewards() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminRewardListReplyValidationError{
+						field:  fmt.Sprintf("Rewards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminRewardListReplyValidationError{
+						field:  fmt.Sprintf("Rewards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminRewardListReplyValidationError{
+					field:  fmt.Sprintf("Rewards[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminRewardListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminRewardListReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminRewardListReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminRewardListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminRewardListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminRewardListReplyMultiError) AllErrors() []error { return m }
+
+// AdminRewardListReplyValidationError is the validation error returned by
+// AdminRewardListReply.Validate if the designated constraints aren't met.
+type AdminRewardListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminRewardListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminRewardListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminRewardListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminRewardListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminRewardListReplyValidationError) ErrorName() string {
+	return "AdminRewardListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminRewardListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminRewardListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminRewardListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminRewardListReplyValidationError{}
+
+// Validate checks the field values on AdminUserListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUserListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserListRequestMultiError, or nil if none found.
+func (m *AdminUserListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUserListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for Address
+
+	if len(errors) > 0 {
+		return AdminUserListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUserListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminUserListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminUserListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUserListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUserListRequestMultiError) AllErrors() []error { return m }
+
+// AdminUserListRequestValidationError is the validation error returned by
+// AdminUserListRequest.Validate if the designated constraints aren't met.
+type AdminUserListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUserListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUserListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUserListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUserListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUserListRequestValidationError) ErrorName() string {
+	return "AdminUserListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUserListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUserListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUserListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUserListRequestValidationError{}
+
+// Validate checks the field values on AdminUserListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUserListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserListReplyMultiError, or nil if none found.
+func (m *AdminUserListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUserListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminUserListReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminUserListReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminUserListReplyValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminUserListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUserListReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminUserListReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminUserListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUserListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUserListReplyMultiError) AllErrors() []error { return m }
+
+// AdminUserListReplyValidationError is the validation error returned by
+// AdminUserListReply.Validate if the designated constraints aren't met.
+type AdminUserListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUserListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUserListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUserListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUserListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUserListReplyValidationError) ErrorName() string {
+	return "AdminUserListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUserListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUserListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUserListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUserListReplyValidationError{}
+
+// Validate checks the field values on AdminLocationListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLocationListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLocationListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLocationListRequestMultiError, or nil if none found.
+func (m *AdminLocationListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLocationListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for Address
+
+	if len(errors) > 0 {
+		return AdminLocationListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLocationListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminLocationListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminLocationListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLocationListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLocationListRequestMultiError) AllErrors() []error { return m }
+
+// AdminLocationListRequestValidationError is the validation error returned by
+// AdminLocationListRequest.Validate if the designated constraints aren't met.
+type AdminLocationListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLocationListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLocationListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLocationListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLocationListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLocationListRequestValidationError) ErrorName() string {
+	return "AdminLocationListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLocationListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLocationListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLocationListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLocationListRequestValidationError{}
+
+// Validate checks the field values on AdminLocationListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLocationListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLocationListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLocationListReplyMultiError, or nil if none found.
+func (m *AdminLocationListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLocationListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLocations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminLocationListReplyValidationError{
+						field:  fmt.Sprintf("Locations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminLocationListReplyValidationError{
+						field:  fmt.Sprintf("Locations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminLocationListReplyValidationError{
+					field:  fmt.Sprintf("Locations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminLocationListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLocationListReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminLocationListReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminLocationListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLocationListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLocationListReplyMultiError) AllErrors() []error { return m }
+
+// AdminLocationListReplyValidationError is the validation error returned by
+// AdminLocationListReply.Validate if the designated constraints aren't met.
+type AdminLocationListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLocationListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLocationListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLocationListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLocationListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLocationListReplyValidationError) ErrorName() string {
+	return "AdminLocationListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLocationListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLocationListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLocationListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLocationListReplyValidationError{}
+
+// Validate checks the field values on AdminWithdrawListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawListRequestMultiError, or nil if none found.
+func (m *AdminWithdrawListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for Address
+
+	if len(errors) > 0 {
+		return AdminWithdrawListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawListRequestMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawListRequestValidationError is the validation error returned by
+// AdminWithdrawListRequest.Validate if the designated constraints aren't met.
+type AdminWithdrawListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawListRequestValidationError) ErrorName() string {
+	return "AdminWithdrawListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawListRequestValidationError{}
+
+// Validate checks the field values on AdminWithdrawListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawListReplyMultiError, or nil if none found.
+func (m *AdminWithdrawListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetWithdraw() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminWithdrawListReplyValidationError{
+						field:  fmt.Sprintf("Withdraw[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminWithdrawListReplyValidationError{
+						field:  fmt.Sprintf("Withdraw[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminWithdrawListReplyValidationError{
+					field:  fmt.Sprintf("Withdraw[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminWithdrawListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawListReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawListReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawListReplyMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawListReplyValidationError is the validation error returned by
+// AdminWithdrawListReply.Validate if the designated constraints aren't met.
+type AdminWithdrawListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawListReplyValidationError) ErrorName() string {
+	return "AdminWithdrawListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawListReplyValidationError{}
+
+// Validate checks the field values on AdminLoginRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminLoginRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLoginRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLoginRequestMultiError, or nil if none found.
+func (m *AdminLoginRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLoginRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminLoginRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminLoginRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminLoginRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminLoginRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLoginRequestMultiError is an error wrapping multiple validation errors
+// returned by AdminLoginRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AdminLoginRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLoginRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLoginRequestMultiError) AllErrors() []error { return m }
+
+// AdminLoginRequestValidationError is the validation error returned by
+// AdminLoginRequest.Validate if the designated constraints aren't met.
+type AdminLoginRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLoginRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLoginRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLoginRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLoginRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLoginRequestValidationError) ErrorName() string {
+	return "AdminLoginRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLoginRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLoginRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLoginRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLoginRequestValidationError{}
+
+// Validate checks the field values on AdminLoginReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminLoginReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLoginReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLoginReplyMultiError, or nil if none found.
+func (m *AdminLoginReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLoginReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	// no validation rules for RefreshToken
+
+	// no validation rules for Role
+
+	if len(errors) > 0 {
+		return AdminLoginReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLoginReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminLoginReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminLoginReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLoginReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLoginReplyMultiError) AllErrors() []error { return m }
+
+// AdminLoginReplyValidationError is the validation error returned by
+// AdminLoginReply.Validate if the designated constraints aren't met.
+type AdminLoginReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLoginReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLoginReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLoginReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLoginReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLoginReplyValidationError) ErrorName() string { return "AdminLoginReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminLoginReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLoginReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLoginReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLoginReplyValidationError{}
+
+// Validate checks the field values on AdminWithdrawApproveRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawApproveRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawApproveRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawApproveRequestMultiError, or nil if none found.
+func (m *AdminWithdrawApproveRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawApproveRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminWithdrawApproveRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminWithdrawApproveRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminWithdrawApproveRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminWithdrawApproveRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawApproveRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminWithdrawApproveRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminWithdrawApproveRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawApproveRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawApproveRequestMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawApproveRequestValidationError is the validation error returned
+// by AdminWithdrawApproveRequest.Validate if the designated constraints
+// aren't met.
+type AdminWithdrawApproveRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawApproveRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawApproveRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawApproveRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawApproveRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawApproveRequestValidationError) ErrorName() string {
+	return "AdminWithdrawApproveRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawApproveRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawApproveRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawApproveRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawApproveRequestValidationError{}
+
+// Validate checks the field values on AdminWithdrawApproveReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawApproveReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawApproveReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawApproveReplyMultiError, or nil if none found.
+func (m *AdminWithdrawApproveReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawApproveReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return AdminWithdrawApproveReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawApproveReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawApproveReply.ValidateAll() if the
+// designated constraints aren't met.
+type AdminWithdrawApproveReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawApproveReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawApproveReplyMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawApproveReplyValidationError is the validation error returned by
+// AdminWithdrawApproveReply.Validate if the designated constraints aren't met.
+type AdminWithdrawApproveReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawApproveReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawApproveReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawApproveReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawApproveReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawApproveReplyValidationError) ErrorName() string {
+	return "AdminWithdrawApproveReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawApproveReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawApproveReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawApproveReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawApproveReplyValidationError{}
+
+// Validate checks the field values on AdminWithdrawRejectRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawRejectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawRejectRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawRejectRequestMultiError, or nil if none found.
+func (m *AdminWithdrawRejectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawRejectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminWithdrawRejectRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminWithdrawRejectRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminWithdrawRejectRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminWithdrawRejectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawRejectRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminWithdrawRejectRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminWithdrawRejectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawRejectRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawRejectRequestMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawRejectRequestValidationError is the validation error returned
+// by AdminWithdrawRejectRequest.Validate if the designated constraints aren't met.
+type AdminWithdrawRejectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawRejectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawRejectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawRejectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawRejectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawRejectRequestValidationError) ErrorName() string {
+	return "AdminWithdrawRejectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawRejectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawRejectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawRejectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawRejectRequestValidationError{}
+
+// Validate checks the field values on AdminWithdrawRejectReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawRejectReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawRejectReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawRejectReplyMultiError, or nil if none found.
+func (m *AdminWithdrawRejectReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawRejectReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return AdminWithdrawRejectReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawRejectReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawRejectReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawRejectReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawRejectReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawRejectReplyMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawRejectReplyValidationError is the validation error returned by
+// AdminWithdrawRejectReply.Validate if the designated constraints aren't met.
+type AdminWithdrawRejectReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawRejectReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawRejectReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawRejectReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawRejectReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawRejectReplyValidationError) ErrorName() string {
+	return "AdminWithdrawRejectReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawRejectReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawRejectReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawRejectReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawRejectReplyValidationError{}
+
+// Validate checks the field values on AdminWithdrawRetryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawRetryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawRetryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawRetryRequestMultiError, or nil if none found.
+func (m *AdminWithdrawRetryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawRetryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminWithdrawRetryRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminWithdrawRetryRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminWithdrawRetryRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminWithdrawRetryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawRetryRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawRetryRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AdminWithdrawRetryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawRetryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawRetryRequestMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawRetryRequestValidationError is the validation error returned by
+// AdminWithdrawRetryRequest.Validate if the designated constraints aren't met.
+type AdminWithdrawRetryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawRetryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawRetryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawRetryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawRetryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawRetryRequestValidationError) ErrorName() string {
+	return "AdminWithdrawRetryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawRetryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawRetryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawRetryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawRetryRequestValidationError{}
+
+// Validate checks the field values on AdminWithdrawRetryReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawRetryReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawRetryReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawRetryReplyMultiError, or nil if none found.
+func (m *AdminWithdrawRetryReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawRetryReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return AdminWithdrawRetryReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawRetryReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawRetryReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawRetryReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawRetryReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawRetryReplyMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawRetryReplyValidationError is the validation error returned by
+// AdminWithdrawRetryReply.Validate if the designated constraints aren't met.
+type AdminWithdrawRetryReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawRetryReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawRetryReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawRetryReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawRetryReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawRetryReplyValidationError) ErrorName() string {
+	return "AdminWithdrawRetryReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawRetryReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawRetryReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawRetryReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawRetryReplyValidationError{}
+
+// Validate checks the field values on AdminWithdrawRefundRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawRefundRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawRefundRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawRefundRequestMultiError, or nil if none found.
+func (m *AdminWithdrawRefundRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawRefundRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminWithdrawRefundRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminWithdrawRefundRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminWithdrawRefundRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminWithdrawRefundRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawRefundRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminWithdrawRefundRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminWithdrawRefundRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawRefundRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawRefundRequestMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawRefundRequestValidationError is the validation error returned
+// by AdminWithdrawRefundRequest.Validate if the designated constraints aren't met.
+type AdminWithdrawRefundRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawRefundRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawRefundRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawRefundRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawRefundRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawRefundRequestValidationError) ErrorName() string {
+	return "AdminWithdrawRefundRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawRefundRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawRefundRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawRefundRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawRefundRequestValidationError{}
+
+// Validate checks the field values on AdminWithdrawRefundReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawRefundReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawRefundReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawRefundReplyMultiError, or nil if none found.
+func (m *AdminWithdrawRefundReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawRefundReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return AdminWithdrawRefundReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawRefundReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawRefundReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawRefundReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawRefundReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawRefundReplyMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawRefundReplyValidationError is the validation error returned by
+// AdminWithdrawRefundReply.Validate if the designated constraints aren't met.
+type AdminWithdrawRefundReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawRefundReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawRefundReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawRefundReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawRefundReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawRefundReplyValidationError) ErrorName() string {
+	return "AdminWithdrawRefundReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawRefundReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawRefundReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawRefundReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawRefundReplyValidationError{}
+
+// Validate checks the field values on AdminWithdrawLegacyMigrateRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminWithdrawLegacyMigrateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawLegacyMigrateRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminWithdrawLegacyMigrateRequestMultiError, or nil if none found.
+func (m *AdminWithdrawLegacyMigrateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawLegacyMigrateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminWithdrawLegacyMigrateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminWithdrawLegacyMigrateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminWithdrawLegacyMigrateRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminWithdrawLegacyMigrateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawLegacyMigrateRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminWithdrawLegacyMigrateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawLegacyMigrateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawLegacyMigrateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawLegacyMigrateRequestMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawLegacyMigrateRequestValidationError is the validation error
+// returned by AdminWithdrawLegacyMigrateRequest.Validate if the designated
+// constraints aren't met.
+type AdminWithdrawLegacyMigrateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawLegacyMigrateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawLegacyMigrateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawLegacyMigrateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawLegacyMigrateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawLegacyMigrateRequestValidationError) ErrorName() string {
+	return "AdminWithdrawLegacyMigrateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawLegacyMigrateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawLegacyMigrateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawLegacyMigrateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawLegacyMigrateRequestValidationError{}
+
+// Validate checks the field values on AdminWithdrawLegacyMigrateReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawLegacyMigrateReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawLegacyMigrateReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminWithdrawLegacyMigrateReplyMultiError, or nil if none found.
+func (m *AdminWithdrawLegacyMigrateReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawLegacyMigrateReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminWithdrawLegacyMigrateReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawLegacyMigrateReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminWithdrawLegacyMigrateReply.ValidateAll()
+// if the designated constraints aren't met.
+type AdminWithdrawLegacyMigrateReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawLegacyMigrateReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawLegacyMigrateReplyMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawLegacyMigrateReplyValidationError is the validation error
+// returned by AdminWithdrawLegacyMigrateReply.Validate if the designated
+// constraints aren't met.
+type AdminWithdrawLegacyMigrateReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawLegacyMigrateReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawLegacyMigrateReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawLegacyMigrateReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawLegacyMigrateReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawLegacyMigrateReplyValidationError) ErrorName() string {
+	return "AdminWithdrawLegacyMigrateReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawLegacyMigrateReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawLegacyMigrateReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawLegacyMigrateReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawLegacyMigrateReplyValidationError{}
+
+// Validate checks the field values on AdminWithdrawBulkApproveRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawBulkApproveRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawBulkApproveRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminWithdrawBulkApproveRequestMultiError, or nil if none found.
+func (m *AdminWithdrawBulkApproveRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawBulkApproveRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminWithdrawBulkApproveRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminWithdrawBulkApproveRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminWithdrawBulkApproveRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminWithdrawBulkApproveRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawBulkApproveRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminWithdrawBulkApproveRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AdminWithdrawBulkApproveRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawBulkApproveRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawBulkApproveRequestMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawBulkApproveRequestValidationError is the validation error
+// returned by AdminWithdrawBulkApproveRequest.Validate if the designated
+// constraints aren't met.
+type AdminWithdrawBulkApproveRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawBulkApproveRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawBulkApproveRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawBulkApproveRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawBulkApproveRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawBulkApproveRequestValidationError) ErrorName() string {
+	return "AdminWithdrawBulkApproveRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawBulkApproveRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawBulkApproveRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawBulkApproveRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawBulkApproveRequestValidationError{}
+
+// Validate checks the field values on AdminWithdrawBulkApproveReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawBulkApproveReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawBulkApproveReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminWithdrawBulkApproveReplyMultiError, or nil if none found.
+func (m *AdminWithdrawBulkApproveReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawBulkApproveReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFailed() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminWithdrawBulkApproveReplyValidationError{
+						field:  fmt.Sprintf("Failed[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminWithdrawBulkApproveReplyValidationError{
+						field:  fmt.Sprintf("Failed[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminWithdrawBulkApproveReplyValidationError{
+					field:  fmt.Sprintf("Failed[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminWithdrawBulkApproveReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawBulkApproveReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminWithdrawBulkApproveReply.ValidateAll()
+// if the designated constraints aren't met.
+type AdminWithdrawBulkApproveReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawBulkApproveReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawBulkApproveReplyMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawBulkApproveReplyValidationError is the validation error
+// returned by AdminWithdrawBulkApproveReply.Validate if the designated
+// constraints aren't met.
+type AdminWithdrawBulkApproveReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawBulkApproveReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawBulkApproveReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawBulkApproveReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawBulkApproveReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawBulkApproveReplyValidationError) ErrorName() string {
+	return "AdminWithdrawBulkApproveReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawBulkApproveReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawBulkApproveReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawBulkApproveReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawBulkApproveReplyValidationError{}
+
+// Validate checks the field values on AdminLedgerBalanceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLedgerBalanceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLedgerBalanceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLedgerBalanceRequestMultiError, or nil if none found.
+func (m *AdminLedgerBalanceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLedgerBalanceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminLedgerBalanceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLedgerBalanceRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminLedgerBalanceRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AdminLedgerBalanceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLedgerBalanceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLedgerBalanceRequestMultiError) AllErrors() []error { return m }
+
+// AdminLedgerBalanceRequestValidationError is the validation error returned by
+// AdminLedgerBalanceRequest.Validate if the designated constraints aren't met.
+type AdminLedgerBalanceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLedgerBalanceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLedgerBalanceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLedgerBalanceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLedgerBalanceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLedgerBalanceRequestValidationError) ErrorName() string {
+	return "AdminLedgerBalanceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLedgerBalanceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLedgerBalanceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLedgerBalanceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLedgerBalanceRequestValidationError{}
+
+// Validate checks the field values on AdminLedgerBalanceReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLedgerBalanceReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLedgerBalanceReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLedgerBalanceReplyMultiError, or nil if none found.
+func (m *AdminLedgerBalanceReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLedgerBalanceReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCoins() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminLedgerBalanceReplyValidationError{
+						field:  fmt.Sprintf("Coins[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminLedgerBalanceReplyValidationError{
+						field:  fmt.Sprintf("Coins[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminLedgerBalanceReplyValidationError{
+					field:  fmt.Sprintf("Coins[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminLedgerBalanceReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLedgerBalanceReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminLedgerBalanceReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminLedgerBalanceReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLedgerBalanceReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLedgerBalanceReplyMultiError) AllErrors() []error { return m }
+
+// AdminLedgerBalanceReplyValidationError is the validation error returned by
+// AdminLedgerBalanceReply.Validate if the designated constraints aren't met.
+type AdminLedgerBalanceReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLedgerBalanceReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLedgerBalanceReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLedgerBalanceReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLedgerBalanceReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLedgerBalanceReplyValidationError) ErrorName() string {
+	return "AdminLedgerBalanceReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLedgerBalanceReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLedgerBalanceReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLedgerBalanceReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLedgerBalanceReplyValidationError{}
+
+// Validate checks the field values on AdminRecommendClosureBackfillRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *AdminRecommendClosureBackfillRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminRecommendClosureBackfillRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminRecommendClosureBackfillRequestMultiError, or nil if none found.
+func (m *AdminRecommendClosureBackfillRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminRecommendClosureBackfillRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminRecommendClosureBackfillRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminRecommendClosureBackfillRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminRecommendClosureBackfillRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminRecommendClosureBackfillRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminRecommendClosureBackfillRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminRecommendClosureBackfillRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminRecommendClosureBackfillRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminRecommendClosureBackfillRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminRecommendClosureBackfillRequestMultiError) AllErrors() []error { return m }
+
+// AdminRecommendClosureBackfillRequestValidationError is the validation error
+// returned by AdminRecommendClosureBackfillRequest.Validate if the designated
+// constraints aren't met.
+type AdminRecommendClosureBackfillRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminRecommendClosureBackfillRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminRecommendClosureBackfillRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminRecommendClosureBackfillRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminRecommendClosureBackfillRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminRecommendClosureBackfillRequestValidationError) ErrorName() string {
+	return "AdminRecommendClosureBackfillRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminRecommendClosureBackfillRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminRecommendClosureBackfillRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminRecommendClosureBackfillRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminRecommendClosureBackfillRequestValidationError{}
+
+// Validate checks the field values on AdminRecommendClosureBackfillReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminRecommendClosureBackfillReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminRecommendClosureBackfillReply
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminRecommendClosureBackfillReplyMultiError, or nil if none found.
+func (m *AdminRecommendClosureBackfillReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminRecommendClosureBackfillReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminRecommendClosureBackfillReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminRecommendClosureBackfillReplyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminRecommendClosureBackfillReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminRecommendClosureBackfillReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminRecommendClosureBackfillReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminRecommendClosureBackfillReplyMultiError) AllErrors() []error { return m }
+
+// AdminRecommendClosureBackfillReplyValidationError is the validation error
+// returned by AdminRecommendClosureBackfillReply.Validate if the designated
+// constraints aren't met.
+type AdminRecommendClosureBackfillReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminRecommendClosureBackfillReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminRecommendClosureBackfillReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminRecommendClosureBackfillReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminRecommendClosureBackfillReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminRecommendClosureBackfillReplyValidationError) ErrorName() string {
+	return "AdminRecommendClosureBackfillReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminRecommendClosureBackfillReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminRecommendClosureBackfillReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminRecommendClosureBackfillReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminRecommendClosureBackfillReplyValidationError{}
+
+// Validate checks the field values on AdminLedgerOpenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLedgerOpenRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLedgerOpenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLedgerOpenRequestMultiError, or nil if none found.
+func (m *AdminLedgerOpenRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLedgerOpenRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminLedgerOpenRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminLedgerOpenRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminLedgerOpenRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminLedgerOpenRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLedgerOpenRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminLedgerOpenRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminLedgerOpenRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLedgerOpenRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLedgerOpenRequestMultiError) AllErrors() []error { return m }
+
+// AdminLedgerOpenRequestValidationError is the validation error returned by
+// AdminLedgerOpenRequest.Validate if the designated constraints aren't met.
+type AdminLedgerOpenRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLedgerOpenRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLedgerOpenRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLedgerOpenRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLedgerOpenRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLedgerOpenRequestValidationError) ErrorName() string {
+	return "AdminLedgerOpenRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLedgerOpenRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLedgerOpenRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLedgerOpenRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLedgerOpenRequestValidationError{}
+
+// Validate checks the field values on AdminLedgerOpenReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLedgerOpenReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLedgerOpenReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLedgerOpenReplyMultiError, or nil if none found.
+func (m *AdminLedgerOpenReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLedgerOpenReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminLedgerOpenReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLedgerOpenReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminLedgerOpenReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminLedgerOpenReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLedgerOpenReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLedgerOpenReplyMultiError) AllErrors() []error { return m }
+
+// AdminLedgerOpenReplyValidationError is the validation error returned by
+// AdminLedgerOpenReply.Validate if the designated constraints aren't met.
+type AdminLedgerOpenReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLedgerOpenReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLedgerOpenReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLedgerOpenReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLedgerOpenReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLedgerOpenReplyValidationError) ErrorName() string {
+	return "AdminLedgerOpenReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLedgerOpenReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLedgerOpenReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLedgerOpenReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLedgerOpenReplyValidationError{}
+
+// Validate checks the field values on AdminReconcileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminReconcileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminReconcileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminReconcileRequestMultiError, or nil if none found.
+func (m *AdminReconcileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminReconcileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminReconcileRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminReconcileRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminReconcileRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminReconcileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminReconcileRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminReconcileRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminReconcileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminReconcileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminReconcileRequestMultiError) AllErrors() []error { return m }
+
+// AdminReconcileRequestValidationError is the validation error returned by
+// AdminReconcileRequest.Validate if the designated constraints aren't met.
+type AdminReconcileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminReconcileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminReconcileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminReconcileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminReconcileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminReconcileRequestValidationError) ErrorName() string {
+	return "AdminReconcileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminReconcileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminReconcileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminReconcileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminReconcileRequestValidationError{}
+
+// Validate checks the field values on AdminReconcileReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminReconcileReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminReconcileReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminReconcileReplyMultiError, or nil if none found.
+func (m *AdminReconcileReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminReconcileReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Checked
+
+	// no validation rules for Discrepancies
+
+	if len(errors) > 0 {
+		return AdminReconcileReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminReconcileReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminReconcileReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminReconcileReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminReconcileReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminReconcileReplyMultiError) AllErrors() []error { return m }
+
+// AdminReconcileReplyValidationError is the validation error returned by
+// AdminReconcileReply.Validate if the designated constraints aren't met.
+type AdminReconcileReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminReconcileReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminReconcileReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminReconcileReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminReconcileReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminReconcileReplyValidationError) ErrorName() string {
+	return "AdminReconcileReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminReconcileReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminReconcileReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminReconcileReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminReconcileReplyValidationError{}
+
+// Validate checks the field values on AdminDepositUnmatchedListRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminDepositUnmatchedListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDepositUnmatchedListRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminDepositUnmatchedListRequestMultiError, or nil if none found.
+func (m *AdminDepositUnmatchedListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDepositUnmatchedListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminDepositUnmatchedListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDepositUnmatchedListRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminDepositUnmatchedListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminDepositUnmatchedListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDepositUnmatchedListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDepositUnmatchedListRequestMultiError) AllErrors() []error { return m }
+
+// AdminDepositUnmatchedListRequestValidationError is the validation error
+// returned by AdminDepositUnmatchedListRequest.Validate if the designated
+// constraints aren't met.
+type AdminDepositUnmatchedListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDepositUnmatchedListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDepositUnmatchedListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDepositUnmatchedListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDepositUnmatchedListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDepositUnmatchedListRequestValidationError) ErrorName() string {
+	return "AdminDepositUnmatchedListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDepositUnmatchedListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDepositUnmatchedListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDepositUnmatchedListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDepositUnmatchedListRequestValidationError{}
+
+// Validate checks the field values on AdminDepositUnmatchedListReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDepositUnmatchedListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDepositUnmatchedListReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminDepositUnmatchedListReplyMultiError, or nil if none found.
+func (m *AdminDepositUnmatchedListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDepositUnmatchedListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDeposits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminDepositUnmatchedListReplyValidationError{
+						field:  fmt.Sprintf("Deposits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminDepositUnmatchedListReplyValidationError{
+						field:  fmt.Sprintf("Deposits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminDepositUnmatchedListReplyValidationError{
+					field:  fmt.Sprintf("Deposits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminDepositUnmatchedListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDepositUnmatchedListReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminDepositUnmatchedListReply.ValidateAll()
+// if the designated constraints aren't met.
+type AdminDepositUnmatchedListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDepositUnmatchedListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDepositUnmatchedListReplyMultiError) AllErrors() []error { return m }
+
+// AdminDepositUnmatchedListReplyValidationError is the validation error
+// returned by AdminDepositUnmatchedListReply.Validate if the designated
+// constraints aren't met.
+type AdminDepositUnmatchedListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDepositUnmatchedListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDepositUnmatchedListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDepositUnmatchedListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDepositUnmatchedListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDepositUnmatchedListReplyValidationError) ErrorName() string {
+	return "AdminDepositUnmatchedListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDepositUnmatchedListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDepositUnmatchedListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDepositUnmatchedListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDepositUnmatchedListReplyValidationError{}
+
+// Validate checks the field values on AdminDepositResolveRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDepositResolveRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDepositResolveRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDepositResolveRequestMultiError, or nil if none found.
+func (m *AdminDepositResolveRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDepositResolveRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminDepositResolveRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminDepositResolveRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminDepositResolveRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminDepositResolveRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDepositResolveRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminDepositResolveRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminDepositResolveRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDepositResolveRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDepositResolveRequestMultiError) AllErrors() []error { return m }
+
+// AdminDepositResolveRequestValidationError is the validation error returned
+// by AdminDepositResolveRequest.Validate if the designated constraints aren't met.
+type AdminDepositResolveRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDepositResolveRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDepositResolveRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDepositResolveRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDepositResolveRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDepositResolveRequestValidationError) ErrorName() string {
+	return "AdminDepositResolveRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDepositResolveRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDepositResolveRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDepositResolveRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDepositResolveRequestValidationError{}
+
+// Validate checks the field values on AdminDepositResolveReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDepositResolveReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDepositResolveReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDepositResolveReplyMultiError, or nil if none found.
+func (m *AdminDepositResolveReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDepositResolveReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminDepositResolveReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDepositResolveReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminDepositResolveReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminDepositResolveReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDepositResolveReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDepositResolveReplyMultiError) AllErrors() []error { return m }
+
+// AdminDepositResolveReplyValidationError is the validation error returned by
+// AdminDepositResolveReply.Validate if the designated constraints aren't met.
+type AdminDepositResolveReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDepositResolveReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDepositResolveReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDepositResolveReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDepositResolveReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDepositResolveReplyValidationError) ErrorName() string {
+	return "AdminDepositResolveReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDepositResolveReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDepositResolveReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDepositResolveReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDepositResolveReplyValidationError{}
+
+// Validate checks the field values on AdminDiscrepancyListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDiscrepancyListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDiscrepancyListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDiscrepancyListRequestMultiError, or nil if none found.
+func (m *AdminDiscrepancyListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDiscrepancyListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return AdminDiscrepancyListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDiscrepancyListRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminDiscrepancyListRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminDiscrepancyListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDiscrepancyListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDiscrepancyListRequestMultiError) AllErrors() []error { return m }
+
+// AdminDiscrepancyListRequestValidationError is the validation error returned
+// by AdminDiscrepancyListRequest.Validate if the designated constraints
+// aren't met.
+type AdminDiscrepancyListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDiscrepancyListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDiscrepancyListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDiscrepancyListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDiscrepancyListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDiscrepancyListRequestValidationError) ErrorName() string {
+	return "AdminDiscrepancyListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDiscrepancyListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDiscrepancyListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDiscrepancyListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDiscrepancyListRequestValidationError{}
+
+// Validate checks the field values on AdminDiscrepancyListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDiscrepancyListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDiscrepancyListReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDiscrepancyListReplyMultiError, or nil if none found.
+func (m *AdminDiscrepancyListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDiscrepancyListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDiscrepancies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminDiscrepancyListReplyValidationError{
+						field:  fmt.Sprintf("Discrepancies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminDiscrepancyListReplyValidationError{
+						field:  fmt.Sprintf("Discrepancies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminDiscrepancyListReplyValidationError{
+					field:  fmt.Sprintf("Discrepancies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminDiscrepancyListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDiscrepancyListReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminDiscrepancyListReply.ValidateAll() if the
+// designated constraints aren't met.
+type AdminDiscrepancyListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDiscrepancyListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDiscrepancyListReplyMultiError) AllErrors() []error { return m }
+
+// AdminDiscrepancyListReplyValidationError is the validation error returned by
+// AdminDiscrepancyListReply.Validate if the designated constraints aren't met.
+type AdminDiscrepancyListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDiscrepancyListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDiscrepancyListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDiscrepancyListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDiscrepancyListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDiscrepancyListReplyValidationError) ErrorName() string {
+	return "AdminDiscrepancyListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDiscrepancyListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDiscrepancyListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDiscrepancyListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDiscrepancyListReplyValidationError{}
+
+// Validate checks the field values on AdminLocationDailySettleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLocationDailySettleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLocationDailySettleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminLocationDailySettleRequestMultiError, or nil if none found.
+func (m *AdminLocationDailySettleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLocationDailySettleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminLocationDailySettleRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminLocationDailySettleRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminLocationDailySettleRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminLocationDailySettleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLocationDailySettleRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminLocationDailySettleRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AdminLocationDailySettleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLocationDailySettleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLocationDailySettleRequestMultiError) AllErrors() []error { return m }
+
+// AdminLocationDailySettleRequestValidationError is the validation error
+// returned by AdminLocationDailySettleRequest.Validate if the designated
+// constraints aren't met.
+type AdminLocationDailySettleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLocationDailySettleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLocationDailySettleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLocationDailySettleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLocationDailySettleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLocationDailySettleRequestValidationError) ErrorName() string {
+	return "AdminLocationDailySettleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLocationDailySettleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLocationDailySettleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLocationDailySettleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLocationDailySettleRequestValidationError{}
+
+// Validate checks the field values on AdminLocationDailySettleReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLocationDailySettleReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLocationDailySettleReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminLocationDailySettleReplyMultiError, or nil if none found.
+func (m *AdminLocationDailySettleReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLocationDailySettleReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Date
+
+	// no validation rules for Users
+
+	// no validation rules for Count
+
+	// no validation rules for Amount
+
+	// no validation rules for Skipped
+
+	// no validation rules for TeamAmount
+
+	// no validation rules for AreaAmount
+
+	if len(errors) > 0 {
+		return AdminLocationDailySettleReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLocationDailySettleReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminLocationDailySettleReply.ValidateAll()
+// if the designated constraints aren't met.
+type AdminLocationDailySettleReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLocationDailySettleReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLocationDailySettleReplyMultiError) AllErrors() []error { return m }
+
+// AdminLocationDailySettleReplyValidationError is the validation error
+// returned by AdminLocationDailySettleReply.Validate if the designated
+// constraints aren't met.
+type AdminLocationDailySettleReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLocationDailySettleReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLocationDailySettleReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLocationDailySettleReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLocationDailySettleReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLocationDailySettleReplyValidationError) ErrorName() string {
+	return "AdminLocationDailySettleReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLocationDailySettleReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLocationDailySettleReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLocationDailySettleReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLocationDailySettleReplyValidationError{}
+
+// Validate checks the field values on AdminUserAreaRebuildRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserAreaRebuildRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUserAreaRebuildRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserAreaRebuildRequestMultiError, or nil if none found.
+func (m *AdminUserAreaRebuildRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUserAreaRebuildRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminUserAreaRebuildRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminUserAreaRebuildRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminUserAreaRebuildRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminUserAreaRebuildRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUserAreaRebuildRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminUserAreaRebuildRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminUserAreaRebuildRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUserAreaRebuildRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUserAreaRebuildRequestMultiError) AllErrors() []error { return m }
+
+// AdminUserAreaRebuildRequestValidationError is the validation error returned
+// by AdminUserAreaRebuildRequest.Validate if the designated constraints
+// aren't met.
+type AdminUserAreaRebuildRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUserAreaRebuildRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUserAreaRebuildRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUserAreaRebuildRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUserAreaRebuildRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUserAreaRebuildRequestValidationError) ErrorName() string {
+	return "AdminUserAreaRebuildRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUserAreaRebuildRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUserAreaRebuildRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUserAreaRebuildRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUserAreaRebuildRequestValidationError{}
+
+// Validate checks the field values on AdminUserAreaRebuildReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserAreaRebuildReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUserAreaRebuildReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserAreaRebuildReplyMultiError, or nil if none found.
+func (m *AdminUserAreaRebuildReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUserAreaRebuildReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Changed
+
+	if len(errors) > 0 {
+		return AdminUserAreaRebuildReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUserAreaRebuildReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminUserAreaRebuildReply.ValidateAll() if the
+// designated constraints aren't met.
+type AdminUserAreaRebuildReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUserAreaRebuildReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUserAreaRebuildReplyMultiError) AllErrors() []error { return m }
+
+// AdminUserAreaRebuildReplyValidationError is the validation error returned by
+// AdminUserAreaRebuildReply.Validate if the designated constraints aren't met.
+type AdminUserAreaRebuildReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUserAreaRebuildReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUserAreaRebuildReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUserAreaRebuildReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUserAreaRebuildReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUserAreaRebuildReplyValidationError) ErrorName() string {
+	return "AdminUserAreaRebuildReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUserAreaRebuildReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUserAreaRebuildReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUserAreaRebuildReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUserAreaRebuildReplyValidationError{}
+
+// Validate checks the field values on SettlementConfig with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SettlementConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SettlementConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SettlementConfigMultiError, or nil if none found.
+func (m *SettlementConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SettlementConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	// no validation rules for Value
+
+	if len(errors) > 0 {
+		return SettlementConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// SettlementConfigMultiError is an error wrapping multiple validation errors
+// returned by SettlementConfig.ValidateAll() if the designated constraints
+// aren't met.
+type SettlementConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SettlementConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SettlementConfigMultiError) AllErrors() []error { return m }
+
+// SettlementConfigValidationError is the validation error returned by
+// SettlementConfig.Validate if the designated constraints aren't met.
+type SettlementConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SettlementConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SettlementConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SettlementConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SettlementConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SettlementConfigValidationError) ErrorName() string { return "SettlementConfigValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SettlementConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSettlementConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SettlementConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SettlementConfigValidationError{}
+
+// Validate checks the field values on SettlementSummary with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SettlementSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SettlementSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SettlementSummaryMultiError, or nil if none found.
+func (m *SettlementSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SettlementSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Users
+
+	// no validation rules for Count
+
+	// no validation rules for Amount
+
+	// no validation rules for TeamAmount
+
+	// no validation rules for AreaAmount
+
+	if len(errors) > 0 {
+		return SettlementSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// SettlementSummaryMultiError is an error wrapping multiple validation errors
+// returned by SettlementSummary.ValidateAll() if the designated constraints
+// aren't met.
+type SettlementSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SettlementSummaryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SettlementSummaryMultiError) AllErrors() []error { return m }
+
+// SettlementSummaryValidationError is the validation error returned by
+// SettlementSummary.Validate if the designated constraints aren't met.
+type SettlementSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SettlementSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SettlementSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SettlementSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SettlementSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SettlementSummaryValidationError) ErrorName() string {
+	return "SettlementSummaryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SettlementSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSettlementSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SettlementSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SettlementSummaryValidationError{}
+
+// Validate checks the field values on SettlementRewardTotal with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SettlementRewardTotal) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SettlementRewardTotal with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SettlementRewardTotalMultiError, or nil if none found.
+func (m *SettlementRewardTotal) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SettlementRewardTotal) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Reason
+
+	// no validation rules for Amount
+
+	if len(errors) > 0 {
+		return SettlementRewardTotalMultiError(errors)
+	}
+
+	return nil
+}
+
+// SettlementRewardTotalMultiError is an error wrapping multiple validation
+// errors returned by SettlementRewardTotal.ValidateAll() if the designated
+// constraints aren't met.
+type SettlementRewardTotalMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SettlementRewardTotalMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SettlementRewardTotalMultiError) AllErrors() []error { return m }
+
+// SettlementRewardTotalValidationError is the validation error returned by
+// SettlementRewardTotal.Validate if the designated constraints aren't met.
+type SettlementRewardTotalValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SettlementRewardTotalValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SettlementRewardTotalValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SettlementRewardTotalValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SettlementRewardTotalValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SettlementRewardTotalValidationError) ErrorName() string {
+	return "SettlementRewardTotalValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SettlementRewardTotalValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSettlementRewardTotal.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SettlementRewardTotalValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SettlementRewardTotalValidationError{}
+
+// Validate checks the field values on SettlementRewardDiff with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SettlementRewardDiff) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SettlementRewardDiff with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SettlementRewardDiffMultiError, or nil if none found.
+func (m *SettlementRewardDiff) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SettlementRewardDiff) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Reason
+
+	// no validation rules for Amount
+
+	// no validation rules for CompareAmount
+
+	// no validation rules for Diff
+
+	if len(errors) > 0 {
+		return SettlementRewardDiffMultiError(errors)
+	}
+
+	return nil
+}
+
+// SettlementRewardDiffMultiError is an error wrapping multiple validation
+// errors returned by SettlementRewardDiff.ValidateAll() if the designated
+// constraints aren't met.
+type SettlementRewardDiffMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SettlementRewardDiffMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SettlementRewardDiffMultiError) AllErrors() []error { return m }
+
+// SettlementRewardDiffValidationError is the validation error returned by
+// SettlementRewardDiff.Validate if the designated constraints aren't met.
+type SettlementRewardDiffValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SettlementRewardDiffValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SettlementRewardDiffValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SettlementRewardDiffValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SettlementRewardDiffValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SettlementRewardDiffValidationError) ErrorName() string {
+	return "SettlementRewardDiffValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SettlementRewardDiffValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSettlementRewardDiff.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SettlementRewardDiffValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SettlementRewardDiffValidationError{}
+
+// Validate checks the field values on SettlementConfigDiff with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SettlementConfigDiff) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SettlementConfigDiff with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SettlementConfigDiffMultiError, or nil if none found.
+func (m *SettlementConfigDiff) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SettlementConfigDiff) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	// no validation rules for Value
+
+	// no validation rules for CompareValue
+
+	if len(errors) > 0 {
+		return SettlementConfigDiffMultiError(errors)
+	}
+
+	return nil
+}
+
+// SettlementConfigDiffMultiError is an error wrapping multiple validation
+// errors returned by SettlementConfigDiff.ValidateAll() if the designated
+// constraints aren't met.
+type SettlementConfigDiffMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SettlementConfigDiffMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SettlementConfigDiffMultiError) AllErrors() []error { return m }
+
+// SettlementConfigDiffValidationError is the validation error returned by
+// SettlementConfigDiff.Validate if the designated constraints aren't met.
+type SettlementConfigDiffValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SettlementConfigDiffValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SettlementConfigDiffValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SettlementConfigDiffValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SettlementConfigDiffValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SettlementConfigDiffValidationError) ErrorName() string {
+	return "SettlementConfigDiffValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SettlementConfigDiffValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSettlementConfigDiff.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SettlementConfigDiffValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SettlementConfigDiffValidationError{}
+
+// Validate checks the field values on AdminSettlementDryRunRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminSettlementDryRunRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminSettlementDryRunRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminSettlementDryRunRequestMultiError, or nil if none found.
+func (m *AdminSettlementDryRunRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminSettlementDryRunRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminSettlementDryRunRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminSettlementDryRunRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminSettlementDryRunRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminSettlementDryRunRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminSettlementDryRunRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminSettlementDryRunRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminSettlementDryRunRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminSettlementDryRunRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminSettlementDryRunRequestMultiError) AllErrors() []error { return m }
+
+// AdminSettlementDryRunRequestValidationError is the validation error returned
+// by AdminSettlementDryRunRequest.Validate if the designated constraints
+// aren't met.
+type AdminSettlementDryRunRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminSettlementDryRunRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminSettlementDryRunRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminSettlementDryRunRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminSettlementDryRunRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminSettlementDryRunRequestValidationError) ErrorName() string {
+	return "AdminSettlementDryRunRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminSettlementDryRunRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminSettlementDryRunRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminSettlementDryRunRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminSettlementDryRunRequestValidationError{}
+
+// Validate checks the field values on AdminSettlementDryRunReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminSettlementDryRunReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminSettlementDryRunReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminSettlementDryRunReplyMultiError, or nil if none found.
+func (m *AdminSettlementDryRunReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminSettlementDryRunReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Date
+
+	if all {
+		switch v := interface{}(m.GetSummary()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminSettlementDryRunReplyValidationError{
+					field:  "Summary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminSettlementDryRunReplyValidationError{
+					field:  "Summary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSummary()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminSettlementDryRunReplyValidationError{
+				field:  "Summary",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetRewards() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminSettlementDryRunReplyValidationError{
+						field:  fmt.Sprintf("Rewards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminSettlementDryRunReplyValidationError{
+						field:  fmt.Sprintf("Rewards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminSettlementDryRunReplyValidationError{
+					field:  fmt.Sprintf("Rewards[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetCompareSummary()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminSettlementDryRunReplyValidationError{
+					field:  "CompareSummary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminSettlementDryRunReplyValidationError{
+					field:  "CompareSummary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCompareSummary()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminSettlementDryRunReplyValidationError{
+				field:  "CompareSummary",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetDiffs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminSettlementDryRunReplyValidationError{
+						field:  fmt.Sprintf("Diffs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminSettlementDryRunReplyValidationError{
+						field:  fmt.Sprintf("Diffs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminSettlementDryRunReplyValidationError{
+					field:  fmt.Sprintf("Diffs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetConfigDiffs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminSettlementDryRunReplyValidationError{
+						field:  fmt.Sprintf("ConfigDiffs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminSettlementDryRunReplyValidationError{
+						field:  fmt.Sprintf("ConfigDiffs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminSettlementDryRunReplyValidationError{
+					field:  fmt.Sprintf("ConfigDiffs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminSettlementDryRunReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminSettlementDryRunReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminSettlementDryRunReply.ValidateAll() if
+// the designated constraints aren't met.
+type AdminSettlementDryRunReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminSettlementDryRunReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminSettlementDryRunReplyMultiError) AllErrors() []error { return m }
+
+// AdminSettlementDryRunReplyValidationError is the validation error returned
+// by AdminSettlementDryRunReply.Validate if the designated constraints aren't met.
+type AdminSettlementDryRunReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminSettlementDryRunReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminSettlementDryRunReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminSettlementDryRunReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminSettlementDryRunReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminSettlementDryRunReplyValidationError) ErrorName() string {
+	return "AdminSettlementDryRunReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminSettlementDryRunReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminSettlementDryRunReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminSettlementDryRunReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminSettlementDryRunReplyValidationError{}
+
+// Validate checks the field values on AdminSettlementReplayRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminSettlementReplayRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminSettlementReplayRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminSettlementReplayRequestMultiError, or nil if none found.
+func (m *AdminSettlementReplayRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminSettlementReplayRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminSettlementReplayRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminSettlementReplayRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminSettlementReplayRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminSettlementReplayRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminSettlementReplayRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminSettlementReplayRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminSettlementReplayRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminSettlementReplayRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminSettlementReplayRequestMultiError) AllErrors() []error { return m }
+
+// AdminSettlementReplayRequestValidationError is the validation error returned
+// by AdminSettlementReplayRequest.Validate if the designated constraints
+// aren't met.
+type AdminSettlementReplayRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminSettlementReplayRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminSettlementReplayRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminSettlementReplayRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminSettlementReplayRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminSettlementReplayRequestValidationError) ErrorName() string {
+	return "AdminSettlementReplayRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminSettlementReplayRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminSettlementReplayRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminSettlementReplayRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminSettlementReplayRequestValidationError{}
+
+// Validate checks the field values on AdminSettlementReplayReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminSettlementReplayReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminSettlementReplayReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminSettlementReplayReplyMultiError, or nil if none found.
+func (m *AdminSettlementReplayReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminSettlementReplayReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Date
+
+	// no validation rules for Rate
+
+	if all {
+		switch v := interface{}(m.GetExpected()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminSettlementReplayReplyValidationError{
+					field:  "Expected",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminSettlementReplayReplyValidationError{
+					field:  "Expected",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpected()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminSettlementReplayReplyValidationError{
+				field:  "Expected",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetActual()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminSettlementReplayReplyValidationError{
+					field:  "Actual",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminSettlementReplayReplyValidationError{
+					field:  "Actual",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetActual()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminSettlementReplayReplyValidationError{
+				field:  "Actual",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Matched
+
+	for idx, item := range m.GetDiffs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminSettlementReplayReplyValidationError{
+						field:  fmt.Sprintf("Diffs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminSettlementReplayReplyValidationError{
+						field:  fmt.Sprintf("Diffs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminSettlementReplayReplyValidationError{
+					field:  fmt.Sprintf("Diffs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminSettlementReplayReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminSettlementReplayReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminSettlementReplayReply.ValidateAll() if
+// the designated constraints aren't met.
+type AdminSettlementReplayReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminSettlementReplayReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminSettlementReplayReplyMultiError) AllErrors() []error { return m }
+
+// AdminSettlementReplayReplyValidationError is the validation error returned
+// by AdminSettlementReplayReply.Validate if the designated constraints aren't met.
+type AdminSettlementReplayReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminSettlementReplayReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminSettlementReplayReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminSettlementReplayReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminSettlementReplayReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminSettlementReplayReplyValidationError) ErrorName() string {
+	return "AdminSettlementReplayReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminSettlementReplayReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminSettlementReplayReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminSettlementReplayReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminSettlementReplayReplyValidationError{}
+
+// Validate checks the field values on AdminWithdrawEthRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawEthRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawEthRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawEthRequestMultiError, or nil if none found.
+func (m *AdminWithdrawEthRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawEthRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminWithdrawEthRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawEthRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawEthRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawEthRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawEthRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawEthRequestMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawEthRequestValidationError is the validation error returned by
+// AdminWithdrawEthRequest.Validate if the designated constraints aren't met.
+type AdminWithdrawEthRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawEthRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawEthRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawEthRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawEthRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawEthRequestValidationError) ErrorName() string {
+	return "AdminWithdrawEthRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawEthRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawEthRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawEthRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawEthRequestValidationError{}
+
+// Validate checks the field values on AdminWithdrawEthReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawEthReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawEthReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawEthReplyMultiError, or nil if none found.
+func (m *AdminWithdrawEthReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawEthReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Broadcast
+
+	// no validation rules for Confirmed
+
+	// no validation rules for Failed
+
+	if len(errors) > 0 {
+		return AdminWithdrawEthReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawEthReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawEthReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawEthReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawEthReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawEthReplyMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawEthReplyValidationError is the validation error returned by
+// AdminWithdrawEthReply.Validate if the designated constraints aren't met.
+type AdminWithdrawEthReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawEthReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawEthReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawEthReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawEthReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawEthReplyValidationError) ErrorName() string {
+	return "AdminWithdrawEthReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawEthReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawEthReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawEthReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawEthReplyValidationError{}
+
+// Validate checks the field values on AdminFeeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminFeeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminFeeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminFeeRequestMultiError, or nil if none found.
+func (m *AdminFeeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminFeeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminFeeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminFeeRequestMultiError is an error wrapping multiple validation errors
+// returned by AdminFeeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AdminFeeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminFeeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminFeeRequestMultiError) AllErrors() []error { return m }
+
+// AdminFeeRequestValidationError is the validation error returned by
+// AdminFeeRequest.Validate if the designated constraints aren't met.
+type AdminFeeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminFeeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminFeeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminFeeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminFeeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminFeeRequestValidationError) ErrorName() string { return "AdminFeeRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminFeeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminFeeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminFeeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminFeeRequestValidationError{}
+
+// Validate checks the field values on AdminFeeReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminFeeReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminFeeReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AdminFeeReplyMultiError, or
+// nil if none found.
+func (m *AdminFeeReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminFeeReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminFeeReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminFeeReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminFeeReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminFeeReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminFeeReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminFeeReplyMultiError) AllErrors() []error { return m }
+
+// AdminFeeReplyValidationError is the validation error returned by
+// AdminFeeReply.Validate if the designated constraints aren't met.
+type AdminFeeReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminFeeReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminFeeReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminFeeReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminFeeReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminFeeReplyValidationError) ErrorName() string { return "AdminFeeReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminFeeReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminFeeReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminFeeReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminFeeReplyValidationError{}
+
+// Validate checks the field values on AdminAllRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminAllRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminAllRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminAllRequestMultiError, or nil if none found.
+func (m *AdminAllRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminAllRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminAllRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminAllRequestMultiError is an error wrapping multiple validation errors
+// returned by AdminAllRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AdminAllRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminAllRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminAllRequestMultiError) AllErrors() []error { return m }
+
+// AdminAllRequestValidationError is the validation error returned by
+// AdminAllRequest.Validate if the designated constraints aren't met.
+type AdminAllRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminAllRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminAllRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminAllRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminAllRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminAllRequestValidationError) ErrorName() string { return "AdminAllRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminAllRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminAllRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminAllRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminAllRequestValidationError{}
+
+// Validate checks the field values on AdminAllReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminAllReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminAllReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AdminAllReplyMultiError, or
+// nil if none found.
+func (m *AdminAllReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminAllReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TodayTotalUser
+
+	// no validation rules for TotalUser
+
+	// no validation rules for AllBalance
+
+	// no validation rules for TodayLocation
+
+	// no validation rules for AllLocation
+
+	// no validation rules for TodayWithdraw
+
+	// no validation rules for AllWithdraw
+
+	// no validation rules for AllReward
+
+	// no validation rules for AllSystemRewardAndFee
+
+	if len(errors) > 0 {
+		return AdminAllReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminAllReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminAllReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminAllReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminAllReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminAllReplyMultiError) AllErrors() []error { return m }
+
+// AdminAllReplyValidationError is the validation error returned by
+// AdminAllReply.Validate if the designated constraints aren't met.
+type AdminAllReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminAllReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminAllReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminAllReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminAllReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminAllReplyValidationError) ErrorName() string { return "AdminAllReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminAllReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminAllReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminAllReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminAllReplyValidationError{}
+
+// Validate checks the field values on AdminUserRecommendRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserRecommendRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUserRecommendRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserRecommendRequestMultiError, or nil if none found.
+func (m *AdminUserRecommendRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUserRecommendRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return AdminUserRecommendRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUserRecommendRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminUserRecommendRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AdminUserRecommendRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUserRecommendRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUserRecommendRequestMultiError) AllErrors() []error { return m }
+
+// AdminUserRecommendRequestValidationError is the validation error returned by
+// AdminUserRecommendRequest.Validate if the designated constraints aren't met.
+type AdminUserRecommendRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUserRecommendRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUserRecommendRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUserRecommendRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUserRecommendRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUserRecommendRequestValidationError) ErrorName() string {
+	return "AdminUserRecommendRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUserRecommendRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
 
+	key := ""
+	if e.key {
+		key = "key for "
 	}
 
-	for idx, item := range m.GetMyRecommendAddressList() {
-		_, _ = idx, item
+	return fmt.Sprintf(
+		"invalid %sAdminUserRecommendRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UserInfoReplyValidationError{
-						field:  fmt.Sprintf("MyRecommendAddressList[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, UserInfoReplyValidationError{
-						field:  fmt.Sprintf("MyRecommendAddressList[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return UserInfoReplyValidationError{
-					field:  fmt.Sprintf("MyRecommendAddressList[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+var _ error = AdminUserRecommendRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUserRecommendRequestValidationError{}
+
+// Validate checks the field values on AdminUserRecommendReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserRecommendReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUserRecommendReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserRecommendReplyMultiError, or nil if none found.
+func (m *AdminUserRecommendReply) ValidateAll() error {
+	return m.validate(true)
+}
 
+func (m *AdminUserRecommendReply) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	for idx, item := range m.GetAllRewardList() {
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UserInfoReplyValidationError{
-						field:  fmt.Sprintf("AllRewardList[%v]", idx),
+					errors = append(errors, AdminUserRecommendReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, UserInfoReplyValidationError{
-						field:  fmt.Sprintf("AllRewardList[%v]", idx),
+					errors = append(errors, AdminUserRecommendReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -1210,8 +12522,8 @@ func (m *UserInfoReply) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return UserInfoReplyValidationError{
-					field:  fmt.Sprintf("AllRewardList[%v]", idx),
+				return AdminUserRecommendReplyValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -1221,19 +12533,19 @@ func (m *UserInfoReply) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UserInfoReplyMultiError(errors)
+		return AdminUserRecommendReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserInfoReplyMultiError is an error wrapping multiple validation errors
-// returned by UserInfoReply.ValidateAll() if the designated constraints
-// aren't met.
-type UserInfoReplyMultiError []error
+// AdminUserRecommendReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminUserRecommendReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminUserRecommendReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserInfoReplyMultiError) Error() string {
+func (m AdminUserRecommendReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1242,11 +12554,11 @@ func (m UserInfoReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserInfoReplyMultiError) AllErrors() []error { return m }
+func (m AdminUserRecommendReplyMultiError) AllErrors() []error { return m }
 
-// UserInfoReplyValidationError is the validation error returned by
-// UserInfoReply.Validate if the designated constraints aren't met.
-type UserInfoReplyValidationError struct {
+// AdminUserRecommendReplyValidationError is the validation error returned by
+// AdminUserRecommendReply.Validate if the designated constraints aren't met.
+type AdminUserRecommendReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1254,22 +12566,24 @@ type UserInfoReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserInfoReplyValidationError) Field() string { return e.field }
+func (e AdminUserRecommendReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserInfoReplyValidationError) Reason() string { return e.reason }
+func (e AdminUserRecommendReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserInfoReplyValidationError) Cause() error { return e.cause }
+func (e AdminUserRecommendReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserInfoReplyValidationError) Key() bool { return e.key }
+func (e AdminUserRecommendReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserInfoReplyValidationError) ErrorName() string { return "UserInfoReplyValidationError" }
+func (e AdminUserRecommendReplyValidationError) ErrorName() string {
+	return "AdminUserRecommendReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e UserInfoReplyValidationError) Error() string {
+func (e AdminUserRecommendReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1281,14 +12595,14 @@ func (e UserInfoReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserInfoReply.%s: %s%s",
+		"invalid %sAdminUserRecommendReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserInfoReplyValidationError{}
+var _ error = AdminUserRecommendReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1296,46 +12610,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserInfoReplyValidationError{}
+} = AdminUserRecommendReplyValidationError{}
 
-// Validate checks the field values on RewardListRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *RewardListRequest) Validate() error {
+// Validate checks the field values on AdminRecommendTreeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminRecommendTreeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RewardListRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminRecommendTreeRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RewardListRequestMultiError, or nil if none found.
-func (m *RewardListRequest) ValidateAll() error {
+// AdminRecommendTreeRequestMultiError, or nil if none found.
+func (m *AdminRecommendTreeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RewardListRequest) validate(all bool) error {
+func (m *AdminRecommendTreeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Type
+	// no validation rules for UserId
+
+	// no validation rules for Depth
+
+	// no validation rules for Address
 
 	if len(errors) > 0 {
-		return RewardListRequestMultiError(errors)
+		return AdminRecommendTreeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RewardListRequestMultiError is an error wrapping multiple validation errors
-// returned by RewardListRequest.ValidateAll() if the designated constraints
-// aren't met.
-type RewardListRequestMultiError []error
+// AdminRecommendTreeRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminRecommendTreeRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AdminRecommendTreeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RewardListRequestMultiError) Error() string {
+func (m AdminRecommendTreeRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1344,11 +12662,11 @@ func (m RewardListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RewardListRequestMultiError) AllErrors() []error { return m }
+func (m AdminRecommendTreeRequestMultiError) AllErrors() []error { return m }
 
-// RewardListRequestValidationError is the validation error returned by
-// RewardListRequest.Validate if the designated constraints aren't met.
-type RewardListRequestValidationError struct {
+// AdminRecommendTreeRequestValidationError is the validation error returned by
+// AdminRecommendTreeRequest.Validate if the designated constraints aren't met.
+type AdminRecommendTreeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1356,24 +12674,24 @@ type RewardListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RewardListRequestValidationError) Field() string { return e.field }
+func (e AdminRecommendTreeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RewardListRequestValidationError) Reason() string { return e.reason }
+func (e AdminRecommendTreeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RewardListRequestValidationError) Cause() error { return e.cause }
+func (e AdminRecommendTreeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RewardListRequestValidationError) Key() bool { return e.key }
+func (e AdminRecommendTreeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RewardListRequestValidationError) ErrorName() string {
-	return "RewardListRequestValidationError"
+func (e AdminRecommendTreeRequestValidationError) ErrorName() string {
+	return "AdminRecommendTreeRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RewardListRequestValidationError) Error() string {
+func (e AdminRecommendTreeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1385,14 +12703,14 @@ func (e RewardListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRewardListRequest.%s: %s%s",
+		"invalid %sAdminRecommendTreeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RewardListRequestValidationError{}
+var _ error = AdminRecommendTreeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1400,47 +12718,76 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RewardListRequestValidationError{}
+} = AdminRecommendTreeRequestValidationError{}
 
-// Validate checks the field values on RewardListReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *RewardListReply) Validate() error {
+// Validate checks the field values on AdminRecommendTreeReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminRecommendTreeReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RewardListReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminRecommendTreeReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RewardListReplyMultiError, or nil if none found.
-func (m *RewardListReply) ValidateAll() error {
+// AdminRecommendTreeReplyMultiError, or nil if none found.
+func (m *AdminRecommendTreeReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RewardListReply) validate(all bool) error {
+func (m *AdminRecommendTreeReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetRewards() {
+	if all {
+		switch v := interface{}(m.GetNode()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminRecommendTreeReplyValidationError{
+					field:  "Node",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminRecommendTreeReplyValidationError{
+					field:  "Node",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNode()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminRecommendTreeReplyValidationError{
+				field:  "Node",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetPath() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, RewardListReplyValidationError{
-						field:  fmt.Sprintf("Rewards[%v]", idx),
+					errors = append(errors, AdminRecommendTreeReplyValidationError{
+						field:  fmt.Sprintf("Path[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, RewardListReplyValidationError{
-						field:  fmt.Sprintf("Rewards[%v]", idx),
+					errors = append(errors, AdminRecommendTreeReplyValidationError{
+						field:  fmt.Sprintf("Path[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -1448,8 +12795,76 @@ func (m *RewardListReply) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return RewardListReplyValidationError{
-					field:  fmt.Sprintf("Rewards[%v]", idx),
+				return AdminRecommendTreeReplyValidationError{
+					field:  fmt.Sprintf("Path[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetChildren() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminRecommendTreeReplyValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminRecommendTreeReplyValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminRecommendTreeReplyValidationError{
+					field:  fmt.Sprintf("Children[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetMatches() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminRecommendTreeReplyValidationError{
+						field:  fmt.Sprintf("Matches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminRecommendTreeReplyValidationError{
+						field:  fmt.Sprintf("Matches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminRecommendTreeReplyValidationError{
+					field:  fmt.Sprintf("Matches[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -1459,19 +12874,19 @@ func (m *RewardListReply) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return RewardListReplyMultiError(errors)
+		return AdminRecommendTreeReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// RewardListReplyMultiError is an error wrapping multiple validation errors
-// returned by RewardListReply.ValidateAll() if the designated constraints
-// aren't met.
-type RewardListReplyMultiError []error
+// AdminRecommendTreeReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminRecommendTreeReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminRecommendTreeReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RewardListReplyMultiError) Error() string {
+func (m AdminRecommendTreeReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1480,11 +12895,11 @@ func (m RewardListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RewardListReplyMultiError) AllErrors() []error { return m }
+func (m AdminRecommendTreeReplyMultiError) AllErrors() []error { return m }
 
-// RewardListReplyValidationError is the validation error returned by
-// RewardListReply.Validate if the designated constraints aren't met.
-type RewardListReplyValidationError struct {
+// AdminRecommendTreeReplyValidationError is the validation error returned by
+// AdminRecommendTreeReply.Validate if the designated constraints aren't met.
+type AdminRecommendTreeReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1492,22 +12907,24 @@ type RewardListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e RewardListReplyValidationError) Field() string { return e.field }
+func (e AdminRecommendTreeReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RewardListReplyValidationError) Reason() string { return e.reason }
+func (e AdminRecommendTreeReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RewardListReplyValidationError) Cause() error { return e.cause }
+func (e AdminRecommendTreeReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RewardListReplyValidationError) Key() bool { return e.key }
+func (e AdminRecommendTreeReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RewardListReplyValidationError) ErrorName() string { return "RewardListReplyValidationError" }
+func (e AdminRecommendTreeReplyValidationError) ErrorName() string {
+	return "AdminRecommendTreeReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e RewardListReplyValidationError) Error() string {
+func (e AdminRecommendTreeReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1519,14 +12936,14 @@ func (e RewardListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRewardListReply.%s: %s%s",
+		"invalid %sAdminRecommendTreeReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RewardListReplyValidationError{}
+var _ error = AdminRecommendTreeReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1534,44 +12951,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RewardListReplyValidationError{}
+} = AdminRecommendTreeReplyValidationError{}
 
-// Validate checks the field values on RecommendRewardListRequest with the
+// Validate checks the field values on AdminUserLevelOverrideRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RecommendRewardListRequest) Validate() error {
+func (m *AdminUserLevelOverrideRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RecommendRewardListRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RecommendRewardListRequestMultiError, or nil if none found.
-func (m *RecommendRewardListRequest) ValidateAll() error {
+// ValidateAll checks the field values on AdminUserLevelOverrideRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminUserLevelOverrideRequestMultiError, or nil if none found.
+func (m *AdminUserLevelOverrideRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecommendRewardListRequest) validate(all bool) error {
+func (m *AdminUserLevelOverrideRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminUserLevelOverrideRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminUserLevelOverrideRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminUserLevelOverrideRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return RecommendRewardListRequestMultiError(errors)
+		return AdminUserLevelOverrideRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RecommendRewardListRequestMultiError is an error wrapping multiple
-// validation errors returned by RecommendRewardListRequest.ValidateAll() if
-// the designated constraints aren't met.
-type RecommendRewardListRequestMultiError []error
+// AdminUserLevelOverrideRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminUserLevelOverrideRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AdminUserLevelOverrideRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecommendRewardListRequestMultiError) Error() string {
+func (m AdminUserLevelOverrideRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1580,11 +13026,12 @@ func (m RecommendRewardListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecommendRewardListRequestMultiError) AllErrors() []error { return m }
+func (m AdminUserLevelOverrideRequestMultiError) AllErrors() []error { return m }
 
-// RecommendRewardListRequestValidationError is the validation error returned
-// by RecommendRewardListRequest.Validate if the designated constraints aren't met.
-type RecommendRewardListRequestValidationError struct {
+// AdminUserLevelOverrideRequestValidationError is the validation error
+// returned by AdminUserLevelOverrideRequest.Validate if the designated
+// constraints aren't met.
+type AdminUserLevelOverrideRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1592,24 +13039,24 @@ type RecommendRewardListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecommendRewardListRequestValidationError) Field() string { return e.field }
+func (e AdminUserLevelOverrideRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecommendRewardListRequestValidationError) Reason() string { return e.reason }
+func (e AdminUserLevelOverrideRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecommendRewardListRequestValidationError) Cause() error { return e.cause }
+func (e AdminUserLevelOverrideRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecommendRewardListRequestValidationError) Key() bool { return e.key }
+func (e AdminUserLevelOverrideRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RecommendRewardListRequestValidationError) ErrorName() string {
-	return "RecommendRewardListRequestValidationError"
+func (e AdminUserLevelOverrideRequestValidationError) ErrorName() string {
+	return "AdminUserLevelOverrideRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RecommendRewardListRequestValidationError) Error() string {
+func (e AdminUserLevelOverrideRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1621,14 +13068,14 @@ func (e RecommendRewardListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecommendRewardListRequest.%s: %s%s",
+		"invalid %sAdminUserLevelOverrideRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecommendRewardListRequestValidationError{}
+var _ error = AdminUserLevelOverrideRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1636,78 +13083,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecommendRewardListRequestValidationError{}
+} = AdminUserLevelOverrideRequestValidationError{}
 
-// Validate checks the field values on RecommendRewardListReply with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminUserLevelOverrideReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RecommendRewardListReply) Validate() error {
+func (m *AdminUserLevelOverrideReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RecommendRewardListReply with the
+// ValidateAll checks the field values on AdminUserLevelOverrideReply with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RecommendRewardListReplyMultiError, or nil if none found.
-func (m *RecommendRewardListReply) ValidateAll() error {
+// AdminUserLevelOverrideReplyMultiError, or nil if none found.
+func (m *AdminUserLevelOverrideReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecommendRewardListReply) validate(all bool) error {
+func (m *AdminUserLevelOverrideReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetRewards() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, RecommendRewardListReplyValidationError{
-						field:  fmt.Sprintf("Rewards[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, RecommendRewardListReplyValidationError{
-						field:  fmt.Sprintf("Rewards[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return RecommendRewardListReplyValidationError{
-					field:  fmt.Sprintf("Rewards[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for Level
 
 	if len(errors) > 0 {
-		return RecommendRewardListReplyMultiError(errors)
+		return AdminUserLevelOverrideReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// RecommendRewardListReplyMultiError is an error wrapping multiple validation
-// errors returned by RecommendRewardListReply.ValidateAll() if the designated
-// constraints aren't met.
-type RecommendRewardListReplyMultiError []error
+// AdminUserLevelOverrideReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminUserLevelOverrideReply.ValidateAll() if
+// the designated constraints aren't met.
+type AdminUserLevelOverrideReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecommendRewardListReplyMultiError) Error() string {
+func (m AdminUserLevelOverrideReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1716,11 +13131,12 @@ func (m RecommendRewardListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecommendRewardListReplyMultiError) AllErrors() []error { return m }
+func (m AdminUserLevelOverrideReplyMultiError) AllErrors() []error { return m }
 
-// RecommendRewardListReplyValidationError is the validation error returned by
-// RecommendRewardListReply.Validate if the designated constraints aren't met.
-type RecommendRewardListReplyValidationError struct {
+// AdminUserLevelOverrideReplyValidationError is the validation error returned
+// by AdminUserLevelOverrideReply.Validate if the designated constraints
+// aren't met.
+type AdminUserLevelOverrideReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1728,24 +13144,24 @@ type RecommendRewardListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecommendRewardListReplyValidationError) Field() string { return e.field }
+func (e AdminUserLevelOverrideReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecommendRewardListReplyValidationError) Reason() string { return e.reason }
+func (e AdminUserLevelOverrideReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecommendRewardListReplyValidationError) Cause() error { return e.cause }
+func (e AdminUserLevelOverrideReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecommendRewardListReplyValidationError) Key() bool { return e.key }
+func (e AdminUserLevelOverrideReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RecommendRewardListReplyValidationError) ErrorName() string {
-	return "RecommendRewardListReplyValidationError"
+func (e AdminUserLevelOverrideReplyValidationError) ErrorName() string {
+	return "AdminUserLevelOverrideReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RecommendRewardListReplyValidationError) Error() string {
+func (e AdminUserLevelOverrideReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1757,14 +13173,14 @@ func (e RecommendRewardListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecommendRewardListReply.%s: %s%s",
+		"invalid %sAdminUserLevelOverrideReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecommendRewardListReplyValidationError{}
+var _ error = AdminUserLevelOverrideReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1772,44 +13188,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecommendRewardListReplyValidationError{}
+} = AdminUserLevelOverrideReplyValidationError{}
 
-// Validate checks the field values on FeeRewardListRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminUserLevelHistoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *FeeRewardListRequest) Validate() error {
+func (m *AdminUserLevelHistoryRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on FeeRewardListRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminUserLevelHistoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// FeeRewardListRequestMultiError, or nil if none found.
-func (m *FeeRewardListRequest) ValidateAll() error {
+// AdminUserLevelHistoryRequestMultiError, or nil if none found.
+func (m *AdminUserLevelHistoryRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *FeeRewardListRequest) validate(all bool) error {
+func (m *AdminUserLevelHistoryRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for UserId
+
+	// no validation rules for AfterId
+
+	// no validation rules for Limit
+
 	if len(errors) > 0 {
-		return FeeRewardListRequestMultiError(errors)
+		return AdminUserLevelHistoryRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// FeeRewardListRequestMultiError is an error wrapping multiple validation
-// errors returned by FeeRewardListRequest.ValidateAll() if the designated
-// constraints aren't met.
-type FeeRewardListRequestMultiError []error
+// AdminUserLevelHistoryRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminUserLevelHistoryRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminUserLevelHistoryRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m FeeRewardListRequestMultiError) Error() string {
+func (m AdminUserLevelHistoryRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1818,11 +13240,12 @@ func (m FeeRewardListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m FeeRewardListRequestMultiError) AllErrors() []error { return m }
+func (m AdminUserLevelHistoryRequestMultiError) AllErrors() []error { return m }
 
-// FeeRewardListRequestValidationError is the validation error returned by
-// FeeRewardListRequest.Validate if the designated constraints aren't met.
-type FeeRewardListRequestValidationError struct {
+// AdminUserLevelHistoryRequestValidationError is the validation error returned
+// by AdminUserLevelHistoryRequest.Validate if the designated constraints
+// aren't met.
+type AdminUserLevelHistoryRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1830,24 +13253,24 @@ type FeeRewardListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e FeeRewardListRequestValidationError) Field() string { return e.field }
+func (e AdminUserLevelHistoryRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e FeeRewardListRequestValidationError) Reason() string { return e.reason }
+func (e AdminUserLevelHistoryRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e FeeRewardListRequestValidationError) Cause() error { return e.cause }
+func (e AdminUserLevelHistoryRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e FeeRewardListRequestValidationError) Key() bool { return e.key }
+func (e AdminUserLevelHistoryRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e FeeRewardListRequestValidationError) ErrorName() string {
-	return "FeeRewardListRequestValidationError"
+func (e AdminUserLevelHistoryRequestValidationError) ErrorName() string {
+	return "AdminUserLevelHistoryRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e FeeRewardListRequestValidationError) Error() string {
+func (e AdminUserLevelHistoryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1859,14 +13282,14 @@ func (e FeeRewardListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sFeeRewardListRequest.%s: %s%s",
+		"invalid %sAdminUserLevelHistoryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = FeeRewardListRequestValidationError{}
+var _ error = AdminUserLevelHistoryRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1874,47 +13297,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = FeeRewardListRequestValidationError{}
+} = AdminUserLevelHistoryRequestValidationError{}
 
-// Validate checks the field values on FeeRewardListReply with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminUserLevelHistoryReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *FeeRewardListReply) Validate() error {
+func (m *AdminUserLevelHistoryReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on FeeRewardListReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminUserLevelHistoryReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// FeeRewardListReplyMultiError, or nil if none found.
-func (m *FeeRewardListReply) ValidateAll() error {
+// AdminUserLevelHistoryReplyMultiError, or nil if none found.
+func (m *AdminUserLevelHistoryReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *FeeRewardListReply) validate(all bool) error {
+func (m *AdminUserLevelHistoryReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetRewards() {
+	for idx, item := range m.GetHistories() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, FeeRewardListReplyValidationError{
-						field:  fmt.Sprintf("Rewards[%v]", idx),
+					errors = append(errors, AdminUserLevelHistoryReplyValidationError{
+						field:  fmt.Sprintf("Histories[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, FeeRewardListReplyValidationError{
-						field:  fmt.Sprintf("Rewards[%v]", idx),
+					errors = append(errors, AdminUserLevelHistoryReplyValidationError{
+						field:  fmt.Sprintf("Histories[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -1922,8 +13345,8 @@ func (m *FeeRewardListReply) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return FeeRewardListReplyValidationError{
-					field:  fmt.Sprintf("Rewards[%v]", idx),
+				return AdminUserLevelHistoryReplyValidationError{
+					field:  fmt.Sprintf("Histories[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -1932,20 +13355,22 @@ func (m *FeeRewardListReply) validate(all bool) error {
 
 	}
 
+	// no validation rules for LastId
+
 	if len(errors) > 0 {
-		return FeeRewardListReplyMultiError(errors)
+		return AdminUserLevelHistoryReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// FeeRewardListReplyMultiError is an error wrapping multiple validation errors
-// returned by FeeRewardListReply.ValidateAll() if the designated constraints
-// aren't met.
-type FeeRewardListReplyMultiError []error
+// AdminUserLevelHistoryReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminUserLevelHistoryReply.ValidateAll() if
+// the designated constraints aren't met.
+type AdminUserLevelHistoryReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m FeeRewardListReplyMultiError) Error() string {
+func (m AdminUserLevelHistoryReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1954,11 +13379,11 @@ func (m FeeRewardListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m FeeRewardListReplyMultiError) AllErrors() []error { return m }
+func (m AdminUserLevelHistoryReplyMultiError) AllErrors() []error { return m }
 
-// FeeRewardListReplyValidationError is the validation error returned by
-// FeeRewardListReply.Validate if the designated constraints aren't met.
-type FeeRewardListReplyValidationError struct {
+// AdminUserLevelHistoryReplyValidationError is the validation error returned
+// by AdminUserLevelHistoryReply.Validate if the designated constraints aren't met.
+type AdminUserLevelHistoryReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1966,24 +13391,24 @@ type FeeRewardListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e FeeRewardListReplyValidationError) Field() string { return e.field }
+func (e AdminUserLevelHistoryReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e FeeRewardListReplyValidationError) Reason() string { return e.reason }
+func (e AdminUserLevelHistoryReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e FeeRewardListReplyValidationError) Cause() error { return e.cause }
+func (e AdminUserLevelHistoryReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e FeeRewardListReplyValidationError) Key() bool { return e.key }
+func (e AdminUserLevelHistoryReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e FeeRewardListReplyValidationError) ErrorName() string {
-	return "FeeRewardListReplyValidationError"
+func (e AdminUserLevelHistoryReplyValidationError) ErrorName() string {
+	return "AdminUserLevelHistoryReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e FeeRewardListReplyValidationError) Error() string {
+func (e AdminUserLevelHistoryReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1995,14 +13420,14 @@ func (e FeeRewardListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sFeeRewardListReply.%s: %s%s",
+		"invalid %sAdminUserLevelHistoryReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = FeeRewardListReplyValidationError{}
+var _ error = AdminUserLevelHistoryReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2010,46 +13435,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = FeeRewardListReplyValidationError{}
+} = AdminUserLevelHistoryReplyValidationError{}
 
-// Validate checks the field values on WithdrawListRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminMonthRecommendRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *WithdrawListRequest) Validate() error {
+func (m *AdminMonthRecommendRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WithdrawListRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminMonthRecommendRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WithdrawListRequestMultiError, or nil if none found.
-func (m *WithdrawListRequest) ValidateAll() error {
+// AdminMonthRecommendRequestMultiError, or nil if none found.
+func (m *AdminMonthRecommendRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WithdrawListRequest) validate(all bool) error {
+func (m *AdminMonthRecommendRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Type
+	// no validation rules for Address
+
+	// no validation rules for Page
 
 	if len(errors) > 0 {
-		return WithdrawListRequestMultiError(errors)
+		return AdminMonthRecommendRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// WithdrawListRequestMultiError is an error wrapping multiple validation
-// errors returned by WithdrawListRequest.ValidateAll() if the designated
-// constraints aren't met.
-type WithdrawListRequestMultiError []error
+// AdminMonthRecommendRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminMonthRecommendRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminMonthRecommendRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WithdrawListRequestMultiError) Error() string {
+func (m AdminMonthRecommendRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2058,11 +13485,11 @@ func (m WithdrawListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WithdrawListRequestMultiError) AllErrors() []error { return m }
+func (m AdminMonthRecommendRequestMultiError) AllErrors() []error { return m }
 
-// WithdrawListRequestValidationError is the validation error returned by
-// WithdrawListRequest.Validate if the designated constraints aren't met.
-type WithdrawListRequestValidationError struct {
+// AdminMonthRecommendRequestValidationError is the validation error returned
+// by AdminMonthRecommendRequest.Validate if the designated constraints aren't met.
+type AdminMonthRecommendRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2070,24 +13497,24 @@ type WithdrawListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e WithdrawListRequestValidationError) Field() string { return e.field }
+func (e AdminMonthRecommendRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WithdrawListRequestValidationError) Reason() string { return e.reason }
+func (e AdminMonthRecommendRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WithdrawListRequestValidationError) Cause() error { return e.cause }
+func (e AdminMonthRecommendRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WithdrawListRequestValidationError) Key() bool { return e.key }
+func (e AdminMonthRecommendRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WithdrawListRequestValidationError) ErrorName() string {
-	return "WithdrawListRequestValidationError"
+func (e AdminMonthRecommendRequestValidationError) ErrorName() string {
+	return "AdminMonthRecommendRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WithdrawListRequestValidationError) Error() string {
+func (e AdminMonthRecommendRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2099,14 +13526,14 @@ func (e WithdrawListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWithdrawListRequest.%s: %s%s",
+		"invalid %sAdminMonthRecommendRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WithdrawListRequestValidationError{}
+var _ error = AdminMonthRecommendRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2114,47 +13541,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WithdrawListRequestValidationError{}
+} = AdminMonthRecommendRequestValidationError{}
 
-// Validate checks the field values on WithdrawListReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *WithdrawListReply) Validate() error {
+// Validate checks the field values on AdminMonthRecommendReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminMonthRecommendReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WithdrawListReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminMonthRecommendReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WithdrawListReplyMultiError, or nil if none found.
-func (m *WithdrawListReply) ValidateAll() error {
+// AdminMonthRecommendReplyMultiError, or nil if none found.
+func (m *AdminMonthRecommendReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WithdrawListReply) validate(all bool) error {
+func (m *AdminMonthRecommendReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetWithdraw() {
+	for idx, item := range m.GetUsers() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, WithdrawListReplyValidationError{
-						field:  fmt.Sprintf("Withdraw[%v]", idx),
+					errors = append(errors, AdminMonthRecommendReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, WithdrawListReplyValidationError{
-						field:  fmt.Sprintf("Withdraw[%v]", idx),
+					errors = append(errors, AdminMonthRecommendReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -2162,8 +13589,8 @@ func (m *WithdrawListReply) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return WithdrawListReplyValidationError{
-					field:  fmt.Sprintf("Withdraw[%v]", idx),
+				return AdminMonthRecommendReplyValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -2172,20 +13599,22 @@ func (m *WithdrawListReply) validate(all bool) error {
 
 	}
 
+	// no validation rules for Count
+
 	if len(errors) > 0 {
-		return WithdrawListReplyMultiError(errors)
+		return AdminMonthRecommendReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// WithdrawListReplyMultiError is an error wrapping multiple validation errors
-// returned by WithdrawListReply.ValidateAll() if the designated constraints
-// aren't met.
-type WithdrawListReplyMultiError []error
+// AdminMonthRecommendReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminMonthRecommendReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminMonthRecommendReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WithdrawListReplyMultiError) Error() string {
+func (m AdminMonthRecommendReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2194,11 +13623,11 @@ func (m WithdrawListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WithdrawListReplyMultiError) AllErrors() []error { return m }
+func (m AdminMonthRecommendReplyMultiError) AllErrors() []error { return m }
 
-// WithdrawListReplyValidationError is the validation error returned by
-// WithdrawListReply.Validate if the designated constraints aren't met.
-type WithdrawListReplyValidationError struct {
+// AdminMonthRecommendReplyValidationError is the validation error returned by
+// AdminMonthRecommendReply.Validate if the designated constraints aren't met.
+type AdminMonthRecommendReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2206,24 +13635,24 @@ type WithdrawListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e WithdrawListReplyValidationError) Field() string { return e.field }
+func (e AdminMonthRecommendReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WithdrawListReplyValidationError) Reason() string { return e.reason }
+func (e AdminMonthRecommendReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WithdrawListReplyValidationError) Cause() error { return e.cause }
+func (e AdminMonthRecommendReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WithdrawListReplyValidationError) Key() bool { return e.key }
+func (e AdminMonthRecommendReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WithdrawListReplyValidationError) ErrorName() string {
-	return "WithdrawListReplyValidationError"
+func (e AdminMonthRecommendReplyValidationError) ErrorName() string {
+	return "AdminMonthRecommendReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WithdrawListReplyValidationError) Error() string {
+func (e AdminMonthRecommendReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2235,14 +13664,14 @@ func (e WithdrawListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWithdrawListReply.%s: %s%s",
+		"invalid %sAdminMonthRecommendReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WithdrawListReplyValidationError{}
+var _ error = AdminMonthRecommendReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2250,46 +13679,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WithdrawListReplyValidationError{}
+} = AdminMonthRecommendReplyValidationError{}
 
-// Validate checks the field values on RecommendListRequest with the rules
+// Validate checks the field values on AdminConfigRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RecommendListRequest) Validate() error {
+func (m *AdminConfigRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RecommendListRequest with the rules
+// ValidateAll checks the field values on AdminConfigRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RecommendListRequestMultiError, or nil if none found.
-func (m *RecommendListRequest) ValidateAll() error {
+// AdminConfigRequestMultiError, or nil if none found.
+func (m *AdminConfigRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecommendListRequest) validate(all bool) error {
+func (m *AdminConfigRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Type
+	// no validation rules for UserId
+
+	// no validation rules for Page
 
 	if len(errors) > 0 {
-		return RecommendListRequestMultiError(errors)
+		return AdminConfigRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RecommendListRequestMultiError is an error wrapping multiple validation
-// errors returned by RecommendListRequest.ValidateAll() if the designated
-// constraints aren't met.
-type RecommendListRequestMultiError []error
+// AdminConfigRequestMultiError is an error wrapping multiple validation errors
+// returned by AdminConfigRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AdminConfigRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecommendListRequestMultiError) Error() string {
+func (m AdminConfigRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2298,11 +13729,11 @@ func (m RecommendListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecommendListRequestMultiError) AllErrors() []error { return m }
+func (m AdminConfigRequestMultiError) AllErrors() []error { return m }
 
-// RecommendListRequestValidationError is the validation error returned by
-// RecommendListRequest.Validate if the designated constraints aren't met.
-type RecommendListRequestValidationError struct {
+// AdminConfigRequestValidationError is the validation error returned by
+// AdminConfigRequest.Validate if the designated constraints aren't met.
+type AdminConfigRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2310,24 +13741,24 @@ type RecommendListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecommendListRequestValidationError) Field() string { return e.field }
+func (e AdminConfigRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecommendListRequestValidationError) Reason() string { return e.reason }
+func (e AdminConfigRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecommendListRequestValidationError) Cause() error { return e.cause }
+func (e AdminConfigRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecommendListRequestValidationError) Key() bool { return e.key }
+func (e AdminConfigRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RecommendListRequestValidationError) ErrorName() string {
-	return "RecommendListRequestValidationError"
+func (e AdminConfigRequestValidationError) ErrorName() string {
+	return "AdminConfigRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RecommendListRequestValidationError) Error() string {
+func (e AdminConfigRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2339,14 +13770,14 @@ func (e RecommendListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecommendListRequest.%s: %s%s",
+		"invalid %sAdminConfigRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecommendListRequestValidationError{}
+var _ error = AdminConfigRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2354,47 +13785,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecommendListRequestValidationError{}
+} = AdminConfigRequestValidationError{}
 
-// Validate checks the field values on RecommendListReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RecommendListReply) Validate() error {
+// Validate checks the field values on AdminConfigReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminConfigReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RecommendListReply with the rules
+// ValidateAll checks the field values on AdminConfigReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RecommendListReplyMultiError, or nil if none found.
-func (m *RecommendListReply) ValidateAll() error {
+// AdminConfigReplyMultiError, or nil if none found.
+func (m *AdminConfigReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecommendListReply) validate(all bool) error {
+func (m *AdminConfigReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetRecommends() {
+	for idx, item := range m.GetConfig() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, RecommendListReplyValidationError{
-						field:  fmt.Sprintf("Recommends[%v]", idx),
+					errors = append(errors, AdminConfigReplyValidationError{
+						field:  fmt.Sprintf("Config[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, RecommendListReplyValidationError{
-						field:  fmt.Sprintf("Recommends[%v]", idx),
+					errors = append(errors, AdminConfigReplyValidationError{
+						field:  fmt.Sprintf("Config[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -2402,8 +13833,8 @@ func (m *RecommendListReply) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return RecommendListReplyValidationError{
-					field:  fmt.Sprintf("Recommends[%v]", idx),
+				return AdminConfigReplyValidationError{
+					field:  fmt.Sprintf("Config[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -2412,20 +13843,22 @@ func (m *RecommendListReply) validate(all bool) error {
 
 	}
 
+	// no validation rules for Count
+
 	if len(errors) > 0 {
-		return RecommendListReplyMultiError(errors)
+		return AdminConfigReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// RecommendListReplyMultiError is an error wrapping multiple validation errors
-// returned by RecommendListReply.ValidateAll() if the designated constraints
+// AdminConfigReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminConfigReply.ValidateAll() if the designated constraints
 // aren't met.
-type RecommendListReplyMultiError []error
+type AdminConfigReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecommendListReplyMultiError) Error() string {
+func (m AdminConfigReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2434,11 +13867,11 @@ func (m RecommendListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecommendListReplyMultiError) AllErrors() []error { return m }
+func (m AdminConfigReplyMultiError) AllErrors() []error { return m }
 
-// RecommendListReplyValidationError is the validation error returned by
-// RecommendListReply.Validate if the designated constraints aren't met.
-type RecommendListReplyValidationError struct {
+// AdminConfigReplyValidationError is the validation error returned by
+// AdminConfigReply.Validate if the designated constraints aren't met.
+type AdminConfigReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2446,24 +13879,22 @@ type RecommendListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecommendListReplyValidationError) Field() string { return e.field }
+func (e AdminConfigReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecommendListReplyValidationError) Reason() string { return e.reason }
+func (e AdminConfigReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecommendListReplyValidationError) Cause() error { return e.cause }
+func (e AdminConfigReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecommendListReplyValidationError) Key() bool { return e.key }
+func (e AdminConfigReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RecommendListReplyValidationError) ErrorName() string {
-	return "RecommendListReplyValidationError"
-}
+func (e AdminConfigReplyValidationError) ErrorName() string { return "AdminConfigReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RecommendListReplyValidationError) Error() string {
+func (e AdminConfigReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2475,14 +13906,14 @@ func (e RecommendListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecommendListReply.%s: %s%s",
+		"invalid %sAdminConfigReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecommendListReplyValidationError{}
+var _ error = AdminConfigReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2490,24 +13921,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecommendListReplyValidationError{}
+} = AdminConfigReplyValidationError{}
 
-// Validate checks the field values on WithdrawRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *WithdrawRequest) Validate() error {
+// Validate checks the field values on AdminConfigUpdateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminConfigUpdateRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WithdrawRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminConfigUpdateRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WithdrawRequestMultiError, or nil if none found.
-func (m *WithdrawRequest) ValidateAll() error {
+// AdminConfigUpdateRequestMultiError, or nil if none found.
+func (m *AdminConfigUpdateRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WithdrawRequest) validate(all bool) error {
+func (m *AdminConfigUpdateRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2518,7 +13949,7 @@ func (m *WithdrawRequest) validate(all bool) error {
 		switch v := interface{}(m.GetSendBody()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, WithdrawRequestValidationError{
+				errors = append(errors, AdminConfigUpdateRequestValidationError{
 					field:  "SendBody",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2526,7 +13957,7 @@ func (m *WithdrawRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, WithdrawRequestValidationError{
+				errors = append(errors, AdminConfigUpdateRequestValidationError{
 					field:  "SendBody",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2535,7 +13966,7 @@ func (m *WithdrawRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return WithdrawRequestValidationError{
+			return AdminConfigUpdateRequestValidationError{
 				field:  "SendBody",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -2544,19 +13975,19 @@ func (m *WithdrawRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return WithdrawRequestMultiError(errors)
+		return AdminConfigUpdateRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// WithdrawRequestMultiError is an error wrapping multiple validation errors
-// returned by WithdrawRequest.ValidateAll() if the designated constraints
-// aren't met.
-type WithdrawRequestMultiError []error
+// AdminConfigUpdateRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminConfigUpdateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminConfigUpdateRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WithdrawRequestMultiError) Error() string {
+func (m AdminConfigUpdateRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2565,11 +13996,11 @@ func (m WithdrawRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WithdrawRequestMultiError) AllErrors() []error { return m }
+func (m AdminConfigUpdateRequestMultiError) AllErrors() []error { return m }
 
-// WithdrawRequestValidationError is the validation error returned by
-// WithdrawRequest.Validate if the designated constraints aren't met.
-type WithdrawRequestValidationError struct {
+// AdminConfigUpdateRequestValidationError is the validation error returned by
+// AdminConfigUpdateRequest.Validate if the designated constraints aren't met.
+type AdminConfigUpdateRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2577,22 +14008,24 @@ type WithdrawRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e WithdrawRequestValidationError) Field() string { return e.field }
+func (e AdminConfigUpdateRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WithdrawRequestValidationError) Reason() string { return e.reason }
+func (e AdminConfigUpdateRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WithdrawRequestValidationError) Cause() error { return e.cause }
+func (e AdminConfigUpdateRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WithdrawRequestValidationError) Key() bool { return e.key }
+func (e AdminConfigUpdateRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WithdrawRequestValidationError) ErrorName() string { return "WithdrawRequestValidationError" }
+func (e AdminConfigUpdateRequestValidationError) ErrorName() string {
+	return "AdminConfigUpdateRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e WithdrawRequestValidationError) Error() string {
+func (e AdminConfigUpdateRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2604,14 +14037,14 @@ func (e WithdrawRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWithdrawRequest.%s: %s%s",
+		"invalid %sAdminConfigUpdateRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WithdrawRequestValidationError{}
+var _ error = AdminConfigUpdateRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2619,46 +14052,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WithdrawRequestValidationError{}
+} = AdminConfigUpdateRequestValidationError{}
 
-// Validate checks the field values on WithdrawReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *WithdrawReply) Validate() error {
+// Validate checks the field values on AdminConfigUpdateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminConfigUpdateReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WithdrawReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in WithdrawReplyMultiError, or
-// nil if none found.
-func (m *WithdrawReply) ValidateAll() error {
+// ValidateAll checks the field values on AdminConfigUpdateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminConfigUpdateReplyMultiError, or nil if none found.
+func (m *AdminConfigUpdateReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WithdrawReply) validate(all bool) error {
+func (m *AdminConfigUpdateReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Status
-
 	if len(errors) > 0 {
-		return WithdrawReplyMultiError(errors)
+		return AdminConfigUpdateReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// WithdrawReplyMultiError is an error wrapping multiple validation errors
-// returned by WithdrawReply.ValidateAll() if the designated constraints
-// aren't met.
-type WithdrawReplyMultiError []error
+// AdminConfigUpdateReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminConfigUpdateReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminConfigUpdateReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WithdrawReplyMultiError) Error() string {
+func (m AdminConfigUpdateReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2667,11 +14098,11 @@ func (m WithdrawReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WithdrawReplyMultiError) AllErrors() []error { return m }
+func (m AdminConfigUpdateReplyMultiError) AllErrors() []error { return m }
 
-// WithdrawReplyValidationError is the validation error returned by
-// WithdrawReply.Validate if the designated constraints aren't met.
-type WithdrawReplyValidationError struct {
+// AdminConfigUpdateReplyValidationError is the validation error returned by
+// AdminConfigUpdateReply.Validate if the designated constraints aren't met.
+type AdminConfigUpdateReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2679,22 +14110,24 @@ type WithdrawReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e WithdrawReplyValidationError) Field() string { return e.field }
+func (e AdminConfigUpdateReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WithdrawReplyValidationError) Reason() string { return e.reason }
+func (e AdminConfigUpdateReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WithdrawReplyValidationError) Cause() error { return e.cause }
+func (e AdminConfigUpdateReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WithdrawReplyValidationError) Key() bool { return e.key }
+func (e AdminConfigUpdateReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WithdrawReplyValidationError) ErrorName() string { return "WithdrawReplyValidationError" }
+func (e AdminConfigUpdateReplyValidationError) ErrorName() string {
+	return "AdminConfigUpdateReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e WithdrawReplyValidationError) Error() string {
+func (e AdminConfigUpdateReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2706,14 +14139,14 @@ func (e WithdrawReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWithdrawReply.%s: %s%s",
+		"invalid %sAdminConfigUpdateReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WithdrawReplyValidationError{}
+var _ error = AdminConfigUpdateReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2721,73 +14154,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WithdrawReplyValidationError{}
+} = AdminConfigUpdateReplyValidationError{}
 
-// Validate checks the field values on SetBalanceRewardRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on EthAuthorizeRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetBalanceRewardRequest) Validate() error {
+func (m *EthAuthorizeRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetBalanceRewardRequest with the
+// ValidateAll checks the field values on EthAuthorizeRequest_SendBody with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SetBalanceRewardRequestMultiError, or nil if none found.
-func (m *SetBalanceRewardRequest) ValidateAll() error {
+// EthAuthorizeRequest_SendBodyMultiError, or nil if none found.
+func (m *EthAuthorizeRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetBalanceRewardRequest) validate(all bool) error {
+func (m *EthAuthorizeRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSendBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SetBalanceRewardRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SetBalanceRewardRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SetBalanceRewardRequestValidationError{
-				field:  "SendBody",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Address
+
+	// no validation rules for Code
+
+	// no validation rules for Sign
+
+	// no validation rules for Message
 
 	if len(errors) > 0 {
-		return SetBalanceRewardRequestMultiError(errors)
+		return EthAuthorizeRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetBalanceRewardRequestMultiError is an error wrapping multiple validation
-// errors returned by SetBalanceRewardRequest.ValidateAll() if the designated
-// constraints aren't met.
-type SetBalanceRewardRequestMultiError []error
+// EthAuthorizeRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by EthAuthorizeRequest_SendBody.ValidateAll() if
+// the designated constraints aren't met.
+type EthAuthorizeRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetBalanceRewardRequestMultiError) Error() string {
+func (m EthAuthorizeRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2796,11 +14208,12 @@ func (m SetBalanceRewardRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetBalanceRewardRequestMultiError) AllErrors() []error { return m }
+func (m EthAuthorizeRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// SetBalanceRewardRequestValidationError is the validation error returned by
-// SetBalanceRewardRequest.Validate if the designated constraints aren't met.
-type SetBalanceRewardRequestValidationError struct {
+// EthAuthorizeRequest_SendBodyValidationError is the validation error returned
+// by EthAuthorizeRequest_SendBody.Validate if the designated constraints
+// aren't met.
+type EthAuthorizeRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2808,24 +14221,24 @@ type SetBalanceRewardRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetBalanceRewardRequestValidationError) Field() string { return e.field }
+func (e EthAuthorizeRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetBalanceRewardRequestValidationError) Reason() string { return e.reason }
+func (e EthAuthorizeRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetBalanceRewardRequestValidationError) Cause() error { return e.cause }
+func (e EthAuthorizeRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetBalanceRewardRequestValidationError) Key() bool { return e.key }
+func (e EthAuthorizeRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetBalanceRewardRequestValidationError) ErrorName() string {
-	return "SetBalanceRewardRequestValidationError"
+func (e EthAuthorizeRequest_SendBodyValidationError) ErrorName() string {
+	return "EthAuthorizeRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetBalanceRewardRequestValidationError) Error() string {
+func (e EthAuthorizeRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2837,14 +14250,14 @@ func (e SetBalanceRewardRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetBalanceRewardRequest.%s: %s%s",
+		"invalid %sEthAuthorizeRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetBalanceRewardRequestValidationError{}
+var _ error = EthAuthorizeRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2852,46 +14265,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetBalanceRewardRequestValidationError{}
+} = EthAuthorizeRequest_SendBodyValidationError{}
 
-// Validate checks the field values on SetBalanceRewardReply with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetAuthNonceRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetBalanceRewardReply) Validate() error {
+func (m *GetAuthNonceRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetBalanceRewardReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetAuthNonceRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SetBalanceRewardReplyMultiError, or nil if none found.
-func (m *SetBalanceRewardReply) ValidateAll() error {
+// GetAuthNonceRequest_SendBodyMultiError, or nil if none found.
+func (m *GetAuthNonceRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetBalanceRewardReply) validate(all bool) error {
+func (m *GetAuthNonceRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Status
+	// no validation rules for Address
 
 	if len(errors) > 0 {
-		return SetBalanceRewardReplyMultiError(errors)
+		return GetAuthNonceRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetBalanceRewardReplyMultiError is an error wrapping multiple validation
-// errors returned by SetBalanceRewardReply.ValidateAll() if the designated
-// constraints aren't met.
-type SetBalanceRewardReplyMultiError []error
+// GetAuthNonceRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by GetAuthNonceRequest_SendBody.ValidateAll() if
+// the designated constraints aren't met.
+type GetAuthNonceRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetBalanceRewardReplyMultiError) Error() string {
+func (m GetAuthNonceRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2900,11 +14313,12 @@ func (m SetBalanceRewardReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetBalanceRewardReplyMultiError) AllErrors() []error { return m }
+func (m GetAuthNonceRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// SetBalanceRewardReplyValidationError is the validation error returned by
-// SetBalanceRewardReply.Validate if the designated constraints aren't met.
-type SetBalanceRewardReplyValidationError struct {
+// GetAuthNonceRequest_SendBodyValidationError is the validation error returned
+// by GetAuthNonceRequest_SendBody.Validate if the designated constraints
+// aren't met.
+type GetAuthNonceRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2912,24 +14326,24 @@ type SetBalanceRewardReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetBalanceRewardReplyValidationError) Field() string { return e.field }
+func (e GetAuthNonceRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetBalanceRewardReplyValidationError) Reason() string { return e.reason }
+func (e GetAuthNonceRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetBalanceRewardReplyValidationError) Cause() error { return e.cause }
+func (e GetAuthNonceRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetBalanceRewardReplyValidationError) Key() bool { return e.key }
+func (e GetAuthNonceRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetBalanceRewardReplyValidationError) ErrorName() string {
-	return "SetBalanceRewardReplyValidationError"
+func (e GetAuthNonceRequest_SendBodyValidationError) ErrorName() string {
+	return "GetAuthNonceRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetBalanceRewardReplyValidationError) Error() string {
+func (e GetAuthNonceRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2941,14 +14355,14 @@ func (e SetBalanceRewardReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetBalanceRewardReply.%s: %s%s",
+		"invalid %sGetAuthNonceRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetBalanceRewardReplyValidationError{}
+var _ error = GetAuthNonceRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2956,73 +14370,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetBalanceRewardReplyValidationError{}
+} = GetAuthNonceRequest_SendBodyValidationError{}
 
-// Validate checks the field values on DeleteBalanceRewardRequest with the
+// Validate checks the field values on RefreshTokenRequest_SendBody with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteBalanceRewardRequest) Validate() error {
+func (m *RefreshTokenRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteBalanceRewardRequest with the
+// ValidateAll checks the field values on RefreshTokenRequest_SendBody with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteBalanceRewardRequestMultiError, or nil if none found.
-func (m *DeleteBalanceRewardRequest) ValidateAll() error {
+// RefreshTokenRequest_SendBodyMultiError, or nil if none found.
+func (m *RefreshTokenRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
-}
-
-func (m *DeleteBalanceRewardRequest) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if all {
-		switch v := interface{}(m.GetSendBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DeleteBalanceRewardRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, DeleteBalanceRewardRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return DeleteBalanceRewardRequestValidationError{
-				field:  "SendBody",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+}
+
+func (m *RefreshTokenRequest_SendBody) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for RefreshToken
+
 	if len(errors) > 0 {
-		return DeleteBalanceRewardRequestMultiError(errors)
+		return RefreshTokenRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteBalanceRewardRequestMultiError is an error wrapping multiple
-// validation errors returned by DeleteBalanceRewardRequest.ValidateAll() if
+// RefreshTokenRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by RefreshTokenRequest_SendBody.ValidateAll() if
 // the designated constraints aren't met.
-type DeleteBalanceRewardRequestMultiError []error
+type RefreshTokenRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteBalanceRewardRequestMultiError) Error() string {
+func (m RefreshTokenRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3031,11 +14418,12 @@ func (m DeleteBalanceRewardRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteBalanceRewardRequestMultiError) AllErrors() []error { return m }
+func (m RefreshTokenRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// DeleteBalanceRewardRequestValidationError is the validation error returned
-// by DeleteBalanceRewardRequest.Validate if the designated constraints aren't met.
-type DeleteBalanceRewardRequestValidationError struct {
+// RefreshTokenRequest_SendBodyValidationError is the validation error returned
+// by RefreshTokenRequest_SendBody.Validate if the designated constraints
+// aren't met.
+type RefreshTokenRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3043,24 +14431,24 @@ type DeleteBalanceRewardRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteBalanceRewardRequestValidationError) Field() string { return e.field }
+func (e RefreshTokenRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteBalanceRewardRequestValidationError) Reason() string { return e.reason }
+func (e RefreshTokenRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteBalanceRewardRequestValidationError) Cause() error { return e.cause }
+func (e RefreshTokenRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteBalanceRewardRequestValidationError) Key() bool { return e.key }
+func (e RefreshTokenRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteBalanceRewardRequestValidationError) ErrorName() string {
-	return "DeleteBalanceRewardRequestValidationError"
+func (e RefreshTokenRequest_SendBodyValidationError) ErrorName() string {
+	return "RefreshTokenRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteBalanceRewardRequestValidationError) Error() string {
+func (e RefreshTokenRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3072,14 +14460,14 @@ func (e DeleteBalanceRewardRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteBalanceRewardRequest.%s: %s%s",
+		"invalid %sRefreshTokenRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteBalanceRewardRequestValidationError{}
+var _ error = RefreshTokenRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -3087,46 +14475,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteBalanceRewardRequestValidationError{}
+} = RefreshTokenRequest_SendBodyValidationError{}
 
-// Validate checks the field values on DeleteBalanceRewardReply with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RecommendUpdateRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteBalanceRewardReply) Validate() error {
+func (m *RecommendUpdateRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteBalanceRewardReply with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteBalanceRewardReplyMultiError, or nil if none found.
-func (m *DeleteBalanceRewardReply) ValidateAll() error {
+// ValidateAll checks the field values on RecommendUpdateRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RecommendUpdateRequest_SendBodyMultiError, or nil if none found.
+func (m *RecommendUpdateRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteBalanceRewardReply) validate(all bool) error {
+func (m *RecommendUpdateRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Status
+	// no validation rules for Code
 
 	if len(errors) > 0 {
-		return DeleteBalanceRewardReplyMultiError(errors)
+		return RecommendUpdateRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteBalanceRewardReplyMultiError is an error wrapping multiple validation
-// errors returned by DeleteBalanceRewardReply.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteBalanceRewardReplyMultiError []error
+// RecommendUpdateRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by RecommendUpdateRequest_SendBody.ValidateAll()
+// if the designated constraints aren't met.
+type RecommendUpdateRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteBalanceRewardReplyMultiError) Error() string {
+func (m RecommendUpdateRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3135,11 +14523,12 @@ func (m DeleteBalanceRewardReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteBalanceRewardReplyMultiError) AllErrors() []error { return m }
+func (m RecommendUpdateRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// DeleteBalanceRewardReplyValidationError is the validation error returned by
-// DeleteBalanceRewardReply.Validate if the designated constraints aren't met.
-type DeleteBalanceRewardReplyValidationError struct {
+// RecommendUpdateRequest_SendBodyValidationError is the validation error
+// returned by RecommendUpdateRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type RecommendUpdateRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3147,24 +14536,24 @@ type DeleteBalanceRewardReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteBalanceRewardReplyValidationError) Field() string { return e.field }
+func (e RecommendUpdateRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteBalanceRewardReplyValidationError) Reason() string { return e.reason }
+func (e RecommendUpdateRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteBalanceRewardReplyValidationError) Cause() error { return e.cause }
+func (e RecommendUpdateRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteBalanceRewardReplyValidationError) Key() bool { return e.key }
+func (e RecommendUpdateRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteBalanceRewardReplyValidationError) ErrorName() string {
-	return "DeleteBalanceRewardReplyValidationError"
+func (e RecommendUpdateRequest_SendBodyValidationError) ErrorName() string {
+	return "RecommendUpdateRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteBalanceRewardReplyValidationError) Error() string {
+func (e RecommendUpdateRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3176,14 +14565,14 @@ func (e DeleteBalanceRewardReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteBalanceRewardReply.%s: %s%s",
+		"invalid %sRecommendUpdateRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteBalanceRewardReplyValidationError{}
+var _ error = RecommendUpdateRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -3191,48 +14580,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteBalanceRewardReplyValidationError{}
+} = RecommendUpdateRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminRewardListRequest with the rules
+// Validate checks the field values on UserInfoReply_List with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminRewardListRequest) Validate() error {
+func (m *UserInfoReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminRewardListRequest with the rules
+// ValidateAll checks the field values on UserInfoReply_List with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminRewardListRequestMultiError, or nil if none found.
-func (m *AdminRewardListRequest) ValidateAll() error {
+// UserInfoReply_ListMultiError, or nil if none found.
+func (m *UserInfoReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminRewardListRequest) validate(all bool) error {
+func (m *UserInfoReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Page
+	// no validation rules for CreatedAt
 
-	// no validation rules for Address
+	// no validation rules for Amount
+
+	// no validation rules for LocationStatus
+
+	// no validation rules for AmountMax
+
+	// no validation rules for OutRate
 
 	if len(errors) > 0 {
-		return AdminRewardListRequestMultiError(errors)
+		return UserInfoReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminRewardListRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminRewardListRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AdminRewardListRequestMultiError []error
+// UserInfoReply_ListMultiError is an error wrapping multiple validation errors
+// returned by UserInfoReply_List.ValidateAll() if the designated constraints
+// aren't met.
+type UserInfoReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminRewardListRequestMultiError) Error() string {
+func (m UserInfoReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3241,11 +14636,11 @@ func (m AdminRewardListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminRewardListRequestMultiError) AllErrors() []error { return m }
+func (m UserInfoReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminRewardListRequestValidationError is the validation error returned by
-// AdminRewardListRequest.Validate if the designated constraints aren't met.
-type AdminRewardListRequestValidationError struct {
+// UserInfoReply_ListValidationError is the validation error returned by
+// UserInfoReply_List.Validate if the designated constraints aren't met.
+type UserInfoReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3253,24 +14648,24 @@ type AdminRewardListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminRewardListRequestValidationError) Field() string { return e.field }
+func (e UserInfoReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminRewardListRequestValidationError) Reason() string { return e.reason }
+func (e UserInfoReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminRewardListRequestValidationError) Cause() error { return e.cause }
+func (e UserInfoReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminRewardListRequestValidationError) Key() bool { return e.key }
+func (e UserInfoReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminRewardListRequestValidationError) ErrorName() string {
-	return "AdminRewardListRequestValidationError"
+func (e UserInfoReply_ListValidationError) ErrorName() string {
+	return "UserInfoReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminRewardListRequestValidationError) Error() string {
+func (e UserInfoReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3282,14 +14677,14 @@ func (e AdminRewardListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminRewardListRequest.%s: %s%s",
+		"invalid %sUserInfoReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminRewardListRequestValidationError{}
+var _ error = UserInfoReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -3297,80 +14692,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminRewardListRequestValidationError{}
+} = UserInfoReply_ListValidationError{}
 
-// Validate checks the field values on AdminRewardListReply with the rules
+// Validate checks the field values on UserInfoReply_List2 with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminRewardListReply) Validate() error {
+func (m *UserInfoReply_List2) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminRewardListReply with the rules
+// ValidateAll checks the field values on UserInfoReply_List2 with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminRewardListReplyMultiError, or nil if none found.
-func (m *AdminRewardListReply) ValidateAll() error {
+// UserInfoReply_List2MultiError, or nil if none found.
+func (m *UserInfoReply_List2) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminRewardListReply) validate(all bool) error {
+func (m *UserInfoReply_List2) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetRewards() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminRewardListReplyValidationError{
-						field:  fmt.Sprintf("Rewards[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminRewardListReplyValidationError{
-						field:  fmt.Sprintf("Rewards[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AdminRewardListReplyValidationError{
-					field:  fmt.Sprintf("Rewards[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for CreatedAt
 
-	}
+	// no validation rules for Amount
 
-	// no validation rules for Count
+	// no validation rules for RecommendNum
 
 	if len(errors) > 0 {
-		return AdminRewardListReplyMultiError(errors)
+		return UserInfoReply_List2MultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminRewardListReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminRewardListReply.ValidateAll() if the designated
+// UserInfoReply_List2MultiError is an error wrapping multiple validation
+// errors returned by UserInfoReply_List2.ValidateAll() if the designated
 // constraints aren't met.
-type AdminRewardListReplyMultiError []error
+type UserInfoReply_List2MultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminRewardListReplyMultiError) Error() string {
+func (m UserInfoReply_List2MultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3379,11 +14744,11 @@ func (m AdminRewardListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminRewardListReplyMultiError) AllErrors() []error { return m }
+func (m UserInfoReply_List2MultiError) AllErrors() []error { return m }
 
-// AdminRewardListReplyValidationError is the validation error returned by
-// AdminRewardListReply.Validate if the designated constraints aren't met.
-type AdminRewardListReplyValidationError struct {
+// UserInfoReply_List2ValidationError is the validation error returned by
+// UserInfoReply_List2.Validate if the designated constraints aren't met.
+type UserInfoReply_List2ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3391,24 +14756,24 @@ type AdminRewardListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminRewardListReplyValidationError) Field() string { return e.field }
+func (e UserInfoReply_List2ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminRewardListReplyValidationError) Reason() string { return e.reason }
+func (e UserInfoReply_List2ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminRewardListReplyValidationError) Cause() error { return e.cause }
+func (e UserInfoReply_List2ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminRewardListReplyValidationError) Key() bool { return e.key }
+func (e UserInfoReply_List2ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminRewardListReplyValidationError) ErrorName() string {
-	return "AdminRewardListReplyValidationError"
+func (e UserInfoReply_List2ValidationError) ErrorName() string {
+	return "UserInfoReply_List2ValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminRewardListReplyValidationError) Error() string {
+func (e UserInfoReply_List2ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3420,14 +14785,14 @@ func (e AdminRewardListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminRewardListReply.%s: %s%s",
+		"invalid %sUserInfoReply_List2.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminRewardListReplyValidationError{}
+var _ error = UserInfoReply_List2ValidationError{}
 
 var _ interface {
 	Field() string
@@ -3435,48 +14800,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminRewardListReplyValidationError{}
+} = UserInfoReply_List2ValidationError{}
 
-// Validate checks the field values on AdminUserListRequest with the rules
+// Validate checks the field values on UserInfoReply_List3 with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUserListRequest) Validate() error {
+func (m *UserInfoReply_List3) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUserListRequest with the rules
+// ValidateAll checks the field values on UserInfoReply_List3 with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminUserListRequestMultiError, or nil if none found.
-func (m *AdminUserListRequest) ValidateAll() error {
+// UserInfoReply_List3MultiError, or nil if none found.
+func (m *UserInfoReply_List3) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUserListRequest) validate(all bool) error {
+func (m *UserInfoReply_List3) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Page
+	// no validation rules for CreatedAt
 
-	// no validation rules for Address
+	// no validation rules for Amount
 
 	if len(errors) > 0 {
-		return AdminUserListRequestMultiError(errors)
+		return UserInfoReply_List3MultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUserListRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminUserListRequest.ValidateAll() if the designated
+// UserInfoReply_List3MultiError is an error wrapping multiple validation
+// errors returned by UserInfoReply_List3.ValidateAll() if the designated
 // constraints aren't met.
-type AdminUserListRequestMultiError []error
+type UserInfoReply_List3MultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUserListRequestMultiError) Error() string {
+func (m UserInfoReply_List3MultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3485,11 +14850,11 @@ func (m AdminUserListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUserListRequestMultiError) AllErrors() []error { return m }
+func (m UserInfoReply_List3MultiError) AllErrors() []error { return m }
 
-// AdminUserListRequestValidationError is the validation error returned by
-// AdminUserListRequest.Validate if the designated constraints aren't met.
-type AdminUserListRequestValidationError struct {
+// UserInfoReply_List3ValidationError is the validation error returned by
+// UserInfoReply_List3.Validate if the designated constraints aren't met.
+type UserInfoReply_List3ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3497,24 +14862,24 @@ type AdminUserListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUserListRequestValidationError) Field() string { return e.field }
+func (e UserInfoReply_List3ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUserListRequestValidationError) Reason() string { return e.reason }
+func (e UserInfoReply_List3ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUserListRequestValidationError) Cause() error { return e.cause }
+func (e UserInfoReply_List3ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUserListRequestValidationError) Key() bool { return e.key }
+func (e UserInfoReply_List3ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUserListRequestValidationError) ErrorName() string {
-	return "AdminUserListRequestValidationError"
+func (e UserInfoReply_List3ValidationError) ErrorName() string {
+	return "UserInfoReply_List3ValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUserListRequestValidationError) Error() string {
+func (e UserInfoReply_List3ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3526,14 +14891,14 @@ func (e AdminUserListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUserListRequest.%s: %s%s",
+		"invalid %sUserInfoReply_List3.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUserListRequestValidationError{}
+var _ error = UserInfoReply_List3ValidationError{}
 
 var _ interface {
 	Field() string
@@ -3541,80 +14906,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUserListRequestValidationError{}
+} = UserInfoReply_List3ValidationError{}
 
-// Validate checks the field values on AdminUserListReply with the rules
+// Validate checks the field values on UserInfoReply_List4 with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUserListReply) Validate() error {
+func (m *UserInfoReply_List4) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUserListReply with the rules
+// ValidateAll checks the field values on UserInfoReply_List4 with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminUserListReplyMultiError, or nil if none found.
-func (m *AdminUserListReply) ValidateAll() error {
+// UserInfoReply_List4MultiError, or nil if none found.
+func (m *UserInfoReply_List4) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUserListReply) validate(all bool) error {
+func (m *UserInfoReply_List4) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUsers() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminUserListReplyValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminUserListReplyValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AdminUserListReplyValidationError{
-					field:  fmt.Sprintf("Users[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for CreatedAt
 
-	// no validation rules for Count
+	// no validation rules for Amount
 
 	if len(errors) > 0 {
-		return AdminUserListReplyMultiError(errors)
+		return UserInfoReply_List4MultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUserListReplyMultiError is an error wrapping multiple validation errors
-// returned by AdminUserListReply.ValidateAll() if the designated constraints
-// aren't met.
-type AdminUserListReplyMultiError []error
+// UserInfoReply_List4MultiError is an error wrapping multiple validation
+// errors returned by UserInfoReply_List4.ValidateAll() if the designated
+// constraints aren't met.
+type UserInfoReply_List4MultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUserListReplyMultiError) Error() string {
+func (m UserInfoReply_List4MultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3623,11 +14956,11 @@ func (m AdminUserListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUserListReplyMultiError) AllErrors() []error { return m }
+func (m UserInfoReply_List4MultiError) AllErrors() []error { return m }
 
-// AdminUserListReplyValidationError is the validation error returned by
-// AdminUserListReply.Validate if the designated constraints aren't met.
-type AdminUserListReplyValidationError struct {
+// UserInfoReply_List4ValidationError is the validation error returned by
+// UserInfoReply_List4.Validate if the designated constraints aren't met.
+type UserInfoReply_List4ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3635,24 +14968,24 @@ type AdminUserListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUserListReplyValidationError) Field() string { return e.field }
+func (e UserInfoReply_List4ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUserListReplyValidationError) Reason() string { return e.reason }
+func (e UserInfoReply_List4ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUserListReplyValidationError) Cause() error { return e.cause }
+func (e UserInfoReply_List4ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUserListReplyValidationError) Key() bool { return e.key }
+func (e UserInfoReply_List4ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUserListReplyValidationError) ErrorName() string {
-	return "AdminUserListReplyValidationError"
+func (e UserInfoReply_List4ValidationError) ErrorName() string {
+	return "UserInfoReply_List4ValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUserListReplyValidationError) Error() string {
+func (e UserInfoReply_List4ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3664,14 +14997,14 @@ func (e AdminUserListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUserListReply.%s: %s%s",
+		"invalid %sUserInfoReply_List4.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUserListReplyValidationError{}
+var _ error = UserInfoReply_List4ValidationError{}
 
 var _ interface {
 	Field() string
@@ -3679,48 +15012,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUserListReplyValidationError{}
+} = UserInfoReply_List4ValidationError{}
 
-// Validate checks the field values on AdminLocationListRequest with the rules
+// Validate checks the field values on UserInfoReply_List5 with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminLocationListRequest) Validate() error {
+func (m *UserInfoReply_List5) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminLocationListRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UserInfoReply_List5 with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminLocationListRequestMultiError, or nil if none found.
-func (m *AdminLocationListRequest) ValidateAll() error {
+// UserInfoReply_List5MultiError, or nil if none found.
+func (m *UserInfoReply_List5) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLocationListRequest) validate(all bool) error {
+func (m *UserInfoReply_List5) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Page
+	// no validation rules for CreatedAt
 
-	// no validation rules for Address
+	// no validation rules for Amount
 
 	if len(errors) > 0 {
-		return AdminLocationListRequestMultiError(errors)
+		return UserInfoReply_List5MultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLocationListRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminLocationListRequest.ValidateAll() if the designated
+// UserInfoReply_List5MultiError is an error wrapping multiple validation
+// errors returned by UserInfoReply_List5.ValidateAll() if the designated
 // constraints aren't met.
-type AdminLocationListRequestMultiError []error
+type UserInfoReply_List5MultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLocationListRequestMultiError) Error() string {
+func (m UserInfoReply_List5MultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3729,11 +15062,11 @@ func (m AdminLocationListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLocationListRequestMultiError) AllErrors() []error { return m }
+func (m UserInfoReply_List5MultiError) AllErrors() []error { return m }
 
-// AdminLocationListRequestValidationError is the validation error returned by
-// AdminLocationListRequest.Validate if the designated constraints aren't met.
-type AdminLocationListRequestValidationError struct {
+// UserInfoReply_List5ValidationError is the validation error returned by
+// UserInfoReply_List5.Validate if the designated constraints aren't met.
+type UserInfoReply_List5ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3741,24 +15074,24 @@ type AdminLocationListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLocationListRequestValidationError) Field() string { return e.field }
+func (e UserInfoReply_List5ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLocationListRequestValidationError) Reason() string { return e.reason }
+func (e UserInfoReply_List5ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLocationListRequestValidationError) Cause() error { return e.cause }
+func (e UserInfoReply_List5ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLocationListRequestValidationError) Key() bool { return e.key }
+func (e UserInfoReply_List5ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLocationListRequestValidationError) ErrorName() string {
-	return "AdminLocationListRequestValidationError"
+func (e UserInfoReply_List5ValidationError) ErrorName() string {
+	return "UserInfoReply_List5ValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminLocationListRequestValidationError) Error() string {
+func (e UserInfoReply_List5ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3770,14 +15103,14 @@ func (e AdminLocationListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLocationListRequest.%s: %s%s",
+		"invalid %sUserInfoReply_List5.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLocationListRequestValidationError{}
+var _ error = UserInfoReply_List5ValidationError{}
 
 var _ interface {
 	Field() string
@@ -3785,80 +15118,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLocationListRequestValidationError{}
+} = UserInfoReply_List5ValidationError{}
 
-// Validate checks the field values on AdminLocationListReply with the rules
+// Validate checks the field values on UserInfoReply_List6 with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminLocationListReply) Validate() error {
+func (m *UserInfoReply_List6) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminLocationListReply with the rules
+// ValidateAll checks the field values on UserInfoReply_List6 with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminLocationListReplyMultiError, or nil if none found.
-func (m *AdminLocationListReply) ValidateAll() error {
+// UserInfoReply_List6MultiError, or nil if none found.
+func (m *UserInfoReply_List6) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLocationListReply) validate(all bool) error {
+func (m *UserInfoReply_List6) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetLocations() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminLocationListReplyValidationError{
-						field:  fmt.Sprintf("Locations[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminLocationListReplyValidationError{
-						field:  fmt.Sprintf("Locations[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AdminLocationListReplyValidationError{
-					field:  fmt.Sprintf("Locations[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for CreatedAt
 
-	// no validation rules for Count
+	// no validation rules for Amount
 
 	if len(errors) > 0 {
-		return AdminLocationListReplyMultiError(errors)
+		return UserInfoReply_List6MultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLocationListReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminLocationListReply.ValidateAll() if the designated
+// UserInfoReply_List6MultiError is an error wrapping multiple validation
+// errors returned by UserInfoReply_List6.ValidateAll() if the designated
 // constraints aren't met.
-type AdminLocationListReplyMultiError []error
+type UserInfoReply_List6MultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLocationListReplyMultiError) Error() string {
+func (m UserInfoReply_List6MultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3867,11 +15168,11 @@ func (m AdminLocationListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLocationListReplyMultiError) AllErrors() []error { return m }
+func (m UserInfoReply_List6MultiError) AllErrors() []error { return m }
 
-// AdminLocationListReplyValidationError is the validation error returned by
-// AdminLocationListReply.Validate if the designated constraints aren't met.
-type AdminLocationListReplyValidationError struct {
+// UserInfoReply_List6ValidationError is the validation error returned by
+// UserInfoReply_List6.Validate if the designated constraints aren't met.
+type UserInfoReply_List6ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3879,24 +15180,24 @@ type AdminLocationListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLocationListReplyValidationError) Field() string { return e.field }
+func (e UserInfoReply_List6ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLocationListReplyValidationError) Reason() string { return e.reason }
+func (e UserInfoReply_List6ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLocationListReplyValidationError) Cause() error { return e.cause }
+func (e UserInfoReply_List6ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLocationListReplyValidationError) Key() bool { return e.key }
+func (e UserInfoReply_List6ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLocationListReplyValidationError) ErrorName() string {
-	return "AdminLocationListReplyValidationError"
+func (e UserInfoReply_List6ValidationError) ErrorName() string {
+	return "UserInfoReply_List6ValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminLocationListReplyValidationError) Error() string {
+func (e UserInfoReply_List6ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3908,14 +15209,14 @@ func (e AdminLocationListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLocationListReply.%s: %s%s",
+		"invalid %sUserInfoReply_List6.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLocationListReplyValidationError{}
+var _ error = UserInfoReply_List6ValidationError{}
 
 var _ interface {
 	Field() string
@@ -3923,48 +15224,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLocationListReplyValidationError{}
+} = UserInfoReply_List6ValidationError{}
 
-// Validate checks the field values on AdminWithdrawListRequest with the rules
+// Validate checks the field values on UserInfoReply_List7 with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawListRequest) Validate() error {
+func (m *UserInfoReply_List7) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawListRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UserInfoReply_List7 with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminWithdrawListRequestMultiError, or nil if none found.
-func (m *AdminWithdrawListRequest) ValidateAll() error {
+// UserInfoReply_List7MultiError, or nil if none found.
+func (m *UserInfoReply_List7) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawListRequest) validate(all bool) error {
+func (m *UserInfoReply_List7) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Page
-
 	// no validation rules for Address
 
+	// no validation rules for Amount
+
 	if len(errors) > 0 {
-		return AdminWithdrawListRequestMultiError(errors)
+		return UserInfoReply_List7MultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawListRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminWithdrawListRequest.ValidateAll() if the designated
+// UserInfoReply_List7MultiError is an error wrapping multiple validation
+// errors returned by UserInfoReply_List7.ValidateAll() if the designated
 // constraints aren't met.
-type AdminWithdrawListRequestMultiError []error
+type UserInfoReply_List7MultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawListRequestMultiError) Error() string {
+func (m UserInfoReply_List7MultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3973,11 +15274,11 @@ func (m AdminWithdrawListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawListRequestMultiError) AllErrors() []error { return m }
+func (m UserInfoReply_List7MultiError) AllErrors() []error { return m }
 
-// AdminWithdrawListRequestValidationError is the validation error returned by
-// AdminWithdrawListRequest.Validate if the designated constraints aren't met.
-type AdminWithdrawListRequestValidationError struct {
+// UserInfoReply_List7ValidationError is the validation error returned by
+// UserInfoReply_List7.Validate if the designated constraints aren't met.
+type UserInfoReply_List7ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3985,24 +15286,24 @@ type AdminWithdrawListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawListRequestValidationError) Field() string { return e.field }
+func (e UserInfoReply_List7ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawListRequestValidationError) Reason() string { return e.reason }
+func (e UserInfoReply_List7ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawListRequestValidationError) Cause() error { return e.cause }
+func (e UserInfoReply_List7ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawListRequestValidationError) Key() bool { return e.key }
+func (e UserInfoReply_List7ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawListRequestValidationError) ErrorName() string {
-	return "AdminWithdrawListRequestValidationError"
+func (e UserInfoReply_List7ValidationError) ErrorName() string {
+	return "UserInfoReply_List7ValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawListRequestValidationError) Error() string {
+func (e UserInfoReply_List7ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4014,14 +15315,14 @@ func (e AdminWithdrawListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawListRequest.%s: %s%s",
+		"invalid %sUserInfoReply_List7.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawListRequestValidationError{}
+var _ error = UserInfoReply_List7ValidationError{}
 
 var _ interface {
 	Field() string
@@ -4029,80 +15330,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawListRequestValidationError{}
+} = UserInfoReply_List7ValidationError{}
 
-// Validate checks the field values on AdminWithdrawListReply with the rules
+// Validate checks the field values on UserInfoReply_List8 with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawListReply) Validate() error {
+func (m *UserInfoReply_List8) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawListReply with the rules
+// ValidateAll checks the field values on UserInfoReply_List8 with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminWithdrawListReplyMultiError, or nil if none found.
-func (m *AdminWithdrawListReply) ValidateAll() error {
+// UserInfoReply_List8MultiError, or nil if none found.
+func (m *UserInfoReply_List8) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawListReply) validate(all bool) error {
+func (m *UserInfoReply_List8) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetWithdraw() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminWithdrawListReplyValidationError{
-						field:  fmt.Sprintf("Withdraw[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminWithdrawListReplyValidationError{
-						field:  fmt.Sprintf("Withdraw[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AdminWithdrawListReplyValidationError{
-					field:  fmt.Sprintf("Withdraw[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for Count
+	// no validation rules for Address
 
 	if len(errors) > 0 {
-		return AdminWithdrawListReplyMultiError(errors)
+		return UserInfoReply_List8MultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawListReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminWithdrawListReply.ValidateAll() if the designated
+// UserInfoReply_List8MultiError is an error wrapping multiple validation
+// errors returned by UserInfoReply_List8.ValidateAll() if the designated
 // constraints aren't met.
-type AdminWithdrawListReplyMultiError []error
+type UserInfoReply_List8MultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawListReplyMultiError) Error() string {
+func (m UserInfoReply_List8MultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4111,11 +15378,11 @@ func (m AdminWithdrawListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawListReplyMultiError) AllErrors() []error { return m }
+func (m UserInfoReply_List8MultiError) AllErrors() []error { return m }
 
-// AdminWithdrawListReplyValidationError is the validation error returned by
-// AdminWithdrawListReply.Validate if the designated constraints aren't met.
-type AdminWithdrawListReplyValidationError struct {
+// UserInfoReply_List8ValidationError is the validation error returned by
+// UserInfoReply_List8.Validate if the designated constraints aren't met.
+type UserInfoReply_List8ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4123,24 +15390,24 @@ type AdminWithdrawListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawListReplyValidationError) Field() string { return e.field }
+func (e UserInfoReply_List8ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawListReplyValidationError) Reason() string { return e.reason }
+func (e UserInfoReply_List8ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawListReplyValidationError) Cause() error { return e.cause }
+func (e UserInfoReply_List8ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawListReplyValidationError) Key() bool { return e.key }
+func (e UserInfoReply_List8ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawListReplyValidationError) ErrorName() string {
-	return "AdminWithdrawListReplyValidationError"
+func (e UserInfoReply_List8ValidationError) ErrorName() string {
+	return "UserInfoReply_List8ValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawListReplyValidationError) Error() string {
+func (e UserInfoReply_List8ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4152,14 +15419,14 @@ func (e AdminWithdrawListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawListReply.%s: %s%s",
+		"invalid %sUserInfoReply_List8.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawListReplyValidationError{}
+var _ error = UserInfoReply_List8ValidationError{}
 
 var _ interface {
 	Field() string
@@ -4167,44 +15434,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawListReplyValidationError{}
+} = UserInfoReply_List8ValidationError{}
 
-// Validate checks the field values on AdminWithdrawRequest with the rules
+// Validate checks the field values on UserInfoReply_List9 with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawRequest) Validate() error {
+func (m *UserInfoReply_List9) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawRequest with the rules
+// ValidateAll checks the field values on UserInfoReply_List9 with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminWithdrawRequestMultiError, or nil if none found.
-func (m *AdminWithdrawRequest) ValidateAll() error {
+// UserInfoReply_List9MultiError, or nil if none found.
+func (m *UserInfoReply_List9) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawRequest) validate(all bool) error {
+func (m *UserInfoReply_List9) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
 	if len(errors) > 0 {
-		return AdminWithdrawRequestMultiError(errors)
+		return UserInfoReply_List9MultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminWithdrawRequest.ValidateAll() if the designated
+// UserInfoReply_List9MultiError is an error wrapping multiple validation
+// errors returned by UserInfoReply_List9.ValidateAll() if the designated
 // constraints aren't met.
-type AdminWithdrawRequestMultiError []error
+type UserInfoReply_List9MultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawRequestMultiError) Error() string {
+func (m UserInfoReply_List9MultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4213,11 +15484,11 @@ func (m AdminWithdrawRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawRequestMultiError) AllErrors() []error { return m }
+func (m UserInfoReply_List9MultiError) AllErrors() []error { return m }
 
-// AdminWithdrawRequestValidationError is the validation error returned by
-// AdminWithdrawRequest.Validate if the designated constraints aren't met.
-type AdminWithdrawRequestValidationError struct {
+// UserInfoReply_List9ValidationError is the validation error returned by
+// UserInfoReply_List9.Validate if the designated constraints aren't met.
+type UserInfoReply_List9ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4225,24 +15496,24 @@ type AdminWithdrawRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawRequestValidationError) Field() string { return e.field }
+func (e UserInfoReply_List9ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawRequestValidationError) Reason() string { return e.reason }
+func (e UserInfoReply_List9ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawRequestValidationError) Cause() error { return e.cause }
+func (e UserInfoReply_List9ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawRequestValidationError) Key() bool { return e.key }
+func (e UserInfoReply_List9ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawRequestValidationError) ErrorName() string {
-	return "AdminWithdrawRequestValidationError"
+func (e UserInfoReply_List9ValidationError) ErrorName() string {
+	return "UserInfoReply_List9ValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawRequestValidationError) Error() string {
+func (e UserInfoReply_List9ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4254,14 +15525,14 @@ func (e AdminWithdrawRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawRequest.%s: %s%s",
+		"invalid %sUserInfoReply_List9.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawRequestValidationError{}
+var _ error = UserInfoReply_List9ValidationError{}
 
 var _ interface {
 	Field() string
@@ -4269,44 +15540,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawRequestValidationError{}
+} = UserInfoReply_List9ValidationError{}
 
-// Validate checks the field values on AdminWithdrawReply with the rules
+// Validate checks the field values on RewardListReply_List with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawReply) Validate() error {
+func (m *RewardListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawReply with the rules
+// ValidateAll checks the field values on RewardListReply_List with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminWithdrawReplyMultiError, or nil if none found.
-func (m *AdminWithdrawReply) ValidateAll() error {
+// RewardListReply_ListMultiError, or nil if none found.
+func (m *RewardListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawReply) validate(all bool) error {
+func (m *RewardListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for LocationStatus
+
+	// no validation rules for Type
+
+	// no validation rules for Reason
+
+	// no validation rules for Id
+
 	if len(errors) > 0 {
-		return AdminWithdrawReplyMultiError(errors)
+		return RewardListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawReplyMultiError is an error wrapping multiple validation errors
-// returned by AdminWithdrawReply.ValidateAll() if the designated constraints
-// aren't met.
-type AdminWithdrawReplyMultiError []error
+// RewardListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by RewardListReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type RewardListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawReplyMultiError) Error() string {
+func (m RewardListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4315,11 +15598,11 @@ func (m AdminWithdrawReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawReplyMultiError) AllErrors() []error { return m }
+func (m RewardListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawReplyValidationError is the validation error returned by
-// AdminWithdrawReply.Validate if the designated constraints aren't met.
-type AdminWithdrawReplyValidationError struct {
+// RewardListReply_ListValidationError is the validation error returned by
+// RewardListReply_List.Validate if the designated constraints aren't met.
+type RewardListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4327,24 +15610,24 @@ type AdminWithdrawReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawReplyValidationError) Field() string { return e.field }
+func (e RewardListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawReplyValidationError) Reason() string { return e.reason }
+func (e RewardListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawReplyValidationError) Cause() error { return e.cause }
+func (e RewardListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawReplyValidationError) Key() bool { return e.key }
+func (e RewardListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawReplyValidationError) ErrorName() string {
-	return "AdminWithdrawReplyValidationError"
+func (e RewardListReply_ListValidationError) ErrorName() string {
+	return "RewardListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawReplyValidationError) Error() string {
+func (e RewardListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4356,14 +15639,14 @@ func (e AdminWithdrawReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawReply.%s: %s%s",
+		"invalid %sRewardListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawReplyValidationError{}
+var _ error = RewardListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -4371,44 +15654,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawReplyValidationError{}
+} = RewardListReply_ListValidationError{}
 
-// Validate checks the field values on AdminWithdrawEthRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RecommendRewardListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawEthRequest) Validate() error {
+func (m *RecommendRewardListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawEthRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminWithdrawEthRequestMultiError, or nil if none found.
-func (m *AdminWithdrawEthRequest) ValidateAll() error {
+// ValidateAll checks the field values on RecommendRewardListReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RecommendRewardListReply_ListMultiError, or nil if none found.
+func (m *RecommendRewardListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawEthRequest) validate(all bool) error {
+func (m *RecommendRewardListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for Type
+
+	// no validation rules for Reason
+
+	// no validation rules for Id
+
 	if len(errors) > 0 {
-		return AdminWithdrawEthRequestMultiError(errors)
+		return RecommendRewardListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawEthRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminWithdrawEthRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AdminWithdrawEthRequestMultiError []error
+// RecommendRewardListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by RecommendRewardListReply_List.ValidateAll()
+// if the designated constraints aren't met.
+type RecommendRewardListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawEthRequestMultiError) Error() string {
+func (m RecommendRewardListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4417,11 +15710,12 @@ func (m AdminWithdrawEthRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawEthRequestMultiError) AllErrors() []error { return m }
+func (m RecommendRewardListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawEthRequestValidationError is the validation error returned by
-// AdminWithdrawEthRequest.Validate if the designated constraints aren't met.
-type AdminWithdrawEthRequestValidationError struct {
+// RecommendRewardListReply_ListValidationError is the validation error
+// returned by RecommendRewardListReply_List.Validate if the designated
+// constraints aren't met.
+type RecommendRewardListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4429,24 +15723,24 @@ type AdminWithdrawEthRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawEthRequestValidationError) Field() string { return e.field }
+func (e RecommendRewardListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawEthRequestValidationError) Reason() string { return e.reason }
+func (e RecommendRewardListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawEthRequestValidationError) Cause() error { return e.cause }
+func (e RecommendRewardListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawEthRequestValidationError) Key() bool { return e.key }
+func (e RecommendRewardListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawEthRequestValidationError) ErrorName() string {
-	return "AdminWithdrawEthRequestValidationError"
+func (e RecommendRewardListReply_ListValidationError) ErrorName() string {
+	return "RecommendRewardListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawEthRequestValidationError) Error() string {
+func (e RecommendRewardListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4458,14 +15752,14 @@ func (e AdminWithdrawEthRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawEthRequest.%s: %s%s",
+		"invalid %sRecommendRewardListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawEthRequestValidationError{}
+var _ error = RecommendRewardListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -4473,44 +15767,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawEthRequestValidationError{}
+} = RecommendRewardListReply_ListValidationError{}
 
-// Validate checks the field values on AdminWithdrawEthReply with the rules
+// Validate checks the field values on FeeRewardListReply_List with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawEthReply) Validate() error {
+func (m *FeeRewardListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawEthReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on FeeRewardListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminWithdrawEthReplyMultiError, or nil if none found.
-func (m *AdminWithdrawEthReply) ValidateAll() error {
+// FeeRewardListReply_ListMultiError, or nil if none found.
+func (m *FeeRewardListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawEthReply) validate(all bool) error {
+func (m *FeeRewardListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for Id
+
 	if len(errors) > 0 {
-		return AdminWithdrawEthReplyMultiError(errors)
+		return FeeRewardListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawEthReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminWithdrawEthReply.ValidateAll() if the designated
+// FeeRewardListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by FeeRewardListReply_List.ValidateAll() if the designated
 // constraints aren't met.
-type AdminWithdrawEthReplyMultiError []error
+type FeeRewardListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawEthReplyMultiError) Error() string {
+func (m FeeRewardListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4519,11 +15819,11 @@ func (m AdminWithdrawEthReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawEthReplyMultiError) AllErrors() []error { return m }
+func (m FeeRewardListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawEthReplyValidationError is the validation error returned by
-// AdminWithdrawEthReply.Validate if the designated constraints aren't met.
-type AdminWithdrawEthReplyValidationError struct {
+// FeeRewardListReply_ListValidationError is the validation error returned by
+// FeeRewardListReply_List.Validate if the designated constraints aren't met.
+type FeeRewardListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4531,24 +15831,24 @@ type AdminWithdrawEthReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawEthReplyValidationError) Field() string { return e.field }
+func (e FeeRewardListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawEthReplyValidationError) Reason() string { return e.reason }
+func (e FeeRewardListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawEthReplyValidationError) Cause() error { return e.cause }
+func (e FeeRewardListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawEthReplyValidationError) Key() bool { return e.key }
+func (e FeeRewardListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawEthReplyValidationError) ErrorName() string {
-	return "AdminWithdrawEthReplyValidationError"
+func (e FeeRewardListReply_ListValidationError) ErrorName() string {
+	return "FeeRewardListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawEthReplyValidationError) Error() string {
+func (e FeeRewardListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4560,14 +15860,14 @@ func (e AdminWithdrawEthReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawEthReply.%s: %s%s",
+		"invalid %sFeeRewardListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawEthReplyValidationError{}
+var _ error = FeeRewardListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -4575,44 +15875,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawEthReplyValidationError{}
+} = FeeRewardListReply_ListValidationError{}
 
-// Validate checks the field values on AdminFeeRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AdminFeeRequest) Validate() error {
+// Validate checks the field values on WithdrawListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminFeeRequest with the rules
+// ValidateAll checks the field values on WithdrawListReply_List with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminFeeRequestMultiError, or nil if none found.
-func (m *AdminFeeRequest) ValidateAll() error {
+// WithdrawListReply_ListMultiError, or nil if none found.
+func (m *WithdrawListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminFeeRequest) validate(all bool) error {
+func (m *WithdrawListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for Type
+
+	// no validation rules for Status
+
+	// no validation rules for Reason
+
+	// no validation rules for Id
+
 	if len(errors) > 0 {
-		return AdminFeeRequestMultiError(errors)
+		return WithdrawListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminFeeRequestMultiError is an error wrapping multiple validation errors
-// returned by AdminFeeRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AdminFeeRequestMultiError []error
+// WithdrawListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by WithdrawListReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type WithdrawListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminFeeRequestMultiError) Error() string {
+func (m WithdrawListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4621,11 +15933,11 @@ func (m AdminFeeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminFeeRequestMultiError) AllErrors() []error { return m }
+func (m WithdrawListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminFeeRequestValidationError is the validation error returned by
-// AdminFeeRequest.Validate if the designated constraints aren't met.
-type AdminFeeRequestValidationError struct {
+// WithdrawListReply_ListValidationError is the validation error returned by
+// WithdrawListReply_List.Validate if the designated constraints aren't met.
+type WithdrawListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4633,22 +15945,24 @@ type AdminFeeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminFeeRequestValidationError) Field() string { return e.field }
+func (e WithdrawListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminFeeRequestValidationError) Reason() string { return e.reason }
+func (e WithdrawListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminFeeRequestValidationError) Cause() error { return e.cause }
+func (e WithdrawListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminFeeRequestValidationError) Key() bool { return e.key }
+func (e WithdrawListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminFeeRequestValidationError) ErrorName() string { return "AdminFeeRequestValidationError" }
+func (e WithdrawListReply_ListValidationError) ErrorName() string {
+	return "WithdrawListReply_ListValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AdminFeeRequestValidationError) Error() string {
+func (e WithdrawListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4660,14 +15974,14 @@ func (e AdminFeeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminFeeRequest.%s: %s%s",
+		"invalid %sWithdrawListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminFeeRequestValidationError{}
+var _ error = WithdrawListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -4675,44 +15989,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminFeeRequestValidationError{}
+} = WithdrawListReply_ListValidationError{}
 
-// Validate checks the field values on AdminFeeReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AdminFeeReply) Validate() error {
+// Validate checks the field values on RecommendListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecommendListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminFeeReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in AdminFeeReplyMultiError, or
-// nil if none found.
-func (m *AdminFeeReply) ValidateAll() error {
+// ValidateAll checks the field values on RecommendListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecommendListReply_ListMultiError, or nil if none found.
+func (m *RecommendListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminFeeReply) validate(all bool) error {
+func (m *RecommendListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Address
+
+	// no validation rules for Amount
+
+	// no validation rules for Status
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UserId
+
 	if len(errors) > 0 {
-		return AdminFeeReplyMultiError(errors)
+		return RecommendListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminFeeReplyMultiError is an error wrapping multiple validation errors
-// returned by AdminFeeReply.ValidateAll() if the designated constraints
-// aren't met.
-type AdminFeeReplyMultiError []error
+// RecommendListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by RecommendListReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type RecommendListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminFeeReplyMultiError) Error() string {
+func (m RecommendListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4721,11 +16045,11 @@ func (m AdminFeeReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminFeeReplyMultiError) AllErrors() []error { return m }
+func (m RecommendListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminFeeReplyValidationError is the validation error returned by
-// AdminFeeReply.Validate if the designated constraints aren't met.
-type AdminFeeReplyValidationError struct {
+// RecommendListReply_ListValidationError is the validation error returned by
+// RecommendListReply_List.Validate if the designated constraints aren't met.
+type RecommendListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4733,22 +16057,24 @@ type AdminFeeReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminFeeReplyValidationError) Field() string { return e.field }
+func (e RecommendListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminFeeReplyValidationError) Reason() string { return e.reason }
+func (e RecommendListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminFeeReplyValidationError) Cause() error { return e.cause }
+func (e RecommendListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminFeeReplyValidationError) Key() bool { return e.key }
+func (e RecommendListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminFeeReplyValidationError) ErrorName() string { return "AdminFeeReplyValidationError" }
+func (e RecommendListReply_ListValidationError) ErrorName() string {
+	return "RecommendListReply_ListValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AdminFeeReplyValidationError) Error() string {
+func (e RecommendListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4760,14 +16086,14 @@ func (e AdminFeeReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminFeeReply.%s: %s%s",
+		"invalid %sRecommendListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminFeeReplyValidationError{}
+var _ error = RecommendListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -4775,44 +16101,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminFeeReplyValidationError{}
+} = RecommendListReply_ListValidationError{}
 
-// Validate checks the field values on AdminAllRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AdminAllRequest) Validate() error {
+// Validate checks the field values on WithdrawRequest_SendBody with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminAllRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on WithdrawRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminAllRequestMultiError, or nil if none found.
-func (m *AdminAllRequest) ValidateAll() error {
+// WithdrawRequest_SendBodyMultiError, or nil if none found.
+func (m *WithdrawRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminAllRequest) validate(all bool) error {
+func (m *WithdrawRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Type
+
+	// no validation rules for Amount
+
+	// no validation rules for AddressId
+
 	if len(errors) > 0 {
-		return AdminAllRequestMultiError(errors)
+		return WithdrawRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminAllRequestMultiError is an error wrapping multiple validation errors
-// returned by AdminAllRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AdminAllRequestMultiError []error
+// WithdrawRequest_SendBodyMultiError is an error wrapping multiple validation
+// errors returned by WithdrawRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type WithdrawRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminAllRequestMultiError) Error() string {
+func (m WithdrawRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4821,11 +16153,11 @@ func (m AdminAllRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminAllRequestMultiError) AllErrors() []error { return m }
+func (m WithdrawRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminAllRequestValidationError is the validation error returned by
-// AdminAllRequest.Validate if the designated constraints aren't met.
-type AdminAllRequestValidationError struct {
+// WithdrawRequest_SendBodyValidationError is the validation error returned by
+// WithdrawRequest_SendBody.Validate if the designated constraints aren't met.
+type WithdrawRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4833,22 +16165,24 @@ type AdminAllRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminAllRequestValidationError) Field() string { return e.field }
+func (e WithdrawRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminAllRequestValidationError) Reason() string { return e.reason }
+func (e WithdrawRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminAllRequestValidationError) Cause() error { return e.cause }
+func (e WithdrawRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminAllRequestValidationError) Key() bool { return e.key }
+func (e WithdrawRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminAllRequestValidationError) ErrorName() string { return "AdminAllRequestValidationError" }
+func (e WithdrawRequest_SendBodyValidationError) ErrorName() string {
+	return "WithdrawRequest_SendBodyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AdminAllRequestValidationError) Error() string {
+func (e WithdrawRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4860,14 +16194,14 @@ func (e AdminAllRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminAllRequest.%s: %s%s",
+		"invalid %sWithdrawRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminAllRequestValidationError{}
+var _ error = WithdrawRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -4875,62 +16209,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminAllRequestValidationError{}
+} = WithdrawRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminAllReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AdminAllReply) Validate() error {
+// Validate checks the field values on CancelWithdrawRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelWithdrawRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminAllReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in AdminAllReplyMultiError, or
-// nil if none found.
-func (m *AdminAllReply) ValidateAll() error {
+// ValidateAll checks the field values on CancelWithdrawRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CancelWithdrawRequest_SendBodyMultiError, or nil if none found.
+func (m *CancelWithdrawRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminAllReply) validate(all bool) error {
+func (m *CancelWithdrawRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TodayTotalUser
-
-	// no validation rules for TotalUser
-
-	// no validation rules for AllBalance
-
-	// no validation rules for TodayLocation
-
-	// no validation rules for AllLocation
-
-	// no validation rules for TodayWithdraw
-
-	// no validation rules for AllWithdraw
-
-	// no validation rules for AllReward
-
-	// no validation rules for AllSystemRewardAndFee
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return AdminAllReplyMultiError(errors)
+		return CancelWithdrawRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminAllReplyMultiError is an error wrapping multiple validation errors
-// returned by AdminAllReply.ValidateAll() if the designated constraints
-// aren't met.
-type AdminAllReplyMultiError []error
+// CancelWithdrawRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by CancelWithdrawRequest_SendBody.ValidateAll()
+// if the designated constraints aren't met.
+type CancelWithdrawRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminAllReplyMultiError) Error() string {
+func (m CancelWithdrawRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4939,11 +16257,12 @@ func (m AdminAllReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminAllReplyMultiError) AllErrors() []error { return m }
+func (m CancelWithdrawRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminAllReplyValidationError is the validation error returned by
-// AdminAllReply.Validate if the designated constraints aren't met.
-type AdminAllReplyValidationError struct {
+// CancelWithdrawRequest_SendBodyValidationError is the validation error
+// returned by CancelWithdrawRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type CancelWithdrawRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4951,22 +16270,24 @@ type AdminAllReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminAllReplyValidationError) Field() string { return e.field }
+func (e CancelWithdrawRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminAllReplyValidationError) Reason() string { return e.reason }
+func (e CancelWithdrawRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminAllReplyValidationError) Cause() error { return e.cause }
+func (e CancelWithdrawRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminAllReplyValidationError) Key() bool { return e.key }
+func (e CancelWithdrawRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminAllReplyValidationError) ErrorName() string { return "AdminAllReplyValidationError" }
+func (e CancelWithdrawRequest_SendBodyValidationError) ErrorName() string {
+	return "CancelWithdrawRequest_SendBodyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AdminAllReplyValidationError) Error() string {
+func (e CancelWithdrawRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4978,14 +16299,14 @@ func (e AdminAllReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminAllReply.%s: %s%s",
+		"invalid %sCancelWithdrawRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminAllReplyValidationError{}
+var _ error = CancelWithdrawRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -4993,46 +16314,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminAllReplyValidationError{}
+} = CancelWithdrawRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminUserRecommendRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on WithdrawAddressListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUserRecommendRequest) Validate() error {
+func (m *WithdrawAddressListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUserRecommendRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminUserRecommendRequestMultiError, or nil if none found.
-func (m *AdminUserRecommendRequest) ValidateAll() error {
+// ValidateAll checks the field values on WithdrawAddressListReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// WithdrawAddressListReply_ListMultiError, or nil if none found.
+func (m *WithdrawAddressListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUserRecommendRequest) validate(all bool) error {
+func (m *WithdrawAddressListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
+	// no validation rules for Id
+
+	// no validation rules for Address
+
+	// no validation rules for Label
+
+	// no validation rules for Status
+
+	// no validation rules for ActivateAt
+
+	// no validation rules for CreatedAt
 
 	if len(errors) > 0 {
-		return AdminUserRecommendRequestMultiError(errors)
+		return WithdrawAddressListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUserRecommendRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminUserRecommendRequest.ValidateAll() if the
-// designated constraints aren't met.
-type AdminUserRecommendRequestMultiError []error
+// WithdrawAddressListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by WithdrawAddressListReply_List.ValidateAll()
+// if the designated constraints aren't met.
+type WithdrawAddressListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUserRecommendRequestMultiError) Error() string {
+func (m WithdrawAddressListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5041,11 +16372,12 @@ func (m AdminUserRecommendRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUserRecommendRequestMultiError) AllErrors() []error { return m }
+func (m WithdrawAddressListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminUserRecommendRequestValidationError is the validation error returned by
-// AdminUserRecommendRequest.Validate if the designated constraints aren't met.
-type AdminUserRecommendRequestValidationError struct {
+// WithdrawAddressListReply_ListValidationError is the validation error
+// returned by WithdrawAddressListReply_List.Validate if the designated
+// constraints aren't met.
+type WithdrawAddressListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5053,24 +16385,24 @@ type AdminUserRecommendRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUserRecommendRequestValidationError) Field() string { return e.field }
+func (e WithdrawAddressListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUserRecommendRequestValidationError) Reason() string { return e.reason }
+func (e WithdrawAddressListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUserRecommendRequestValidationError) Cause() error { return e.cause }
+func (e WithdrawAddressListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUserRecommendRequestValidationError) Key() bool { return e.key }
+func (e WithdrawAddressListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUserRecommendRequestValidationError) ErrorName() string {
-	return "AdminUserRecommendRequestValidationError"
+func (e WithdrawAddressListReply_ListValidationError) ErrorName() string {
+	return "WithdrawAddressListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUserRecommendRequestValidationError) Error() string {
+func (e WithdrawAddressListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5082,14 +16414,14 @@ func (e AdminUserRecommendRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUserRecommendRequest.%s: %s%s",
+		"invalid %sWithdrawAddressListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUserRecommendRequestValidationError{}
+var _ error = WithdrawAddressListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -5097,78 +16429,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUserRecommendRequestValidationError{}
+} = WithdrawAddressListReply_ListValidationError{}
 
-// Validate checks the field values on AdminUserRecommendReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUserRecommendReply) Validate() error {
+// Validate checks the field values on AddWithdrawAddressRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AddWithdrawAddressRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUserRecommendReply with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminUserRecommendReplyMultiError, or nil if none found.
-func (m *AdminUserRecommendReply) ValidateAll() error {
+// ValidateAll checks the field values on AddWithdrawAddressRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AddWithdrawAddressRequest_SendBodyMultiError, or nil if none found.
+func (m *AddWithdrawAddressRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUserRecommendReply) validate(all bool) error {
+func (m *AddWithdrawAddressRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUsers() {
-		_, _ = idx, item
+	// no validation rules for Address
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminUserRecommendReplyValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminUserRecommendReplyValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AdminUserRecommendReplyValidationError{
-					field:  fmt.Sprintf("Users[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Label
 
-	}
+	// no validation rules for Sign
 
 	if len(errors) > 0 {
-		return AdminUserRecommendReplyMultiError(errors)
+		return AddWithdrawAddressRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUserRecommendReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminUserRecommendReply.ValidateAll() if the designated
+// AddWithdrawAddressRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AddWithdrawAddressRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type AdminUserRecommendReplyMultiError []error
+type AddWithdrawAddressRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUserRecommendReplyMultiError) Error() string {
+func (m AddWithdrawAddressRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5177,11 +16483,12 @@ func (m AdminUserRecommendReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUserRecommendReplyMultiError) AllErrors() []error { return m }
+func (m AddWithdrawAddressRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminUserRecommendReplyValidationError is the validation error returned by
-// AdminUserRecommendReply.Validate if the designated constraints aren't met.
-type AdminUserRecommendReplyValidationError struct {
+// AddWithdrawAddressRequest_SendBodyValidationError is the validation error
+// returned by AddWithdrawAddressRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AddWithdrawAddressRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5189,24 +16496,24 @@ type AdminUserRecommendReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUserRecommendReplyValidationError) Field() string { return e.field }
+func (e AddWithdrawAddressRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUserRecommendReplyValidationError) Reason() string { return e.reason }
+func (e AddWithdrawAddressRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUserRecommendReplyValidationError) Cause() error { return e.cause }
+func (e AddWithdrawAddressRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUserRecommendReplyValidationError) Key() bool { return e.key }
+func (e AddWithdrawAddressRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUserRecommendReplyValidationError) ErrorName() string {
-	return "AdminUserRecommendReplyValidationError"
+func (e AddWithdrawAddressRequest_SendBodyValidationError) ErrorName() string {
+	return "AddWithdrawAddressRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUserRecommendReplyValidationError) Error() string {
+func (e AddWithdrawAddressRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5218,14 +16525,14 @@ func (e AdminUserRecommendReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUserRecommendReply.%s: %s%s",
+		"invalid %sAddWithdrawAddressRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUserRecommendReplyValidationError{}
+var _ error = AddWithdrawAddressRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -5233,48 +16540,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUserRecommendReplyValidationError{}
+} = AddWithdrawAddressRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminMonthRecommendRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminMonthRecommendRequest) Validate() error {
+// Validate checks the field values on DeleteWithdrawAddressRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *DeleteWithdrawAddressRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminMonthRecommendRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminMonthRecommendRequestMultiError, or nil if none found.
-func (m *AdminMonthRecommendRequest) ValidateAll() error {
+// ValidateAll checks the field values on DeleteWithdrawAddressRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// DeleteWithdrawAddressRequest_SendBodyMultiError, or nil if none found.
+func (m *DeleteWithdrawAddressRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminMonthRecommendRequest) validate(all bool) error {
+func (m *DeleteWithdrawAddressRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Address
-
-	// no validation rules for Page
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return AdminMonthRecommendRequestMultiError(errors)
+		return DeleteWithdrawAddressRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminMonthRecommendRequestMultiError is an error wrapping multiple
-// validation errors returned by AdminMonthRecommendRequest.ValidateAll() if
-// the designated constraints aren't met.
-type AdminMonthRecommendRequestMultiError []error
+// DeleteWithdrawAddressRequest_SendBodyMultiError is an error wrapping
+// multiple validation errors returned by
+// DeleteWithdrawAddressRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteWithdrawAddressRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminMonthRecommendRequestMultiError) Error() string {
+func (m DeleteWithdrawAddressRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5283,11 +16590,12 @@ func (m AdminMonthRecommendRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminMonthRecommendRequestMultiError) AllErrors() []error { return m }
+func (m DeleteWithdrawAddressRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminMonthRecommendRequestValidationError is the validation error returned
-// by AdminMonthRecommendRequest.Validate if the designated constraints aren't met.
-type AdminMonthRecommendRequestValidationError struct {
+// DeleteWithdrawAddressRequest_SendBodyValidationError is the validation error
+// returned by DeleteWithdrawAddressRequest_SendBody.Validate if the
+// designated constraints aren't met.
+type DeleteWithdrawAddressRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5295,24 +16603,24 @@ type AdminMonthRecommendRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminMonthRecommendRequestValidationError) Field() string { return e.field }
+func (e DeleteWithdrawAddressRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminMonthRecommendRequestValidationError) Reason() string { return e.reason }
+func (e DeleteWithdrawAddressRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminMonthRecommendRequestValidationError) Cause() error { return e.cause }
+func (e DeleteWithdrawAddressRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminMonthRecommendRequestValidationError) Key() bool { return e.key }
+func (e DeleteWithdrawAddressRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminMonthRecommendRequestValidationError) ErrorName() string {
-	return "AdminMonthRecommendRequestValidationError"
+func (e DeleteWithdrawAddressRequest_SendBodyValidationError) ErrorName() string {
+	return "DeleteWithdrawAddressRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminMonthRecommendRequestValidationError) Error() string {
+func (e DeleteWithdrawAddressRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5324,14 +16632,14 @@ func (e AdminMonthRecommendRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminMonthRecommendRequest.%s: %s%s",
+		"invalid %sDeleteWithdrawAddressRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminMonthRecommendRequestValidationError{}
+var _ error = DeleteWithdrawAddressRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -5339,80 +16647,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminMonthRecommendRequestValidationError{}
+} = DeleteWithdrawAddressRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminMonthRecommendReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminMonthRecommendReply) Validate() error {
+// Validate checks the field values on SetBalanceRewardRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SetBalanceRewardRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminMonthRecommendReply with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminMonthRecommendReplyMultiError, or nil if none found.
-func (m *AdminMonthRecommendReply) ValidateAll() error {
+// ValidateAll checks the field values on SetBalanceRewardRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetBalanceRewardRequest_SendBodyMultiError, or nil if none found.
+func (m *SetBalanceRewardRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminMonthRecommendReply) validate(all bool) error {
+func (m *SetBalanceRewardRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUsers() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminMonthRecommendReplyValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminMonthRecommendReplyValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AdminMonthRecommendReplyValidationError{
-					field:  fmt.Sprintf("Users[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for Count
+	// no validation rules for Amount
 
 	if len(errors) > 0 {
-		return AdminMonthRecommendReplyMultiError(errors)
+		return SetBalanceRewardRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminMonthRecommendReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminMonthRecommendReply.ValidateAll() if the designated
+// SetBalanceRewardRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// SetBalanceRewardRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type AdminMonthRecommendReplyMultiError []error
+type SetBalanceRewardRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminMonthRecommendReplyMultiError) Error() string {
+func (m SetBalanceRewardRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5421,11 +16697,12 @@ func (m AdminMonthRecommendReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminMonthRecommendReplyMultiError) AllErrors() []error { return m }
+func (m SetBalanceRewardRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminMonthRecommendReplyValidationError is the validation error returned by
-// AdminMonthRecommendReply.Validate if the designated constraints aren't met.
-type AdminMonthRecommendReplyValidationError struct {
+// SetBalanceRewardRequest_SendBodyValidationError is the validation error
+// returned by SetBalanceRewardRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type SetBalanceRewardRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5433,24 +16710,24 @@ type AdminMonthRecommendReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminMonthRecommendReplyValidationError) Field() string { return e.field }
+func (e SetBalanceRewardRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminMonthRecommendReplyValidationError) Reason() string { return e.reason }
+func (e SetBalanceRewardRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminMonthRecommendReplyValidationError) Cause() error { return e.cause }
+func (e SetBalanceRewardRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminMonthRecommendReplyValidationError) Key() bool { return e.key }
+func (e SetBalanceRewardRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminMonthRecommendReplyValidationError) ErrorName() string {
-	return "AdminMonthRecommendReplyValidationError"
+func (e SetBalanceRewardRequest_SendBodyValidationError) ErrorName() string {
+	return "SetBalanceRewardRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminMonthRecommendReplyValidationError) Error() string {
+func (e SetBalanceRewardRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5462,14 +16739,14 @@ func (e AdminMonthRecommendReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminMonthRecommendReply.%s: %s%s",
+		"invalid %sSetBalanceRewardRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminMonthRecommendReplyValidationError{}
+var _ error = SetBalanceRewardRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -5477,48 +16754,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminMonthRecommendReplyValidationError{}
+} = SetBalanceRewardRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminConfigRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminConfigRequest) Validate() error {
+// Validate checks the field values on DeleteBalanceRewardRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *DeleteBalanceRewardRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminConfigRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminConfigRequestMultiError, or nil if none found.
-func (m *AdminConfigRequest) ValidateAll() error {
+// ValidateAll checks the field values on DeleteBalanceRewardRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// DeleteBalanceRewardRequest_SendBodyMultiError, or nil if none found.
+func (m *DeleteBalanceRewardRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminConfigRequest) validate(all bool) error {
+func (m *DeleteBalanceRewardRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
-
-	// no validation rules for Page
+	// no validation rules for Amount
 
 	if len(errors) > 0 {
-		return AdminConfigRequestMultiError(errors)
+		return DeleteBalanceRewardRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminConfigRequestMultiError is an error wrapping multiple validation errors
-// returned by AdminConfigRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AdminConfigRequestMultiError []error
+// DeleteBalanceRewardRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// DeleteBalanceRewardRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteBalanceRewardRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminConfigRequestMultiError) Error() string {
+func (m DeleteBalanceRewardRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5527,11 +16804,12 @@ func (m AdminConfigRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminConfigRequestMultiError) AllErrors() []error { return m }
+func (m DeleteBalanceRewardRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminConfigRequestValidationError is the validation error returned by
-// AdminConfigRequest.Validate if the designated constraints aren't met.
-type AdminConfigRequestValidationError struct {
+// DeleteBalanceRewardRequest_SendBodyValidationError is the validation error
+// returned by DeleteBalanceRewardRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type DeleteBalanceRewardRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5539,24 +16817,24 @@ type AdminConfigRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminConfigRequestValidationError) Field() string { return e.field }
+func (e DeleteBalanceRewardRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminConfigRequestValidationError) Reason() string { return e.reason }
+func (e DeleteBalanceRewardRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminConfigRequestValidationError) Cause() error { return e.cause }
+func (e DeleteBalanceRewardRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminConfigRequestValidationError) Key() bool { return e.key }
+func (e DeleteBalanceRewardRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminConfigRequestValidationError) ErrorName() string {
-	return "AdminConfigRequestValidationError"
+func (e DeleteBalanceRewardRequest_SendBodyValidationError) ErrorName() string {
+	return "DeleteBalanceRewardRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminConfigRequestValidationError) Error() string {
+func (e DeleteBalanceRewardRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5568,14 +16846,14 @@ func (e AdminConfigRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminConfigRequest.%s: %s%s",
+		"invalid %sDeleteBalanceRewardRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminConfigRequestValidationError{}
+var _ error = DeleteBalanceRewardRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -5583,80 +16861,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminConfigRequestValidationError{}
+} = DeleteBalanceRewardRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminConfigReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AdminConfigReply) Validate() error {
+// Validate checks the field values on AdminRewardListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminRewardListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminConfigReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminRewardListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminConfigReplyMultiError, or nil if none found.
-func (m *AdminConfigReply) ValidateAll() error {
+// AdminRewardListReply_ListMultiError, or nil if none found.
+func (m *AdminRewardListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminConfigReply) validate(all bool) error {
+func (m *AdminRewardListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetConfig() {
-		_, _ = idx, item
+	// no validation rules for CreatedAt
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminConfigReplyValidationError{
-						field:  fmt.Sprintf("Config[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminConfigReplyValidationError{
-						field:  fmt.Sprintf("Config[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AdminConfigReplyValidationError{
-					field:  fmt.Sprintf("Config[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Amount
 
-	}
+	// no validation rules for Type
 
-	// no validation rules for Count
+	// no validation rules for Address
+
+	// no validation rules for Reason
 
 	if len(errors) > 0 {
-		return AdminConfigReplyMultiError(errors)
+		return AdminRewardListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminConfigReplyMultiError is an error wrapping multiple validation errors
-// returned by AdminConfigReply.ValidateAll() if the designated constraints
-// aren't met.
-type AdminConfigReplyMultiError []error
+// AdminRewardListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by AdminRewardListReply_List.ValidateAll() if the
+// designated constraints aren't met.
+type AdminRewardListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminConfigReplyMultiError) Error() string {
+func (m AdminRewardListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5665,11 +16917,11 @@ func (m AdminConfigReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminConfigReplyMultiError) AllErrors() []error { return m }
+func (m AdminRewardListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminConfigReplyValidationError is the validation error returned by
-// AdminConfigReply.Validate if the designated constraints aren't met.
-type AdminConfigReplyValidationError struct {
+// AdminRewardListReply_ListValidationError is the validation error returned by
+// AdminRewardListReply_List.Validate if the designated constraints aren't met.
+type AdminRewardListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5677,22 +16929,24 @@ type AdminConfigReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminConfigReplyValidationError) Field() string { return e.field }
+func (e AdminRewardListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminConfigReplyValidationError) Reason() string { return e.reason }
+func (e AdminRewardListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminConfigReplyValidationError) Cause() error { return e.cause }
+func (e AdminRewardListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminConfigReplyValidationError) Key() bool { return e.key }
+func (e AdminRewardListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminConfigReplyValidationError) ErrorName() string { return "AdminConfigReplyValidationError" }
+func (e AdminRewardListReply_ListValidationError) ErrorName() string {
+	return "AdminRewardListReply_ListValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AdminConfigReplyValidationError) Error() string {
+func (e AdminRewardListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5704,14 +16958,14 @@ func (e AdminConfigReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminConfigReply.%s: %s%s",
+		"invalid %sAdminRewardListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminConfigReplyValidationError{}
+var _ error = AdminRewardListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -5719,73 +16973,60 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminConfigReplyValidationError{}
+} = AdminRewardListReply_ListValidationError{}
 
-// Validate checks the field values on AdminConfigUpdateRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminUserListReply_UserList with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminConfigUpdateRequest) Validate() error {
+func (m *AdminUserListReply_UserList) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminConfigUpdateRequest with the
+// ValidateAll checks the field values on AdminUserListReply_UserList with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminConfigUpdateRequestMultiError, or nil if none found.
-func (m *AdminConfigUpdateRequest) ValidateAll() error {
+// AdminUserListReply_UserListMultiError, or nil if none found.
+func (m *AdminUserListReply_UserList) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminConfigUpdateRequest) validate(all bool) error {
+func (m *AdminUserListReply_UserList) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSendBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AdminConfigUpdateRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AdminConfigUpdateRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AdminConfigUpdateRequestValidationError{
-				field:  "SendBody",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for UserId
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Address
+
+	// no validation rules for BalanceUsdt
+
+	// no validation rules for BalanceDhb
+
+	// no validation rules for Vip
+
+	// no validation rules for MonthRecommend
+
+	// no validation rules for HistoryRecommend
 
 	if len(errors) > 0 {
-		return AdminConfigUpdateRequestMultiError(errors)
+		return AdminUserListReply_UserListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminConfigUpdateRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminConfigUpdateRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AdminConfigUpdateRequestMultiError []error
+// AdminUserListReply_UserListMultiError is an error wrapping multiple
+// validation errors returned by AdminUserListReply_UserList.ValidateAll() if
+// the designated constraints aren't met.
+type AdminUserListReply_UserListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminConfigUpdateRequestMultiError) Error() string {
+func (m AdminUserListReply_UserListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5794,11 +17035,12 @@ func (m AdminConfigUpdateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminConfigUpdateRequestMultiError) AllErrors() []error { return m }
+func (m AdminUserListReply_UserListMultiError) AllErrors() []error { return m }
 
-// AdminConfigUpdateRequestValidationError is the validation error returned by
-// AdminConfigUpdateRequest.Validate if the designated constraints aren't met.
-type AdminConfigUpdateRequestValidationError struct {
+// AdminUserListReply_UserListValidationError is the validation error returned
+// by AdminUserListReply_UserList.Validate if the designated constraints
+// aren't met.
+type AdminUserListReply_UserListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5806,24 +17048,24 @@ type AdminConfigUpdateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminConfigUpdateRequestValidationError) Field() string { return e.field }
+func (e AdminUserListReply_UserListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminConfigUpdateRequestValidationError) Reason() string { return e.reason }
+func (e AdminUserListReply_UserListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminConfigUpdateRequestValidationError) Cause() error { return e.cause }
+func (e AdminUserListReply_UserListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminConfigUpdateRequestValidationError) Key() bool { return e.key }
+func (e AdminUserListReply_UserListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminConfigUpdateRequestValidationError) ErrorName() string {
-	return "AdminConfigUpdateRequestValidationError"
+func (e AdminUserListReply_UserListValidationError) ErrorName() string {
+	return "AdminUserListReply_UserListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminConfigUpdateRequestValidationError) Error() string {
+func (e AdminUserListReply_UserListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5835,14 +17077,14 @@ func (e AdminConfigUpdateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminConfigUpdateRequest.%s: %s%s",
+		"invalid %sAdminUserListReply_UserList.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminConfigUpdateRequestValidationError{}
+var _ error = AdminUserListReply_UserListValidationError{}
 
 var _ interface {
 	Field() string
@@ -5850,44 +17092,62 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminConfigUpdateRequestValidationError{}
+} = AdminUserListReply_UserListValidationError{}
 
-// Validate checks the field values on AdminConfigUpdateReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminConfigUpdateReply) Validate() error {
+// Validate checks the field values on AdminLocationListReply_LocationList with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminLocationListReply_LocationList) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminConfigUpdateReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminConfigUpdateReplyMultiError, or nil if none found.
-func (m *AdminConfigUpdateReply) ValidateAll() error {
+// ValidateAll checks the field values on AdminLocationListReply_LocationList
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminLocationListReply_LocationListMultiError, or nil if none found.
+func (m *AdminLocationListReply_LocationList) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminConfigUpdateReply) validate(all bool) error {
+func (m *AdminLocationListReply_LocationList) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for CreatedAt
+
+	// no validation rules for Address
+
+	// no validation rules for Row
+
+	// no validation rules for Col
+
+	// no validation rules for Status
+
+	// no validation rules for CurrentLevel
+
+	// no validation rules for Current
+
+	// no validation rules for CurrentMax
+
 	if len(errors) > 0 {
-		return AdminConfigUpdateReplyMultiError(errors)
+		return AdminLocationListReply_LocationListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminConfigUpdateReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminConfigUpdateReply.ValidateAll() if the designated
+// AdminLocationListReply_LocationListMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminLocationListReply_LocationList.ValidateAll() if the designated
 // constraints aren't met.
-type AdminConfigUpdateReplyMultiError []error
+type AdminLocationListReply_LocationListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminConfigUpdateReplyMultiError) Error() string {
+func (m AdminLocationListReply_LocationListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5896,11 +17156,12 @@ func (m AdminConfigUpdateReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminConfigUpdateReplyMultiError) AllErrors() []error { return m }
+func (m AdminLocationListReply_LocationListMultiError) AllErrors() []error { return m }
 
-// AdminConfigUpdateReplyValidationError is the validation error returned by
-// AdminConfigUpdateReply.Validate if the designated constraints aren't met.
-type AdminConfigUpdateReplyValidationError struct {
+// AdminLocationListReply_LocationListValidationError is the validation error
+// returned by AdminLocationListReply_LocationList.Validate if the designated
+// constraints aren't met.
+type AdminLocationListReply_LocationListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5908,24 +17169,24 @@ type AdminConfigUpdateReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminConfigUpdateReplyValidationError) Field() string { return e.field }
+func (e AdminLocationListReply_LocationListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminConfigUpdateReplyValidationError) Reason() string { return e.reason }
+func (e AdminLocationListReply_LocationListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminConfigUpdateReplyValidationError) Cause() error { return e.cause }
+func (e AdminLocationListReply_LocationListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminConfigUpdateReplyValidationError) Key() bool { return e.key }
+func (e AdminLocationListReply_LocationListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminConfigUpdateReplyValidationError) ErrorName() string {
-	return "AdminConfigUpdateReplyValidationError"
+func (e AdminLocationListReply_LocationListValidationError) ErrorName() string {
+	return "AdminLocationListReply_LocationListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminConfigUpdateReplyValidationError) Error() string {
+func (e AdminLocationListReply_LocationListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5937,14 +17198,14 @@ func (e AdminConfigUpdateReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminConfigUpdateReply.%s: %s%s",
+		"invalid %sAdminLocationListReply_LocationList.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminConfigUpdateReplyValidationError{}
+var _ error = AdminLocationListReply_LocationListValidationError{}
 
 var _ interface {
 	Field() string
@@ -5952,24 +17213,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminConfigUpdateReplyValidationError{}
+} = AdminLocationListReply_LocationListValidationError{}
 
-// Validate checks the field values on EthAuthorizeRequest_SendBody with the
+// Validate checks the field values on AdminWithdrawListReply_List with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *EthAuthorizeRequest_SendBody) Validate() error {
+func (m *AdminWithdrawListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EthAuthorizeRequest_SendBody with the
+// ValidateAll checks the field values on AdminWithdrawListReply_List with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// EthAuthorizeRequest_SendBodyMultiError, or nil if none found.
-func (m *EthAuthorizeRequest_SendBody) ValidateAll() error {
+// AdminWithdrawListReply_ListMultiError, or nil if none found.
+func (m *AdminWithdrawListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EthAuthorizeRequest_SendBody) validate(all bool) error {
+func (m *AdminWithdrawListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -5978,22 +17239,32 @@ func (m *EthAuthorizeRequest_SendBody) validate(all bool) error {
 
 	// no validation rules for Address
 
-	// no validation rules for Code
+	// no validation rules for Id
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for RelAmount
+
+	// no validation rules for Type
+
+	// no validation rules for Status
 
 	if len(errors) > 0 {
-		return EthAuthorizeRequest_SendBodyMultiError(errors)
+		return AdminWithdrawListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// EthAuthorizeRequest_SendBodyMultiError is an error wrapping multiple
-// validation errors returned by EthAuthorizeRequest_SendBody.ValidateAll() if
+// AdminWithdrawListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminWithdrawListReply_List.ValidateAll() if
 // the designated constraints aren't met.
-type EthAuthorizeRequest_SendBodyMultiError []error
+type AdminWithdrawListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EthAuthorizeRequest_SendBodyMultiError) Error() string {
+func (m AdminWithdrawListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6002,12 +17273,12 @@ func (m EthAuthorizeRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EthAuthorizeRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminWithdrawListReply_ListMultiError) AllErrors() []error { return m }
 
-// EthAuthorizeRequest_SendBodyValidationError is the validation error returned
-// by EthAuthorizeRequest_SendBody.Validate if the designated constraints
+// AdminWithdrawListReply_ListValidationError is the validation error returned
+// by AdminWithdrawListReply_List.Validate if the designated constraints
 // aren't met.
-type EthAuthorizeRequest_SendBodyValidationError struct {
+type AdminWithdrawListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6015,24 +17286,24 @@ type EthAuthorizeRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e EthAuthorizeRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminWithdrawListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EthAuthorizeRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EthAuthorizeRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EthAuthorizeRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminWithdrawListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EthAuthorizeRequest_SendBodyValidationError) ErrorName() string {
-	return "EthAuthorizeRequest_SendBodyValidationError"
+func (e AdminWithdrawListReply_ListValidationError) ErrorName() string {
+	return "AdminWithdrawListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e EthAuthorizeRequest_SendBodyValidationError) Error() string {
+func (e AdminWithdrawListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6044,14 +17315,14 @@ func (e EthAuthorizeRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEthAuthorizeRequest_SendBody.%s: %s%s",
+		"invalid %sAdminWithdrawListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EthAuthorizeRequest_SendBodyValidationError{}
+var _ error = AdminWithdrawListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -6059,46 +17330,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EthAuthorizeRequest_SendBodyValidationError{}
+} = AdminWithdrawListReply_ListValidationError{}
 
-// Validate checks the field values on RecommendUpdateRequest_SendBody with the
+// Validate checks the field values on AdminLoginRequest_SendBody with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RecommendUpdateRequest_SendBody) Validate() error {
+func (m *AdminLoginRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RecommendUpdateRequest_SendBody with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RecommendUpdateRequest_SendBodyMultiError, or nil if none found.
-func (m *RecommendUpdateRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on AdminLoginRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLoginRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminLoginRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecommendUpdateRequest_SendBody) validate(all bool) error {
+func (m *AdminLoginRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Code
+	// no validation rules for Account
+
+	// no validation rules for Password
 
 	if len(errors) > 0 {
-		return RecommendUpdateRequest_SendBodyMultiError(errors)
+		return AdminLoginRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// RecommendUpdateRequest_SendBodyMultiError is an error wrapping multiple
-// validation errors returned by RecommendUpdateRequest_SendBody.ValidateAll()
-// if the designated constraints aren't met.
-type RecommendUpdateRequest_SendBodyMultiError []error
+// AdminLoginRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by AdminLoginRequest_SendBody.ValidateAll() if
+// the designated constraints aren't met.
+type AdminLoginRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecommendUpdateRequest_SendBodyMultiError) Error() string {
+func (m AdminLoginRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6107,12 +17380,11 @@ func (m RecommendUpdateRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecommendUpdateRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminLoginRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// RecommendUpdateRequest_SendBodyValidationError is the validation error
-// returned by RecommendUpdateRequest_SendBody.Validate if the designated
-// constraints aren't met.
-type RecommendUpdateRequest_SendBodyValidationError struct {
+// AdminLoginRequest_SendBodyValidationError is the validation error returned
+// by AdminLoginRequest_SendBody.Validate if the designated constraints aren't met.
+type AdminLoginRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6120,24 +17392,24 @@ type RecommendUpdateRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecommendUpdateRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminLoginRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecommendUpdateRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminLoginRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecommendUpdateRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminLoginRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecommendUpdateRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminLoginRequest_SendBodyValidationError) Key() bool { return e.key }
 
-// ErrorName returns error name.
-func (e RecommendUpdateRequest_SendBodyValidationError) ErrorName() string {
-	return "RecommendUpdateRequest_SendBodyValidationError"
+// ErrorName returns error name.
+func (e AdminLoginRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminLoginRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RecommendUpdateRequest_SendBodyValidationError) Error() string {
+func (e AdminLoginRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6149,14 +17421,14 @@ func (e RecommendUpdateRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecommendUpdateRequest_SendBody.%s: %s%s",
+		"invalid %sAdminLoginRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecommendUpdateRequest_SendBodyValidationError{}
+var _ error = AdminLoginRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -6164,54 +17436,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecommendUpdateRequest_SendBodyValidationError{}
+} = AdminLoginRequest_SendBodyValidationError{}
 
-// Validate checks the field values on UserInfoReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserInfoReply_List) Validate() error {
+// Validate checks the field values on AdminWithdrawApproveRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *AdminWithdrawApproveRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserInfoReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UserInfoReply_ListMultiError, or nil if none found.
-func (m *UserInfoReply_List) ValidateAll() error {
+// ValidateAll checks the field values on AdminWithdrawApproveRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminWithdrawApproveRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminWithdrawApproveRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserInfoReply_List) validate(all bool) error {
+func (m *AdminWithdrawApproveRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
-
-	// no validation rules for Amount
-
-	// no validation rules for LocationStatus
-
-	// no validation rules for AmountMax
-
-	// no validation rules for OutRate
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return UserInfoReply_ListMultiError(errors)
+		return AdminWithdrawApproveRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserInfoReply_ListMultiError is an error wrapping multiple validation errors
-// returned by UserInfoReply_List.ValidateAll() if the designated constraints
-// aren't met.
-type UserInfoReply_ListMultiError []error
+// AdminWithdrawApproveRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminWithdrawApproveRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawApproveRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserInfoReply_ListMultiError) Error() string {
+func (m AdminWithdrawApproveRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6220,11 +17486,12 @@ func (m UserInfoReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserInfoReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminWithdrawApproveRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// UserInfoReply_ListValidationError is the validation error returned by
-// UserInfoReply_List.Validate if the designated constraints aren't met.
-type UserInfoReply_ListValidationError struct {
+// AdminWithdrawApproveRequest_SendBodyValidationError is the validation error
+// returned by AdminWithdrawApproveRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminWithdrawApproveRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6232,24 +17499,24 @@ type UserInfoReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserInfoReply_ListValidationError) Field() string { return e.field }
+func (e AdminWithdrawApproveRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserInfoReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawApproveRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserInfoReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawApproveRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserInfoReply_ListValidationError) Key() bool { return e.key }
+func (e AdminWithdrawApproveRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserInfoReply_ListValidationError) ErrorName() string {
-	return "UserInfoReply_ListValidationError"
+func (e AdminWithdrawApproveRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminWithdrawApproveRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserInfoReply_ListValidationError) Error() string {
+func (e AdminWithdrawApproveRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6261,14 +17528,14 @@ func (e UserInfoReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserInfoReply_List.%s: %s%s",
+		"invalid %sAdminWithdrawApproveRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserInfoReply_ListValidationError{}
+var _ error = AdminWithdrawApproveRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -6276,50 +17543,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserInfoReply_ListValidationError{}
+} = AdminWithdrawApproveRequest_SendBodyValidationError{}
 
-// Validate checks the field values on UserInfoReply_List2 with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserInfoReply_List2) Validate() error {
+// Validate checks the field values on AdminWithdrawRejectRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminWithdrawRejectRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserInfoReply_List2 with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UserInfoReply_List2MultiError, or nil if none found.
-func (m *UserInfoReply_List2) ValidateAll() error {
+// ValidateAll checks the field values on AdminWithdrawRejectRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminWithdrawRejectRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminWithdrawRejectRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserInfoReply_List2) validate(all bool) error {
+func (m *AdminWithdrawRejectRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
-
-	// no validation rules for Amount
+	// no validation rules for Id
 
-	// no validation rules for RecommendNum
+	// no validation rules for Reason
 
 	if len(errors) > 0 {
-		return UserInfoReply_List2MultiError(errors)
+		return AdminWithdrawRejectRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserInfoReply_List2MultiError is an error wrapping multiple validation
-// errors returned by UserInfoReply_List2.ValidateAll() if the designated
+// AdminWithdrawRejectRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminWithdrawRejectRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type UserInfoReply_List2MultiError []error
+type AdminWithdrawRejectRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserInfoReply_List2MultiError) Error() string {
+func (m AdminWithdrawRejectRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6328,11 +17595,12 @@ func (m UserInfoReply_List2MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserInfoReply_List2MultiError) AllErrors() []error { return m }
+func (m AdminWithdrawRejectRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// UserInfoReply_List2ValidationError is the validation error returned by
-// UserInfoReply_List2.Validate if the designated constraints aren't met.
-type UserInfoReply_List2ValidationError struct {
+// AdminWithdrawRejectRequest_SendBodyValidationError is the validation error
+// returned by AdminWithdrawRejectRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminWithdrawRejectRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6340,24 +17608,24 @@ type UserInfoReply_List2ValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserInfoReply_List2ValidationError) Field() string { return e.field }
+func (e AdminWithdrawRejectRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserInfoReply_List2ValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawRejectRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserInfoReply_List2ValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawRejectRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserInfoReply_List2ValidationError) Key() bool { return e.key }
+func (e AdminWithdrawRejectRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserInfoReply_List2ValidationError) ErrorName() string {
-	return "UserInfoReply_List2ValidationError"
+func (e AdminWithdrawRejectRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminWithdrawRejectRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserInfoReply_List2ValidationError) Error() string {
+func (e AdminWithdrawRejectRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6369,14 +17637,14 @@ func (e UserInfoReply_List2ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserInfoReply_List2.%s: %s%s",
+		"invalid %sAdminWithdrawRejectRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserInfoReply_List2ValidationError{}
+var _ error = AdminWithdrawRejectRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -6384,48 +17652,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserInfoReply_List2ValidationError{}
+} = AdminWithdrawRejectRequest_SendBodyValidationError{}
 
-// Validate checks the field values on UserInfoReply_List3 with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserInfoReply_List3) Validate() error {
+// Validate checks the field values on AdminWithdrawRetryRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminWithdrawRetryRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserInfoReply_List3 with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UserInfoReply_List3MultiError, or nil if none found.
-func (m *UserInfoReply_List3) ValidateAll() error {
+// ValidateAll checks the field values on AdminWithdrawRetryRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminWithdrawRetryRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminWithdrawRetryRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserInfoReply_List3) validate(all bool) error {
+func (m *AdminWithdrawRetryRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
-
-	// no validation rules for Amount
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return UserInfoReply_List3MultiError(errors)
+		return AdminWithdrawRetryRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserInfoReply_List3MultiError is an error wrapping multiple validation
-// errors returned by UserInfoReply_List3.ValidateAll() if the designated
+// AdminWithdrawRetryRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminWithdrawRetryRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type UserInfoReply_List3MultiError []error
+type AdminWithdrawRetryRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserInfoReply_List3MultiError) Error() string {
+func (m AdminWithdrawRetryRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6434,11 +17702,12 @@ func (m UserInfoReply_List3MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserInfoReply_List3MultiError) AllErrors() []error { return m }
+func (m AdminWithdrawRetryRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// UserInfoReply_List3ValidationError is the validation error returned by
-// UserInfoReply_List3.Validate if the designated constraints aren't met.
-type UserInfoReply_List3ValidationError struct {
+// AdminWithdrawRetryRequest_SendBodyValidationError is the validation error
+// returned by AdminWithdrawRetryRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminWithdrawRetryRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6446,24 +17715,24 @@ type UserInfoReply_List3ValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserInfoReply_List3ValidationError) Field() string { return e.field }
+func (e AdminWithdrawRetryRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserInfoReply_List3ValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawRetryRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserInfoReply_List3ValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawRetryRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserInfoReply_List3ValidationError) Key() bool { return e.key }
+func (e AdminWithdrawRetryRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserInfoReply_List3ValidationError) ErrorName() string {
-	return "UserInfoReply_List3ValidationError"
+func (e AdminWithdrawRetryRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminWithdrawRetryRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserInfoReply_List3ValidationError) Error() string {
+func (e AdminWithdrawRetryRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6475,14 +17744,14 @@ func (e UserInfoReply_List3ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserInfoReply_List3.%s: %s%s",
+		"invalid %sAdminWithdrawRetryRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserInfoReply_List3ValidationError{}
+var _ error = AdminWithdrawRetryRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -6490,48 +17759,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserInfoReply_List3ValidationError{}
+} = AdminWithdrawRetryRequest_SendBodyValidationError{}
 
-// Validate checks the field values on UserInfoReply_List4 with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserInfoReply_List4) Validate() error {
+// Validate checks the field values on AdminWithdrawRefundRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminWithdrawRefundRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserInfoReply_List4 with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UserInfoReply_List4MultiError, or nil if none found.
-func (m *UserInfoReply_List4) ValidateAll() error {
+// ValidateAll checks the field values on AdminWithdrawRefundRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminWithdrawRefundRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminWithdrawRefundRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserInfoReply_List4) validate(all bool) error {
+func (m *AdminWithdrawRefundRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
-
-	// no validation rules for Amount
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return UserInfoReply_List4MultiError(errors)
+		return AdminWithdrawRefundRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserInfoReply_List4MultiError is an error wrapping multiple validation
-// errors returned by UserInfoReply_List4.ValidateAll() if the designated
+// AdminWithdrawRefundRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminWithdrawRefundRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type UserInfoReply_List4MultiError []error
+type AdminWithdrawRefundRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserInfoReply_List4MultiError) Error() string {
+func (m AdminWithdrawRefundRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6540,11 +17809,12 @@ func (m UserInfoReply_List4MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserInfoReply_List4MultiError) AllErrors() []error { return m }
+func (m AdminWithdrawRefundRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// UserInfoReply_List4ValidationError is the validation error returned by
-// UserInfoReply_List4.Validate if the designated constraints aren't met.
-type UserInfoReply_List4ValidationError struct {
+// AdminWithdrawRefundRequest_SendBodyValidationError is the validation error
+// returned by AdminWithdrawRefundRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminWithdrawRefundRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6552,24 +17822,24 @@ type UserInfoReply_List4ValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserInfoReply_List4ValidationError) Field() string { return e.field }
+func (e AdminWithdrawRefundRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserInfoReply_List4ValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawRefundRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserInfoReply_List4ValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawRefundRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserInfoReply_List4ValidationError) Key() bool { return e.key }
+func (e AdminWithdrawRefundRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserInfoReply_List4ValidationError) ErrorName() string {
-	return "UserInfoReply_List4ValidationError"
+func (e AdminWithdrawRefundRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminWithdrawRefundRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserInfoReply_List4ValidationError) Error() string {
+func (e AdminWithdrawRefundRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6581,14 +17851,14 @@ func (e UserInfoReply_List4ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserInfoReply_List4.%s: %s%s",
+		"invalid %sAdminWithdrawRefundRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserInfoReply_List4ValidationError{}
+var _ error = AdminWithdrawRefundRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -6596,48 +17866,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserInfoReply_List4ValidationError{}
+} = AdminWithdrawRefundRequest_SendBodyValidationError{}
 
-// Validate checks the field values on UserInfoReply_List5 with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserInfoReply_List5) Validate() error {
+// Validate checks the field values on
+// AdminWithdrawLegacyMigrateRequest_SendBody with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawLegacyMigrateRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserInfoReply_List5 with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UserInfoReply_List5MultiError, or nil if none found.
-func (m *UserInfoReply_List5) ValidateAll() error {
+// ValidateAll checks the field values on
+// AdminWithdrawLegacyMigrateRequest_SendBody with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// AdminWithdrawLegacyMigrateRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminWithdrawLegacyMigrateRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserInfoReply_List5) validate(all bool) error {
+func (m *AdminWithdrawLegacyMigrateRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
-
-	// no validation rules for Amount
-
 	if len(errors) > 0 {
-		return UserInfoReply_List5MultiError(errors)
+		return AdminWithdrawLegacyMigrateRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserInfoReply_List5MultiError is an error wrapping multiple validation
-// errors returned by UserInfoReply_List5.ValidateAll() if the designated
+// AdminWithdrawLegacyMigrateRequest_SendBodyMultiError is an error wrapping
+// multiple validation errors returned by
+// AdminWithdrawLegacyMigrateRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type UserInfoReply_List5MultiError []error
+type AdminWithdrawLegacyMigrateRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserInfoReply_List5MultiError) Error() string {
+func (m AdminWithdrawLegacyMigrateRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6646,11 +17915,12 @@ func (m UserInfoReply_List5MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserInfoReply_List5MultiError) AllErrors() []error { return m }
+func (m AdminWithdrawLegacyMigrateRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// UserInfoReply_List5ValidationError is the validation error returned by
-// UserInfoReply_List5.Validate if the designated constraints aren't met.
-type UserInfoReply_List5ValidationError struct {
+// AdminWithdrawLegacyMigrateRequest_SendBodyValidationError is the validation
+// error returned by AdminWithdrawLegacyMigrateRequest_SendBody.Validate if
+// the designated constraints aren't met.
+type AdminWithdrawLegacyMigrateRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6658,24 +17928,24 @@ type UserInfoReply_List5ValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserInfoReply_List5ValidationError) Field() string { return e.field }
+func (e AdminWithdrawLegacyMigrateRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserInfoReply_List5ValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawLegacyMigrateRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserInfoReply_List5ValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawLegacyMigrateRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserInfoReply_List5ValidationError) Key() bool { return e.key }
+func (e AdminWithdrawLegacyMigrateRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserInfoReply_List5ValidationError) ErrorName() string {
-	return "UserInfoReply_List5ValidationError"
+func (e AdminWithdrawLegacyMigrateRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminWithdrawLegacyMigrateRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserInfoReply_List5ValidationError) Error() string {
+func (e AdminWithdrawLegacyMigrateRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6687,14 +17957,14 @@ func (e UserInfoReply_List5ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserInfoReply_List5.%s: %s%s",
+		"invalid %sAdminWithdrawLegacyMigrateRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserInfoReply_List5ValidationError{}
+var _ error = AdminWithdrawLegacyMigrateRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -6702,48 +17972,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserInfoReply_List5ValidationError{}
+} = AdminWithdrawLegacyMigrateRequest_SendBodyValidationError{}
 
-// Validate checks the field values on UserInfoReply_List6 with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserInfoReply_List6) Validate() error {
+// Validate checks the field values on AdminWithdrawBulkApproveRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *AdminWithdrawBulkApproveRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserInfoReply_List6 with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UserInfoReply_List6MultiError, or nil if none found.
-func (m *UserInfoReply_List6) ValidateAll() error {
+// ValidateAll checks the field values on
+// AdminWithdrawBulkApproveRequest_SendBody with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// AdminWithdrawBulkApproveRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminWithdrawBulkApproveRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserInfoReply_List6) validate(all bool) error {
+func (m *AdminWithdrawBulkApproveRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
-
-	// no validation rules for Amount
-
 	if len(errors) > 0 {
-		return UserInfoReply_List6MultiError(errors)
+		return AdminWithdrawBulkApproveRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserInfoReply_List6MultiError is an error wrapping multiple validation
-// errors returned by UserInfoReply_List6.ValidateAll() if the designated
+// AdminWithdrawBulkApproveRequest_SendBodyMultiError is an error wrapping
+// multiple validation errors returned by
+// AdminWithdrawBulkApproveRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type UserInfoReply_List6MultiError []error
+type AdminWithdrawBulkApproveRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserInfoReply_List6MultiError) Error() string {
+func (m AdminWithdrawBulkApproveRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6752,11 +18021,12 @@ func (m UserInfoReply_List6MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserInfoReply_List6MultiError) AllErrors() []error { return m }
+func (m AdminWithdrawBulkApproveRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// UserInfoReply_List6ValidationError is the validation error returned by
-// UserInfoReply_List6.Validate if the designated constraints aren't met.
-type UserInfoReply_List6ValidationError struct {
+// AdminWithdrawBulkApproveRequest_SendBodyValidationError is the validation
+// error returned by AdminWithdrawBulkApproveRequest_SendBody.Validate if the
+// designated constraints aren't met.
+type AdminWithdrawBulkApproveRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6764,24 +18034,24 @@ type UserInfoReply_List6ValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserInfoReply_List6ValidationError) Field() string { return e.field }
+func (e AdminWithdrawBulkApproveRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserInfoReply_List6ValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawBulkApproveRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserInfoReply_List6ValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawBulkApproveRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserInfoReply_List6ValidationError) Key() bool { return e.key }
+func (e AdminWithdrawBulkApproveRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserInfoReply_List6ValidationError) ErrorName() string {
-	return "UserInfoReply_List6ValidationError"
+func (e AdminWithdrawBulkApproveRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminWithdrawBulkApproveRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserInfoReply_List6ValidationError) Error() string {
+func (e AdminWithdrawBulkApproveRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6793,14 +18063,14 @@ func (e UserInfoReply_List6ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserInfoReply_List6.%s: %s%s",
+		"invalid %sAdminWithdrawBulkApproveRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserInfoReply_List6ValidationError{}
+var _ error = AdminWithdrawBulkApproveRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -6808,48 +18078,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserInfoReply_List6ValidationError{}
+} = AdminWithdrawBulkApproveRequest_SendBodyValidationError{}
 
-// Validate checks the field values on UserInfoReply_List7 with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserInfoReply_List7) Validate() error {
+// Validate checks the field values on AdminWithdrawBulkApproveReply_Fail with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminWithdrawBulkApproveReply_Fail) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserInfoReply_List7 with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UserInfoReply_List7MultiError, or nil if none found.
-func (m *UserInfoReply_List7) ValidateAll() error {
+// ValidateAll checks the field values on AdminWithdrawBulkApproveReply_Fail
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminWithdrawBulkApproveReply_FailMultiError, or nil if none found.
+func (m *AdminWithdrawBulkApproveReply_Fail) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserInfoReply_List7) validate(all bool) error {
+func (m *AdminWithdrawBulkApproveReply_Fail) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Address
+	// no validation rules for Id
 
-	// no validation rules for Amount
+	// no validation rules for Reason
 
 	if len(errors) > 0 {
-		return UserInfoReply_List7MultiError(errors)
+		return AdminWithdrawBulkApproveReply_FailMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserInfoReply_List7MultiError is an error wrapping multiple validation
-// errors returned by UserInfoReply_List7.ValidateAll() if the designated
+// AdminWithdrawBulkApproveReply_FailMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminWithdrawBulkApproveReply_Fail.ValidateAll() if the designated
 // constraints aren't met.
-type UserInfoReply_List7MultiError []error
+type AdminWithdrawBulkApproveReply_FailMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserInfoReply_List7MultiError) Error() string {
+func (m AdminWithdrawBulkApproveReply_FailMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6858,11 +18130,12 @@ func (m UserInfoReply_List7MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserInfoReply_List7MultiError) AllErrors() []error { return m }
+func (m AdminWithdrawBulkApproveReply_FailMultiError) AllErrors() []error { return m }
 
-// UserInfoReply_List7ValidationError is the validation error returned by
-// UserInfoReply_List7.Validate if the designated constraints aren't met.
-type UserInfoReply_List7ValidationError struct {
+// AdminWithdrawBulkApproveReply_FailValidationError is the validation error
+// returned by AdminWithdrawBulkApproveReply_Fail.Validate if the designated
+// constraints aren't met.
+type AdminWithdrawBulkApproveReply_FailValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6870,24 +18143,24 @@ type UserInfoReply_List7ValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserInfoReply_List7ValidationError) Field() string { return e.field }
+func (e AdminWithdrawBulkApproveReply_FailValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserInfoReply_List7ValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawBulkApproveReply_FailValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserInfoReply_List7ValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawBulkApproveReply_FailValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserInfoReply_List7ValidationError) Key() bool { return e.key }
+func (e AdminWithdrawBulkApproveReply_FailValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserInfoReply_List7ValidationError) ErrorName() string {
-	return "UserInfoReply_List7ValidationError"
+func (e AdminWithdrawBulkApproveReply_FailValidationError) ErrorName() string {
+	return "AdminWithdrawBulkApproveReply_FailValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserInfoReply_List7ValidationError) Error() string {
+func (e AdminWithdrawBulkApproveReply_FailValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6899,14 +18172,14 @@ func (e UserInfoReply_List7ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserInfoReply_List7.%s: %s%s",
+		"invalid %sAdminWithdrawBulkApproveReply_Fail.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserInfoReply_List7ValidationError{}
+var _ error = AdminWithdrawBulkApproveReply_FailValidationError{}
 
 var _ interface {
 	Field() string
@@ -6914,46 +18187,90 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserInfoReply_List7ValidationError{}
+} = AdminWithdrawBulkApproveReply_FailValidationError{}
 
-// Validate checks the field values on UserInfoReply_List8 with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminLedgerBalanceReply_Coin with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserInfoReply_List8) Validate() error {
+func (m *AdminLedgerBalanceReply_Coin) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserInfoReply_List8 with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminLedgerBalanceReply_Coin with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UserInfoReply_List8MultiError, or nil if none found.
-func (m *UserInfoReply_List8) ValidateAll() error {
+// AdminLedgerBalanceReply_CoinMultiError, or nil if none found.
+func (m *AdminLedgerBalanceReply_Coin) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserInfoReply_List8) validate(all bool) error {
+func (m *AdminLedgerBalanceReply_Coin) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Address
+	// no validation rules for CoinType
+
+	for idx, item := range m.GetAccounts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminLedgerBalanceReply_CoinValidationError{
+						field:  fmt.Sprintf("Accounts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminLedgerBalanceReply_CoinValidationError{
+						field:  fmt.Sprintf("Accounts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminLedgerBalanceReply_CoinValidationError{
+					field:  fmt.Sprintf("Accounts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for UserLiabilities
+
+	// no validation rules for SystemTotal
+
+	// no validation rules for ChainHoldings
+
+	// no validation rules for UserBalance
+
+	// no validation rules for Balanced
 
 	if len(errors) > 0 {
-		return UserInfoReply_List8MultiError(errors)
+		return AdminLedgerBalanceReply_CoinMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserInfoReply_List8MultiError is an error wrapping multiple validation
-// errors returned by UserInfoReply_List8.ValidateAll() if the designated
-// constraints aren't met.
-type UserInfoReply_List8MultiError []error
+// AdminLedgerBalanceReply_CoinMultiError is an error wrapping multiple
+// validation errors returned by AdminLedgerBalanceReply_Coin.ValidateAll() if
+// the designated constraints aren't met.
+type AdminLedgerBalanceReply_CoinMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserInfoReply_List8MultiError) Error() string {
+func (m AdminLedgerBalanceReply_CoinMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6962,11 +18279,12 @@ func (m UserInfoReply_List8MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserInfoReply_List8MultiError) AllErrors() []error { return m }
+func (m AdminLedgerBalanceReply_CoinMultiError) AllErrors() []error { return m }
 
-// UserInfoReply_List8ValidationError is the validation error returned by
-// UserInfoReply_List8.Validate if the designated constraints aren't met.
-type UserInfoReply_List8ValidationError struct {
+// AdminLedgerBalanceReply_CoinValidationError is the validation error returned
+// by AdminLedgerBalanceReply_Coin.Validate if the designated constraints
+// aren't met.
+type AdminLedgerBalanceReply_CoinValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6974,24 +18292,24 @@ type UserInfoReply_List8ValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserInfoReply_List8ValidationError) Field() string { return e.field }
+func (e AdminLedgerBalanceReply_CoinValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserInfoReply_List8ValidationError) Reason() string { return e.reason }
+func (e AdminLedgerBalanceReply_CoinValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserInfoReply_List8ValidationError) Cause() error { return e.cause }
+func (e AdminLedgerBalanceReply_CoinValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserInfoReply_List8ValidationError) Key() bool { return e.key }
+func (e AdminLedgerBalanceReply_CoinValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserInfoReply_List8ValidationError) ErrorName() string {
-	return "UserInfoReply_List8ValidationError"
+func (e AdminLedgerBalanceReply_CoinValidationError) ErrorName() string {
+	return "AdminLedgerBalanceReply_CoinValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserInfoReply_List8ValidationError) Error() string {
+func (e AdminLedgerBalanceReply_CoinValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7003,14 +18321,14 @@ func (e UserInfoReply_List8ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserInfoReply_List8.%s: %s%s",
+		"invalid %sAdminLedgerBalanceReply_Coin.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserInfoReply_List8ValidationError{}
+var _ error = AdminLedgerBalanceReply_CoinValidationError{}
 
 var _ interface {
 	Field() string
@@ -7018,48 +18336,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserInfoReply_List8ValidationError{}
+} = AdminLedgerBalanceReply_CoinValidationError{}
 
-// Validate checks the field values on UserInfoReply_List9 with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminLedgerBalanceReply_Account with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserInfoReply_List9) Validate() error {
+func (m *AdminLedgerBalanceReply_Account) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserInfoReply_List9 with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UserInfoReply_List9MultiError, or nil if none found.
-func (m *UserInfoReply_List9) ValidateAll() error {
+// ValidateAll checks the field values on AdminLedgerBalanceReply_Account with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminLedgerBalanceReply_AccountMultiError, or nil if none found.
+func (m *AdminLedgerBalanceReply_Account) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserInfoReply_List9) validate(all bool) error {
+func (m *AdminLedgerBalanceReply_Account) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
+	// no validation rules for Account
 
 	// no validation rules for Amount
 
 	if len(errors) > 0 {
-		return UserInfoReply_List9MultiError(errors)
+		return AdminLedgerBalanceReply_AccountMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserInfoReply_List9MultiError is an error wrapping multiple validation
-// errors returned by UserInfoReply_List9.ValidateAll() if the designated
-// constraints aren't met.
-type UserInfoReply_List9MultiError []error
+// AdminLedgerBalanceReply_AccountMultiError is an error wrapping multiple
+// validation errors returned by AdminLedgerBalanceReply_Account.ValidateAll()
+// if the designated constraints aren't met.
+type AdminLedgerBalanceReply_AccountMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserInfoReply_List9MultiError) Error() string {
+func (m AdminLedgerBalanceReply_AccountMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7068,11 +18386,12 @@ func (m UserInfoReply_List9MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserInfoReply_List9MultiError) AllErrors() []error { return m }
+func (m AdminLedgerBalanceReply_AccountMultiError) AllErrors() []error { return m }
 
-// UserInfoReply_List9ValidationError is the validation error returned by
-// UserInfoReply_List9.Validate if the designated constraints aren't met.
-type UserInfoReply_List9ValidationError struct {
+// AdminLedgerBalanceReply_AccountValidationError is the validation error
+// returned by AdminLedgerBalanceReply_Account.Validate if the designated
+// constraints aren't met.
+type AdminLedgerBalanceReply_AccountValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7080,24 +18399,24 @@ type UserInfoReply_List9ValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserInfoReply_List9ValidationError) Field() string { return e.field }
+func (e AdminLedgerBalanceReply_AccountValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserInfoReply_List9ValidationError) Reason() string { return e.reason }
+func (e AdminLedgerBalanceReply_AccountValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserInfoReply_List9ValidationError) Cause() error { return e.cause }
+func (e AdminLedgerBalanceReply_AccountValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserInfoReply_List9ValidationError) Key() bool { return e.key }
+func (e AdminLedgerBalanceReply_AccountValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserInfoReply_List9ValidationError) ErrorName() string {
-	return "UserInfoReply_List9ValidationError"
+func (e AdminLedgerBalanceReply_AccountValidationError) ErrorName() string {
+	return "AdminLedgerBalanceReply_AccountValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserInfoReply_List9ValidationError) Error() string {
+func (e AdminLedgerBalanceReply_AccountValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7109,14 +18428,14 @@ func (e UserInfoReply_List9ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserInfoReply_List9.%s: %s%s",
+		"invalid %sAdminLedgerBalanceReply_Account.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserInfoReply_List9ValidationError{}
+var _ error = AdminLedgerBalanceReply_AccountValidationError{}
 
 var _ interface {
 	Field() string
@@ -7124,52 +18443,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserInfoReply_List9ValidationError{}
+} = AdminLedgerBalanceReply_AccountValidationError{}
 
-// Validate checks the field values on RewardListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RewardListReply_List) Validate() error {
+// Validate checks the field values on
+// AdminRecommendClosureBackfillRequest_SendBody with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminRecommendClosureBackfillRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RewardListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RewardListReply_ListMultiError, or nil if none found.
-func (m *RewardListReply_List) ValidateAll() error {
+// ValidateAll checks the field values on
+// AdminRecommendClosureBackfillRequest_SendBody with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// AdminRecommendClosureBackfillRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminRecommendClosureBackfillRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RewardListReply_List) validate(all bool) error {
+func (m *AdminRecommendClosureBackfillRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
-
-	// no validation rules for Amount
-
-	// no validation rules for LocationStatus
-
-	// no validation rules for Type
-
 	if len(errors) > 0 {
-		return RewardListReply_ListMultiError(errors)
+		return AdminRecommendClosureBackfillRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// RewardListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by RewardListReply_List.ValidateAll() if the designated
-// constraints aren't met.
-type RewardListReply_ListMultiError []error
+// AdminRecommendClosureBackfillRequest_SendBodyMultiError is an error wrapping
+// multiple validation errors returned by
+// AdminRecommendClosureBackfillRequest_SendBody.ValidateAll() if the
+// designated constraints aren't met.
+type AdminRecommendClosureBackfillRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RewardListReply_ListMultiError) Error() string {
+func (m AdminRecommendClosureBackfillRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7178,11 +18492,13 @@ func (m RewardListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RewardListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminRecommendClosureBackfillRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// RewardListReply_ListValidationError is the validation error returned by
-// RewardListReply_List.Validate if the designated constraints aren't met.
-type RewardListReply_ListValidationError struct {
+// AdminRecommendClosureBackfillRequest_SendBodyValidationError is the
+// validation error returned by
+// AdminRecommendClosureBackfillRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminRecommendClosureBackfillRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7190,24 +18506,26 @@ type RewardListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e RewardListReply_ListValidationError) Field() string { return e.field }
+func (e AdminRecommendClosureBackfillRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RewardListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminRecommendClosureBackfillRequest_SendBodyValidationError) Reason() string {
+	return e.reason
+}
 
 // Cause function returns cause value.
-func (e RewardListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminRecommendClosureBackfillRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RewardListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminRecommendClosureBackfillRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RewardListReply_ListValidationError) ErrorName() string {
-	return "RewardListReply_ListValidationError"
+func (e AdminRecommendClosureBackfillRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminRecommendClosureBackfillRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RewardListReply_ListValidationError) Error() string {
+func (e AdminRecommendClosureBackfillRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7219,14 +18537,14 @@ func (e RewardListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRewardListReply_List.%s: %s%s",
+		"invalid %sAdminRecommendClosureBackfillRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RewardListReply_ListValidationError{}
+var _ error = AdminRecommendClosureBackfillRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -7234,52 +18552,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RewardListReply_ListValidationError{}
+} = AdminRecommendClosureBackfillRequest_SendBodyValidationError{}
 
-// Validate checks the field values on RecommendRewardListReply_List with the
+// Validate checks the field values on AdminLedgerOpenRequest_SendBody with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RecommendRewardListReply_List) Validate() error {
+func (m *AdminLedgerOpenRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RecommendRewardListReply_List with
+// ValidateAll checks the field values on AdminLedgerOpenRequest_SendBody with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// RecommendRewardListReply_ListMultiError, or nil if none found.
-func (m *RecommendRewardListReply_List) ValidateAll() error {
+// AdminLedgerOpenRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminLedgerOpenRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecommendRewardListReply_List) validate(all bool) error {
+func (m *AdminLedgerOpenRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
-
-	// no validation rules for Amount
-
-	// no validation rules for Type
-
-	// no validation rules for Reason
-
 	if len(errors) > 0 {
-		return RecommendRewardListReply_ListMultiError(errors)
+		return AdminLedgerOpenRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// RecommendRewardListReply_ListMultiError is an error wrapping multiple
-// validation errors returned by RecommendRewardListReply_List.ValidateAll()
+// AdminLedgerOpenRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by AdminLedgerOpenRequest_SendBody.ValidateAll()
 // if the designated constraints aren't met.
-type RecommendRewardListReply_ListMultiError []error
+type AdminLedgerOpenRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecommendRewardListReply_ListMultiError) Error() string {
+func (m AdminLedgerOpenRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7288,12 +18598,12 @@ func (m RecommendRewardListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecommendRewardListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminLedgerOpenRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// RecommendRewardListReply_ListValidationError is the validation error
-// returned by RecommendRewardListReply_List.Validate if the designated
+// AdminLedgerOpenRequest_SendBodyValidationError is the validation error
+// returned by AdminLedgerOpenRequest_SendBody.Validate if the designated
 // constraints aren't met.
-type RecommendRewardListReply_ListValidationError struct {
+type AdminLedgerOpenRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7301,24 +18611,24 @@ type RecommendRewardListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecommendRewardListReply_ListValidationError) Field() string { return e.field }
+func (e AdminLedgerOpenRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecommendRewardListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminLedgerOpenRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecommendRewardListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminLedgerOpenRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecommendRewardListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminLedgerOpenRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RecommendRewardListReply_ListValidationError) ErrorName() string {
-	return "RecommendRewardListReply_ListValidationError"
+func (e AdminLedgerOpenRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminLedgerOpenRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RecommendRewardListReply_ListValidationError) Error() string {
+func (e AdminLedgerOpenRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7330,14 +18640,14 @@ func (e RecommendRewardListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecommendRewardListReply_List.%s: %s%s",
+		"invalid %sAdminLedgerOpenRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecommendRewardListReply_ListValidationError{}
+var _ error = AdminLedgerOpenRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -7345,48 +18655,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecommendRewardListReply_ListValidationError{}
+} = AdminLedgerOpenRequest_SendBodyValidationError{}
 
-// Validate checks the field values on FeeRewardListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminReconcileRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *FeeRewardListReply_List) Validate() error {
+func (m *AdminReconcileRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on FeeRewardListReply_List with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// FeeRewardListReply_ListMultiError, or nil if none found.
-func (m *FeeRewardListReply_List) ValidateAll() error {
+// ValidateAll checks the field values on AdminReconcileRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminReconcileRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminReconcileRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *FeeRewardListReply_List) validate(all bool) error {
+func (m *AdminReconcileRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
-
-	// no validation rules for Amount
-
 	if len(errors) > 0 {
-		return FeeRewardListReply_ListMultiError(errors)
+		return AdminReconcileRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// FeeRewardListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by FeeRewardListReply_List.ValidateAll() if the designated
-// constraints aren't met.
-type FeeRewardListReply_ListMultiError []error
+// AdminReconcileRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by AdminReconcileRequest_SendBody.ValidateAll()
+// if the designated constraints aren't met.
+type AdminReconcileRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m FeeRewardListReply_ListMultiError) Error() string {
+func (m AdminReconcileRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7395,11 +18701,12 @@ func (m FeeRewardListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m FeeRewardListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminReconcileRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// FeeRewardListReply_ListValidationError is the validation error returned by
-// FeeRewardListReply_List.Validate if the designated constraints aren't met.
-type FeeRewardListReply_ListValidationError struct {
+// AdminReconcileRequest_SendBodyValidationError is the validation error
+// returned by AdminReconcileRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminReconcileRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7407,24 +18714,24 @@ type FeeRewardListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e FeeRewardListReply_ListValidationError) Field() string { return e.field }
+func (e AdminReconcileRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e FeeRewardListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminReconcileRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e FeeRewardListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminReconcileRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e FeeRewardListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminReconcileRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e FeeRewardListReply_ListValidationError) ErrorName() string {
-	return "FeeRewardListReply_ListValidationError"
+func (e AdminReconcileRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminReconcileRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e FeeRewardListReply_ListValidationError) Error() string {
+func (e AdminReconcileRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7436,14 +18743,14 @@ func (e FeeRewardListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sFeeRewardListReply_List.%s: %s%s",
+		"invalid %sAdminReconcileRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = FeeRewardListReply_ListValidationError{}
+var _ error = AdminReconcileRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -7451,52 +18758,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = FeeRewardListReply_ListValidationError{}
+} = AdminReconcileRequest_SendBodyValidationError{}
 
-// Validate checks the field values on WithdrawListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *WithdrawListReply_List) Validate() error {
+// Validate checks the field values on AdminDepositUnmatchedListReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminDepositUnmatchedListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WithdrawListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// WithdrawListReply_ListMultiError, or nil if none found.
-func (m *WithdrawListReply_List) ValidateAll() error {
+// ValidateAll checks the field values on AdminDepositUnmatchedListReply_List
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminDepositUnmatchedListReply_ListMultiError, or nil if none found.
+func (m *AdminDepositUnmatchedListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WithdrawListReply_List) validate(all bool) error {
+func (m *AdminDepositUnmatchedListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
+	// no validation rules for Id
 
-	// no validation rules for Amount
+	// no validation rules for Hash
 
-	// no validation rules for Type
+	// no validation rules for From
 
-	// no validation rules for Status
+	// no validation rules for Amount
+
+	// no validation rules for BlockNumber
 
 	if len(errors) > 0 {
-		return WithdrawListReply_ListMultiError(errors)
+		return AdminDepositUnmatchedListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// WithdrawListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by WithdrawListReply_List.ValidateAll() if the designated
+// AdminDepositUnmatchedListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminDepositUnmatchedListReply_List.ValidateAll() if the designated
 // constraints aren't met.
-type WithdrawListReply_ListMultiError []error
+type AdminDepositUnmatchedListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WithdrawListReply_ListMultiError) Error() string {
+func (m AdminDepositUnmatchedListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7505,11 +18816,12 @@ func (m WithdrawListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WithdrawListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminDepositUnmatchedListReply_ListMultiError) AllErrors() []error { return m }
 
-// WithdrawListReply_ListValidationError is the validation error returned by
-// WithdrawListReply_List.Validate if the designated constraints aren't met.
-type WithdrawListReply_ListValidationError struct {
+// AdminDepositUnmatchedListReply_ListValidationError is the validation error
+// returned by AdminDepositUnmatchedListReply_List.Validate if the designated
+// constraints aren't met.
+type AdminDepositUnmatchedListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7517,24 +18829,24 @@ type WithdrawListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e WithdrawListReply_ListValidationError) Field() string { return e.field }
+func (e AdminDepositUnmatchedListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WithdrawListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminDepositUnmatchedListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WithdrawListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminDepositUnmatchedListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WithdrawListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminDepositUnmatchedListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WithdrawListReply_ListValidationError) ErrorName() string {
-	return "WithdrawListReply_ListValidationError"
+func (e AdminDepositUnmatchedListReply_ListValidationError) ErrorName() string {
+	return "AdminDepositUnmatchedListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WithdrawListReply_ListValidationError) Error() string {
+func (e AdminDepositUnmatchedListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7546,14 +18858,14 @@ func (e WithdrawListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWithdrawListReply_List.%s: %s%s",
+		"invalid %sAdminDepositUnmatchedListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WithdrawListReply_ListValidationError{}
+var _ error = AdminDepositUnmatchedListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -7561,48 +18873,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WithdrawListReply_ListValidationError{}
+} = AdminDepositUnmatchedListReply_ListValidationError{}
 
-// Validate checks the field values on RecommendListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RecommendListReply_List) Validate() error {
+// Validate checks the field values on AdminDepositResolveRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminDepositResolveRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RecommendListReply_List with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RecommendListReply_ListMultiError, or nil if none found.
-func (m *RecommendListReply_List) ValidateAll() error {
+// ValidateAll checks the field values on AdminDepositResolveRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminDepositResolveRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminDepositResolveRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecommendListReply_List) validate(all bool) error {
+func (m *AdminDepositResolveRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Amount
+	// no validation rules for Id
 
-	// no validation rules for Status
+	// no validation rules for UserId
 
 	if len(errors) > 0 {
-		return RecommendListReply_ListMultiError(errors)
+		return AdminDepositResolveRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// RecommendListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by RecommendListReply_List.ValidateAll() if the designated
+// AdminDepositResolveRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminDepositResolveRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type RecommendListReply_ListMultiError []error
+type AdminDepositResolveRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecommendListReply_ListMultiError) Error() string {
+func (m AdminDepositResolveRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7611,11 +18925,12 @@ func (m RecommendListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecommendListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminDepositResolveRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// RecommendListReply_ListValidationError is the validation error returned by
-// RecommendListReply_List.Validate if the designated constraints aren't met.
-type RecommendListReply_ListValidationError struct {
+// AdminDepositResolveRequest_SendBodyValidationError is the validation error
+// returned by AdminDepositResolveRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminDepositResolveRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7623,24 +18938,24 @@ type RecommendListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecommendListReply_ListValidationError) Field() string { return e.field }
+func (e AdminDepositResolveRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecommendListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminDepositResolveRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecommendListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminDepositResolveRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecommendListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminDepositResolveRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RecommendListReply_ListValidationError) ErrorName() string {
-	return "RecommendListReply_ListValidationError"
+func (e AdminDepositResolveRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminDepositResolveRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RecommendListReply_ListValidationError) Error() string {
+func (e AdminDepositResolveRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7652,14 +18967,14 @@ func (e RecommendListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecommendListReply_List.%s: %s%s",
+		"invalid %sAdminDepositResolveRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecommendListReply_ListValidationError{}
+var _ error = AdminDepositResolveRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -7667,48 +18982,62 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecommendListReply_ListValidationError{}
+} = AdminDepositResolveRequest_SendBodyValidationError{}
 
-// Validate checks the field values on WithdrawRequest_SendBody with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminDiscrepancyListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *WithdrawRequest_SendBody) Validate() error {
+func (m *AdminDiscrepancyListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WithdrawRequest_SendBody with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// WithdrawRequest_SendBodyMultiError, or nil if none found.
-func (m *WithdrawRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on AdminDiscrepancyListReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminDiscrepancyListReply_ListMultiError, or nil if none found.
+func (m *AdminDiscrepancyListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WithdrawRequest_SendBody) validate(all bool) error {
+func (m *AdminDiscrepancyListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Type
+	// no validation rules for Id
 
-	// no validation rules for Amount
+	// no validation rules for UserId
+
+	// no validation rules for CoinType
+
+	// no validation rules for Source
+
+	// no validation rules for Expected
+
+	// no validation rules for Actual
+
+	// no validation rules for Diff
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
 
 	if len(errors) > 0 {
-		return WithdrawRequest_SendBodyMultiError(errors)
+		return AdminDiscrepancyListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// WithdrawRequest_SendBodyMultiError is an error wrapping multiple validation
-// errors returned by WithdrawRequest_SendBody.ValidateAll() if the designated
-// constraints aren't met.
-type WithdrawRequest_SendBodyMultiError []error
+// AdminDiscrepancyListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminDiscrepancyListReply_List.ValidateAll()
+// if the designated constraints aren't met.
+type AdminDiscrepancyListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WithdrawRequest_SendBodyMultiError) Error() string {
+func (m AdminDiscrepancyListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7717,11 +19046,12 @@ func (m WithdrawRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WithdrawRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminDiscrepancyListReply_ListMultiError) AllErrors() []error { return m }
 
-// WithdrawRequest_SendBodyValidationError is the validation error returned by
-// WithdrawRequest_SendBody.Validate if the designated constraints aren't met.
-type WithdrawRequest_SendBodyValidationError struct {
+// AdminDiscrepancyListReply_ListValidationError is the validation error
+// returned by AdminDiscrepancyListReply_List.Validate if the designated
+// constraints aren't met.
+type AdminDiscrepancyListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7729,24 +19059,24 @@ type WithdrawRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e WithdrawRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminDiscrepancyListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WithdrawRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminDiscrepancyListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WithdrawRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminDiscrepancyListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WithdrawRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminDiscrepancyListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WithdrawRequest_SendBodyValidationError) ErrorName() string {
-	return "WithdrawRequest_SendBodyValidationError"
+func (e AdminDiscrepancyListReply_ListValidationError) ErrorName() string {
+	return "AdminDiscrepancyListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WithdrawRequest_SendBodyValidationError) Error() string {
+func (e AdminDiscrepancyListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7758,14 +19088,14 @@ func (e WithdrawRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWithdrawRequest_SendBody.%s: %s%s",
+		"invalid %sAdminDiscrepancyListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WithdrawRequest_SendBodyValidationError{}
+var _ error = AdminDiscrepancyListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -7773,48 +19103,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WithdrawRequest_SendBodyValidationError{}
+} = AdminDiscrepancyListReply_ListValidationError{}
 
-// Validate checks the field values on SetBalanceRewardRequest_SendBody with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *SetBalanceRewardRequest_SendBody) Validate() error {
+// Validate checks the field values on AdminLocationDailySettleRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *AdminLocationDailySettleRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetBalanceRewardRequest_SendBody with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// SetBalanceRewardRequest_SendBodyMultiError, or nil if none found.
-func (m *SetBalanceRewardRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on
+// AdminLocationDailySettleRequest_SendBody with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// AdminLocationDailySettleRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminLocationDailySettleRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetBalanceRewardRequest_SendBody) validate(all bool) error {
+func (m *AdminLocationDailySettleRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Amount
-
 	if len(errors) > 0 {
-		return SetBalanceRewardRequest_SendBodyMultiError(errors)
+		return AdminLocationDailySettleRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetBalanceRewardRequest_SendBodyMultiError is an error wrapping multiple
-// validation errors returned by
-// SetBalanceRewardRequest_SendBody.ValidateAll() if the designated
+// AdminLocationDailySettleRequest_SendBodyMultiError is an error wrapping
+// multiple validation errors returned by
+// AdminLocationDailySettleRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type SetBalanceRewardRequest_SendBodyMultiError []error
+type AdminLocationDailySettleRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetBalanceRewardRequest_SendBodyMultiError) Error() string {
+func (m AdminLocationDailySettleRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7823,12 +19152,12 @@ func (m SetBalanceRewardRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetBalanceRewardRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminLocationDailySettleRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// SetBalanceRewardRequest_SendBodyValidationError is the validation error
-// returned by SetBalanceRewardRequest_SendBody.Validate if the designated
-// constraints aren't met.
-type SetBalanceRewardRequest_SendBodyValidationError struct {
+// AdminLocationDailySettleRequest_SendBodyValidationError is the validation
+// error returned by AdminLocationDailySettleRequest_SendBody.Validate if the
+// designated constraints aren't met.
+type AdminLocationDailySettleRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7836,24 +19165,24 @@ type SetBalanceRewardRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetBalanceRewardRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminLocationDailySettleRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetBalanceRewardRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminLocationDailySettleRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetBalanceRewardRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminLocationDailySettleRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetBalanceRewardRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminLocationDailySettleRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetBalanceRewardRequest_SendBodyValidationError) ErrorName() string {
-	return "SetBalanceRewardRequest_SendBodyValidationError"
+func (e AdminLocationDailySettleRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminLocationDailySettleRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetBalanceRewardRequest_SendBodyValidationError) Error() string {
+func (e AdminLocationDailySettleRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7865,14 +19194,14 @@ func (e SetBalanceRewardRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetBalanceRewardRequest_SendBody.%s: %s%s",
+		"invalid %sAdminLocationDailySettleRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetBalanceRewardRequest_SendBodyValidationError{}
+var _ error = AdminLocationDailySettleRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -7880,48 +19209,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetBalanceRewardRequest_SendBodyValidationError{}
+} = AdminLocationDailySettleRequest_SendBodyValidationError{}
 
-// Validate checks the field values on DeleteBalanceRewardRequest_SendBody with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *DeleteBalanceRewardRequest_SendBody) Validate() error {
+// Validate checks the field values on AdminUserAreaRebuildRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *AdminUserAreaRebuildRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteBalanceRewardRequest_SendBody
+// ValidateAll checks the field values on AdminUserAreaRebuildRequest_SendBody
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the result is a list of violation errors wrapped in
-// DeleteBalanceRewardRequest_SendBodyMultiError, or nil if none found.
-func (m *DeleteBalanceRewardRequest_SendBody) ValidateAll() error {
+// AdminUserAreaRebuildRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminUserAreaRebuildRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteBalanceRewardRequest_SendBody) validate(all bool) error {
+func (m *AdminUserAreaRebuildRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Amount
-
 	if len(errors) > 0 {
-		return DeleteBalanceRewardRequest_SendBodyMultiError(errors)
+		return AdminUserAreaRebuildRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteBalanceRewardRequest_SendBodyMultiError is an error wrapping multiple
+// AdminUserAreaRebuildRequest_SendBodyMultiError is an error wrapping multiple
 // validation errors returned by
-// DeleteBalanceRewardRequest_SendBody.ValidateAll() if the designated
+// AdminUserAreaRebuildRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteBalanceRewardRequest_SendBodyMultiError []error
+type AdminUserAreaRebuildRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteBalanceRewardRequest_SendBodyMultiError) Error() string {
+func (m AdminUserAreaRebuildRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7930,12 +19257,12 @@ func (m DeleteBalanceRewardRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteBalanceRewardRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminUserAreaRebuildRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// DeleteBalanceRewardRequest_SendBodyValidationError is the validation error
-// returned by DeleteBalanceRewardRequest_SendBody.Validate if the designated
+// AdminUserAreaRebuildRequest_SendBodyValidationError is the validation error
+// returned by AdminUserAreaRebuildRequest_SendBody.Validate if the designated
 // constraints aren't met.
-type DeleteBalanceRewardRequest_SendBodyValidationError struct {
+type AdminUserAreaRebuildRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7943,24 +19270,24 @@ type DeleteBalanceRewardRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteBalanceRewardRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminUserAreaRebuildRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteBalanceRewardRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminUserAreaRebuildRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteBalanceRewardRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminUserAreaRebuildRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteBalanceRewardRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminUserAreaRebuildRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteBalanceRewardRequest_SendBodyValidationError) ErrorName() string {
-	return "DeleteBalanceRewardRequest_SendBodyValidationError"
+func (e AdminUserAreaRebuildRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminUserAreaRebuildRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteBalanceRewardRequest_SendBodyValidationError) Error() string {
+func (e AdminUserAreaRebuildRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7972,14 +19299,14 @@ func (e DeleteBalanceRewardRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteBalanceRewardRequest_SendBody.%s: %s%s",
+		"invalid %sAdminUserAreaRebuildRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteBalanceRewardRequest_SendBodyValidationError{}
+var _ error = AdminUserAreaRebuildRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -7987,54 +19314,116 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteBalanceRewardRequest_SendBodyValidationError{}
+} = AdminUserAreaRebuildRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminRewardListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminRewardListReply_List) Validate() error {
+// Validate checks the field values on AdminSettlementDryRunRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *AdminSettlementDryRunRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminRewardListReply_List with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminRewardListReply_ListMultiError, or nil if none found.
-func (m *AdminRewardListReply_List) ValidateAll() error {
+// ValidateAll checks the field values on AdminSettlementDryRunRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminSettlementDryRunRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminSettlementDryRunRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminRewardListReply_List) validate(all bool) error {
+func (m *AdminSettlementDryRunRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
+	for idx, item := range m.GetConfigs() {
+		_, _ = idx, item
 
-	// no validation rules for Amount
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminSettlementDryRunRequest_SendBodyValidationError{
+						field:  fmt.Sprintf("Configs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminSettlementDryRunRequest_SendBodyValidationError{
+						field:  fmt.Sprintf("Configs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminSettlementDryRunRequest_SendBodyValidationError{
+					field:  fmt.Sprintf("Configs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for Type
+	}
 
-	// no validation rules for Address
+	for idx, item := range m.GetCompareConfigs() {
+		_, _ = idx, item
 
-	// no validation rules for Reason
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminSettlementDryRunRequest_SendBodyValidationError{
+						field:  fmt.Sprintf("CompareConfigs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminSettlementDryRunRequest_SendBodyValidationError{
+						field:  fmt.Sprintf("CompareConfigs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminSettlementDryRunRequest_SendBodyValidationError{
+					field:  fmt.Sprintf("CompareConfigs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Compare
 
 	if len(errors) > 0 {
-		return AdminRewardListReply_ListMultiError(errors)
+		return AdminSettlementDryRunRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminRewardListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by AdminRewardListReply_List.ValidateAll() if the
-// designated constraints aren't met.
-type AdminRewardListReply_ListMultiError []error
+// AdminSettlementDryRunRequest_SendBodyMultiError is an error wrapping
+// multiple validation errors returned by
+// AdminSettlementDryRunRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type AdminSettlementDryRunRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminRewardListReply_ListMultiError) Error() string {
+func (m AdminSettlementDryRunRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8043,11 +19432,12 @@ func (m AdminRewardListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminRewardListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminSettlementDryRunRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminRewardListReply_ListValidationError is the validation error returned by
-// AdminRewardListReply_List.Validate if the designated constraints aren't met.
-type AdminRewardListReply_ListValidationError struct {
+// AdminSettlementDryRunRequest_SendBodyValidationError is the validation error
+// returned by AdminSettlementDryRunRequest_SendBody.Validate if the
+// designated constraints aren't met.
+type AdminSettlementDryRunRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8055,24 +19445,24 @@ type AdminRewardListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminRewardListReply_ListValidationError) Field() string { return e.field }
+func (e AdminSettlementDryRunRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminRewardListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminSettlementDryRunRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminRewardListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminSettlementDryRunRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminRewardListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminSettlementDryRunRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminRewardListReply_ListValidationError) ErrorName() string {
-	return "AdminRewardListReply_ListValidationError"
+func (e AdminSettlementDryRunRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminSettlementDryRunRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminRewardListReply_ListValidationError) Error() string {
+func (e AdminSettlementDryRunRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8084,14 +19474,14 @@ func (e AdminRewardListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminRewardListReply_List.%s: %s%s",
+		"invalid %sAdminSettlementDryRunRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminRewardListReply_ListValidationError{}
+var _ error = AdminSettlementDryRunRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -8099,60 +19489,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminRewardListReply_ListValidationError{}
+} = AdminSettlementDryRunRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminUserListReply_UserList with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUserListReply_UserList) Validate() error {
+// Validate checks the field values on AdminSettlementReplayRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *AdminSettlementReplayRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUserListReply_UserList with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminUserListReply_UserListMultiError, or nil if none found.
-func (m *AdminUserListReply_UserList) ValidateAll() error {
+// ValidateAll checks the field values on AdminSettlementReplayRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminSettlementReplayRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminSettlementReplayRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUserListReply_UserList) validate(all bool) error {
+func (m *AdminSettlementReplayRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
-
-	// no validation rules for CreatedAt
-
-	// no validation rules for Address
-
-	// no validation rules for BalanceUsdt
-
-	// no validation rules for BalanceDhb
+	// no validation rules for Date
 
-	// no validation rules for Vip
+	for idx, item := range m.GetConfigs() {
+		_, _ = idx, item
 
-	// no validation rules for MonthRecommend
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminSettlementReplayRequest_SendBodyValidationError{
+						field:  fmt.Sprintf("Configs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminSettlementReplayRequest_SendBodyValidationError{
+						field:  fmt.Sprintf("Configs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminSettlementReplayRequest_SendBodyValidationError{
+					field:  fmt.Sprintf("Configs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for HistoryRecommend
+	}
 
 	if len(errors) > 0 {
-		return AdminUserListReply_UserListMultiError(errors)
+		return AdminSettlementReplayRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUserListReply_UserListMultiError is an error wrapping multiple
-// validation errors returned by AdminUserListReply_UserList.ValidateAll() if
-// the designated constraints aren't met.
-type AdminUserListReply_UserListMultiError []error
+// AdminSettlementReplayRequest_SendBodyMultiError is an error wrapping
+// multiple validation errors returned by
+// AdminSettlementReplayRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type AdminSettlementReplayRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUserListReply_UserListMultiError) Error() string {
+func (m AdminSettlementReplayRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8161,12 +19573,12 @@ func (m AdminUserListReply_UserListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUserListReply_UserListMultiError) AllErrors() []error { return m }
+func (m AdminSettlementReplayRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminUserListReply_UserListValidationError is the validation error returned
-// by AdminUserListReply_UserList.Validate if the designated constraints
-// aren't met.
-type AdminUserListReply_UserListValidationError struct {
+// AdminSettlementReplayRequest_SendBodyValidationError is the validation error
+// returned by AdminSettlementReplayRequest_SendBody.Validate if the
+// designated constraints aren't met.
+type AdminSettlementReplayRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8174,24 +19586,24 @@ type AdminUserListReply_UserListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUserListReply_UserListValidationError) Field() string { return e.field }
+func (e AdminSettlementReplayRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUserListReply_UserListValidationError) Reason() string { return e.reason }
+func (e AdminSettlementReplayRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUserListReply_UserListValidationError) Cause() error { return e.cause }
+func (e AdminSettlementReplayRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUserListReply_UserListValidationError) Key() bool { return e.key }
+func (e AdminSettlementReplayRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUserListReply_UserListValidationError) ErrorName() string {
-	return "AdminUserListReply_UserListValidationError"
+func (e AdminSettlementReplayRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminSettlementReplayRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUserListReply_UserListValidationError) Error() string {
+func (e AdminSettlementReplayRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8203,14 +19615,14 @@ func (e AdminUserListReply_UserListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUserListReply_UserList.%s: %s%s",
+		"invalid %sAdminSettlementReplayRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUserListReply_UserListValidationError{}
+var _ error = AdminSettlementReplayRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -8218,62 +19630,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUserListReply_UserListValidationError{}
+} = AdminSettlementReplayRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminLocationListReply_LocationList with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *AdminLocationListReply_LocationList) Validate() error {
+// Validate checks the field values on AdminUserRecommendReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserRecommendReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminLocationListReply_LocationList
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// AdminLocationListReply_LocationListMultiError, or nil if none found.
-func (m *AdminLocationListReply_LocationList) ValidateAll() error {
+// ValidateAll checks the field values on AdminUserRecommendReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserRecommendReply_ListMultiError, or nil if none found.
+func (m *AdminUserRecommendReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLocationListReply_LocationList) validate(all bool) error {
+func (m *AdminUserRecommendReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
+	// no validation rules for UserId
 
 	// no validation rules for Address
 
-	// no validation rules for Row
-
-	// no validation rules for Col
-
-	// no validation rules for Status
-
-	// no validation rules for CurrentLevel
-
-	// no validation rules for Current
+	// no validation rules for Id
 
-	// no validation rules for CurrentMax
+	// no validation rules for CreatedAt
 
 	if len(errors) > 0 {
-		return AdminLocationListReply_LocationListMultiError(errors)
+		return AdminUserRecommendReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLocationListReply_LocationListMultiError is an error wrapping multiple
-// validation errors returned by
-// AdminLocationListReply_LocationList.ValidateAll() if the designated
-// constraints aren't met.
-type AdminLocationListReply_LocationListMultiError []error
+// AdminUserRecommendReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminUserRecommendReply_List.ValidateAll() if
+// the designated constraints aren't met.
+type AdminUserRecommendReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLocationListReply_LocationListMultiError) Error() string {
+func (m AdminUserRecommendReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8282,12 +19684,12 @@ func (m AdminLocationListReply_LocationListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLocationListReply_LocationListMultiError) AllErrors() []error { return m }
+func (m AdminUserRecommendReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminLocationListReply_LocationListValidationError is the validation error
-// returned by AdminLocationListReply_LocationList.Validate if the designated
-// constraints aren't met.
-type AdminLocationListReply_LocationListValidationError struct {
+// AdminUserRecommendReply_ListValidationError is the validation error returned
+// by AdminUserRecommendReply_List.Validate if the designated constraints
+// aren't met.
+type AdminUserRecommendReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8295,24 +19697,24 @@ type AdminLocationListReply_LocationListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLocationListReply_LocationListValidationError) Field() string { return e.field }
+func (e AdminUserRecommendReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLocationListReply_LocationListValidationError) Reason() string { return e.reason }
+func (e AdminUserRecommendReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLocationListReply_LocationListValidationError) Cause() error { return e.cause }
+func (e AdminUserRecommendReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLocationListReply_LocationListValidationError) Key() bool { return e.key }
+func (e AdminUserRecommendReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLocationListReply_LocationListValidationError) ErrorName() string {
-	return "AdminLocationListReply_LocationListValidationError"
+func (e AdminUserRecommendReply_ListValidationError) ErrorName() string {
+	return "AdminUserRecommendReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminLocationListReply_LocationListValidationError) Error() string {
+func (e AdminUserRecommendReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8324,14 +19726,14 @@ func (e AdminLocationListReply_LocationListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLocationListReply_LocationList.%s: %s%s",
+		"invalid %sAdminUserRecommendReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLocationListReply_LocationListValidationError{}
+var _ error = AdminUserRecommendReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -8339,58 +19741,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLocationListReply_LocationListValidationError{}
+} = AdminUserRecommendReply_ListValidationError{}
 
-// Validate checks the field values on AdminWithdrawListReply_List with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawListReply_List) Validate() error {
+// Validate checks the field values on AdminUserLevelOverrideRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *AdminUserLevelOverrideRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawListReply_List with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminWithdrawListReply_ListMultiError, or nil if none found.
-func (m *AdminWithdrawListReply_List) ValidateAll() error {
+// ValidateAll checks the field values on
+// AdminUserLevelOverrideRequest_SendBody with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// AdminUserLevelOverrideRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminUserLevelOverrideRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawListReply_List) validate(all bool) error {
+func (m *AdminUserLevelOverrideRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Address
-
-	// no validation rules for Id
-
-	// no validation rules for CreatedAt
-
-	// no validation rules for Amount
+	// no validation rules for UserId
 
-	// no validation rules for RelAmount
+	// no validation rules for Level
 
-	// no validation rules for Type
+	// no validation rules for ExpireAt
 
-	// no validation rules for Status
+	// no validation rules for Reason
 
 	if len(errors) > 0 {
-		return AdminWithdrawListReply_ListMultiError(errors)
+		return AdminUserLevelOverrideRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawListReply_ListMultiError is an error wrapping multiple
-// validation errors returned by AdminWithdrawListReply_List.ValidateAll() if
-// the designated constraints aren't met.
-type AdminWithdrawListReply_ListMultiError []error
+// AdminUserLevelOverrideRequest_SendBodyMultiError is an error wrapping
+// multiple validation errors returned by
+// AdminUserLevelOverrideRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type AdminUserLevelOverrideRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawListReply_ListMultiError) Error() string {
+func (m AdminUserLevelOverrideRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8399,12 +19798,12 @@ func (m AdminWithdrawListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminUserLevelOverrideRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawListReply_ListValidationError is the validation error returned
-// by AdminWithdrawListReply_List.Validate if the designated constraints
-// aren't met.
-type AdminWithdrawListReply_ListValidationError struct {
+// AdminUserLevelOverrideRequest_SendBodyValidationError is the validation
+// error returned by AdminUserLevelOverrideRequest_SendBody.Validate if the
+// designated constraints aren't met.
+type AdminUserLevelOverrideRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8412,24 +19811,24 @@ type AdminWithdrawListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawListReply_ListValidationError) Field() string { return e.field }
+func (e AdminUserLevelOverrideRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminUserLevelOverrideRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminUserLevelOverrideRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminUserLevelOverrideRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawListReply_ListValidationError) ErrorName() string {
-	return "AdminWithdrawListReply_ListValidationError"
+func (e AdminUserLevelOverrideRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminUserLevelOverrideRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawListReply_ListValidationError) Error() string {
+func (e AdminUserLevelOverrideRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8441,14 +19840,14 @@ func (e AdminWithdrawListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawListReply_List.%s: %s%s",
+		"invalid %sAdminUserLevelOverrideRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawListReply_ListValidationError{}
+var _ error = AdminUserLevelOverrideRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -8456,52 +19855,64 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawListReply_ListValidationError{}
+} = AdminUserLevelOverrideRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminUserRecommendReply_List with the
+// Validate checks the field values on AdminUserLevelHistoryReply_List with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUserRecommendReply_List) Validate() error {
+func (m *AdminUserLevelHistoryReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUserRecommendReply_List with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminUserRecommendReply_ListMultiError, or nil if none found.
-func (m *AdminUserRecommendReply_List) ValidateAll() error {
+// ValidateAll checks the field values on AdminUserLevelHistoryReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminUserLevelHistoryReply_ListMultiError, or nil if none found.
+func (m *AdminUserLevelHistoryReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUserRecommendReply_List) validate(all bool) error {
+func (m *AdminUserLevelHistoryReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
 	// no validation rules for UserId
 
-	// no validation rules for Address
+	// no validation rules for OldLevel
 
-	// no validation rules for Id
+	// no validation rules for NewLevel
+
+	// no validation rules for AutoLevel
+
+	// no validation rules for OverrideLevel
+
+	// no validation rules for AreaAmount
+
+	// no validation rules for RecommendNum
+
+	// no validation rules for Source
 
 	// no validation rules for CreatedAt
 
 	if len(errors) > 0 {
-		return AdminUserRecommendReply_ListMultiError(errors)
+		return AdminUserLevelHistoryReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUserRecommendReply_ListMultiError is an error wrapping multiple
-// validation errors returned by AdminUserRecommendReply_List.ValidateAll() if
-// the designated constraints aren't met.
-type AdminUserRecommendReply_ListMultiError []error
+// AdminUserLevelHistoryReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminUserLevelHistoryReply_List.ValidateAll()
+// if the designated constraints aren't met.
+type AdminUserLevelHistoryReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUserRecommendReply_ListMultiError) Error() string {
+func (m AdminUserLevelHistoryReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8510,12 +19921,12 @@ func (m AdminUserRecommendReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUserRecommendReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminUserLevelHistoryReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminUserRecommendReply_ListValidationError is the validation error returned
-// by AdminUserRecommendReply_List.Validate if the designated constraints
-// aren't met.
-type AdminUserRecommendReply_ListValidationError struct {
+// AdminUserLevelHistoryReply_ListValidationError is the validation error
+// returned by AdminUserLevelHistoryReply_List.Validate if the designated
+// constraints aren't met.
+type AdminUserLevelHistoryReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8523,24 +19934,24 @@ type AdminUserRecommendReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUserRecommendReply_ListValidationError) Field() string { return e.field }
+func (e AdminUserLevelHistoryReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUserRecommendReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminUserLevelHistoryReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUserRecommendReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminUserLevelHistoryReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUserRecommendReply_ListValidationError) Key() bool { return e.key }
+func (e AdminUserLevelHistoryReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUserRecommendReply_ListValidationError) ErrorName() string {
-	return "AdminUserRecommendReply_ListValidationError"
+func (e AdminUserLevelHistoryReply_ListValidationError) ErrorName() string {
+	return "AdminUserLevelHistoryReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUserRecommendReply_ListValidationError) Error() string {
+func (e AdminUserLevelHistoryReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8552,14 +19963,14 @@ func (e AdminUserRecommendReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUserRecommendReply_List.%s: %s%s",
+		"invalid %sAdminUserLevelHistoryReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUserRecommendReply_ListValidationError{}
+var _ error = AdminUserLevelHistoryReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -8567,7 +19978,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUserRecommendReply_ListValidationError{}
+} = AdminUserLevelHistoryReply_ListValidationError{}
 
 // Validate checks the field values on AdminMonthRecommendReply_List with the
 // rules defined in the proto definition for this message. If any rules are
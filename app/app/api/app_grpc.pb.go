@@ -23,6 +23,10 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type AppClient interface {
 	EthAuthorize(ctx context.Context, in *EthAuthorizeRequest, opts ...grpc.CallOption) (*EthAuthorizeReply, error)
+	GetAuthNonce(ctx context.Context, in *GetAuthNonceRequest, opts ...grpc.CallOption) (*GetAuthNonceReply, error)
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenReply, error)
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutReply, error)
+	LogoutAllSessions(ctx context.Context, in *LogoutAllSessionsRequest, opts ...grpc.CallOption) (*LogoutAllSessionsReply, error)
 	RecommendUpdate(ctx context.Context, in *RecommendUpdateRequest, opts ...grpc.CallOption) (*RecommendUpdateReply, error)
 	UserInfo(ctx context.Context, in *UserInfoRequest, opts ...grpc.CallOption) (*UserInfoReply, error)
 	RewardList(ctx context.Context, in *RewardListRequest, opts ...grpc.CallOption) (*RewardListReply, error)
@@ -30,7 +34,12 @@ type AppClient interface {
 	FeeRewardList(ctx context.Context, in *FeeRewardListRequest, opts ...grpc.CallOption) (*FeeRewardListReply, error)
 	WithdrawList(ctx context.Context, in *WithdrawListRequest, opts ...grpc.CallOption) (*WithdrawListReply, error)
 	RecommendList(ctx context.Context, in *RecommendListRequest, opts ...grpc.CallOption) (*RecommendListReply, error)
+	RecommendTree(ctx context.Context, in *RecommendTreeRequest, opts ...grpc.CallOption) (*RecommendTreeReply, error)
 	Withdraw(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*WithdrawReply, error)
+	CancelWithdraw(ctx context.Context, in *CancelWithdrawRequest, opts ...grpc.CallOption) (*CancelWithdrawReply, error)
+	WithdrawAddressList(ctx context.Context, in *WithdrawAddressListRequest, opts ...grpc.CallOption) (*WithdrawAddressListReply, error)
+	AddWithdrawAddress(ctx context.Context, in *AddWithdrawAddressRequest, opts ...grpc.CallOption) (*AddWithdrawAddressReply, error)
+	DeleteWithdrawAddress(ctx context.Context, in *DeleteWithdrawAddressRequest, opts ...grpc.CallOption) (*DeleteWithdrawAddressReply, error)
 	SetBalanceReward(ctx context.Context, in *SetBalanceRewardRequest, opts ...grpc.CallOption) (*SetBalanceRewardReply, error)
 	DeleteBalanceReward(ctx context.Context, in *DeleteBalanceRewardRequest, opts ...grpc.CallOption) (*DeleteBalanceRewardReply, error)
 	Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositReply, error)
@@ -59,7 +68,28 @@ type AppClient interface {
 	//		};
 	//	};
 	//
-	AdminWithdraw(ctx context.Context, in *AdminWithdrawRequest, opts ...grpc.CallOption) (*AdminWithdrawReply, error)
+	AdminLogin(ctx context.Context, in *AdminLoginRequest, opts ...grpc.CallOption) (*AdminLoginReply, error)
+	AdminWithdrawApprove(ctx context.Context, in *AdminWithdrawApproveRequest, opts ...grpc.CallOption) (*AdminWithdrawApproveReply, error)
+	AdminWithdrawReject(ctx context.Context, in *AdminWithdrawRejectRequest, opts ...grpc.CallOption) (*AdminWithdrawRejectReply, error)
+	AdminWithdrawRetry(ctx context.Context, in *AdminWithdrawRetryRequest, opts ...grpc.CallOption) (*AdminWithdrawRetryReply, error)
+	AdminWithdrawRefund(ctx context.Context, in *AdminWithdrawRefundRequest, opts ...grpc.CallOption) (*AdminWithdrawRefundReply, error)
+	AdminWithdrawLegacyMigrate(ctx context.Context, in *AdminWithdrawLegacyMigrateRequest, opts ...grpc.CallOption) (*AdminWithdrawLegacyMigrateReply, error)
+	AdminWithdrawBulkApprove(ctx context.Context, in *AdminWithdrawBulkApproveRequest, opts ...grpc.CallOption) (*AdminWithdrawBulkApproveReply, error)
+	AdminLedgerBalance(ctx context.Context, in *AdminLedgerBalanceRequest, opts ...grpc.CallOption) (*AdminLedgerBalanceReply, error)
+	AdminRecommendClosureBackfill(ctx context.Context, in *AdminRecommendClosureBackfillRequest, opts ...grpc.CallOption) (*AdminRecommendClosureBackfillReply, error)
+	AdminLedgerOpen(ctx context.Context, in *AdminLedgerOpenRequest, opts ...grpc.CallOption) (*AdminLedgerOpenReply, error)
+	AdminReconcile(ctx context.Context, in *AdminReconcileRequest, opts ...grpc.CallOption) (*AdminReconcileReply, error)
+	AdminDepositUnmatchedList(ctx context.Context, in *AdminDepositUnmatchedListRequest, opts ...grpc.CallOption) (*AdminDepositUnmatchedListReply, error)
+	AdminDepositResolve(ctx context.Context, in *AdminDepositResolveRequest, opts ...grpc.CallOption) (*AdminDepositResolveReply, error)
+	AdminDiscrepancyList(ctx context.Context, in *AdminDiscrepancyListRequest, opts ...grpc.CallOption) (*AdminDiscrepancyListReply, error)
+	AdminLocationDailySettle(ctx context.Context, in *AdminLocationDailySettleRequest, opts ...grpc.CallOption) (*AdminLocationDailySettleReply, error)
+	AdminUserAreaRebuild(ctx context.Context, in *AdminUserAreaRebuildRequest, opts ...grpc.CallOption) (*AdminUserAreaRebuildReply, error)
+	AdminSettlementDryRun(ctx context.Context, in *AdminSettlementDryRunRequest, opts ...grpc.CallOption) (*AdminSettlementDryRunReply, error)
+	AdminSettlementReplay(ctx context.Context, in *AdminSettlementReplayRequest, opts ...grpc.CallOption) (*AdminSettlementReplayReply, error)
+	AdminUserRecommend(ctx context.Context, in *AdminUserRecommendRequest, opts ...grpc.CallOption) (*AdminUserRecommendReply, error)
+	AdminRecommendTree(ctx context.Context, in *AdminRecommendTreeRequest, opts ...grpc.CallOption) (*AdminRecommendTreeReply, error)
+	AdminUserLevelOverride(ctx context.Context, in *AdminUserLevelOverrideRequest, opts ...grpc.CallOption) (*AdminUserLevelOverrideReply, error)
+	AdminUserLevelHistory(ctx context.Context, in *AdminUserLevelHistoryRequest, opts ...grpc.CallOption) (*AdminUserLevelHistoryReply, error)
 	AdminWithdrawEth(ctx context.Context, in *AdminWithdrawEthRequest, opts ...grpc.CallOption) (*AdminWithdrawEthReply, error)
 	AdminFee(ctx context.Context, in *AdminFeeRequest, opts ...grpc.CallOption) (*AdminFeeReply, error)
 }
@@ -81,6 +111,42 @@ func (c *appClient) EthAuthorize(ctx context.Context, in *EthAuthorizeRequest, o
 	return out, nil
 }
 
+func (c *appClient) GetAuthNonce(ctx context.Context, in *GetAuthNonceRequest, opts ...grpc.CallOption) (*GetAuthNonceReply, error) {
+	out := new(GetAuthNonceReply)
+	err := c.cc.Invoke(ctx, "/api.App/GetAuthNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenReply, error) {
+	out := new(RefreshTokenReply)
+	err := c.cc.Invoke(ctx, "/api.App/RefreshToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutReply, error) {
+	out := new(LogoutReply)
+	err := c.cc.Invoke(ctx, "/api.App/Logout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) LogoutAllSessions(ctx context.Context, in *LogoutAllSessionsRequest, opts ...grpc.CallOption) (*LogoutAllSessionsReply, error) {
+	out := new(LogoutAllSessionsReply)
+	err := c.cc.Invoke(ctx, "/api.App/LogoutAllSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appClient) RecommendUpdate(ctx context.Context, in *RecommendUpdateRequest, opts ...grpc.CallOption) (*RecommendUpdateReply, error) {
 	out := new(RecommendUpdateReply)
 	err := c.cc.Invoke(ctx, "/api.App/RecommendUpdate", in, out, opts...)
@@ -144,6 +210,15 @@ func (c *appClient) RecommendList(ctx context.Context, in *RecommendListRequest,
 	return out, nil
 }
 
+func (c *appClient) RecommendTree(ctx context.Context, in *RecommendTreeRequest, opts ...grpc.CallOption) (*RecommendTreeReply, error) {
+	out := new(RecommendTreeReply)
+	err := c.cc.Invoke(ctx, "/api.App/RecommendTree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appClient) Withdraw(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*WithdrawReply, error) {
 	out := new(WithdrawReply)
 	err := c.cc.Invoke(ctx, "/api.App/Withdraw", in, out, opts...)
@@ -153,6 +228,42 @@ func (c *appClient) Withdraw(ctx context.Context, in *WithdrawRequest, opts ...g
 	return out, nil
 }
 
+func (c *appClient) CancelWithdraw(ctx context.Context, in *CancelWithdrawRequest, opts ...grpc.CallOption) (*CancelWithdrawReply, error) {
+	out := new(CancelWithdrawReply)
+	err := c.cc.Invoke(ctx, "/api.App/CancelWithdraw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) WithdrawAddressList(ctx context.Context, in *WithdrawAddressListRequest, opts ...grpc.CallOption) (*WithdrawAddressListReply, error) {
+	out := new(WithdrawAddressListReply)
+	err := c.cc.Invoke(ctx, "/api.App/WithdrawAddressList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AddWithdrawAddress(ctx context.Context, in *AddWithdrawAddressRequest, opts ...grpc.CallOption) (*AddWithdrawAddressReply, error) {
+	out := new(AddWithdrawAddressReply)
+	err := c.cc.Invoke(ctx, "/api.App/AddWithdrawAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) DeleteWithdrawAddress(ctx context.Context, in *DeleteWithdrawAddressRequest, opts ...grpc.CallOption) (*DeleteWithdrawAddressReply, error) {
+	out := new(DeleteWithdrawAddressReply)
+	err := c.cc.Invoke(ctx, "/api.App/DeleteWithdrawAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appClient) SetBalanceReward(ctx context.Context, in *SetBalanceRewardRequest, opts ...grpc.CallOption) (*SetBalanceRewardReply, error) {
 	out := new(SetBalanceRewardReply)
 	err := c.cc.Invoke(ctx, "/api.App/SetBalanceReward", in, out, opts...)
@@ -180,9 +291,198 @@ func (c *appClient) Deposit(ctx context.Context, in *DepositRequest, opts ...grp
 	return out, nil
 }
 
-func (c *appClient) AdminWithdraw(ctx context.Context, in *AdminWithdrawRequest, opts ...grpc.CallOption) (*AdminWithdrawReply, error) {
-	out := new(AdminWithdrawReply)
-	err := c.cc.Invoke(ctx, "/api.App/AdminWithdraw", in, out, opts...)
+func (c *appClient) AdminLogin(ctx context.Context, in *AdminLoginRequest, opts ...grpc.CallOption) (*AdminLoginReply, error) {
+	out := new(AdminLoginReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminLogin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminWithdrawApprove(ctx context.Context, in *AdminWithdrawApproveRequest, opts ...grpc.CallOption) (*AdminWithdrawApproveReply, error) {
+	out := new(AdminWithdrawApproveReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminWithdrawApprove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminWithdrawReject(ctx context.Context, in *AdminWithdrawRejectRequest, opts ...grpc.CallOption) (*AdminWithdrawRejectReply, error) {
+	out := new(AdminWithdrawRejectReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminWithdrawReject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminWithdrawRetry(ctx context.Context, in *AdminWithdrawRetryRequest, opts ...grpc.CallOption) (*AdminWithdrawRetryReply, error) {
+	out := new(AdminWithdrawRetryReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminWithdrawRetry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminWithdrawRefund(ctx context.Context, in *AdminWithdrawRefundRequest, opts ...grpc.CallOption) (*AdminWithdrawRefundReply, error) {
+	out := new(AdminWithdrawRefundReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminWithdrawRefund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminWithdrawLegacyMigrate(ctx context.Context, in *AdminWithdrawLegacyMigrateRequest, opts ...grpc.CallOption) (*AdminWithdrawLegacyMigrateReply, error) {
+	out := new(AdminWithdrawLegacyMigrateReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminWithdrawLegacyMigrate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminWithdrawBulkApprove(ctx context.Context, in *AdminWithdrawBulkApproveRequest, opts ...grpc.CallOption) (*AdminWithdrawBulkApproveReply, error) {
+	out := new(AdminWithdrawBulkApproveReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminWithdrawBulkApprove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminLedgerBalance(ctx context.Context, in *AdminLedgerBalanceRequest, opts ...grpc.CallOption) (*AdminLedgerBalanceReply, error) {
+	out := new(AdminLedgerBalanceReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminLedgerBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminRecommendClosureBackfill(ctx context.Context, in *AdminRecommendClosureBackfillRequest, opts ...grpc.CallOption) (*AdminRecommendClosureBackfillReply, error) {
+	out := new(AdminRecommendClosureBackfillReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminRecommendClosureBackfill", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminLedgerOpen(ctx context.Context, in *AdminLedgerOpenRequest, opts ...grpc.CallOption) (*AdminLedgerOpenReply, error) {
+	out := new(AdminLedgerOpenReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminLedgerOpen", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminReconcile(ctx context.Context, in *AdminReconcileRequest, opts ...grpc.CallOption) (*AdminReconcileReply, error) {
+	out := new(AdminReconcileReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminReconcile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminDepositUnmatchedList(ctx context.Context, in *AdminDepositUnmatchedListRequest, opts ...grpc.CallOption) (*AdminDepositUnmatchedListReply, error) {
+	out := new(AdminDepositUnmatchedListReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminDepositUnmatchedList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminDepositResolve(ctx context.Context, in *AdminDepositResolveRequest, opts ...grpc.CallOption) (*AdminDepositResolveReply, error) {
+	out := new(AdminDepositResolveReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminDepositResolve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminDiscrepancyList(ctx context.Context, in *AdminDiscrepancyListRequest, opts ...grpc.CallOption) (*AdminDiscrepancyListReply, error) {
+	out := new(AdminDiscrepancyListReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminDiscrepancyList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminLocationDailySettle(ctx context.Context, in *AdminLocationDailySettleRequest, opts ...grpc.CallOption) (*AdminLocationDailySettleReply, error) {
+	out := new(AdminLocationDailySettleReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminLocationDailySettle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminUserAreaRebuild(ctx context.Context, in *AdminUserAreaRebuildRequest, opts ...grpc.CallOption) (*AdminUserAreaRebuildReply, error) {
+	out := new(AdminUserAreaRebuildReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminUserAreaRebuild", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminSettlementDryRun(ctx context.Context, in *AdminSettlementDryRunRequest, opts ...grpc.CallOption) (*AdminSettlementDryRunReply, error) {
+	out := new(AdminSettlementDryRunReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminSettlementDryRun", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminSettlementReplay(ctx context.Context, in *AdminSettlementReplayRequest, opts ...grpc.CallOption) (*AdminSettlementReplayReply, error) {
+	out := new(AdminSettlementReplayReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminSettlementReplay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminUserRecommend(ctx context.Context, in *AdminUserRecommendRequest, opts ...grpc.CallOption) (*AdminUserRecommendReply, error) {
+	out := new(AdminUserRecommendReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminUserRecommend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminRecommendTree(ctx context.Context, in *AdminRecommendTreeRequest, opts ...grpc.CallOption) (*AdminRecommendTreeReply, error) {
+	out := new(AdminRecommendTreeReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminRecommendTree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminUserLevelOverride(ctx context.Context, in *AdminUserLevelOverrideRequest, opts ...grpc.CallOption) (*AdminUserLevelOverrideReply, error) {
+	out := new(AdminUserLevelOverrideReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminUserLevelOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminUserLevelHistory(ctx context.Context, in *AdminUserLevelHistoryRequest, opts ...grpc.CallOption) (*AdminUserLevelHistoryReply, error) {
+	out := new(AdminUserLevelHistoryReply)
+	err := c.cc.Invoke(ctx, "/api.App/AdminUserLevelHistory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -212,6 +512,10 @@ func (c *appClient) AdminFee(ctx context.Context, in *AdminFeeRequest, opts ...g
 // for forward compatibility
 type AppServer interface {
 	EthAuthorize(context.Context, *EthAuthorizeRequest) (*EthAuthorizeReply, error)
+	GetAuthNonce(context.Context, *GetAuthNonceRequest) (*GetAuthNonceReply, error)
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenReply, error)
+	Logout(context.Context, *LogoutRequest) (*LogoutReply, error)
+	LogoutAllSessions(context.Context, *LogoutAllSessionsRequest) (*LogoutAllSessionsReply, error)
 	RecommendUpdate(context.Context, *RecommendUpdateRequest) (*RecommendUpdateReply, error)
 	UserInfo(context.Context, *UserInfoRequest) (*UserInfoReply, error)
 	RewardList(context.Context, *RewardListRequest) (*RewardListReply, error)
@@ -219,7 +523,12 @@ type AppServer interface {
 	FeeRewardList(context.Context, *FeeRewardListRequest) (*FeeRewardListReply, error)
 	WithdrawList(context.Context, *WithdrawListRequest) (*WithdrawListReply, error)
 	RecommendList(context.Context, *RecommendListRequest) (*RecommendListReply, error)
+	RecommendTree(context.Context, *RecommendTreeRequest) (*RecommendTreeReply, error)
 	Withdraw(context.Context, *WithdrawRequest) (*WithdrawReply, error)
+	CancelWithdraw(context.Context, *CancelWithdrawRequest) (*CancelWithdrawReply, error)
+	WithdrawAddressList(context.Context, *WithdrawAddressListRequest) (*WithdrawAddressListReply, error)
+	AddWithdrawAddress(context.Context, *AddWithdrawAddressRequest) (*AddWithdrawAddressReply, error)
+	DeleteWithdrawAddress(context.Context, *DeleteWithdrawAddressRequest) (*DeleteWithdrawAddressReply, error)
 	SetBalanceReward(context.Context, *SetBalanceRewardRequest) (*SetBalanceRewardReply, error)
 	DeleteBalanceReward(context.Context, *DeleteBalanceRewardRequest) (*DeleteBalanceRewardReply, error)
 	Deposit(context.Context, *DepositRequest) (*DepositReply, error)
@@ -248,7 +557,28 @@ type AppServer interface {
 	//		};
 	//	};
 	//
-	AdminWithdraw(context.Context, *AdminWithdrawRequest) (*AdminWithdrawReply, error)
+	AdminLogin(context.Context, *AdminLoginRequest) (*AdminLoginReply, error)
+	AdminWithdrawApprove(context.Context, *AdminWithdrawApproveRequest) (*AdminWithdrawApproveReply, error)
+	AdminWithdrawReject(context.Context, *AdminWithdrawRejectRequest) (*AdminWithdrawRejectReply, error)
+	AdminWithdrawRetry(context.Context, *AdminWithdrawRetryRequest) (*AdminWithdrawRetryReply, error)
+	AdminWithdrawRefund(context.Context, *AdminWithdrawRefundRequest) (*AdminWithdrawRefundReply, error)
+	AdminWithdrawLegacyMigrate(context.Context, *AdminWithdrawLegacyMigrateRequest) (*AdminWithdrawLegacyMigrateReply, error)
+	AdminWithdrawBulkApprove(context.Context, *AdminWithdrawBulkApproveRequest) (*AdminWithdrawBulkApproveReply, error)
+	AdminLedgerBalance(context.Context, *AdminLedgerBalanceRequest) (*AdminLedgerBalanceReply, error)
+	AdminRecommendClosureBackfill(context.Context, *AdminRecommendClosureBackfillRequest) (*AdminRecommendClosureBackfillReply, error)
+	AdminLedgerOpen(context.Context, *AdminLedgerOpenRequest) (*AdminLedgerOpenReply, error)
+	AdminReconcile(context.Context, *AdminReconcileRequest) (*AdminReconcileReply, error)
+	AdminDepositUnmatchedList(context.Context, *AdminDepositUnmatchedListRequest) (*AdminDepositUnmatchedListReply, error)
+	AdminDepositResolve(context.Context, *AdminDepositResolveRequest) (*AdminDepositResolveReply, error)
+	AdminDiscrepancyList(context.Context, *AdminDiscrepancyListRequest) (*AdminDiscrepancyListReply, error)
+	AdminLocationDailySettle(context.Context, *AdminLocationDailySettleRequest) (*AdminLocationDailySettleReply, error)
+	AdminUserAreaRebuild(context.Context, *AdminUserAreaRebuildRequest) (*AdminUserAreaRebuildReply, error)
+	AdminSettlementDryRun(context.Context, *AdminSettlementDryRunRequest) (*AdminSettlementDryRunReply, error)
+	AdminSettlementReplay(context.Context, *AdminSettlementReplayRequest) (*AdminSettlementReplayReply, error)
+	AdminUserRecommend(context.Context, *AdminUserRecommendRequest) (*AdminUserRecommendReply, error)
+	AdminRecommendTree(context.Context, *AdminRecommendTreeRequest) (*AdminRecommendTreeReply, error)
+	AdminUserLevelOverride(context.Context, *AdminUserLevelOverrideRequest) (*AdminUserLevelOverrideReply, error)
+	AdminUserLevelHistory(context.Context, *AdminUserLevelHistoryRequest) (*AdminUserLevelHistoryReply, error)
 	AdminWithdrawEth(context.Context, *AdminWithdrawEthRequest) (*AdminWithdrawEthReply, error)
 	AdminFee(context.Context, *AdminFeeRequest) (*AdminFeeReply, error)
 	mustEmbedUnimplementedAppServer()
@@ -261,6 +591,18 @@ type UnimplementedAppServer struct {
 func (UnimplementedAppServer) EthAuthorize(context.Context, *EthAuthorizeRequest) (*EthAuthorizeReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EthAuthorize not implemented")
 }
+func (UnimplementedAppServer) GetAuthNonce(context.Context, *GetAuthNonceRequest) (*GetAuthNonceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuthNonce not implemented")
+}
+func (UnimplementedAppServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedAppServer) Logout(context.Context, *LogoutRequest) (*LogoutReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedAppServer) LogoutAllSessions(context.Context, *LogoutAllSessionsRequest) (*LogoutAllSessionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogoutAllSessions not implemented")
+}
 func (UnimplementedAppServer) RecommendUpdate(context.Context, *RecommendUpdateRequest) (*RecommendUpdateReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecommendUpdate not implemented")
 }
@@ -282,9 +624,24 @@ func (UnimplementedAppServer) WithdrawList(context.Context, *WithdrawListRequest
 func (UnimplementedAppServer) RecommendList(context.Context, *RecommendListRequest) (*RecommendListReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecommendList not implemented")
 }
+func (UnimplementedAppServer) RecommendTree(context.Context, *RecommendTreeRequest) (*RecommendTreeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecommendTree not implemented")
+}
 func (UnimplementedAppServer) Withdraw(context.Context, *WithdrawRequest) (*WithdrawReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
 }
+func (UnimplementedAppServer) CancelWithdraw(context.Context, *CancelWithdrawRequest) (*CancelWithdrawReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelWithdraw not implemented")
+}
+func (UnimplementedAppServer) WithdrawAddressList(context.Context, *WithdrawAddressListRequest) (*WithdrawAddressListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAddressList not implemented")
+}
+func (UnimplementedAppServer) AddWithdrawAddress(context.Context, *AddWithdrawAddressRequest) (*AddWithdrawAddressReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWithdrawAddress not implemented")
+}
+func (UnimplementedAppServer) DeleteWithdrawAddress(context.Context, *DeleteWithdrawAddressRequest) (*DeleteWithdrawAddressReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWithdrawAddress not implemented")
+}
 func (UnimplementedAppServer) SetBalanceReward(context.Context, *SetBalanceRewardRequest) (*SetBalanceRewardReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetBalanceReward not implemented")
 }
@@ -294,8 +651,71 @@ func (UnimplementedAppServer) DeleteBalanceReward(context.Context, *DeleteBalanc
 func (UnimplementedAppServer) Deposit(context.Context, *DepositRequest) (*DepositReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
 }
-func (UnimplementedAppServer) AdminWithdraw(context.Context, *AdminWithdrawRequest) (*AdminWithdrawReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdraw not implemented")
+func (UnimplementedAppServer) AdminLogin(context.Context, *AdminLoginRequest) (*AdminLoginReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminLogin not implemented")
+}
+func (UnimplementedAppServer) AdminWithdrawApprove(context.Context, *AdminWithdrawApproveRequest) (*AdminWithdrawApproveReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawApprove not implemented")
+}
+func (UnimplementedAppServer) AdminWithdrawReject(context.Context, *AdminWithdrawRejectRequest) (*AdminWithdrawRejectReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawReject not implemented")
+}
+func (UnimplementedAppServer) AdminWithdrawRetry(context.Context, *AdminWithdrawRetryRequest) (*AdminWithdrawRetryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawRetry not implemented")
+}
+func (UnimplementedAppServer) AdminWithdrawRefund(context.Context, *AdminWithdrawRefundRequest) (*AdminWithdrawRefundReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawRefund not implemented")
+}
+func (UnimplementedAppServer) AdminWithdrawLegacyMigrate(context.Context, *AdminWithdrawLegacyMigrateRequest) (*AdminWithdrawLegacyMigrateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawLegacyMigrate not implemented")
+}
+func (UnimplementedAppServer) AdminWithdrawBulkApprove(context.Context, *AdminWithdrawBulkApproveRequest) (*AdminWithdrawBulkApproveReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawBulkApprove not implemented")
+}
+func (UnimplementedAppServer) AdminLedgerBalance(context.Context, *AdminLedgerBalanceRequest) (*AdminLedgerBalanceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminLedgerBalance not implemented")
+}
+func (UnimplementedAppServer) AdminRecommendClosureBackfill(context.Context, *AdminRecommendClosureBackfillRequest) (*AdminRecommendClosureBackfillReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminRecommendClosureBackfill not implemented")
+}
+func (UnimplementedAppServer) AdminLedgerOpen(context.Context, *AdminLedgerOpenRequest) (*AdminLedgerOpenReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminLedgerOpen not implemented")
+}
+func (UnimplementedAppServer) AdminReconcile(context.Context, *AdminReconcileRequest) (*AdminReconcileReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminReconcile not implemented")
+}
+func (UnimplementedAppServer) AdminDepositUnmatchedList(context.Context, *AdminDepositUnmatchedListRequest) (*AdminDepositUnmatchedListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDepositUnmatchedList not implemented")
+}
+func (UnimplementedAppServer) AdminDepositResolve(context.Context, *AdminDepositResolveRequest) (*AdminDepositResolveReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDepositResolve not implemented")
+}
+func (UnimplementedAppServer) AdminDiscrepancyList(context.Context, *AdminDiscrepancyListRequest) (*AdminDiscrepancyListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDiscrepancyList not implemented")
+}
+func (UnimplementedAppServer) AdminLocationDailySettle(context.Context, *AdminLocationDailySettleRequest) (*AdminLocationDailySettleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminLocationDailySettle not implemented")
+}
+func (UnimplementedAppServer) AdminUserAreaRebuild(context.Context, *AdminUserAreaRebuildRequest) (*AdminUserAreaRebuildReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminUserAreaRebuild not implemented")
+}
+func (UnimplementedAppServer) AdminSettlementDryRun(context.Context, *AdminSettlementDryRunRequest) (*AdminSettlementDryRunReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminSettlementDryRun not implemented")
+}
+func (UnimplementedAppServer) AdminSettlementReplay(context.Context, *AdminSettlementReplayRequest) (*AdminSettlementReplayReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminSettlementReplay not implemented")
+}
+func (UnimplementedAppServer) AdminUserRecommend(context.Context, *AdminUserRecommendRequest) (*AdminUserRecommendReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminUserRecommend not implemented")
+}
+func (UnimplementedAppServer) AdminRecommendTree(context.Context, *AdminRecommendTreeRequest) (*AdminRecommendTreeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminRecommendTree not implemented")
+}
+func (UnimplementedAppServer) AdminUserLevelOverride(context.Context, *AdminUserLevelOverrideRequest) (*AdminUserLevelOverrideReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminUserLevelOverride not implemented")
+}
+func (UnimplementedAppServer) AdminUserLevelHistory(context.Context, *AdminUserLevelHistoryRequest) (*AdminUserLevelHistoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminUserLevelHistory not implemented")
 }
 func (UnimplementedAppServer) AdminWithdrawEth(context.Context, *AdminWithdrawEthRequest) (*AdminWithdrawEthReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawEth not implemented")
@@ -334,121 +754,193 @@ func _App_EthAuthorize_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
-func _App_RecommendUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RecommendUpdateRequest)
+func _App_GetAuthNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuthNonceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppServer).RecommendUpdate(ctx, in)
+		return srv.(AppServer).GetAuthNonce(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.App/RecommendUpdate",
+		FullMethod: "/api.App/GetAuthNonce",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppServer).RecommendUpdate(ctx, req.(*RecommendUpdateRequest))
+		return srv.(AppServer).GetAuthNonce(ctx, req.(*GetAuthNonceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _App_UserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserInfoRequest)
+func _App_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppServer).UserInfo(ctx, in)
+		return srv.(AppServer).RefreshToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.App/UserInfo",
+		FullMethod: "/api.App/RefreshToken",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppServer).UserInfo(ctx, req.(*UserInfoRequest))
+		return srv.(AppServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _App_RewardList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RewardListRequest)
+func _App_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppServer).RewardList(ctx, in)
+		return srv.(AppServer).Logout(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.App/RewardList",
+		FullMethod: "/api.App/Logout",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppServer).RewardList(ctx, req.(*RewardListRequest))
+		return srv.(AppServer).Logout(ctx, req.(*LogoutRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _App_RecommendRewardList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RecommendRewardListRequest)
+func _App_LogoutAllSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutAllSessionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppServer).RecommendRewardList(ctx, in)
+		return srv.(AppServer).LogoutAllSessions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.App/RecommendRewardList",
+		FullMethod: "/api.App/LogoutAllSessions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppServer).RecommendRewardList(ctx, req.(*RecommendRewardListRequest))
+		return srv.(AppServer).LogoutAllSessions(ctx, req.(*LogoutAllSessionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _App_FeeRewardList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FeeRewardListRequest)
+func _App_RecommendUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecommendUpdateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppServer).FeeRewardList(ctx, in)
+		return srv.(AppServer).RecommendUpdate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.App/FeeRewardList",
+		FullMethod: "/api.App/RecommendUpdate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppServer).FeeRewardList(ctx, req.(*FeeRewardListRequest))
+		return srv.(AppServer).RecommendUpdate(ctx, req.(*RecommendUpdateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _App_WithdrawList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawListRequest)
+func _App_UserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserInfoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppServer).WithdrawList(ctx, in)
+		return srv.(AppServer).UserInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.App/WithdrawList",
+		FullMethod: "/api.App/UserInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppServer).WithdrawList(ctx, req.(*WithdrawListRequest))
+		return srv.(AppServer).UserInfo(ctx, req.(*UserInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _App_RecommendList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RecommendListRequest)
+func _App_RewardList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RewardListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppServer).RecommendList(ctx, in)
+		return srv.(AppServer).RewardList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/RewardList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).RewardList(ctx, req.(*RewardListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_RecommendRewardList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecommendRewardListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).RecommendRewardList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/RecommendRewardList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).RecommendRewardList(ctx, req.(*RecommendRewardListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_FeeRewardList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeeRewardListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).FeeRewardList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/FeeRewardList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).FeeRewardList(ctx, req.(*FeeRewardListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_WithdrawList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).WithdrawList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/WithdrawList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).WithdrawList(ctx, req.(*WithdrawListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_RecommendList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecommendListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).RecommendList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
@@ -460,6 +952,24 @@ func _App_RecommendList_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _App_RecommendTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecommendTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).RecommendTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/RecommendTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).RecommendTree(ctx, req.(*RecommendTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _App_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(WithdrawRequest)
 	if err := dec(in); err != nil {
@@ -478,6 +988,78 @@ func _App_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _App_CancelWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelWithdrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).CancelWithdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/CancelWithdraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).CancelWithdraw(ctx, req.(*CancelWithdrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_WithdrawAddressList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawAddressListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).WithdrawAddressList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/WithdrawAddressList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).WithdrawAddressList(ctx, req.(*WithdrawAddressListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AddWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWithdrawAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AddWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AddWithdrawAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AddWithdrawAddress(ctx, req.(*AddWithdrawAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_DeleteWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWithdrawAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).DeleteWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/DeleteWithdrawAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).DeleteWithdrawAddress(ctx, req.(*DeleteWithdrawAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _App_SetBalanceReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetBalanceRewardRequest)
 	if err := dec(in); err != nil {
@@ -532,20 +1114,398 @@ func _App_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
-func _App_AdminWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AdminWithdrawRequest)
+func _App_AdminLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminLogin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminLogin(ctx, req.(*AdminLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminWithdrawApprove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWithdrawApproveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminWithdrawApprove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminWithdrawApprove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminWithdrawApprove(ctx, req.(*AdminWithdrawApproveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminWithdrawReject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWithdrawRejectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminWithdrawReject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminWithdrawReject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminWithdrawReject(ctx, req.(*AdminWithdrawRejectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminWithdrawRetry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWithdrawRetryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminWithdrawRetry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminWithdrawRetry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminWithdrawRetry(ctx, req.(*AdminWithdrawRetryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminWithdrawRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWithdrawRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminWithdrawRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminWithdrawRefund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminWithdrawRefund(ctx, req.(*AdminWithdrawRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminWithdrawLegacyMigrate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWithdrawLegacyMigrateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminWithdrawLegacyMigrate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminWithdrawLegacyMigrate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminWithdrawLegacyMigrate(ctx, req.(*AdminWithdrawLegacyMigrateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminWithdrawBulkApprove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWithdrawBulkApproveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminWithdrawBulkApprove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminWithdrawBulkApprove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminWithdrawBulkApprove(ctx, req.(*AdminWithdrawBulkApproveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminLedgerBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminLedgerBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminLedgerBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminLedgerBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminLedgerBalance(ctx, req.(*AdminLedgerBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminRecommendClosureBackfill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminRecommendClosureBackfillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminRecommendClosureBackfill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminRecommendClosureBackfill",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminRecommendClosureBackfill(ctx, req.(*AdminRecommendClosureBackfillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminLedgerOpen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminLedgerOpenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminLedgerOpen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminLedgerOpen",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminLedgerOpen(ctx, req.(*AdminLedgerOpenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminReconcile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminReconcileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminReconcile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminReconcile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminReconcile(ctx, req.(*AdminReconcileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminDepositUnmatchedList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDepositUnmatchedListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminDepositUnmatchedList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminDepositUnmatchedList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminDepositUnmatchedList(ctx, req.(*AdminDepositUnmatchedListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminDepositResolve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDepositResolveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminDepositResolve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminDepositResolve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminDepositResolve(ctx, req.(*AdminDepositResolveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminDiscrepancyList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDiscrepancyListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminDiscrepancyList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminDiscrepancyList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminDiscrepancyList(ctx, req.(*AdminDiscrepancyListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminLocationDailySettle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminLocationDailySettleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppServer).AdminWithdraw(ctx, in)
+		return srv.(AppServer).AdminLocationDailySettle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.App/AdminWithdraw",
+		FullMethod: "/api.App/AdminLocationDailySettle",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppServer).AdminWithdraw(ctx, req.(*AdminWithdrawRequest))
+		return srv.(AppServer).AdminLocationDailySettle(ctx, req.(*AdminLocationDailySettleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminUserAreaRebuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUserAreaRebuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminUserAreaRebuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminUserAreaRebuild",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminUserAreaRebuild(ctx, req.(*AdminUserAreaRebuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminSettlementDryRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminSettlementDryRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminSettlementDryRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminSettlementDryRun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminSettlementDryRun(ctx, req.(*AdminSettlementDryRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminSettlementReplay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminSettlementReplayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminSettlementReplay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminSettlementReplay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminSettlementReplay(ctx, req.(*AdminSettlementReplayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminUserRecommend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUserRecommendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminUserRecommend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminUserRecommend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminUserRecommend(ctx, req.(*AdminUserRecommendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminRecommendTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminRecommendTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminRecommendTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminRecommendTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminRecommendTree(ctx, req.(*AdminRecommendTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminUserLevelOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUserLevelOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminUserLevelOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminUserLevelOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminUserLevelOverride(ctx, req.(*AdminUserLevelOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminUserLevelHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUserLevelHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminUserLevelHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.App/AdminUserLevelHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminUserLevelHistory(ctx, req.(*AdminUserLevelHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -597,6 +1557,22 @@ var App_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EthAuthorize",
 			Handler:    _App_EthAuthorize_Handler,
 		},
+		{
+			MethodName: "GetAuthNonce",
+			Handler:    _App_GetAuthNonce_Handler,
+		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _App_RefreshToken_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _App_Logout_Handler,
+		},
+		{
+			MethodName: "LogoutAllSessions",
+			Handler:    _App_LogoutAllSessions_Handler,
+		},
 		{
 			MethodName: "RecommendUpdate",
 			Handler:    _App_RecommendUpdate_Handler,
@@ -625,10 +1601,30 @@ var App_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RecommendList",
 			Handler:    _App_RecommendList_Handler,
 		},
+		{
+			MethodName: "RecommendTree",
+			Handler:    _App_RecommendTree_Handler,
+		},
 		{
 			MethodName: "Withdraw",
 			Handler:    _App_Withdraw_Handler,
 		},
+		{
+			MethodName: "CancelWithdraw",
+			Handler:    _App_CancelWithdraw_Handler,
+		},
+		{
+			MethodName: "WithdrawAddressList",
+			Handler:    _App_WithdrawAddressList_Handler,
+		},
+		{
+			MethodName: "AddWithdrawAddress",
+			Handler:    _App_AddWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "DeleteWithdrawAddress",
+			Handler:    _App_DeleteWithdrawAddress_Handler,
+		},
 		{
 			MethodName: "SetBalanceReward",
 			Handler:    _App_SetBalanceReward_Handler,
@@ -642,8 +1638,92 @@ var App_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _App_Deposit_Handler,
 		},
 		{
-			MethodName: "AdminWithdraw",
-			Handler:    _App_AdminWithdraw_Handler,
+			MethodName: "AdminLogin",
+			Handler:    _App_AdminLogin_Handler,
+		},
+		{
+			MethodName: "AdminWithdrawApprove",
+			Handler:    _App_AdminWithdrawApprove_Handler,
+		},
+		{
+			MethodName: "AdminWithdrawReject",
+			Handler:    _App_AdminWithdrawReject_Handler,
+		},
+		{
+			MethodName: "AdminWithdrawRetry",
+			Handler:    _App_AdminWithdrawRetry_Handler,
+		},
+		{
+			MethodName: "AdminWithdrawRefund",
+			Handler:    _App_AdminWithdrawRefund_Handler,
+		},
+		{
+			MethodName: "AdminWithdrawLegacyMigrate",
+			Handler:    _App_AdminWithdrawLegacyMigrate_Handler,
+		},
+		{
+			MethodName: "AdminWithdrawBulkApprove",
+			Handler:    _App_AdminWithdrawBulkApprove_Handler,
+		},
+		{
+			MethodName: "AdminLedgerBalance",
+			Handler:    _App_AdminLedgerBalance_Handler,
+		},
+		{
+			MethodName: "AdminRecommendClosureBackfill",
+			Handler:    _App_AdminRecommendClosureBackfill_Handler,
+		},
+		{
+			MethodName: "AdminLedgerOpen",
+			Handler:    _App_AdminLedgerOpen_Handler,
+		},
+		{
+			MethodName: "AdminReconcile",
+			Handler:    _App_AdminReconcile_Handler,
+		},
+		{
+			MethodName: "AdminDepositUnmatchedList",
+			Handler:    _App_AdminDepositUnmatchedList_Handler,
+		},
+		{
+			MethodName: "AdminDepositResolve",
+			Handler:    _App_AdminDepositResolve_Handler,
+		},
+		{
+			MethodName: "AdminDiscrepancyList",
+			Handler:    _App_AdminDiscrepancyList_Handler,
+		},
+		{
+			MethodName: "AdminLocationDailySettle",
+			Handler:    _App_AdminLocationDailySettle_Handler,
+		},
+		{
+			MethodName: "AdminUserAreaRebuild",
+			Handler:    _App_AdminUserAreaRebuild_Handler,
+		},
+		{
+			MethodName: "AdminSettlementDryRun",
+			Handler:    _App_AdminSettlementDryRun_Handler,
+		},
+		{
+			MethodName: "AdminSettlementReplay",
+			Handler:    _App_AdminSettlementReplay_Handler,
+		},
+		{
+			MethodName: "AdminUserRecommend",
+			Handler:    _App_AdminUserRecommend_Handler,
+		},
+		{
+			MethodName: "AdminRecommendTree",
+			Handler:    _App_AdminRecommendTree_Handler,
+		},
+		{
+			MethodName: "AdminUserLevelOverride",
+			Handler:    _App_AdminUserLevelOverride_Handler,
+		},
+		{
+			MethodName: "AdminUserLevelHistory",
+			Handler:    _App_AdminUserLevelHistory_Handler,
 		},
 		{
 			MethodName: "AdminWithdrawEth",
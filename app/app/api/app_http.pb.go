@@ -19,43 +19,107 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationAppAddWithdrawAddress = "/api.App/AddWithdrawAddress"
+const OperationAppAdminDepositResolve = "/api.App/AdminDepositResolve"
+const OperationAppAdminDepositUnmatchedList = "/api.App/AdminDepositUnmatchedList"
+const OperationAppAdminDiscrepancyList = "/api.App/AdminDiscrepancyList"
 const OperationAppAdminFee = "/api.App/AdminFee"
-const OperationAppAdminWithdraw = "/api.App/AdminWithdraw"
+const OperationAppAdminLedgerBalance = "/api.App/AdminLedgerBalance"
+const OperationAppAdminLedgerOpen = "/api.App/AdminLedgerOpen"
+const OperationAppAdminLocationDailySettle = "/api.App/AdminLocationDailySettle"
+const OperationAppAdminLogin = "/api.App/AdminLogin"
+const OperationAppAdminRecommendClosureBackfill = "/api.App/AdminRecommendClosureBackfill"
+const OperationAppAdminRecommendTree = "/api.App/AdminRecommendTree"
+const OperationAppAdminReconcile = "/api.App/AdminReconcile"
+const OperationAppAdminSettlementDryRun = "/api.App/AdminSettlementDryRun"
+const OperationAppAdminSettlementReplay = "/api.App/AdminSettlementReplay"
+const OperationAppAdminUserAreaRebuild = "/api.App/AdminUserAreaRebuild"
+const OperationAppAdminUserLevelHistory = "/api.App/AdminUserLevelHistory"
+const OperationAppAdminUserLevelOverride = "/api.App/AdminUserLevelOverride"
+const OperationAppAdminUserRecommend = "/api.App/AdminUserRecommend"
+const OperationAppAdminWithdrawApprove = "/api.App/AdminWithdrawApprove"
+const OperationAppAdminWithdrawBulkApprove = "/api.App/AdminWithdrawBulkApprove"
 const OperationAppAdminWithdrawEth = "/api.App/AdminWithdrawEth"
+const OperationAppAdminWithdrawLegacyMigrate = "/api.App/AdminWithdrawLegacyMigrate"
+const OperationAppAdminWithdrawRefund = "/api.App/AdminWithdrawRefund"
+const OperationAppAdminWithdrawReject = "/api.App/AdminWithdrawReject"
+const OperationAppAdminWithdrawRetry = "/api.App/AdminWithdrawRetry"
+const OperationAppCancelWithdraw = "/api.App/CancelWithdraw"
 const OperationAppDeleteBalanceReward = "/api.App/DeleteBalanceReward"
+const OperationAppDeleteWithdrawAddress = "/api.App/DeleteWithdrawAddress"
 const OperationAppDeposit = "/api.App/Deposit"
 const OperationAppEthAuthorize = "/api.App/EthAuthorize"
 const OperationAppFeeRewardList = "/api.App/FeeRewardList"
+const OperationAppGetAuthNonce = "/api.App/GetAuthNonce"
+const OperationAppLogout = "/api.App/Logout"
+const OperationAppLogoutAllSessions = "/api.App/LogoutAllSessions"
 const OperationAppRecommendList = "/api.App/RecommendList"
 const OperationAppRecommendRewardList = "/api.App/RecommendRewardList"
+const OperationAppRecommendTree = "/api.App/RecommendTree"
 const OperationAppRecommendUpdate = "/api.App/RecommendUpdate"
+const OperationAppRefreshToken = "/api.App/RefreshToken"
 const OperationAppRewardList = "/api.App/RewardList"
 const OperationAppSetBalanceReward = "/api.App/SetBalanceReward"
 const OperationAppUserInfo = "/api.App/UserInfo"
 const OperationAppWithdraw = "/api.App/Withdraw"
+const OperationAppWithdrawAddressList = "/api.App/WithdrawAddressList"
 const OperationAppWithdrawList = "/api.App/WithdrawList"
 
 type AppHTTPServer interface {
+	AddWithdrawAddress(context.Context, *AddWithdrawAddressRequest) (*AddWithdrawAddressReply, error)
+	AdminDepositResolve(context.Context, *AdminDepositResolveRequest) (*AdminDepositResolveReply, error)
+	AdminDepositUnmatchedList(context.Context, *AdminDepositUnmatchedListRequest) (*AdminDepositUnmatchedListReply, error)
+	AdminDiscrepancyList(context.Context, *AdminDiscrepancyListRequest) (*AdminDiscrepancyListReply, error)
 	AdminFee(context.Context, *AdminFeeRequest) (*AdminFeeReply, error)
-	AdminWithdraw(context.Context, *AdminWithdrawRequest) (*AdminWithdrawReply, error)
+	AdminLedgerBalance(context.Context, *AdminLedgerBalanceRequest) (*AdminLedgerBalanceReply, error)
+	AdminLedgerOpen(context.Context, *AdminLedgerOpenRequest) (*AdminLedgerOpenReply, error)
+	AdminLocationDailySettle(context.Context, *AdminLocationDailySettleRequest) (*AdminLocationDailySettleReply, error)
+	AdminLogin(context.Context, *AdminLoginRequest) (*AdminLoginReply, error)
+	AdminRecommendClosureBackfill(context.Context, *AdminRecommendClosureBackfillRequest) (*AdminRecommendClosureBackfillReply, error)
+	AdminRecommendTree(context.Context, *AdminRecommendTreeRequest) (*AdminRecommendTreeReply, error)
+	AdminReconcile(context.Context, *AdminReconcileRequest) (*AdminReconcileReply, error)
+	AdminSettlementDryRun(context.Context, *AdminSettlementDryRunRequest) (*AdminSettlementDryRunReply, error)
+	AdminSettlementReplay(context.Context, *AdminSettlementReplayRequest) (*AdminSettlementReplayReply, error)
+	AdminUserAreaRebuild(context.Context, *AdminUserAreaRebuildRequest) (*AdminUserAreaRebuildReply, error)
+	AdminUserLevelHistory(context.Context, *AdminUserLevelHistoryRequest) (*AdminUserLevelHistoryReply, error)
+	AdminUserLevelOverride(context.Context, *AdminUserLevelOverrideRequest) (*AdminUserLevelOverrideReply, error)
+	AdminUserRecommend(context.Context, *AdminUserRecommendRequest) (*AdminUserRecommendReply, error)
+	AdminWithdrawApprove(context.Context, *AdminWithdrawApproveRequest) (*AdminWithdrawApproveReply, error)
+	AdminWithdrawBulkApprove(context.Context, *AdminWithdrawBulkApproveRequest) (*AdminWithdrawBulkApproveReply, error)
 	AdminWithdrawEth(context.Context, *AdminWithdrawEthRequest) (*AdminWithdrawEthReply, error)
+	AdminWithdrawLegacyMigrate(context.Context, *AdminWithdrawLegacyMigrateRequest) (*AdminWithdrawLegacyMigrateReply, error)
+	AdminWithdrawRefund(context.Context, *AdminWithdrawRefundRequest) (*AdminWithdrawRefundReply, error)
+	AdminWithdrawReject(context.Context, *AdminWithdrawRejectRequest) (*AdminWithdrawRejectReply, error)
+	AdminWithdrawRetry(context.Context, *AdminWithdrawRetryRequest) (*AdminWithdrawRetryReply, error)
+	CancelWithdraw(context.Context, *CancelWithdrawRequest) (*CancelWithdrawReply, error)
 	DeleteBalanceReward(context.Context, *DeleteBalanceRewardRequest) (*DeleteBalanceRewardReply, error)
+	DeleteWithdrawAddress(context.Context, *DeleteWithdrawAddressRequest) (*DeleteWithdrawAddressReply, error)
 	Deposit(context.Context, *DepositRequest) (*DepositReply, error)
 	EthAuthorize(context.Context, *EthAuthorizeRequest) (*EthAuthorizeReply, error)
 	FeeRewardList(context.Context, *FeeRewardListRequest) (*FeeRewardListReply, error)
+	GetAuthNonce(context.Context, *GetAuthNonceRequest) (*GetAuthNonceReply, error)
+	Logout(context.Context, *LogoutRequest) (*LogoutReply, error)
+	LogoutAllSessions(context.Context, *LogoutAllSessionsRequest) (*LogoutAllSessionsReply, error)
 	RecommendList(context.Context, *RecommendListRequest) (*RecommendListReply, error)
 	RecommendRewardList(context.Context, *RecommendRewardListRequest) (*RecommendRewardListReply, error)
+	RecommendTree(context.Context, *RecommendTreeRequest) (*RecommendTreeReply, error)
 	RecommendUpdate(context.Context, *RecommendUpdateRequest) (*RecommendUpdateReply, error)
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenReply, error)
 	RewardList(context.Context, *RewardListRequest) (*RewardListReply, error)
 	SetBalanceReward(context.Context, *SetBalanceRewardRequest) (*SetBalanceRewardReply, error)
 	UserInfo(context.Context, *UserInfoRequest) (*UserInfoReply, error)
 	Withdraw(context.Context, *WithdrawRequest) (*WithdrawReply, error)
+	WithdrawAddressList(context.Context, *WithdrawAddressListRequest) (*WithdrawAddressListReply, error)
 	WithdrawList(context.Context, *WithdrawListRequest) (*WithdrawListReply, error)
 }
 
 func RegisterAppHTTPServer(s *http.Server, srv AppHTTPServer) {
 	r := s.Route("/")
 	r.POST("/api/app_server/eth_authorize", _App_EthAuthorize0_HTTP_Handler(srv))
+	r.POST("/api/app_server/auth_nonce", _App_GetAuthNonce0_HTTP_Handler(srv))
+	r.POST("/api/app_server/refresh_token", _App_RefreshToken0_HTTP_Handler(srv))
+	r.POST("/api/app_server/logout", _App_Logout0_HTTP_Handler(srv))
+	r.POST("/api/app_server/logout_all_sessions", _App_LogoutAllSessions0_HTTP_Handler(srv))
 	r.POST("/api/app_server/recommend_update", _App_RecommendUpdate0_HTTP_Handler(srv))
 	r.GET("/api/app_server/user_info", _App_UserInfo0_HTTP_Handler(srv))
 	r.GET("/api/app_server/reward_list", _App_RewardList0_HTTP_Handler(srv))
@@ -63,11 +127,37 @@ func RegisterAppHTTPServer(s *http.Server, srv AppHTTPServer) {
 	r.GET("/api/app_server/fee_reward_list", _App_FeeRewardList0_HTTP_Handler(srv))
 	r.GET("/api/app_server/withdraw_list", _App_WithdrawList0_HTTP_Handler(srv))
 	r.GET("/api/app_server/recommend_list", _App_RecommendList0_HTTP_Handler(srv))
+	r.GET("/api/app_server/recommend_tree", _App_RecommendTree0_HTTP_Handler(srv))
 	r.POST("/api/app_server/withdraw", _App_Withdraw0_HTTP_Handler(srv))
+	r.POST("/api/app_server/cancel_withdraw", _App_CancelWithdraw0_HTTP_Handler(srv))
+	r.GET("/api/app_server/withdraw_address_list", _App_WithdrawAddressList0_HTTP_Handler(srv))
+	r.POST("/api/app_server/add_withdraw_address", _App_AddWithdrawAddress0_HTTP_Handler(srv))
+	r.POST("/api/app_server/delete_withdraw_address", _App_DeleteWithdrawAddress0_HTTP_Handler(srv))
 	r.POST("/api/app_server/set_balance_reward", _App_SetBalanceReward0_HTTP_Handler(srv))
 	r.POST("/api/app_server/delete_balance_reward", _App_DeleteBalanceReward0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/deposit", _App_Deposit0_HTTP_Handler(srv))
-	r.GET("/api/admin_dhb/withdraw", _App_AdminWithdraw0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/login", _App_AdminLogin0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/withdraw_approve", _App_AdminWithdrawApprove0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/withdraw_reject", _App_AdminWithdrawReject0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/withdraw_retry", _App_AdminWithdrawRetry0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/withdraw_refund", _App_AdminWithdrawRefund0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/withdraw_legacy_migrate", _App_AdminWithdrawLegacyMigrate0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/withdraw_bulk_approve", _App_AdminWithdrawBulkApprove0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/ledger_balance", _App_AdminLedgerBalance0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/recommend_closure_backfill", _App_AdminRecommendClosureBackfill0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/ledger_open", _App_AdminLedgerOpen0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/reconcile", _App_AdminReconcile0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/deposit_unmatched", _App_AdminDepositUnmatchedList0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/deposit_resolve", _App_AdminDepositResolve0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/discrepancy_list", _App_AdminDiscrepancyList0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/location_daily_settle", _App_AdminLocationDailySettle0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/user_area_rebuild", _App_AdminUserAreaRebuild0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/settlement_dry_run", _App_AdminSettlementDryRun0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/settlement_replay", _App_AdminSettlementReplay0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/user_recommend", _App_AdminUserRecommend0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/recommend_tree", _App_AdminRecommendTree0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/user_level_override", _App_AdminUserLevelOverride0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/user_level_history", _App_AdminUserLevelHistory0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/withdraw_eth", _App_AdminWithdrawEth0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/fee", _App_AdminFee0_HTTP_Handler(srv))
 }
@@ -94,6 +184,94 @@ func _App_EthAuthorize0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) e
 	}
 }
 
+func _App_GetAuthNonce0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetAuthNonceRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppGetAuthNonce)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetAuthNonce(ctx, req.(*GetAuthNonceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetAuthNonceReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_RefreshToken0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RefreshTokenRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppRefreshToken)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RefreshToken(ctx, req.(*RefreshTokenRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RefreshTokenReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_Logout0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LogoutRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppLogout)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Logout(ctx, req.(*LogoutRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LogoutReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_LogoutAllSessions0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LogoutAllSessionsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppLogoutAllSessions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LogoutAllSessions(ctx, req.(*LogoutAllSessionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LogoutAllSessionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _App_RecommendUpdate0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in RecommendUpdateRequest
@@ -230,6 +408,25 @@ func _App_RecommendList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context)
 	}
 }
 
+func _App_RecommendTree0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RecommendTreeRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppRecommendTree)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RecommendTree(ctx, req.(*RecommendTreeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RecommendTreeReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _App_Withdraw0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in WithdrawRequest
@@ -252,6 +449,91 @@ func _App_Withdraw0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error
 	}
 }
 
+func _App_CancelWithdraw0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CancelWithdrawRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppCancelWithdraw)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CancelWithdraw(ctx, req.(*CancelWithdrawRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CancelWithdrawReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_WithdrawAddressList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in WithdrawAddressListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppWithdrawAddressList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.WithdrawAddressList(ctx, req.(*WithdrawAddressListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*WithdrawAddressListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AddWithdrawAddress0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AddWithdrawAddressRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAddWithdrawAddress)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AddWithdrawAddress(ctx, req.(*AddWithdrawAddressRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AddWithdrawAddressReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_DeleteWithdrawAddress0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteWithdrawAddressRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppDeleteWithdrawAddress)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteWithdrawAddress(ctx, req.(*DeleteWithdrawAddressRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteWithdrawAddressReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _App_SetBalanceReward0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in SetBalanceRewardRequest
@@ -315,110 +597,821 @@ func _App_Deposit0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error
 	}
 }
 
-func _App_AdminWithdraw0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+func _App_AdminLogin0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in AdminWithdrawRequest
+		var in AdminLoginRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationAppAdminWithdraw)
+		http.SetOperation(ctx, OperationAppAdminLogin)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.AdminWithdraw(ctx, req.(*AdminWithdrawRequest))
+			return srv.AdminLogin(ctx, req.(*AdminLoginRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*AdminWithdrawReply)
+		reply := out.(*AdminLoginReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _App_AdminWithdrawEth0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+func _App_AdminWithdrawApprove0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in AdminWithdrawEthRequest
+		var in AdminWithdrawApproveRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationAppAdminWithdrawEth)
+		http.SetOperation(ctx, OperationAppAdminWithdrawApprove)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.AdminWithdrawEth(ctx, req.(*AdminWithdrawEthRequest))
+			return srv.AdminWithdrawApprove(ctx, req.(*AdminWithdrawApproveRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*AdminWithdrawEthReply)
+		reply := out.(*AdminWithdrawApproveReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _App_AdminFee0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+func _App_AdminWithdrawReject0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in AdminFeeRequest
+		var in AdminWithdrawRejectRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationAppAdminFee)
+		http.SetOperation(ctx, OperationAppAdminWithdrawReject)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.AdminFee(ctx, req.(*AdminFeeRequest))
+			return srv.AdminWithdrawReject(ctx, req.(*AdminWithdrawRejectRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*AdminFeeReply)
+		reply := out.(*AdminWithdrawRejectReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-type AppHTTPClient interface {
-	AdminFee(ctx context.Context, req *AdminFeeRequest, opts ...http.CallOption) (rsp *AdminFeeReply, err error)
-	AdminWithdraw(ctx context.Context, req *AdminWithdrawRequest, opts ...http.CallOption) (rsp *AdminWithdrawReply, err error)
-	AdminWithdrawEth(ctx context.Context, req *AdminWithdrawEthRequest, opts ...http.CallOption) (rsp *AdminWithdrawEthReply, err error)
-	DeleteBalanceReward(ctx context.Context, req *DeleteBalanceRewardRequest, opts ...http.CallOption) (rsp *DeleteBalanceRewardReply, err error)
-	Deposit(ctx context.Context, req *DepositRequest, opts ...http.CallOption) (rsp *DepositReply, err error)
-	EthAuthorize(ctx context.Context, req *EthAuthorizeRequest, opts ...http.CallOption) (rsp *EthAuthorizeReply, err error)
-	FeeRewardList(ctx context.Context, req *FeeRewardListRequest, opts ...http.CallOption) (rsp *FeeRewardListReply, err error)
-	RecommendList(ctx context.Context, req *RecommendListRequest, opts ...http.CallOption) (rsp *RecommendListReply, err error)
-	RecommendRewardList(ctx context.Context, req *RecommendRewardListRequest, opts ...http.CallOption) (rsp *RecommendRewardListReply, err error)
-	RecommendUpdate(ctx context.Context, req *RecommendUpdateRequest, opts ...http.CallOption) (rsp *RecommendUpdateReply, err error)
-	RewardList(ctx context.Context, req *RewardListRequest, opts ...http.CallOption) (rsp *RewardListReply, err error)
-	SetBalanceReward(ctx context.Context, req *SetBalanceRewardRequest, opts ...http.CallOption) (rsp *SetBalanceRewardReply, err error)
-	UserInfo(ctx context.Context, req *UserInfoRequest, opts ...http.CallOption) (rsp *UserInfoReply, err error)
-	Withdraw(ctx context.Context, req *WithdrawRequest, opts ...http.CallOption) (rsp *WithdrawReply, err error)
-	WithdrawList(ctx context.Context, req *WithdrawListRequest, opts ...http.CallOption) (rsp *WithdrawListReply, err error)
+func _App_AdminWithdrawRetry0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWithdrawRetryRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminWithdrawRetry)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWithdrawRetry(ctx, req.(*AdminWithdrawRetryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWithdrawRetryReply)
+		return ctx.Result(200, reply)
+	}
 }
 
-type AppHTTPClientImpl struct {
-	cc *http.Client
+func _App_AdminWithdrawRefund0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWithdrawRefundRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminWithdrawRefund)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWithdrawRefund(ctx, req.(*AdminWithdrawRefundRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWithdrawRefundReply)
+		return ctx.Result(200, reply)
+	}
 }
 
-func NewAppHTTPClient(client *http.Client) AppHTTPClient {
-	return &AppHTTPClientImpl{client}
+func _App_AdminWithdrawLegacyMigrate0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWithdrawLegacyMigrateRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminWithdrawLegacyMigrate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWithdrawLegacyMigrate(ctx, req.(*AdminWithdrawLegacyMigrateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWithdrawLegacyMigrateReply)
+		return ctx.Result(200, reply)
+	}
 }
 
-func (c *AppHTTPClientImpl) AdminFee(ctx context.Context, in *AdminFeeRequest, opts ...http.CallOption) (*AdminFeeReply, error) {
-	var out AdminFeeReply
-	pattern := "/api/admin_dhb/fee"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationAppAdminFee))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
-	if err != nil {
-		return nil, err
+func _App_AdminWithdrawBulkApprove0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWithdrawBulkApproveRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminWithdrawBulkApprove)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWithdrawBulkApprove(ctx, req.(*AdminWithdrawBulkApproveRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWithdrawBulkApproveReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, err
 }
 
-func (c *AppHTTPClientImpl) AdminWithdraw(ctx context.Context, in *AdminWithdrawRequest, opts ...http.CallOption) (*AdminWithdrawReply, error) {
-	var out AdminWithdrawReply
-	pattern := "/api/admin_dhb/withdraw"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationAppAdminWithdraw))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
-	if err != nil {
+func _App_AdminLedgerBalance0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminLedgerBalanceRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminLedgerBalance)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminLedgerBalance(ctx, req.(*AdminLedgerBalanceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminLedgerBalanceReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminRecommendClosureBackfill0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminRecommendClosureBackfillRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminRecommendClosureBackfill)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminRecommendClosureBackfill(ctx, req.(*AdminRecommendClosureBackfillRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminRecommendClosureBackfillReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminLedgerOpen0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminLedgerOpenRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminLedgerOpen)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminLedgerOpen(ctx, req.(*AdminLedgerOpenRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminLedgerOpenReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminReconcile0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminReconcileRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminReconcile)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminReconcile(ctx, req.(*AdminReconcileRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminReconcileReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminDepositUnmatchedList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminDepositUnmatchedListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminDepositUnmatchedList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminDepositUnmatchedList(ctx, req.(*AdminDepositUnmatchedListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminDepositUnmatchedListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminDepositResolve0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminDepositResolveRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminDepositResolve)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminDepositResolve(ctx, req.(*AdminDepositResolveRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminDepositResolveReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminDiscrepancyList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminDiscrepancyListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminDiscrepancyList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminDiscrepancyList(ctx, req.(*AdminDiscrepancyListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminDiscrepancyListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminLocationDailySettle0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminLocationDailySettleRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminLocationDailySettle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminLocationDailySettle(ctx, req.(*AdminLocationDailySettleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminLocationDailySettleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminUserAreaRebuild0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminUserAreaRebuildRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminUserAreaRebuild)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminUserAreaRebuild(ctx, req.(*AdminUserAreaRebuildRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminUserAreaRebuildReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminSettlementDryRun0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminSettlementDryRunRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminSettlementDryRun)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminSettlementDryRun(ctx, req.(*AdminSettlementDryRunRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminSettlementDryRunReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminSettlementReplay0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminSettlementReplayRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminSettlementReplay)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminSettlementReplay(ctx, req.(*AdminSettlementReplayRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminSettlementReplayReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminUserRecommend0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminUserRecommendRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminUserRecommend)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminUserRecommend(ctx, req.(*AdminUserRecommendRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminUserRecommendReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminRecommendTree0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminRecommendTreeRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminRecommendTree)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminRecommendTree(ctx, req.(*AdminRecommendTreeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminRecommendTreeReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminUserLevelOverride0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminUserLevelOverrideRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminUserLevelOverride)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminUserLevelOverride(ctx, req.(*AdminUserLevelOverrideRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminUserLevelOverrideReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminUserLevelHistory0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminUserLevelHistoryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminUserLevelHistory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminUserLevelHistory(ctx, req.(*AdminUserLevelHistoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminUserLevelHistoryReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminWithdrawEth0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWithdrawEthRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminWithdrawEth)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWithdrawEth(ctx, req.(*AdminWithdrawEthRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWithdrawEthReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminFee0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminFeeRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminFee)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminFee(ctx, req.(*AdminFeeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminFeeReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type AppHTTPClient interface {
+	AddWithdrawAddress(ctx context.Context, req *AddWithdrawAddressRequest, opts ...http.CallOption) (rsp *AddWithdrawAddressReply, err error)
+	AdminDepositResolve(ctx context.Context, req *AdminDepositResolveRequest, opts ...http.CallOption) (rsp *AdminDepositResolveReply, err error)
+	AdminDepositUnmatchedList(ctx context.Context, req *AdminDepositUnmatchedListRequest, opts ...http.CallOption) (rsp *AdminDepositUnmatchedListReply, err error)
+	AdminDiscrepancyList(ctx context.Context, req *AdminDiscrepancyListRequest, opts ...http.CallOption) (rsp *AdminDiscrepancyListReply, err error)
+	AdminFee(ctx context.Context, req *AdminFeeRequest, opts ...http.CallOption) (rsp *AdminFeeReply, err error)
+	AdminLedgerBalance(ctx context.Context, req *AdminLedgerBalanceRequest, opts ...http.CallOption) (rsp *AdminLedgerBalanceReply, err error)
+	AdminLedgerOpen(ctx context.Context, req *AdminLedgerOpenRequest, opts ...http.CallOption) (rsp *AdminLedgerOpenReply, err error)
+	AdminLocationDailySettle(ctx context.Context, req *AdminLocationDailySettleRequest, opts ...http.CallOption) (rsp *AdminLocationDailySettleReply, err error)
+	AdminLogin(ctx context.Context, req *AdminLoginRequest, opts ...http.CallOption) (rsp *AdminLoginReply, err error)
+	AdminRecommendClosureBackfill(ctx context.Context, req *AdminRecommendClosureBackfillRequest, opts ...http.CallOption) (rsp *AdminRecommendClosureBackfillReply, err error)
+	AdminRecommendTree(ctx context.Context, req *AdminRecommendTreeRequest, opts ...http.CallOption) (rsp *AdminRecommendTreeReply, err error)
+	AdminReconcile(ctx context.Context, req *AdminReconcileRequest, opts ...http.CallOption) (rsp *AdminReconcileReply, err error)
+	AdminSettlementDryRun(ctx context.Context, req *AdminSettlementDryRunRequest, opts ...http.CallOption) (rsp *AdminSettlementDryRunReply, err error)
+	AdminSettlementReplay(ctx context.Context, req *AdminSettlementReplayRequest, opts ...http.CallOption) (rsp *AdminSettlementReplayReply, err error)
+	AdminUserAreaRebuild(ctx context.Context, req *AdminUserAreaRebuildRequest, opts ...http.CallOption) (rsp *AdminUserAreaRebuildReply, err error)
+	AdminUserLevelHistory(ctx context.Context, req *AdminUserLevelHistoryRequest, opts ...http.CallOption) (rsp *AdminUserLevelHistoryReply, err error)
+	AdminUserLevelOverride(ctx context.Context, req *AdminUserLevelOverrideRequest, opts ...http.CallOption) (rsp *AdminUserLevelOverrideReply, err error)
+	AdminUserRecommend(ctx context.Context, req *AdminUserRecommendRequest, opts ...http.CallOption) (rsp *AdminUserRecommendReply, err error)
+	AdminWithdrawApprove(ctx context.Context, req *AdminWithdrawApproveRequest, opts ...http.CallOption) (rsp *AdminWithdrawApproveReply, err error)
+	AdminWithdrawBulkApprove(ctx context.Context, req *AdminWithdrawBulkApproveRequest, opts ...http.CallOption) (rsp *AdminWithdrawBulkApproveReply, err error)
+	AdminWithdrawEth(ctx context.Context, req *AdminWithdrawEthRequest, opts ...http.CallOption) (rsp *AdminWithdrawEthReply, err error)
+	AdminWithdrawLegacyMigrate(ctx context.Context, req *AdminWithdrawLegacyMigrateRequest, opts ...http.CallOption) (rsp *AdminWithdrawLegacyMigrateReply, err error)
+	AdminWithdrawRefund(ctx context.Context, req *AdminWithdrawRefundRequest, opts ...http.CallOption) (rsp *AdminWithdrawRefundReply, err error)
+	AdminWithdrawReject(ctx context.Context, req *AdminWithdrawRejectRequest, opts ...http.CallOption) (rsp *AdminWithdrawRejectReply, err error)
+	AdminWithdrawRetry(ctx context.Context, req *AdminWithdrawRetryRequest, opts ...http.CallOption) (rsp *AdminWithdrawRetryReply, err error)
+	CancelWithdraw(ctx context.Context, req *CancelWithdrawRequest, opts ...http.CallOption) (rsp *CancelWithdrawReply, err error)
+	DeleteBalanceReward(ctx context.Context, req *DeleteBalanceRewardRequest, opts ...http.CallOption) (rsp *DeleteBalanceRewardReply, err error)
+	DeleteWithdrawAddress(ctx context.Context, req *DeleteWithdrawAddressRequest, opts ...http.CallOption) (rsp *DeleteWithdrawAddressReply, err error)
+	Deposit(ctx context.Context, req *DepositRequest, opts ...http.CallOption) (rsp *DepositReply, err error)
+	EthAuthorize(ctx context.Context, req *EthAuthorizeRequest, opts ...http.CallOption) (rsp *EthAuthorizeReply, err error)
+	FeeRewardList(ctx context.Context, req *FeeRewardListRequest, opts ...http.CallOption) (rsp *FeeRewardListReply, err error)
+	GetAuthNonce(ctx context.Context, req *GetAuthNonceRequest, opts ...http.CallOption) (rsp *GetAuthNonceReply, err error)
+	Logout(ctx context.Context, req *LogoutRequest, opts ...http.CallOption) (rsp *LogoutReply, err error)
+	LogoutAllSessions(ctx context.Context, req *LogoutAllSessionsRequest, opts ...http.CallOption) (rsp *LogoutAllSessionsReply, err error)
+	RecommendList(ctx context.Context, req *RecommendListRequest, opts ...http.CallOption) (rsp *RecommendListReply, err error)
+	RecommendRewardList(ctx context.Context, req *RecommendRewardListRequest, opts ...http.CallOption) (rsp *RecommendRewardListReply, err error)
+	RecommendTree(ctx context.Context, req *RecommendTreeRequest, opts ...http.CallOption) (rsp *RecommendTreeReply, err error)
+	RecommendUpdate(ctx context.Context, req *RecommendUpdateRequest, opts ...http.CallOption) (rsp *RecommendUpdateReply, err error)
+	RefreshToken(ctx context.Context, req *RefreshTokenRequest, opts ...http.CallOption) (rsp *RefreshTokenReply, err error)
+	RewardList(ctx context.Context, req *RewardListRequest, opts ...http.CallOption) (rsp *RewardListReply, err error)
+	SetBalanceReward(ctx context.Context, req *SetBalanceRewardRequest, opts ...http.CallOption) (rsp *SetBalanceRewardReply, err error)
+	UserInfo(ctx context.Context, req *UserInfoRequest, opts ...http.CallOption) (rsp *UserInfoReply, err error)
+	Withdraw(ctx context.Context, req *WithdrawRequest, opts ...http.CallOption) (rsp *WithdrawReply, err error)
+	WithdrawAddressList(ctx context.Context, req *WithdrawAddressListRequest, opts ...http.CallOption) (rsp *WithdrawAddressListReply, err error)
+	WithdrawList(ctx context.Context, req *WithdrawListRequest, opts ...http.CallOption) (rsp *WithdrawListReply, err error)
+}
+
+type AppHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewAppHTTPClient(client *http.Client) AppHTTPClient {
+	return &AppHTTPClientImpl{client}
+}
+
+func (c *AppHTTPClientImpl) AddWithdrawAddress(ctx context.Context, in *AddWithdrawAddressRequest, opts ...http.CallOption) (*AddWithdrawAddressReply, error) {
+	var out AddWithdrawAddressReply
+	pattern := "/api/app_server/add_withdraw_address"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAddWithdrawAddress))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminDepositResolve(ctx context.Context, in *AdminDepositResolveRequest, opts ...http.CallOption) (*AdminDepositResolveReply, error) {
+	var out AdminDepositResolveReply
+	pattern := "/api/admin_dhb/deposit_resolve"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminDepositResolve))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminDepositUnmatchedList(ctx context.Context, in *AdminDepositUnmatchedListRequest, opts ...http.CallOption) (*AdminDepositUnmatchedListReply, error) {
+	var out AdminDepositUnmatchedListReply
+	pattern := "/api/admin_dhb/deposit_unmatched"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminDepositUnmatchedList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminDiscrepancyList(ctx context.Context, in *AdminDiscrepancyListRequest, opts ...http.CallOption) (*AdminDiscrepancyListReply, error) {
+	var out AdminDiscrepancyListReply
+	pattern := "/api/admin_dhb/discrepancy_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminDiscrepancyList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminFee(ctx context.Context, in *AdminFeeRequest, opts ...http.CallOption) (*AdminFeeReply, error) {
+	var out AdminFeeReply
+	pattern := "/api/admin_dhb/fee"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminFee))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminLedgerBalance(ctx context.Context, in *AdminLedgerBalanceRequest, opts ...http.CallOption) (*AdminLedgerBalanceReply, error) {
+	var out AdminLedgerBalanceReply
+	pattern := "/api/admin_dhb/ledger_balance"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminLedgerBalance))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminLedgerOpen(ctx context.Context, in *AdminLedgerOpenRequest, opts ...http.CallOption) (*AdminLedgerOpenReply, error) {
+	var out AdminLedgerOpenReply
+	pattern := "/api/admin_dhb/ledger_open"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminLedgerOpen))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminLocationDailySettle(ctx context.Context, in *AdminLocationDailySettleRequest, opts ...http.CallOption) (*AdminLocationDailySettleReply, error) {
+	var out AdminLocationDailySettleReply
+	pattern := "/api/admin_dhb/location_daily_settle"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminLocationDailySettle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminLogin(ctx context.Context, in *AdminLoginRequest, opts ...http.CallOption) (*AdminLoginReply, error) {
+	var out AdminLoginReply
+	pattern := "/api/admin_dhb/login"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminLogin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminRecommendClosureBackfill(ctx context.Context, in *AdminRecommendClosureBackfillRequest, opts ...http.CallOption) (*AdminRecommendClosureBackfillReply, error) {
+	var out AdminRecommendClosureBackfillReply
+	pattern := "/api/admin_dhb/recommend_closure_backfill"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminRecommendClosureBackfill))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminRecommendTree(ctx context.Context, in *AdminRecommendTreeRequest, opts ...http.CallOption) (*AdminRecommendTreeReply, error) {
+	var out AdminRecommendTreeReply
+	pattern := "/api/admin_dhb/recommend_tree"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminRecommendTree))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminReconcile(ctx context.Context, in *AdminReconcileRequest, opts ...http.CallOption) (*AdminReconcileReply, error) {
+	var out AdminReconcileReply
+	pattern := "/api/admin_dhb/reconcile"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminReconcile))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminSettlementDryRun(ctx context.Context, in *AdminSettlementDryRunRequest, opts ...http.CallOption) (*AdminSettlementDryRunReply, error) {
+	var out AdminSettlementDryRunReply
+	pattern := "/api/admin_dhb/settlement_dry_run"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminSettlementDryRun))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminSettlementReplay(ctx context.Context, in *AdminSettlementReplayRequest, opts ...http.CallOption) (*AdminSettlementReplayReply, error) {
+	var out AdminSettlementReplayReply
+	pattern := "/api/admin_dhb/settlement_replay"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminSettlementReplay))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminUserAreaRebuild(ctx context.Context, in *AdminUserAreaRebuildRequest, opts ...http.CallOption) (*AdminUserAreaRebuildReply, error) {
+	var out AdminUserAreaRebuildReply
+	pattern := "/api/admin_dhb/user_area_rebuild"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminUserAreaRebuild))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminUserLevelHistory(ctx context.Context, in *AdminUserLevelHistoryRequest, opts ...http.CallOption) (*AdminUserLevelHistoryReply, error) {
+	var out AdminUserLevelHistoryReply
+	pattern := "/api/admin_dhb/user_level_history"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminUserLevelHistory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminUserLevelOverride(ctx context.Context, in *AdminUserLevelOverrideRequest, opts ...http.CallOption) (*AdminUserLevelOverrideReply, error) {
+	var out AdminUserLevelOverrideReply
+	pattern := "/api/admin_dhb/user_level_override"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminUserLevelOverride))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminUserRecommend(ctx context.Context, in *AdminUserRecommendRequest, opts ...http.CallOption) (*AdminUserRecommendReply, error) {
+	var out AdminUserRecommendReply
+	pattern := "/api/admin_dhb/user_recommend"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminUserRecommend))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminWithdrawApprove(ctx context.Context, in *AdminWithdrawApproveRequest, opts ...http.CallOption) (*AdminWithdrawApproveReply, error) {
+	var out AdminWithdrawApproveReply
+	pattern := "/api/admin_dhb/withdraw_approve"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminWithdrawApprove))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminWithdrawBulkApprove(ctx context.Context, in *AdminWithdrawBulkApproveRequest, opts ...http.CallOption) (*AdminWithdrawBulkApproveReply, error) {
+	var out AdminWithdrawBulkApproveReply
+	pattern := "/api/admin_dhb/withdraw_bulk_approve"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminWithdrawBulkApprove))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
 		return nil, err
 	}
 	return &out, err
@@ -437,6 +1430,71 @@ func (c *AppHTTPClientImpl) AdminWithdrawEth(ctx context.Context, in *AdminWithd
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) AdminWithdrawLegacyMigrate(ctx context.Context, in *AdminWithdrawLegacyMigrateRequest, opts ...http.CallOption) (*AdminWithdrawLegacyMigrateReply, error) {
+	var out AdminWithdrawLegacyMigrateReply
+	pattern := "/api/admin_dhb/withdraw_legacy_migrate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminWithdrawLegacyMigrate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminWithdrawRefund(ctx context.Context, in *AdminWithdrawRefundRequest, opts ...http.CallOption) (*AdminWithdrawRefundReply, error) {
+	var out AdminWithdrawRefundReply
+	pattern := "/api/admin_dhb/withdraw_refund"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminWithdrawRefund))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminWithdrawReject(ctx context.Context, in *AdminWithdrawRejectRequest, opts ...http.CallOption) (*AdminWithdrawRejectReply, error) {
+	var out AdminWithdrawRejectReply
+	pattern := "/api/admin_dhb/withdraw_reject"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminWithdrawReject))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminWithdrawRetry(ctx context.Context, in *AdminWithdrawRetryRequest, opts ...http.CallOption) (*AdminWithdrawRetryReply, error) {
+	var out AdminWithdrawRetryReply
+	pattern := "/api/admin_dhb/withdraw_retry"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminWithdrawRetry))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) CancelWithdraw(ctx context.Context, in *CancelWithdrawRequest, opts ...http.CallOption) (*CancelWithdrawReply, error) {
+	var out CancelWithdrawReply
+	pattern := "/api/app_server/cancel_withdraw"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppCancelWithdraw))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) DeleteBalanceReward(ctx context.Context, in *DeleteBalanceRewardRequest, opts ...http.CallOption) (*DeleteBalanceRewardReply, error) {
 	var out DeleteBalanceRewardReply
 	pattern := "/api/app_server/delete_balance_reward"
@@ -450,6 +1508,19 @@ func (c *AppHTTPClientImpl) DeleteBalanceReward(ctx context.Context, in *DeleteB
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) DeleteWithdrawAddress(ctx context.Context, in *DeleteWithdrawAddressRequest, opts ...http.CallOption) (*DeleteWithdrawAddressReply, error) {
+	var out DeleteWithdrawAddressReply
+	pattern := "/api/app_server/delete_withdraw_address"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppDeleteWithdrawAddress))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) Deposit(ctx context.Context, in *DepositRequest, opts ...http.CallOption) (*DepositReply, error) {
 	var out DepositReply
 	pattern := "/api/admin_dhb/deposit"
@@ -489,6 +1560,45 @@ func (c *AppHTTPClientImpl) FeeRewardList(ctx context.Context, in *FeeRewardList
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) GetAuthNonce(ctx context.Context, in *GetAuthNonceRequest, opts ...http.CallOption) (*GetAuthNonceReply, error) {
+	var out GetAuthNonceReply
+	pattern := "/api/app_server/auth_nonce"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppGetAuthNonce))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) Logout(ctx context.Context, in *LogoutRequest, opts ...http.CallOption) (*LogoutReply, error) {
+	var out LogoutReply
+	pattern := "/api/app_server/logout"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppLogout))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) LogoutAllSessions(ctx context.Context, in *LogoutAllSessionsRequest, opts ...http.CallOption) (*LogoutAllSessionsReply, error) {
+	var out LogoutAllSessionsReply
+	pattern := "/api/app_server/logout_all_sessions"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppLogoutAllSessions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) RecommendList(ctx context.Context, in *RecommendListRequest, opts ...http.CallOption) (*RecommendListReply, error) {
 	var out RecommendListReply
 	pattern := "/api/app_server/recommend_list"
@@ -515,6 +1625,19 @@ func (c *AppHTTPClientImpl) RecommendRewardList(ctx context.Context, in *Recomme
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) RecommendTree(ctx context.Context, in *RecommendTreeRequest, opts ...http.CallOption) (*RecommendTreeReply, error) {
+	var out RecommendTreeReply
+	pattern := "/api/app_server/recommend_tree"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppRecommendTree))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) RecommendUpdate(ctx context.Context, in *RecommendUpdateRequest, opts ...http.CallOption) (*RecommendUpdateReply, error) {
 	var out RecommendUpdateReply
 	pattern := "/api/app_server/recommend_update"
@@ -528,6 +1651,19 @@ func (c *AppHTTPClientImpl) RecommendUpdate(ctx context.Context, in *RecommendUp
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...http.CallOption) (*RefreshTokenReply, error) {
+	var out RefreshTokenReply
+	pattern := "/api/app_server/refresh_token"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppRefreshToken))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) RewardList(ctx context.Context, in *RewardListRequest, opts ...http.CallOption) (*RewardListReply, error) {
 	var out RewardListReply
 	pattern := "/api/app_server/reward_list"
@@ -580,6 +1716,19 @@ func (c *AppHTTPClientImpl) Withdraw(ctx context.Context, in *WithdrawRequest, o
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) WithdrawAddressList(ctx context.Context, in *WithdrawAddressListRequest, opts ...http.CallOption) (*WithdrawAddressListReply, error) {
+	var out WithdrawAddressListReply
+	pattern := "/api/app_server/withdraw_address_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppWithdrawAddressList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) WithdrawList(ctx context.Context, in *WithdrawListRequest, opts ...http.CallOption) (*WithdrawListReply, error) {
 	var out WithdrawListReply
 	pattern := "/api/app_server/withdraw_list"
@@ -5,6 +5,8 @@ import (
 	"os"
 
 	"dhb/app/app/internal/conf"
+	"dhb/app/app/internal/pkg/middleware/auth"
+	"dhb/app/app/internal/server"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
@@ -32,7 +34,7 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, hs *http.Server, js *server.JobServer) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -41,6 +43,7 @@ func newApp(logger log.Logger, hs *http.Server) *kratos.App {
 		kratos.Logger(logger),
 		kratos.Server(
 			hs,
+			js,
 		),
 	)
 }
@@ -72,7 +75,32 @@ func main() {
 		panic(err)
 	}
 
-	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Auth, logger)
+	// 登录消息必须绑定前端域名，防止其他站点诱导签名后冒用
+	if "" == bc.Auth.GetSiwe().GetDomain() {
+		panic("auth.siwe.domain is required")
+	}
+
+	keySet, err := auth.NewKeySet(bc.Auth)
+	if err != nil {
+		panic(err)
+	}
+	// jwt 密钥轮换无需重启
+	if err := c.Watch("auth", func(key string, value config.Value) {
+		var ca conf.Auth
+		if err := value.Scan(&ca); err != nil {
+			log.NewHelper(logger).Error(err)
+			return
+		}
+		if err := keySet.Load(&ca); err != nil {
+			log.NewHelper(logger).Error(err)
+			return
+		}
+		log.NewHelper(logger).Info("jwt keys reloaded")
+	}); err != nil {
+		panic(err)
+	}
+
+	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Auth, bc.Chain, bc.Payout, bc.Job, keySet, logger)
 	if err != nil {
 		panic(err)
 	}
@@ -9,6 +9,7 @@ import (
 	"dhb/app/app/internal/biz"
 	"dhb/app/app/internal/conf"
 	"dhb/app/app/internal/data"
+	"dhb/app/app/internal/pkg/middleware/auth"
 	"dhb/app/app/internal/server"
 	"dhb/app/app/internal/service"
 
@@ -18,6 +19,6 @@ import (
 )
 
 // wireApp init kratos application.
-func wireApp(*conf.Server, *conf.Data, *conf.Auth, log.Logger) (*kratos.App, func(), error) {
+func wireApp(*conf.Server, *conf.Data, *conf.Auth, *conf.Chain, *conf.Payout, *conf.Job, *auth.KeySet, log.Logger) (*kratos.App, func(), error) {
 	panic(wire.Build(server.ProviderSet, data.ProviderSet, biz.ProviderSet, service.ProviderSet, newApp))
 }
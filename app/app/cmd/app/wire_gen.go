@@ -10,6 +10,7 @@ import (
 	"dhb/app/app/internal/biz"
 	"dhb/app/app/internal/conf"
 	"dhb/app/app/internal/data"
+	"dhb/app/app/internal/pkg/middleware/auth"
 	"dhb/app/app/internal/server"
 	"dhb/app/app/internal/service"
 	"github.com/go-kratos/kratos/v2"
@@ -23,7 +24,7 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, confAuth *conf.Auth, confChain *conf.Chain, confPayout *conf.Payout, confJob *conf.Job, keySet *auth.KeySet, logger log.Logger) (*kratos.App, func(), error) {
 	db := data.NewDB(confData)
 	client := data.NewRedis(confData)
 	dataData, cleanup, err := data.NewData(confData, logger, db, client)
@@ -38,12 +39,27 @@ func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, logg
 	locationRepo := data.NewLocationRepo(dataData, logger)
 	userCurrentMonthRecommendRepo := data.NewUserCurrentMonthRecommendRepo(dataData, logger)
 	userBalanceRepo := data.NewUserBalanceRepo(dataData, logger)
-	userUseCase := biz.NewUserUseCase(userRepo, transaction, configRepo, userInfoRepo, userRecommendRepo, locationRepo, userCurrentMonthRecommendRepo, userBalanceRepo, logger)
+	payoutRepo := data.NewPayoutRepo(confChain, confPayout, logger)
+	withdrawAddressRepo := data.NewWithdrawAddressRepo(dataData, logger)
+	userLevelRepo := data.NewUserLevelRepo(dataData, logger)
+	userUseCase := biz.NewUserUseCase(userRepo, transaction, configRepo, userInfoRepo, userRecommendRepo, locationRepo, userCurrentMonthRecommendRepo, userBalanceRepo, payoutRepo, withdrawAddressRepo, userLevelRepo, logger)
 	ethUserRecordRepo := data.NewEthUserRecordRepo(dataData, logger)
-	recordUseCase := biz.NewRecordUseCase(ethUserRecordRepo, locationRepo, userBalanceRepo, userRecommendRepo, userInfoRepo, configRepo, userCurrentMonthRecommendRepo, transaction, logger)
-	appService := service.NewAppService(userUseCase, recordUseCase, logger, auth)
-	httpServer := server.NewHTTPServer(confServer, appService, logger)
-	app := newApp(logger, httpServer)
+	chainRepo := data.NewChainRepo(confChain, logger)
+	chainCheckpointRepo := data.NewChainCheckpointRepo(dataData, logger)
+	rewardSettlementRepo := data.NewRewardSettlementRepo(dataData, logger)
+	recordUseCase := biz.NewRecordUseCase(ethUserRecordRepo, userRepo, chainRepo, chainCheckpointRepo, locationRepo, userBalanceRepo, userRecommendRepo, userInfoRepo, configRepo, userCurrentMonthRecommendRepo, rewardSettlementRepo, transaction, logger)
+	authNonceRepo := data.NewAuthNonceRepo(dataData, logger)
+	sessionRepo := data.NewSessionRepo(dataData, logger)
+	authUseCase := biz.NewAuthUseCase(authNonceRepo, sessionRepo, logger)
+	adminRepo := data.NewAdminRepo(dataData, logger)
+	adminUseCase := biz.NewAdminUseCase(adminRepo, logger)
+	ledgerRepo := data.NewLedgerRepo(dataData, logger)
+	reconcileRepo := data.NewReconcileRepo(dataData, logger)
+	ledgerUseCase := biz.NewLedgerUseCase(ledgerRepo, reconcileRepo, userBalanceRepo, transaction, logger)
+	appService := service.NewAppService(userUseCase, recordUseCase, authUseCase, adminUseCase, ledgerUseCase, logger, confAuth, confChain, confPayout, keySet)
+	httpServer := server.NewHTTPServer(confServer, appService, keySet, logger)
+	jobServer := server.NewJobServer(confChain, confPayout, confJob, appService, logger)
+	app := newApp(logger, httpServer, jobServer)
 	return app, func() {
 		cleanup()
 	}, nil
@@ -0,0 +1,82 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"golang.org/x/crypto/bcrypt"
+	"time"
+)
+
+// 管理员角色
+const (
+	AdminRoleViewer     = "viewer"     // 只读
+	AdminRoleFinance    = "finance"    // 财务，可处理提现
+	AdminRoleSuperAdmin = "superadmin" // 全部权限
+)
+
+type Admin struct {
+	ID        int64
+	Account   string
+	Password  string
+	Role      string
+	Status    int64
+	CreatedAt time.Time
+}
+
+type AdminUseCase struct {
+	adminRepo AdminRepo
+	log       *log.Helper
+}
+
+type AdminRepo interface {
+	GetAdminByAccount(ctx context.Context, account string) (*Admin, error)
+	GetAdminById(ctx context.Context, id int64) (*Admin, error)
+}
+
+func NewAdminUseCase(adminRepo AdminRepo, logger log.Logger) *AdminUseCase {
+	return &AdminUseCase{
+		adminRepo: adminRepo,
+		log:       log.NewHelper(logger),
+	}
+}
+
+// AdminLogin 账号密码登录，密码以 bcrypt 保存
+func (ac *AdminUseCase) AdminLogin(ctx context.Context, account string, password string) (*Admin, error) {
+	if "" == account || "" == password {
+		return nil, errors.New(500, "ADMIN_LOGIN_ERROR", "账号或密码错误")
+	}
+
+	admin, err := ac.adminRepo.GetAdminByAccount(ctx, account)
+	if nil != err || nil == admin {
+		return nil, errors.New(500, "ADMIN_LOGIN_ERROR", "账号或密码错误")
+	}
+
+	if err = bcrypt.CompareHashAndPassword([]byte(admin.Password), []byte(password)); nil != err {
+		return nil, errors.New(500, "ADMIN_LOGIN_ERROR", "账号或密码错误")
+	}
+
+	if 1 != admin.Status {
+		return nil, errors.New(500, "ADMIN_LOGIN_ERROR", "账号已停用")
+	}
+
+	if !IsAdminRole(admin.Role) {
+		return nil, errors.New(500, "ADMIN_LOGIN_ERROR", "账号角色错误")
+	}
+
+	return admin, nil
+}
+
+// GetAdminById .
+func (ac *AdminUseCase) GetAdminById(ctx context.Context, id int64) (*Admin, error) {
+	return ac.adminRepo.GetAdminById(ctx, id)
+}
+
+// IsAdminRole .
+func IsAdminRole(role string) bool {
+	switch role {
+	case AdminRoleViewer, AdminRoleFinance, AdminRoleSuperAdmin:
+		return true
+	}
+	return false
+}
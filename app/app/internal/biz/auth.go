@@ -0,0 +1,333 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"dhb/app/app/internal/pkg/siwe"
+	"encoding/hex"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	authNonceExpire = 5 * time.Minute // 登录随机数有效期
+	siweClockSkew   = time.Minute     // 允许的客户端时钟偏差
+)
+
+type AuthNonce struct {
+	ID        int64
+	Address   string
+	Nonce     string
+	Status    int64
+	ExpiredAt time.Time
+	CreatedAt time.Time
+}
+
+// SiweOption 登录消息需要匹配的配置，Domain 必须匹配，其余空值不校验
+type SiweOption struct {
+	Domain  string
+	Uri     string
+	ChainId int64
+	MaxAge  time.Duration
+}
+
+// Session 登录会话，access token 与 refresh token 都挂在会话上，删除会话即吊销
+type Session struct {
+	ID       string
+	UserId   int64
+	UserType string
+	Role     string
+}
+
+type AuthUseCase struct {
+	authNonceRepo AuthNonceRepo
+	sessionRepo   SessionRepo
+	log           *log.Helper
+}
+
+type AuthNonceRepo interface {
+	CreateAuthNonce(ctx context.Context, an *AuthNonce) (*AuthNonce, error)
+	GetAuthNonceLastByAddress(ctx context.Context, address string) (*AuthNonce, error)
+	UseAuthNonce(ctx context.Context, id int64) (bool, error)
+}
+
+// SessionRepo refresh token 只保存哈希值
+type SessionRepo interface {
+	CreateSession(ctx context.Context, s *Session, refreshTokenHash string, expire time.Duration) error
+	GetSession(ctx context.Context, id string) (*Session, error)
+	RotateRefreshToken(ctx context.Context, refreshTokenHash string, newRefreshTokenHash string, expire time.Duration) (*Session, error)
+	DeleteSession(ctx context.Context, id string) error
+	DeleteUserSessions(ctx context.Context, userType string, userId int64) error
+}
+
+func NewAuthUseCase(authNonceRepo AuthNonceRepo, sessionRepo SessionRepo, logger log.Logger) *AuthUseCase {
+	return &AuthUseCase{
+		authNonceRepo: authNonceRepo,
+		sessionRepo:   sessionRepo,
+		log:           log.NewHelper(logger),
+	}
+}
+
+// AuthNonceMessage 钱包需要签名的原文
+func AuthNonceMessage(nonce string) string {
+	return "DHB login nonce: " + nonce
+}
+
+// GetAuthNonce 为地址生成一次性的登录随机数，旧的随机数随之作废
+func (auc *AuthUseCase) GetAuthNonce(ctx context.Context, address string) (*AuthNonce, error) {
+	if !common.IsHexAddress(address) {
+		return nil, errors.New(500, "AUTHORIZE_ERROR", "账户地址参数错误")
+	}
+
+	nonce, err := randomHex(16)
+	if err != nil {
+		return nil, errors.New(500, "AUTHORIZE_ERROR", "生成随机数失败")
+	}
+
+	now := time.Now().UTC()
+	return auc.authNonceRepo.CreateAuthNonce(ctx, &AuthNonce{
+		Address:   strings.ToLower(address),
+		Nonce:     nonce,
+		ExpiredAt: now.Add(authNonceExpire),
+	})
+}
+
+// VerifyEthSign 校验 personal_sign 签名，成功后消耗随机数
+func (auc *AuthUseCase) VerifyEthSign(ctx context.Context, address string, sign string) error {
+	return auc.VerifyEthSignMessage(ctx, address, sign, AuthNonceMessage)
+}
+
+// VerifyEthSignMessage 校验对 message(随机数) 的 personal_sign 签名，用于登录以外需要钱包确认的操作
+func (auc *AuthUseCase) VerifyEthSignMessage(ctx context.Context, address string, sign string, message func(nonce string) string) error {
+	address = strings.ToLower(address)
+	authNonce, err := auc.getValidAuthNonce(ctx, address)
+	if nil != err {
+		return err
+	}
+
+	signer, err := RecoverPersonalSignAddress(message(authNonce.Nonce), sign)
+	if nil != err {
+		return errors.New(500, "AUTHORIZE_ERROR", "签名格式错误")
+	}
+
+	if strings.ToLower(signer) != address {
+		return errors.New(500, "AUTHORIZE_ERROR", "签名地址不匹配")
+	}
+
+	return auc.useAuthNonce(ctx, authNonce)
+}
+
+// VerifySiwe 校验 EIP-4361 登录消息及其签名，成功后消耗随机数
+func (auc *AuthUseCase) VerifySiwe(ctx context.Context, address string, message string, sign string, opt *SiweOption) error {
+	address = strings.ToLower(address)
+	msg, err := siwe.Parse(message)
+	if nil != err {
+		return errors.New(500, "AUTHORIZE_ERROR", "登录消息格式错误")
+	}
+
+	if strings.ToLower(msg.Address) != address {
+		return errors.New(500, "AUTHORIZE_ERROR", "登录消息地址不匹配")
+	}
+
+	if "" == opt.Domain || msg.Domain != opt.Domain {
+		return errors.New(500, "AUTHORIZE_ERROR", "登录消息域名不匹配")
+	}
+
+	if "" != opt.Uri {
+		uri, err := url.Parse(msg.URI)
+		if nil != err {
+			return errors.New(500, "AUTHORIZE_ERROR", "登录消息URI错误")
+		}
+		allowUri, err := url.Parse(opt.Uri)
+		if nil != err || uri.Scheme != allowUri.Scheme || uri.Host != allowUri.Host {
+			return errors.New(500, "AUTHORIZE_ERROR", "登录消息URI不匹配")
+		}
+	}
+
+	if "1" != msg.Version {
+		return errors.New(500, "AUTHORIZE_ERROR", "登录消息版本错误")
+	}
+
+	if 0 != opt.ChainId && msg.ChainId != opt.ChainId {
+		return errors.New(500, "AUTHORIZE_ERROR", "登录消息链ID不匹配")
+	}
+
+	now := time.Now().UTC()
+	maxAge := opt.MaxAge
+	if 0 >= maxAge {
+		maxAge = authNonceExpire
+	}
+	if msg.IssuedAt.After(now.Add(siweClockSkew)) || msg.IssuedAt.Before(now.Add(-maxAge)) {
+		return errors.New(500, "AUTHORIZE_ERROR", "登录消息签发时间无效")
+	}
+	if nil != msg.ExpirationTime && !now.Before(*msg.ExpirationTime) {
+		return errors.New(500, "AUTHORIZE_ERROR", "登录消息已过期")
+	}
+	if nil != msg.NotBefore && now.Add(siweClockSkew).Before(*msg.NotBefore) {
+		return errors.New(500, "AUTHORIZE_ERROR", "登录消息尚未生效")
+	}
+
+	authNonce, err := auc.getValidAuthNonce(ctx, address)
+	if nil != err {
+		return err
+	}
+	if msg.Nonce != authNonce.Nonce {
+		return errors.New(500, "AUTHORIZE_ERROR", "签名随机数不匹配")
+	}
+
+	signer, err := RecoverPersonalSignAddress(message, sign)
+	if nil != err {
+		return errors.New(500, "AUTHORIZE_ERROR", "签名格式错误")
+	}
+
+	if strings.ToLower(signer) != address {
+		return errors.New(500, "AUTHORIZE_ERROR", "签名地址不匹配")
+	}
+
+	return auc.useAuthNonce(ctx, authNonce)
+}
+
+func (auc *AuthUseCase) getValidAuthNonce(ctx context.Context, address string) (*AuthNonce, error) {
+	authNonce, err := auc.authNonceRepo.GetAuthNonceLastByAddress(ctx, address)
+	if nil != err || nil == authNonce {
+		return nil, errors.New(500, "AUTHORIZE_ERROR", "请先获取签名随机数")
+	}
+
+	if 0 != authNonce.Status {
+		return nil, errors.New(500, "AUTHORIZE_ERROR", "签名随机数已使用")
+	}
+
+	if time.Now().UTC().After(authNonce.ExpiredAt) {
+		return nil, errors.New(500, "AUTHORIZE_ERROR", "签名随机数已过期")
+	}
+
+	return authNonce, nil
+}
+
+func (auc *AuthUseCase) useAuthNonce(ctx context.Context, authNonce *AuthNonce) error {
+	// 并发请求下只有一个能成功消耗
+	used, err := auc.authNonceRepo.UseAuthNonce(ctx, authNonce.ID)
+	if nil != err || !used {
+		return errors.New(500, "AUTHORIZE_ERROR", "签名随机数已使用")
+	}
+
+	return nil
+}
+
+// CreateSession 登录成功后创建会话，返回会话和 refresh token
+func (auc *AuthUseCase) CreateSession(ctx context.Context, userId int64, userType string, role string, expire time.Duration) (*Session, string, error) {
+	sessionId, err := randomHex(16)
+	if err != nil {
+		return nil, "", errors.New(500, "SESSION_ERROR", "生成会话失败")
+	}
+	refreshToken, err := randomHex(32)
+	if err != nil {
+		return nil, "", errors.New(500, "SESSION_ERROR", "生成会话失败")
+	}
+
+	session := &Session{
+		ID:       sessionId,
+		UserId:   userId,
+		UserType: userType,
+		Role:     role,
+	}
+	if err = auc.sessionRepo.CreateSession(ctx, session, hashRefreshToken(refreshToken), expire); err != nil {
+		return nil, "", err
+	}
+
+	return session, refreshToken, nil
+}
+
+// RefreshSession 轮换 refresh token，旧 token 立即失效，重复使用旧 token 会吊销整个会话
+func (auc *AuthUseCase) RefreshSession(ctx context.Context, refreshToken string, expire time.Duration) (*Session, string, error) {
+	if "" == refreshToken {
+		return nil, "", errors.New(500, "REFRESH_TOKEN_ERROR", "无效的refresh token")
+	}
+
+	newRefreshToken, err := randomHex(32)
+	if err != nil {
+		return nil, "", errors.New(500, "SESSION_ERROR", "生成会话失败")
+	}
+
+	session, err := auc.sessionRepo.RotateRefreshToken(ctx, hashRefreshToken(refreshToken), hashRefreshToken(newRefreshToken), expire)
+	if err != nil {
+		return nil, "", errors.New(500, "REFRESH_TOKEN_ERROR", "无效的refresh token")
+	}
+
+	return session, newRefreshToken, nil
+}
+
+// CheckSession 会话是否有效
+func (auc *AuthUseCase) CheckSession(ctx context.Context, sessionId string) (bool, error) {
+	if "" == sessionId {
+		return false, nil
+	}
+
+	session, err := auc.sessionRepo.GetSession(ctx, sessionId)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return nil != session, nil
+}
+
+// DeleteSession 退出当前会话
+func (auc *AuthUseCase) DeleteSession(ctx context.Context, sessionId string) error {
+	return auc.sessionRepo.DeleteSession(ctx, sessionId)
+}
+
+// DeleteUserSessions 退出用户的全部会话
+func (auc *AuthUseCase) DeleteUserSessions(ctx context.Context, userType string, userId int64) error {
+	return auc.sessionRepo.DeleteUserSessions(ctx, userType, userId)
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func hashRefreshToken(refreshToken string) string {
+	h := sha256.Sum256([]byte(refreshToken))
+	return hex.EncodeToString(h[:])
+}
+
+// RecoverPersonalSignAddress 按 EIP-191 personal_sign 规则恢复签名地址
+func RecoverPersonalSignAddress(message string, sign string) (string, error) {
+	sig, err := hexutil.Decode(sign)
+	if nil != err {
+		return "", err
+	}
+	if 65 != len(sig) {
+		return "", fmt.Errorf("invalid signature length %d", len(sig))
+	}
+
+	// 钱包返回的 v 为 27/28
+	if 27 <= sig[64] {
+		sig[64] -= 27
+	}
+	if 1 < sig[64] {
+		return "", fmt.Errorf("invalid signature recovery id %d", sig[64])
+	}
+
+	hash := crypto.Keccak256([]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d%s", len(message), message)))
+	pub, err := crypto.SigToPub(hash, sig)
+	if nil != err {
+		return "", err
+	}
+
+	return crypto.PubkeyToAddress(*pub).Hex(), nil
+}
@@ -0,0 +1,136 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"time"
+)
+
+const balanceRewardBatchSize = 100
+
+// BalanceRewardResult 本次执行锁仓收益的结果
+type BalanceRewardResult struct {
+	Rows   int64 // 发放了收益的锁仓记录
+	Days   int64 // 发放的天数，停机后补发时一条记录可能有多天
+	Amount int64
+}
+
+// NextRewardDate 下一次发放收益的时间，为上次发放之后的第一个 H:M（UTC）
+func (b *BalanceReward) NextRewardDate() time.Time {
+	last := b.LastRewardDate.UTC()
+	day := time.Date(last.Year(), last.Month(), last.Day(), int(b.H), int(b.M), 0, 0, time.UTC)
+	if day.After(last) {
+		return day
+	}
+	return day.AddDate(0, 0, 1)
+}
+
+// getBalanceRewardRate 锁仓每日收益率，配置为百分比，0.1 即锁仓金额的 0.1%
+func (uuc *UserUseCase) getBalanceRewardRate(ctx context.Context) (int64, error) {
+	var rate int64
+	configs, err := uuc.configRepo.GetConfigByKeys(ctx, "balance_reward_rate")
+	if nil != err {
+		return 0, err
+	}
+	for _, vConfig := range configs {
+		if "balance_reward_rate" == vConfig.KeyName {
+			value, err := ParseMoney(vConfig.Value, MoneyScalePercent)
+			if nil != err {
+				return 0, errors.New(500, "BALANCE_REWARD_ERROR", "锁仓收益率配置错误")
+			}
+			rate = value.Amount
+		}
+	}
+
+	return rate, nil
+}
+
+// BalanceRewardAccrue 给到期的锁仓记录发放每日收益；每条记录在各自的 H:M 发放，停机错过的天数逐天补发；
+// 每天的发放和 LastRewardDate 的推进在同一事务中，LastRewardDate 按原值条件更新，重复执行不会重复发放
+func (uuc *UserUseCase) BalanceRewardAccrue(ctx context.Context, now time.Time) (*BalanceRewardResult, error) {
+	res := &BalanceRewardResult{}
+
+	rate, err := uuc.getBalanceRewardRate(ctx)
+	if nil != err {
+		return nil, err
+	}
+	if 0 >= rate {
+		return res, nil
+	}
+
+	// 上次发放超过 23 小时的才可能到期，是否到期以 NextRewardDate 为准
+	var lastId int64
+	for {
+		balanceRewards, err := uuc.ubRepo.GetBalanceRewardsDue(ctx, now.Add(-23*time.Hour), lastId, balanceRewardBatchSize)
+		if nil != err {
+			return nil, err
+		}
+
+		for _, v := range balanceRewards {
+			lastId = v.ID
+
+			days, amount, err := uuc.accrueBalanceReward(ctx, v.ID, rate, now)
+			if nil != err {
+				return nil, err
+			}
+			if 0 < days {
+				res.Rows++
+				res.Days += days
+				res.Amount += amount
+			}
+		}
+
+		if len(balanceRewards) < balanceRewardBatchSize {
+			break
+		}
+	}
+
+	return res, nil
+}
+
+// accrueBalanceReward 逐天发放一条锁仓记录到期的收益，返回发放的天数和金额
+func (uuc *UserUseCase) accrueBalanceReward(ctx context.Context, id int64, rate int64, now time.Time) (int64, int64, error) {
+	var days, total int64
+	for {
+		var paid bool
+		if err := uuc.tx.ExecTx(ctx, func(ctx context.Context) error {
+			balanceReward, err := uuc.ubRepo.GetBalanceRewardForUpdate(ctx, id)
+			if nil != err {
+				return err
+			}
+			if 1 != balanceReward.Status {
+				return nil
+			}
+
+			rewardDate := balanceReward.NextRewardDate()
+			if rewardDate.After(now) {
+				return nil
+			}
+
+			if err = uuc.ubRepo.UpdateBalanceRewardLastRewardDate(ctx, id, balanceReward.LastRewardDate, rewardDate); nil != err {
+				return err
+			}
+
+			reward, err := BalanceMoney(balanceReward.Amount).MulDiv(rate, 10000)
+			if nil != err {
+				return err
+			}
+			if 0 < reward.Amount {
+				if _, err = uuc.ubRepo.BalanceRewardDailyReward(ctx, balanceReward.UserId, reward.Amount, id, rewardDate); nil != err {
+					return err
+				}
+			}
+
+			paid = true
+			days++
+			total += reward.Amount
+			return nil
+		}); nil != err {
+			return 0, 0, err
+		}
+
+		if !paid {
+			return days, total, nil
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"github.com/go-kratos/kratos/v2/log"
+	"sort"
+)
+
+// 账本科目，user 按用户区分，余额为平台对用户的负债；external:chain 为链上资金的对方科目，余额取反即链上应持有的资金
+const (
+	LedgerAccountUser     = "user"
+	LedgerAccountFees     = "system:fees"     // 提现手续费收入
+	LedgerAccountRewards  = "system:rewards"  // 入金形成的奖励池，发放奖励从这里支出
+	LedgerAccountRevenue  = "system:revenue"  // 平台留存的分红
+	LedgerAccountWithdraw = "system:withdraw" // 已扣除余额、等待打款的提现
+	LedgerAccountStaking  = "system:staking"  // 用户锁仓的余额
+	LedgerAccountOpening  = "system:opening"  // 账本上线前已有的余额
+	LedgerAccountChain    = "external:chain"
+)
+
+// LedgerPosting 一条分录，金额有符号，同一凭证同一币种的分录合计必须为 0
+type LedgerPosting struct {
+	Account  string
+	UserId   int64
+	CoinType string
+	Amount   int64
+}
+
+// LedgerEntry 一张凭证，Type 为业务类型，RefType/RefId 指向产生凭证的业务记录
+type LedgerEntry struct {
+	Type     string
+	RefType  string
+	RefId    int64
+	Postings []*LedgerPosting
+}
+
+// LedgerTransfer 从 from 科目转入 to 科目的凭证
+func LedgerTransfer(entryType string, refType string, refId int64, coinType string, amount int64, from *LedgerPosting, to *LedgerPosting) *LedgerEntry {
+	from.CoinType, from.Amount = coinType, -amount
+	to.CoinType, to.Amount = coinType, amount
+	return &LedgerEntry{
+		Type:     entryType,
+		RefType:  refType,
+		RefId:    refId,
+		Postings: []*LedgerPosting{from, to},
+	}
+}
+
+// LedgerSystem 系统科目
+func LedgerSystem(account string) *LedgerPosting {
+	return &LedgerPosting{Account: account}
+}
+
+// LedgerUser 用户科目
+func LedgerUser(userId int64) *LedgerPosting {
+	return &LedgerPosting{Account: LedgerAccountUser, UserId: userId}
+}
+
+// Balanced 每个币种的分录合计为 0
+func (e *LedgerEntry) Balanced() bool {
+	total := make(map[string]int64)
+	for _, v := range e.Postings {
+		total[v.CoinType] += v.Amount
+	}
+	for _, v := range total {
+		if 0 != v {
+			return false
+		}
+	}
+	return 0 < len(e.Postings)
+}
+
+// LedgerAccountTotal 科目余额合计
+type LedgerAccountTotal struct {
+	Account  string
+	CoinType string
+	Amount   int64
+}
+
+type LedgerRepo interface {
+	GetLedgerAccountTotals(ctx context.Context) ([]*LedgerAccountTotal, error)
+	GetUserBalanceTotals(ctx context.Context) (map[string]int64, error)
+	OpenUserLedger(ctx context.Context) (int64, error)
+}
+
+type LedgerUseCase struct {
+	ledgerRepo    LedgerRepo
+	reconcileRepo ReconcileRepo
+	ubRepo        UserBalanceRepo
+	tx            Transaction
+	log           *log.Helper
+}
+
+func NewLedgerUseCase(ledgerRepo LedgerRepo, reconcileRepo ReconcileRepo, ubRepo UserBalanceRepo, tx Transaction, logger log.Logger) *LedgerUseCase {
+	return &LedgerUseCase{
+		ledgerRepo:    ledgerRepo,
+		reconcileRepo: reconcileRepo,
+		ubRepo:        ubRepo,
+		tx:            tx,
+		log:           log.NewHelper(logger),
+	}
+}
+
+// AdminLedgerBalance 试算平衡，各币种全部科目合计应为 0，即用户负债加系统科目等于链上应持有资金，同时核对 user_balance 与账本一致
+func (lc *LedgerUseCase) AdminLedgerBalance(ctx context.Context, req *v1.AdminLedgerBalanceRequest) (*v1.AdminLedgerBalanceReply, error) {
+	totals, err := lc.ledgerRepo.GetLedgerAccountTotals(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	projections, err := lc.ledgerRepo.GetUserBalanceTotals(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	coins := make(map[string]*v1.AdminLedgerBalanceReply_Coin)
+	sums := make(map[string][4]int64) // 用户、系统、链上、合计
+	for coinType := range projections {
+		sums[coinType] = [4]int64{}
+	}
+	for _, v := range totals {
+		if _, ok := coins[v.CoinType]; !ok {
+			coins[v.CoinType] = &v1.AdminLedgerBalanceReply_Coin{CoinType: v.CoinType}
+		}
+		coins[v.CoinType].Accounts = append(coins[v.CoinType].Accounts, &v1.AdminLedgerBalanceReply_Account{
+			Account: v.Account,
+			Amount:  ledgerAmount(v.Amount),
+		})
+
+		sum := sums[v.CoinType]
+		switch v.Account {
+		case LedgerAccountUser:
+			sum[0] += v.Amount
+		case LedgerAccountChain:
+			sum[2] -= v.Amount
+		default:
+			sum[1] += v.Amount
+		}
+		sum[3] += v.Amount
+		sums[v.CoinType] = sum
+	}
+
+	res := &v1.AdminLedgerBalanceReply{
+		Coins: make([]*v1.AdminLedgerBalanceReply_Coin, 0, len(sums)),
+	}
+	for coinType, sum := range sums {
+		coin, ok := coins[coinType]
+		if !ok {
+			coin = &v1.AdminLedgerBalanceReply_Coin{CoinType: coinType}
+		}
+		coin.UserLiabilities = ledgerAmount(sum[0])
+		coin.SystemTotal = ledgerAmount(sum[1])
+		coin.ChainHoldings = ledgerAmount(sum[2])
+		coin.UserBalance = ledgerAmount(projections[coinType])
+		coin.Balanced = 0 == sum[3] && projections[coinType] == sum[0]
+		res.Coins = append(res.Coins, coin)
+	}
+	sort.Slice(res.Coins, func(i, j int) bool {
+		return res.Coins[i].CoinType < res.Coins[j].CoinType
+	})
+
+	return res, nil
+}
+
+// AdminLedgerOpen 账本上线时把用户已有余额记为期初凭证，每个用户只记一次
+func (lc *LedgerUseCase) AdminLedgerOpen(ctx context.Context, req *v1.AdminLedgerOpenRequest) (*v1.AdminLedgerOpenReply, error) {
+	count, err := lc.ledgerRepo.OpenUserLedger(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminLedgerOpenReply{
+		Count: count,
+	}, nil
+}
+
+// ledgerAmount 对账金额需要看到最小单位的差异，不做舍入
+func ledgerAmount(amount int64) string {
+	return BalanceMoney(amount).String()
+}
@@ -0,0 +1,165 @@
+package biz
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// 金额精度，数据库中的金额为按精度放大后的整数
+const (
+	MoneyScaleBalance = 10 // usdt、dhb 的余额、奖励、提现、投资位
+	MoneyScaleArea    = 5  // 区域业绩
+	MoneyScaleChain   = 18 // 链上 BEP-20 代币
+	MoneyScalePercent = 2  // 配置中的百分比，例如 0.5 即 0.5%，放大后为万分比
+)
+
+// CoinScale 币种余额的精度
+func CoinScale(coinType string) (int, error) {
+	switch coinType {
+	case "usdt", "dhb":
+		return MoneyScaleBalance, nil
+	}
+	return 0, fmt.Errorf("money: unknown coin type %q", coinType)
+}
+
+// Money 定点小数金额，Amount 为按 Scale 位小数放大后的整数
+type Money struct {
+	Amount int64
+	Scale  int
+}
+
+func NewMoney(amount int64, scale int) Money {
+	return Money{Amount: amount, Scale: scale}
+}
+
+// BalanceMoney 余额精度的金额
+func BalanceMoney(amount int64) Money {
+	return NewMoney(amount, MoneyScaleBalance)
+}
+
+// AreaMoney 区域业绩精度的金额
+func AreaMoney(amount int64) Money {
+	return NewMoney(amount, MoneyScaleArea)
+}
+
+// ParseMoney 精确解析十进制字符串，只接受 [-]整数[.小数]，小数位数不能超过 scale，不接受科学计数法、NaN、Inf
+func ParseMoney(raw string, scale int) (Money, error) {
+	s := strings.TrimSpace(raw)
+	negative := strings.HasPrefix(s, "-")
+	if negative {
+		s = s[1:]
+	}
+
+	intPart, fracPart := s, ""
+	if i := strings.IndexByte(s, '.'); 0 <= i {
+		intPart, fracPart = s[:i], s[i+1:]
+	}
+	if "" == intPart && "" == fracPart {
+		return Money{}, fmt.Errorf("money: invalid amount %q", raw)
+	}
+	if len(fracPart) > scale {
+		return Money{}, fmt.Errorf("money: %q has more than %d decimals", raw, scale)
+	}
+
+	var amount int64
+	digits := intPart + fracPart + strings.Repeat("0", scale-len(fracPart))
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return Money{}, fmt.Errorf("money: invalid amount %q", raw)
+		}
+		d := int64(c - '0')
+		if amount > (maxMoneyAmount-d)/10 {
+			return Money{}, fmt.Errorf("money: %q overflows", raw)
+		}
+		amount = amount*10 + d
+	}
+
+	if negative {
+		amount = -amount
+	}
+	return NewMoney(amount, scale), nil
+}
+
+const maxMoneyAmount = int64(^uint64(0) >> 1)
+
+// MoneyFromUnits 按 scale 精度放大的大整数转为 toScale 精度，舍去多余位数，用于链上金额
+func MoneyFromUnits(units *big.Int, scale int, toScale int) (Money, error) {
+	value := rescaleUnits(new(big.Int).Set(units), scale, toScale)
+	if !value.IsInt64() {
+		return Money{}, fmt.Errorf("money: %s overflows", units.String())
+	}
+	return NewMoney(value.Int64(), toScale), nil
+}
+
+// Units 按 scale 精度放大后的大整数，舍去多余位数
+func (m Money) Units(scale int) *big.Int {
+	return rescaleUnits(big.NewInt(m.Amount), m.Scale, scale)
+}
+
+// Rescale 转换精度，精度降低时舍去多余位数
+func (m Money) Rescale(scale int) (Money, error) {
+	return MoneyFromUnits(big.NewInt(m.Amount), m.Scale, scale)
+}
+
+// Add 相同精度的金额相加
+func (m Money) Add(o Money) (Money, error) {
+	if m.Scale != o.Scale {
+		return Money{}, fmt.Errorf("money: scale mismatch %d and %d", m.Scale, o.Scale)
+	}
+	if (0 < o.Amount && m.Amount > maxMoneyAmount-o.Amount) || (0 > o.Amount && m.Amount < -maxMoneyAmount-1-o.Amount) {
+		return Money{}, fmt.Errorf("money: add overflows")
+	}
+	return NewMoney(m.Amount+o.Amount, m.Scale), nil
+}
+
+// MulDiv 乘以 num/den，舍去多余位数，中间结果不会溢出
+func (m Money) MulDiv(num int64, den int64) (Money, error) {
+	if 0 == den {
+		return Money{}, fmt.Errorf("money: division by zero")
+	}
+	value := new(big.Int).Mul(big.NewInt(m.Amount), big.NewInt(num))
+	value.Quo(value, big.NewInt(den))
+	if !value.IsInt64() {
+		return Money{}, fmt.Errorf("money: mul overflows")
+	}
+	return NewMoney(value.Int64(), m.Scale), nil
+}
+
+// String 完整精度，去掉末尾的 0
+func (m Money) String() string {
+	s := m.Format(m.Scale)
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	return s
+}
+
+// Format 保留 places 位小数，多余位数直接舍去，不四舍五入
+func (m Money) Format(places int) string {
+	units := m.Units(places)
+	sign := ""
+	if 0 > units.Sign() {
+		sign = "-"
+		units.Neg(units)
+	}
+
+	s := units.String()
+	if 0 == places {
+		return sign + s
+	}
+	if len(s) <= places {
+		s = strings.Repeat("0", places-len(s)+1) + s
+	}
+	return sign + s[:len(s)-places] + "." + s[len(s)-places:]
+}
+
+func rescaleUnits(value *big.Int, scale int, toScale int) *big.Int {
+	if toScale > scale {
+		return value.Mul(value, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(toScale-scale)), nil))
+	}
+	if toScale < scale {
+		return value.Quo(value, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale-toScale)), nil))
+	}
+	return value
+}
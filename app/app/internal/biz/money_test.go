@@ -0,0 +1,178 @@
+package biz
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		raw    string
+		scale  int
+		amount int64
+		err    bool
+	}{
+		{raw: "1", scale: MoneyScaleBalance, amount: 10000000000},
+		{raw: "0.1", scale: MoneyScaleBalance, amount: 1000000000},
+		{raw: " 12.5 ", scale: 2, amount: 1250},
+		{raw: ".5", scale: 2, amount: 50},
+		{raw: "3.", scale: 2, amount: 300},
+		{raw: "0.0000000001", scale: MoneyScaleBalance, amount: 1},
+		{raw: "-1.25", scale: 2, amount: -125},
+		{raw: "-0", scale: 2, amount: 0},
+		{raw: "922337203.6854775807", scale: MoneyScaleBalance, amount: maxMoneyAmount},
+
+		// 小数位数超过精度
+		{raw: "0.00000000001", scale: MoneyScaleBalance, err: true},
+		{raw: "1.001", scale: 2, err: true},
+		// 溢出
+		{raw: "922337203.6854775808", scale: MoneyScaleBalance, err: true},
+		{raw: "10000000000", scale: MoneyScaleBalance, err: true},
+		// 符号
+		{raw: "+1", scale: 2, err: true},
+		{raw: "--1", scale: 2, err: true},
+		{raw: "-", scale: 2, err: true},
+		{raw: "1-", scale: 2, err: true},
+		// 空串和非十进制
+		{raw: "", scale: 2, err: true},
+		{raw: "  ", scale: 2, err: true},
+		{raw: ".", scale: 2, err: true},
+		{raw: "1e3", scale: 2, err: true},
+		{raw: "NaN", scale: 2, err: true},
+		{raw: "Inf", scale: 2, err: true},
+		{raw: "1,000", scale: 2, err: true},
+		{raw: "1.2.3", scale: 2, err: true},
+	}
+
+	for _, tt := range tests {
+		m, err := ParseMoney(tt.raw, tt.scale)
+		if tt.err {
+			if nil == err {
+				t.Errorf("ParseMoney(%q, %d) = %d, want error", tt.raw, tt.scale, m.Amount)
+			}
+			continue
+		}
+		if nil != err {
+			t.Errorf("ParseMoney(%q, %d): %v", tt.raw, tt.scale, err)
+			continue
+		}
+		if tt.amount != m.Amount || tt.scale != m.Scale {
+			t.Errorf("ParseMoney(%q, %d) = %d/%d, want %d/%d", tt.raw, tt.scale, m.Amount, m.Scale, tt.amount, tt.scale)
+		}
+	}
+}
+
+func TestMoneyFormat(t *testing.T) {
+	tests := []struct {
+		money  Money
+		places int
+		want   string
+	}{
+		{money: BalanceMoney(12345678901), places: 2, want: "1.23"},
+		{money: BalanceMoney(19999999999), places: 2, want: "1.99"}, // 舍去，不四舍五入
+		{money: BalanceMoney(-19999999999), places: 2, want: "-1.99"},
+		{money: BalanceMoney(1), places: 2, want: "0.00"},
+		{money: BalanceMoney(-1), places: 2, want: "0.00"},
+		{money: BalanceMoney(1), places: MoneyScaleBalance, want: "0.0000000001"},
+		{money: BalanceMoney(5e9), places: 0, want: "0"},
+		{money: BalanceMoney(25e9), places: 0, want: "2"},
+		{money: NewMoney(150, 2), places: 4, want: "1.5000"},
+		{money: NewMoney(0, 2), places: 2, want: "0.00"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.money.Format(tt.places); tt.want != got {
+			t.Errorf("%d/%d Format(%d) = %s, want %s", tt.money.Amount, tt.money.Scale, tt.places, got, tt.want)
+		}
+	}
+
+	if s := BalanceMoney(15e9).String(); "1.5" != s {
+		t.Errorf("String() = %s, want 1.5", s)
+	}
+	if s := BalanceMoney(2e10).String(); "2" != s {
+		t.Errorf("String() = %s, want 2", s)
+	}
+}
+
+func TestMoneyMulDiv(t *testing.T) {
+	tests := []struct {
+		amount int64
+		num    int64
+		den    int64
+		want   int64
+		err    bool
+	}{
+		{amount: 1000, num: 250, den: 100, want: 2500},
+		{amount: 999, num: 1, den: 100, want: 9}, // 舍去
+		{amount: 199, num: 1, den: 2, want: 99},
+		{amount: -199, num: 1, den: 2, want: -99}, // 负数向零舍去
+		{amount: 7, num: 0, den: 3, want: 0},
+		// 中间结果超过 int64，最终结果不溢出
+		{amount: maxMoneyAmount, num: 50, den: 100, want: maxMoneyAmount / 2},
+		{amount: maxMoneyAmount, num: 3, den: 1, err: true},
+		{amount: 1, num: 1, den: 0, err: true},
+	}
+
+	for _, tt := range tests {
+		m, err := BalanceMoney(tt.amount).MulDiv(tt.num, tt.den)
+		if tt.err {
+			if nil == err {
+				t.Errorf("%d MulDiv(%d, %d) = %d, want error", tt.amount, tt.num, tt.den, m.Amount)
+			}
+			continue
+		}
+		if nil != err {
+			t.Errorf("%d MulDiv(%d, %d): %v", tt.amount, tt.num, tt.den, err)
+			continue
+		}
+		if tt.want != m.Amount || MoneyScaleBalance != m.Scale {
+			t.Errorf("%d MulDiv(%d, %d) = %d/%d, want %d", tt.amount, tt.num, tt.den, m.Amount, m.Scale, tt.want)
+		}
+	}
+}
+
+func TestMoneyFromUnits(t *testing.T) {
+	chainUnit := new(big.Int).Exp(big.NewInt(10), big.NewInt(MoneyScaleChain), nil)
+	tests := []struct {
+		units   *big.Int
+		scale   int
+		toScale int
+		want    int64
+		err     bool
+	}{
+		{units: new(big.Int).Mul(big.NewInt(100), chainUnit), scale: MoneyScaleChain, toScale: MoneyScaleBalance, want: 100 * 1e10},
+		{units: big.NewInt(123456789), scale: MoneyScaleChain, toScale: MoneyScaleBalance, want: 1}, // 链上多余位数舍去
+		{units: big.NewInt(99999999), scale: MoneyScaleChain, toScale: MoneyScaleBalance, want: 0},
+		{units: big.NewInt(15), scale: 1, toScale: 3, want: 1500},
+		{units: big.NewInt(1234567), scale: MoneyScaleBalance, toScale: MoneyScaleArea, want: 12},
+		{units: big.NewInt(42), scale: 2, toScale: 2, want: 42},
+		// 转换后超过 int64
+		{units: new(big.Int).Mul(big.NewInt(1e9), chainUnit), scale: MoneyScaleChain, toScale: MoneyScaleBalance, err: true},
+	}
+
+	for _, tt := range tests {
+		units := new(big.Int).Set(tt.units)
+		m, err := MoneyFromUnits(units, tt.scale, tt.toScale)
+		if 0 != units.Cmp(tt.units) {
+			t.Errorf("MoneyFromUnits(%s) modified its argument", tt.units)
+		}
+		if tt.err {
+			if nil == err {
+				t.Errorf("MoneyFromUnits(%s, %d, %d) = %d, want error", tt.units, tt.scale, tt.toScale, m.Amount)
+			}
+			continue
+		}
+		if nil != err {
+			t.Errorf("MoneyFromUnits(%s, %d, %d): %v", tt.units, tt.scale, tt.toScale, err)
+			continue
+		}
+		if tt.want != m.Amount || tt.toScale != m.Scale {
+			t.Errorf("MoneyFromUnits(%s, %d, %d) = %d/%d, want %d", tt.units, tt.scale, tt.toScale, m.Amount, m.Scale, tt.want)
+		}
+	}
+
+	// 余额转链上精度补 0
+	if units := BalanceMoney(123456789012).Units(MoneyScaleChain); "12345678901200000000" != units.String() {
+		t.Errorf("Units = %s", units)
+	}
+}
@@ -0,0 +1,323 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"github.com/go-kratos/kratos/v2/errors"
+	"strings"
+	"time"
+)
+
+const (
+	recommendTreeDefaultDepth = 1
+	recommendTreeMaxDepth     = 3
+	recommendTreeSearchLimit  = 20
+)
+
+// recommendTreeNodes 节点的地址、注册时间、running 投资位金额、区业绩和团队人数
+type recommendTreeNodes struct {
+	users           map[int64]*User
+	locationAmounts map[int64]int64
+	areaAmounts     map[int64]int64
+	descendants     map[int64]int64
+}
+
+func (uuc *UserUseCase) getRecommendTreeNodes(ctx context.Context, userIds []int64) (*recommendTreeNodes, error) {
+	res := &recommendTreeNodes{
+		locationAmounts: make(map[int64]int64),
+		areaAmounts:     make(map[int64]int64),
+	}
+
+	var err error
+	res.users, err = uuc.repo.GetUserByUserIds(ctx, userIds...)
+	if nil != err {
+		return nil, err
+	}
+
+	locations, err := uuc.locationRepo.GetRunningLocationsByUserIds(ctx, userIds...)
+	if nil != err {
+		return nil, err
+	}
+	for _, v := range locations {
+		res.locationAmounts[v.UserId] += v.Amount().Amount
+	}
+
+	userAreas, err := uuc.urRepo.GetUserAreas(ctx, userIds)
+	if nil != err {
+		return nil, err
+	}
+	for _, v := range userAreas {
+		res.areaAmounts[v.UserId] = v.Amount + v.SelfAmount
+	}
+
+	res.descendants, err = uuc.urRepo.GetUserRecommendDescendantCounts(ctx, userIds...)
+	if nil != err {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (n *recommendTreeNodes) node(userId int64, parentId int64, depth int64) *v1.RecommendTreeNode {
+	node := &v1.RecommendTreeNode{
+		UserId:          userId,
+		LocationAmount:  BalanceMoney(n.locationAmounts[userId]).Format(2),
+		AreaAmount:      AreaMoney(n.areaAmounts[userId]).Format(2),
+		DescendantCount: n.descendants[userId],
+		ParentId:        parentId,
+		Depth:           depth,
+	}
+	if user, ok := n.users[userId]; ok {
+		node.Address = user.Address
+		node.CreatedAt = user.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05")
+	}
+	return node
+}
+
+// isAddressPrefix 是否为 0x 开头、至少一位十六进制字符的地址前缀
+func isAddressPrefix(address string) bool {
+	if 3 > len(address) || 42 < len(address) || ("0x" != address[:2] && "0X" != address[:2]) {
+		return false
+	}
+	for _, c := range address[2:] {
+		if !('0' <= c && c <= '9') && !('a' <= c && c <= 'f') && !('A' <= c && c <= 'F') {
+			return false
+		}
+	}
+	return true
+}
+
+// recommendTree 展开 nodeUserId 之下 depth 代的团队，address 不为空时在 nodeUserId 的团队中按地址前缀搜索；
+// rootUserId 大于 0 时只能查看 rootUserId 自己的团队，上级路径只到 rootUserId 为止
+func (uuc *UserUseCase) recommendTree(ctx context.Context, rootUserId int64, nodeUserId int64, depth int64, address string) (*v1.RecommendTreeReply, error) {
+	if 0 >= nodeUserId {
+		nodeUserId = rootUserId
+	}
+	if 0 >= nodeUserId {
+		return nil, errors.New(500, "RECOMMEND_TREE_ERROR", "用户不存在")
+	}
+	if 0 >= depth {
+		depth = recommendTreeDefaultDepth
+	}
+	if recommendTreeMaxDepth < depth {
+		depth = recommendTreeMaxDepth
+	}
+	address = strings.TrimSpace(address)
+	if "" != address && !isAddressPrefix(address) {
+		return nil, errors.New(500, "RECOMMEND_TREE_ERROR", "搜索地址格式错误")
+	}
+
+	// 上级路径，直推人在前
+	uplines, err := uuc.urRepo.GetUserRecommendUplines(ctx, nodeUserId, 0)
+	if nil != err {
+		return nil, err
+	}
+	if 0 < rootUserId {
+		var rootDepth int64
+		if nodeUserId != rootUserId {
+			for _, v := range uplines {
+				if v.AncestorId == rootUserId {
+					rootDepth = v.Depth
+				}
+			}
+			if 0 == rootDepth {
+				return nil, errors.New(500, "RECOMMEND_TREE_ERROR", "只能查看自己的团队")
+			}
+		}
+
+		tmpUplines := make([]*UserRecommendClosure, 0, rootDepth)
+		for _, v := range uplines {
+			if v.Depth <= rootDepth {
+				tmpUplines = append(tmpUplines, v)
+			}
+		}
+		uplines = tmpUplines
+	}
+	pathIds := make([]int64, 0, len(uplines))
+	for i := len(uplines) - 1; i >= 0; i-- {
+		pathIds = append(pathIds, uplines[i].AncestorId)
+	}
+
+	children, err := uuc.urRepo.GetUserRecommendDownlines(ctx, nodeUserId, depth)
+	if nil != err {
+		return nil, err
+	}
+	userIds := append([]int64{nodeUserId}, pathIds...)
+	deepChildIds := make([]int64, 0)
+	for _, v := range children {
+		userIds = append(userIds, v.DescendantId)
+		if 1 < v.Depth {
+			deepChildIds = append(deepChildIds, v.DescendantId)
+		}
+	}
+	parentIds, err := uuc.urRepo.GetUserRecommendParentIds(ctx, deepChildIds...)
+	if nil != err {
+		return nil, err
+	}
+
+	// 搜索结果附带从 nodeUserId 到其直推人的路径
+	var matches []*UserRecommendClosure
+	matchPaths := make(map[int64][]int64)
+	if "" != address {
+		matches, err = uuc.urRepo.SearchUserRecommendDownlines(ctx, nodeUserId, address, recommendTreeSearchLimit)
+		if nil != err {
+			return nil, err
+		}
+		for _, v := range matches {
+			path := []int64{nodeUserId}
+			if 1 < v.Depth {
+				matchUplines, err := uuc.urRepo.GetUserRecommendUplines(ctx, v.DescendantId, v.Depth-1)
+				if nil != err {
+					return nil, err
+				}
+				for i := len(matchUplines) - 1; i >= 0; i-- {
+					path = append(path, matchUplines[i].AncestorId)
+				}
+			}
+			matchPaths[v.DescendantId] = path
+			userIds = append(userIds, v.DescendantId)
+		}
+	}
+
+	nodes, err := uuc.getRecommendTreeNodes(ctx, userIds)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.RecommendTreeReply{
+		Path:     make([]*v1.RecommendTreeNode, 0, len(pathIds)),
+		Children: make([]*v1.RecommendTreeNode, 0, len(children)),
+		Matches:  make([]*v1.RecommendTreeMatch, 0, len(matches)),
+	}
+	var parentId int64
+	for _, v := range pathIds {
+		res.Path = append(res.Path, nodes.node(v, parentId, 0))
+		parentId = v
+	}
+	res.Node = nodes.node(nodeUserId, parentId, 0)
+	for _, v := range children {
+		childParentId := nodeUserId
+		if 1 < v.Depth {
+			childParentId = parentIds[v.DescendantId]
+		}
+		res.Children = append(res.Children, nodes.node(v.DescendantId, childParentId, v.Depth))
+	}
+	for _, v := range matches {
+		path := matchPaths[v.DescendantId]
+		res.Matches = append(res.Matches, &v1.RecommendTreeMatch{
+			Node: nodes.node(v.DescendantId, path[len(path)-1], v.Depth),
+			Path: path,
+		})
+	}
+
+	return res, nil
+}
+
+// RecommendTree 用户查看自己的团队，按节点逐层展开
+func (uuc *UserUseCase) RecommendTree(ctx context.Context, req *v1.RecommendTreeRequest, user *User) (*v1.RecommendTreeReply, error) {
+	return uuc.recommendTree(ctx, user.ID, req.UserId, req.Depth, req.Address)
+}
+
+// AdminRecommendTree 后台查看任意用户的团队，上级路径到最上级
+func (uuc *UserUseCase) AdminRecommendTree(ctx context.Context, req *v1.AdminRecommendTreeRequest) (*v1.AdminRecommendTreeReply, error) {
+	res, err := uuc.recommendTree(ctx, 0, req.UserId, req.Depth, req.Address)
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminRecommendTreeReply{
+		Node:     res.Node,
+		Path:     res.Path,
+		Children: res.Children,
+		Matches:  res.Matches,
+	}, nil
+}
+
+// RecommendList 直推列表，type 为 running 时只返回有 running 投资位的用户
+func (uuc *UserUseCase) RecommendList(ctx context.Context, req *v1.RecommendListRequest, user *User) (*v1.RecommendListReply, error) {
+	directs, err := uuc.urRepo.GetUserRecommendDownlines(ctx, user.ID, 1)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.RecommendListReply{
+		Recommends: make([]*v1.RecommendListReply_List, 0, len(directs)),
+	}
+	if 0 == len(directs) {
+		return res, nil
+	}
+
+	userIds := make([]int64, 0, len(directs))
+	for _, v := range directs {
+		userIds = append(userIds, v.DescendantId)
+	}
+	nodes, err := uuc.getRecommendTreeNodes(ctx, userIds)
+	if nil != err {
+		return nil, err
+	}
+
+	for _, userId := range userIds {
+		status := "stop"
+		if 0 < nodes.locationAmounts[userId] {
+			status = "running"
+		}
+		if "running" == req.Type && "running" != status {
+			continue
+		}
+
+		node := nodes.node(userId, user.ID, 1)
+		res.Recommends = append(res.Recommends, &v1.RecommendListReply_List{
+			UserId:    userId,
+			Address:   node.Address,
+			CreatedAt: node.CreatedAt,
+			Amount:    node.LocationAmount,
+			Status:    status,
+		})
+	}
+
+	return res, nil
+}
+
+// AdminRecommendList 用户的直推
+func (uuc *UserUseCase) AdminRecommendList(ctx context.Context, req *v1.AdminUserRecommendRequest) (*v1.AdminUserRecommendReply, error) {
+	res := &v1.AdminUserRecommendReply{
+		Users: make([]*v1.AdminUserRecommendReply_List, 0),
+	}
+
+	myRecommendUsers, err := uuc.urRepo.GetUserRecommendDownlines(ctx, req.UserId, 1)
+	if nil != err {
+		return nil, err
+	}
+	if 0 == len(myRecommendUsers) {
+		return res, nil
+	}
+
+	userIds := make([]int64, 0, len(myRecommendUsers))
+	for _, v := range myRecommendUsers {
+		userIds = append(userIds, v.DescendantId)
+	}
+	users, err := uuc.repo.GetUserByUserIds(ctx, userIds...)
+	if nil != err {
+		return nil, err
+	}
+	userRecommends, err := uuc.urRepo.GetUserRecommendByUserIds(ctx, userIds...)
+	if nil != err {
+		return nil, err
+	}
+
+	for _, userId := range userIds {
+		item := &v1.AdminUserRecommendReply_List{
+			UserId: userId,
+		}
+		if user, ok := users[userId]; ok {
+			item.Address = user.Address
+		}
+		if userRecommend, ok := userRecommends[userId]; ok {
+			item.Id = userRecommend.ID
+			item.CreatedAt = userRecommend.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05")
+		}
+		res.Users = append(res.Users, item)
+	}
+
+	return res, nil
+}
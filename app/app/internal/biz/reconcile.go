@@ -0,0 +1,163 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"sort"
+	"time"
+)
+
+// 对账差异来源
+const (
+	DiscrepancySourceHistory = "history" // 按 reward、withdraw、balance_reward 和充值记录重新计算的余额与 user_balance 不一致
+	DiscrepancySourceLedger  = "ledger"  // 账本用户科目余额与 user_balance 不一致
+	DiscrepancySourceTotal   = "total"   // 全部用户重新计算的合计与 user_balance 合计不一致
+)
+
+type BalanceDiscrepancy struct {
+	ID        int64
+	UserId    int64 // 合计差异为 0
+	CoinType  string
+	Source    string
+	Expected  int64
+	Actual    int64
+	CreatedAt time.Time
+	UpdatedAt time.Time // 最近一次对账仍发现该差异的时间
+}
+
+// ReconcileResult 一次对账的结果
+type ReconcileResult struct {
+	Checked       int64
+	Discrepancies int64
+}
+
+type ReconcileRepo interface {
+	GetUserBalances(ctx context.Context) (map[int64]*UserBalance, error)
+	GetUserHistoryBalances(ctx context.Context) (map[int64]*UserBalance, error)
+	GetUserLedgerBalances(ctx context.Context) (map[int64]*UserBalance, bool, error)
+	CreateBalanceDiscrepancies(ctx context.Context, discrepancies []*BalanceDiscrepancy) error
+	GetBalanceDiscrepancies(ctx context.Context, b *Pagination, userId int64) ([]*BalanceDiscrepancy, error, int64)
+}
+
+// Reconcile 按历史记录和账本重新计算每个用户的余额，与 user_balance 比较，差异写入 balance_discrepancy
+func (lc *LedgerUseCase) Reconcile(ctx context.Context) (*ReconcileResult, error) {
+	var (
+		balances        map[int64]*UserBalance
+		historyBalances map[int64]*UserBalance
+		ledgerBalances  map[int64]*UserBalance
+		opened          bool
+		usdtTotal       int64
+	)
+
+	// 在同一个事务中读取，保证读到同一时刻的数据
+	if err := lc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		var err error
+		balances, err = lc.reconcileRepo.GetUserBalances(ctx)
+		if nil != err {
+			return err
+		}
+
+		historyBalances, err = lc.reconcileRepo.GetUserHistoryBalances(ctx)
+		if nil != err {
+			return err
+		}
+
+		ledgerBalances, opened, err = lc.reconcileRepo.GetUserLedgerBalances(ctx)
+		if nil != err {
+			return err
+		}
+
+		usdtTotal, err = lc.ubRepo.GetUserBalanceUsdtTotal(ctx)
+		return err
+	}); nil != err {
+		return nil, err
+	}
+
+	userIds := make([]int64, 0, len(balances))
+	for userId := range balances {
+		userIds = append(userIds, userId)
+	}
+	for userId := range historyBalances {
+		if _, ok := balances[userId]; !ok {
+			userIds = append(userIds, userId)
+		}
+	}
+	sort.Slice(userIds, func(i, j int) bool {
+		return userIds[i] < userIds[j]
+	})
+
+	res := &ReconcileResult{}
+	discrepancies := make([]*BalanceDiscrepancy, 0)
+	compare := func(userId int64, source string, expected *UserBalance, actual *UserBalance) {
+		if nil == expected {
+			expected = &UserBalance{}
+		}
+		if nil == actual {
+			actual = &UserBalance{}
+		}
+		if expected.BalanceUsdt != actual.BalanceUsdt {
+			discrepancies = append(discrepancies, &BalanceDiscrepancy{UserId: userId, CoinType: "usdt", Source: source, Expected: expected.BalanceUsdt, Actual: actual.BalanceUsdt})
+		}
+		if expected.BalanceDhb != actual.BalanceDhb {
+			discrepancies = append(discrepancies, &BalanceDiscrepancy{UserId: userId, CoinType: "dhb", Source: source, Expected: expected.BalanceDhb, Actual: actual.BalanceDhb})
+		}
+	}
+
+	var historyUsdtTotal int64
+	for _, userId := range userIds {
+		compare(userId, DiscrepancySourceHistory, historyBalances[userId], balances[userId])
+		// 账本记录期初余额之前，账本余额不完整
+		if opened {
+			compare(userId, DiscrepancySourceLedger, ledgerBalances[userId], balances[userId])
+		}
+		if nil != historyBalances[userId] {
+			historyUsdtTotal += historyBalances[userId].BalanceUsdt
+		}
+		res.Checked++
+	}
+
+	if historyUsdtTotal != usdtTotal {
+		discrepancies = append(discrepancies, &BalanceDiscrepancy{CoinType: "usdt", Source: DiscrepancySourceTotal, Expected: historyUsdtTotal, Actual: usdtTotal})
+	}
+
+	if 0 < len(discrepancies) {
+		lc.log.Warnf("reconcile: %d discrepancies in %d users", len(discrepancies), res.Checked)
+		if err := lc.reconcileRepo.CreateBalanceDiscrepancies(ctx, discrepancies); nil != err {
+			return nil, err
+		}
+	}
+	res.Discrepancies = int64(len(discrepancies))
+
+	return res, nil
+}
+
+// AdminDiscrepancyList .
+func (lc *LedgerUseCase) AdminDiscrepancyList(ctx context.Context, req *v1.AdminDiscrepancyListRequest) (*v1.AdminDiscrepancyListReply, error) {
+	discrepancies, err, count := lc.reconcileRepo.GetBalanceDiscrepancies(ctx, &Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, req.UserId)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.AdminDiscrepancyListReply{
+		Discrepancies: make([]*v1.AdminDiscrepancyListReply_List, 0),
+		Count:         count,
+	}
+	for _, v := range discrepancies {
+		res.Discrepancies = append(res.Discrepancies, &v1.AdminDiscrepancyListReply_List{
+			Id:        v.ID,
+			UserId:    v.UserId,
+			CoinType:  v.CoinType,
+			Source:    v.Source,
+			Expected:  ledgerAmount(v.Expected),
+			Actual:    ledgerAmount(v.Actual),
+			Diff:      ledgerAmount(v.Actual - v.Expected),
+			CreatedAt: v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+			UpdatedAt: v.UpdatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return res, nil
+}
@@ -2,18 +2,51 @@ package biz
 
 import (
 	"context"
+	v1 "dhb/app/app/api"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
+	"math/big"
+	"strconv"
+	"strings"
 	"time"
 )
 
+// 充值扫描检查点
+const depositCheckpointName = "usdt_deposit"
+
+// 充值记录状态，只有 confirmed 的记录已入账，历史数据的 success 视为已入账
+const (
+	EthUserRecordStatusSeen       = "seen"       // 扫描到，未确认
+	EthUserRecordStatusConfirming = "confirming" // 确认中
+	EthUserRecordStatusConfirmed  = "confirmed"  // 已确认并入账
+	EthUserRecordStatusOrphaned   = "orphaned"   // 所在区块被回滚
+	EthUserRecordStatusUnmatched  = "unmatched"  // 发送地址不是平台用户，待人工指定入账用户
+)
+
+// LocationNewStatusReversed 充值被回滚冲正的投资位，同一交易重新打包入账时另开投资位
+const LocationNewStatusReversed = "reversed"
+
 type EthUserRecord struct {
-	ID       int64
-	UserId   int64
-	Hash     string
-	Status   string
-	Type     string
-	Amount   string
-	CoinType string
+	ID            int64
+	UserId        int64
+	From          string
+	Hash          string
+	Status        string
+	Type          string
+	Amount        string
+	CoinType      string
+	BlockNumber   int64
+	BlockHash     string
+	Confirmations int64
+}
+
+// DepositScanOption 充值扫描参数
+type DepositScanOption struct {
+	StartBlock    int64
+	BatchSize     int64
+	Confirmations int64 // 入账所需确认数
+	ReorgDepth    int64 // 已入账记录的回滚校验深度
 }
 
 type Location struct {
@@ -34,14 +67,36 @@ type GlobalLock struct {
 	Status int64
 }
 
+// ChainTransfer 链上 BEP-20 Transfer 事件
+type ChainTransfer struct {
+	TxHash      string
+	LogIndex    int64
+	BlockNumber int64
+	BlockHash   string
+	From        string
+	To          string
+	Amount      string // 链上原始数量，18位精度
+}
+
+type ChainCheckpoint struct {
+	ID          int64
+	Name        string
+	BlockNumber int64
+	BlockHash   string
+}
+
 type RecordUseCase struct {
 	ethUserRecordRepo             EthUserRecordRepo
+	userRepo                      UserRepo
+	chainRepo                     ChainRepo
+	chainCheckpointRepo           ChainCheckpointRepo
 	userRecommendRepo             UserRecommendRepo
 	configRepo                    ConfigRepo
 	locationRepo                  LocationRepo
 	userBalanceRepo               UserBalanceRepo
 	userInfoRepo                  UserInfoRepo
 	userCurrentMonthRecommendRepo UserCurrentMonthRecommendRepo
+	settlementRepo                RewardSettlementRepo
 	tx                            Transaction
 	log                           *log.Helper
 }
@@ -49,16 +104,39 @@ type RecordUseCase struct {
 type EthUserRecordRepo interface {
 	GetEthUserRecordListByHash(ctx context.Context, hash ...string) (map[string]*EthUserRecord, error)
 	CreateEthUserRecordListByHash(ctx context.Context, r *EthUserRecord) (*EthUserRecord, error)
+	GetEthUserRecordsByStatus(ctx context.Context, fromBlock int64, status ...string) ([]*EthUserRecord, error)
+	UpdateEthUserRecordStatus(ctx context.Context, id int64, oldStatus string, r *EthUserRecord) (bool, error)
+	ResolveEthUserRecord(ctx context.Context, id int64, userId int64) (bool, error)
+}
+
+type ChainRepo interface {
+	GetLatestBlockNumber(ctx context.Context) (int64, error)
+	GetBlockHash(ctx context.Context, number int64) (string, error)
+	GetDepositTransfers(ctx context.Context, fromBlock int64, toBlock int64) ([]*ChainTransfer, error)
+}
+
+type ChainCheckpointRepo interface {
+	GetChainCheckpoint(ctx context.Context, name string) (*ChainCheckpoint, error)
+	UpdateChainCheckpoint(ctx context.Context, name string, blockNumber int64, blockHash string) error
 }
 
 type LocationRepo interface {
 	CreateLocation(ctx context.Context, rel *Location) (*Location, error)
+	CreateLocationNew(ctx context.Context, rel *LocationNew) (*LocationNew, error)
+	GetLocationNewByTxHash(ctx context.Context, txHash string) (*LocationNew, error)
+	UpdateLocationNewStatus(ctx context.Context, id int64, status string, stopDate time.Time) error
+	GetRunningLocationUserIds(ctx context.Context, afterUserId int64, createdBefore time.Time, limit int) ([]int64, error)
+	GetRunningLocationsByUserIdForUpdate(ctx context.Context, userId int64, createdBefore time.Time) ([]*LocationNew, error)
+	UpdateLocationNewCurrent(ctx context.Context, id int64, amount int64, status string, stopDate time.Time, stopCoin int64) error
+	GetSettlementLocations(ctx context.Context, createdBefore time.Time, stoppedSince time.Time) ([]*LocationNew, error)
 	GetLocationLast(ctx context.Context) (*Location, error)
 	GetLocationDaily(ctx context.Context) ([]*Location, error)
 	GetMyLocationLast(ctx context.Context, userId int64) (*Location, error)
 	GetMyStopLocationLast(ctx context.Context, userId int64) (*Location, error)
 	GetMyLocationRunningLast(ctx context.Context, userId int64) (*Location, error)
 	GetLocationsByUserId(ctx context.Context, userId int64) ([]*LocationNew, error)
+	GetRunningLocationsByUserIds(ctx context.Context, userIds ...int64) ([]*LocationNew, error)
+	GetRunningLocations(ctx context.Context) ([]*LocationNew, error)
 	GetRewardLocationByRowOrCol(ctx context.Context, row int64, col int64, locationRowConfig int64) ([]*Location, error)
 	GetRewardLocationByIds(ctx context.Context, ids ...int64) (map[int64]*Location, error)
 	GetLocationByIds(ctx context.Context, userIds ...int64) ([]*Location, error)
@@ -77,22 +155,30 @@ type LocationRepo interface {
 
 func NewRecordUseCase(
 	ethUserRecordRepo EthUserRecordRepo,
+	userRepo UserRepo,
+	chainRepo ChainRepo,
+	chainCheckpointRepo ChainCheckpointRepo,
 	locationRepo LocationRepo,
 	userBalanceRepo UserBalanceRepo,
 	userRecommendRepo UserRecommendRepo,
 	userInfoRepo UserInfoRepo,
 	configRepo ConfigRepo,
 	userCurrentMonthRecommendRepo UserCurrentMonthRecommendRepo,
+	settlementRepo RewardSettlementRepo,
 	tx Transaction,
 	logger log.Logger) *RecordUseCase {
 	return &RecordUseCase{
 		ethUserRecordRepo:             ethUserRecordRepo,
+		userRepo:                      userRepo,
+		chainRepo:                     chainRepo,
+		chainCheckpointRepo:           chainCheckpointRepo,
 		locationRepo:                  locationRepo,
 		configRepo:                    configRepo,
 		userRecommendRepo:             userRecommendRepo,
 		userBalanceRepo:               userBalanceRepo,
 		userCurrentMonthRecommendRepo: userCurrentMonthRecommendRepo,
 		userInfoRepo:                  userInfoRepo,
+		settlementRepo:                settlementRepo,
 		tx:                            tx,
 		log:                           log.NewHelper(logger),
 	}
@@ -102,10 +188,529 @@ func (ruc *RecordUseCase) GetEthUserRecordByTxHash(ctx context.Context, txHash .
 	return ruc.ethUserRecordRepo.GetEthUserRecordListByHash(ctx, txHash...)
 }
 
+// EthUserRecordHandle 记录扫描到的充值，未指定状态的记为 seen，确认数足够后由 DepositConfirm 入账，交易哈希已存在的跳过
 func (ruc *RecordUseCase) EthUserRecordHandle(ctx context.Context, ethUserRecord ...*EthUserRecord) (bool, error) {
+	for _, v := range ethUserRecord {
+		amount, err := chainAmountToUsdt(v.Amount)
+		if nil != err || 0 >= amount {
+			ruc.log.Errorf("deposit %s amount %s: %v", v.Hash, v.Amount, err)
+			continue
+		}
+
+		if err = ruc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			existRecords, err := ruc.ethUserRecordRepo.GetEthUserRecordListByHash(ctx, v.Hash)
+			if nil != err {
+				return err
+			}
+			if _, ok := existRecords[v.Hash]; ok {
+				return nil
+			}
+
+			if "" == v.Status {
+				v.Status = EthUserRecordStatusSeen
+			}
+			_, err = ruc.ethUserRecordRepo.CreateEthUserRecordListByHash(ctx, v)
+			if nil != err {
+				return err
+			}
+
+			return nil
+		}); nil != err {
+			return false, err
+		}
+	}
+
 	return true, nil
 }
 
+// DepositScan 校验回滚、从检查点往后扫描一批区块的 USDT 充值并处理确认，返回扫描到的区块高度
+func (ruc *RecordUseCase) DepositScan(ctx context.Context, opt *DepositScanOption) (int64, error) {
+	latest, err := ruc.chainRepo.GetLatestBlockNumber(ctx)
+	if nil != err {
+		return 0, err
+	}
+
+	reorgDepth := opt.ReorgDepth
+	if reorgDepth < opt.Confirmations {
+		reorgDepth = opt.Confirmations
+	}
+
+	fromBlock := opt.StartBlock
+	checkpoint, err := ruc.chainCheckpointRepo.GetChainCheckpoint(ctx, depositCheckpointName)
+	if nil != err && !errors.IsNotFound(err) {
+		return 0, err
+	}
+	if nil != checkpoint {
+		fromBlock = checkpoint.BlockNumber + 1
+
+		// 检查点区块哈希变化说明发生了回滚，回退后重新扫描
+		if "" != checkpoint.BlockHash {
+			hash, err := ruc.chainRepo.GetBlockHash(ctx, checkpoint.BlockNumber)
+			if nil != err {
+				return 0, err
+			}
+			if hash != checkpoint.BlockHash {
+				ruc.log.Warnf("chain reorg detected at block %d: %s -> %s", checkpoint.BlockNumber, checkpoint.BlockHash, hash)
+				fromBlock = checkpoint.BlockNumber - reorgDepth + 1
+			}
+		}
+	} else if 0 >= fromBlock {
+		fromBlock = latest // 未配置起始区块时从最新区块开始
+	}
+
+	orphanedBlock, err := ruc.depositOrphan(ctx, latest-reorgDepth)
+	if nil != err {
+		return 0, err
+	}
+	if 0 < orphanedBlock && orphanedBlock < fromBlock {
+		fromBlock = orphanedBlock // 被回滚的交易可能重新打包，从最早的回滚区块重新扫描
+	}
+	if 1 > fromBlock {
+		fromBlock = 1
+	}
+
+	toBlock := latest
+	if fromBlock <= latest {
+		batchSize := opt.BatchSize
+		if 0 >= batchSize {
+			batchSize = 1000
+		}
+		if fromBlock+batchSize-1 < toBlock {
+			toBlock = fromBlock + batchSize - 1
+		}
+
+		if err = ruc.depositScanBlocks(ctx, fromBlock, toBlock); nil != err {
+			return 0, err
+		}
+	}
+
+	if err = ruc.DepositConfirm(ctx, latest, opt.Confirmations); nil != err {
+		return 0, err
+	}
+
+	if fromBlock <= latest {
+		hash, err := ruc.chainRepo.GetBlockHash(ctx, toBlock)
+		if nil != err {
+			return 0, err
+		}
+		if err = ruc.chainCheckpointRepo.UpdateChainCheckpoint(ctx, depositCheckpointName, toBlock, hash); nil != err {
+			return 0, err
+		}
+	}
+
+	return toBlock, nil
+}
+
+// depositScanBlocks 扫描区块范围内的充值，新交易记为 seen，已回滚的交易重新打包时恢复为 seen；
+// 发送地址不是平台用户的记为 unmatched，在检查点推进前保存，由后台指定用户后再入账
+func (ruc *RecordUseCase) depositScanBlocks(ctx context.Context, fromBlock int64, toBlock int64) error {
+	transfers, err := ruc.chainRepo.GetDepositTransfers(ctx, fromBlock, toBlock)
+	if nil != err {
+		return err
+	}
+	if 0 == len(transfers) {
+		return nil
+	}
+
+	var (
+		hashes    []string
+		addresses []string
+	)
+	// 同一笔交易的多次转入合并
+	transferByHash := make(map[string]*ChainTransfer, 0)
+	for _, v := range transfers {
+		if tmp, ok := transferByHash[v.TxHash]; ok {
+			sum, ok := new(big.Int).SetString(tmp.Amount, 10)
+			add, ok2 := new(big.Int).SetString(v.Amount, 10)
+			if ok && ok2 {
+				tmp.Amount = sum.Add(sum, add).String()
+			}
+			continue
+		}
+		transferByHash[v.TxHash] = &ChainTransfer{
+			TxHash:      v.TxHash,
+			LogIndex:    v.LogIndex,
+			BlockNumber: v.BlockNumber,
+			BlockHash:   v.BlockHash,
+			From:        v.From,
+			To:          v.To,
+			Amount:      v.Amount,
+		}
+		hashes = append(hashes, v.TxHash)
+		addresses = append(addresses, v.From)
+	}
+
+	users, err := ruc.userRepo.GetUserByAddresses(ctx, addresses...)
+	if nil != err {
+		return err
+	}
+	userByAddress := make(map[string]*User, 0)
+	for _, v := range users {
+		userByAddress[strings.ToLower(v.Address)] = v
+	}
+
+	existRecords, err := ruc.ethUserRecordRepo.GetEthUserRecordListByHash(ctx, hashes...)
+	if nil != err {
+		return err
+	}
+
+	records := make([]*EthUserRecord, 0)
+	for _, hash := range hashes {
+		transfer := transferByHash[hash]
+		if exist, ok := existRecords[hash]; ok {
+			// 未入账或已回滚的记录跟随交易所在的新区块
+			switch exist.Status {
+			case EthUserRecordStatusSeen, EthUserRecordStatusConfirming, EthUserRecordStatusOrphaned:
+				if exist.BlockHash == transfer.BlockHash {
+					continue
+				}
+				if _, err = ruc.ethUserRecordRepo.UpdateEthUserRecordStatus(ctx, exist.ID, exist.Status, &EthUserRecord{
+					Status:      EthUserRecordStatusSeen,
+					BlockNumber: transfer.BlockNumber,
+					BlockHash:   transfer.BlockHash,
+				}); nil != err {
+					return err
+				}
+			case EthUserRecordStatusUnmatched:
+				if exist.BlockHash == transfer.BlockHash {
+					continue
+				}
+				if _, err = ruc.ethUserRecordRepo.UpdateEthUserRecordStatus(ctx, exist.ID, exist.Status, &EthUserRecord{
+					Status:      EthUserRecordStatusUnmatched,
+					BlockNumber: transfer.BlockNumber,
+					BlockHash:   transfer.BlockHash,
+				}); nil != err {
+					return err
+				}
+			}
+			continue
+		}
+
+		var (
+			userId int64
+			status string
+		)
+		if user, ok := userByAddress[strings.ToLower(transfer.From)]; ok {
+			userId = user.ID
+		} else {
+			ruc.log.Warnf("deposit %s from unknown address %s, amount %s", hash, transfer.From, transfer.Amount)
+			status = EthUserRecordStatusUnmatched
+		}
+
+		records = append(records, &EthUserRecord{
+			UserId:      userId,
+			From:        transfer.From,
+			Status:      status,
+			Hash:        hash,
+			Type:        "deposit",
+			Amount:      transfer.Amount,
+			CoinType:    "USDT",
+			BlockNumber: transfer.BlockNumber,
+			BlockHash:   transfer.BlockHash,
+		})
+	}
+
+	_, err = ruc.EthUserRecordHandle(ctx, records...)
+	return err
+}
+
+// depositOrphan 校验未入账记录和 fromBlock 之后已入账记录所在区块，区块哈希变化的记为 orphaned，已入账的冲正，返回最早的回滚区块
+func (ruc *RecordUseCase) depositOrphan(ctx context.Context, fromBlock int64) (int64, error) {
+	pending, err := ruc.ethUserRecordRepo.GetEthUserRecordsByStatus(ctx, 0, EthUserRecordStatusSeen, EthUserRecordStatusConfirming)
+	if nil != err {
+		return 0, err
+	}
+	confirmed, err := ruc.ethUserRecordRepo.GetEthUserRecordsByStatus(ctx, fromBlock, EthUserRecordStatusConfirmed)
+	if nil != err {
+		return 0, err
+	}
+
+	var orphanedBlock int64
+	blockHashes := make(map[int64]string, 0)
+	for _, v := range append(pending, confirmed...) {
+		hash, ok := blockHashes[v.BlockNumber]
+		if !ok {
+			hash, err = ruc.chainRepo.GetBlockHash(ctx, v.BlockNumber)
+			if nil != err {
+				return 0, err
+			}
+			blockHashes[v.BlockNumber] = hash
+		}
+		if hash == v.BlockHash {
+			continue
+		}
+
+		ruc.log.Warnf("deposit %s orphaned, block %d %s -> %s", v.Hash, v.BlockNumber, v.BlockHash, hash)
+		if err = ruc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			updated, err := ruc.ethUserRecordRepo.UpdateEthUserRecordStatus(ctx, v.ID, v.Status, &EthUserRecord{
+				Status:      EthUserRecordStatusOrphaned,
+				BlockNumber: v.BlockNumber,
+				BlockHash:   v.BlockHash,
+			})
+			if nil != err {
+				return err
+			}
+			if !updated || EthUserRecordStatusConfirmed != v.Status {
+				return nil
+			}
+
+			// 已入账的冲正
+			amount, err := chainAmountToUsdt(v.Amount)
+			if nil != err {
+				return err
+			}
+			_, err = ruc.userBalanceRepo.DepositReverse(ctx, v.UserId, amount)
+			if nil != err {
+				return err
+			}
+
+			return ruc.depositLocationReverse(ctx, v, amount)
+		}); nil != err {
+			return 0, err
+		}
+
+		if 0 == orphanedBlock || v.BlockNumber < orphanedBlock {
+			orphanedBlock = v.BlockNumber
+		}
+	}
+
+	return orphanedBlock, nil
+}
+
+// DepositConfirm 更新未入账记录的确认数，达到 confirmations 的入账
+func (ruc *RecordUseCase) DepositConfirm(ctx context.Context, latest int64, confirmations int64) error {
+	pending, err := ruc.ethUserRecordRepo.GetEthUserRecordsByStatus(ctx, 0, EthUserRecordStatusSeen, EthUserRecordStatusConfirming)
+	if nil != err {
+		return err
+	}
+
+	var outRate int64
+	for _, v := range pending {
+		current := latest - v.BlockNumber + 1
+		if 0 > current {
+			current = 0
+		}
+
+		if current < confirmations {
+			if EthUserRecordStatusConfirming == v.Status && current == v.Confirmations {
+				continue
+			}
+			if _, err = ruc.ethUserRecordRepo.UpdateEthUserRecordStatus(ctx, v.ID, v.Status, &EthUserRecord{
+				Status:        EthUserRecordStatusConfirming,
+				BlockNumber:   v.BlockNumber,
+				BlockHash:     v.BlockHash,
+				Confirmations: current,
+			}); nil != err {
+				return err
+			}
+			continue
+		}
+
+		amount, err := chainAmountToUsdt(v.Amount)
+		if nil != err || 0 >= amount {
+			ruc.log.Errorf("deposit %s amount %s: %v", v.Hash, v.Amount, err)
+			continue
+		}
+
+		if 0 == outRate {
+			if outRate, err = ruc.getOutRate(ctx); nil != err {
+				return err
+			}
+		}
+
+		if err = ruc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			// 状态条件更新，防止重复入账
+			updated, err := ruc.ethUserRecordRepo.UpdateEthUserRecordStatus(ctx, v.ID, v.Status, &EthUserRecord{
+				Status:        EthUserRecordStatusConfirmed,
+				BlockNumber:   v.BlockNumber,
+				BlockHash:     v.BlockHash,
+				Confirmations: current,
+			})
+			if nil != err {
+				return err
+			}
+			if !updated {
+				return nil
+			}
+
+			_, err = ruc.userBalanceRepo.Deposit(ctx, v.UserId, amount)
+			if nil != err {
+				return err
+			}
+
+			return ruc.depositLocation(ctx, v, amount, outRate)
+		}); nil != err {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getOutRate 出局倍率，百分比，250 即 2.5 倍
+func (ruc *RecordUseCase) getOutRate(ctx context.Context) (int64, error) {
+	var outRate int64
+	configs, err := ruc.configRepo.GetConfigByKeys(ctx, "out_rate")
+	if nil != err {
+		return 0, err
+	}
+	for _, vConfig := range configs {
+		if "out_rate" == vConfig.KeyName {
+			outRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+		}
+	}
+	if 0 >= outRate {
+		return 0, errors.New(500, "CONFIG_ERROR", "出局倍率未配置")
+	}
+
+	return outRate, nil
+}
+
+// depositLocation 充值确认后开启投资位并累加推荐链路业绩，每笔交易只开一次，已冲正的投资位不计，需在入账事务中调用
+func (ruc *RecordUseCase) depositLocation(ctx context.Context, record *EthUserRecord, amount int64, outRate int64) error {
+	location, err := ruc.locationRepo.GetLocationNewByTxHash(ctx, record.Hash)
+	if nil != err && !errors.IsNotFound(err) {
+		return err
+	}
+	if nil != location {
+		return nil
+	}
+
+	currentMax, err := BalanceMoney(amount).MulDiv(outRate, 100)
+	if nil != err {
+		return err
+	}
+
+	location, err = ruc.locationRepo.CreateLocationNew(ctx, &LocationNew{
+		UserId:     record.UserId,
+		Status:     "running",
+		Current:    0,
+		CurrentMax: currentMax.Amount,
+		OutRate:    outRate,
+		TxHash:     record.Hash,
+	})
+	if nil != err {
+		return err
+	}
+
+	return ruc.updateUserAreaAmount(ctx, record.UserId, location.AreaAmount())
+}
+
+// depositLocationReverse 充值被回滚时投资位记为 reversed 并扣回推荐链路业绩
+func (ruc *RecordUseCase) depositLocationReverse(ctx context.Context, record *EthUserRecord, amount int64) error {
+	location, err := ruc.locationRepo.GetLocationNewByTxHash(ctx, record.Hash)
+	if nil != err {
+		if errors.IsNotFound(err) {
+			return nil // 历史充值没有对应的投资位
+		}
+		return err
+	}
+	if "running" != location.Status {
+		ruc.log.Warnf("deposit %s orphaned, location %d already %s", record.Hash, location.ID, location.Status)
+		return nil
+	}
+
+	if err = ruc.locationRepo.UpdateLocationNewStatus(ctx, location.ID, LocationNewStatusReversed, time.Now().UTC()); nil != err {
+		return err
+	}
+
+	return ruc.updateUserAreaAmount(ctx, location.UserId, -location.AreaAmount())
+}
+
+// updateUserAreaAmount 投资位开启或出局时增减用户自身业绩和推荐链路上所有用户的团队业绩，需在同一事务中调用，区业绩精度1e5
+func (ruc *RecordUseCase) updateUserAreaAmount(ctx context.Context, userId int64, areaAmount int64) error {
+	if err := ruc.userRecommendRepo.UpdateUserAreaSelfAmount(ctx, userId, areaAmount); nil != err {
+		return err
+	}
+
+	recommendUserIds, err := ruc.recommendUserIds(ctx, userId)
+	if nil != err {
+		return err
+	}
+
+	return ruc.userRecommendRepo.UpdateUserAreaAmount(ctx, areaAmount, recommendUserIds...)
+}
+
+// RebuildUserArea 按 running 投资位重新计算所有用户的自身业绩和团队业绩，需持有全局锁；返回业绩有变化的用户数
+func (ruc *RecordUseCase) RebuildUserArea(ctx context.Context) (int64, error) {
+	var changed int64
+	if err := ruc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		locations, err := ruc.locationRepo.GetRunningLocations(ctx)
+		if nil != err {
+			return err
+		}
+
+		selfAmounts := make(map[int64]int64)
+		for _, v := range locations {
+			selfAmounts[v.UserId] += v.AreaAmount()
+		}
+
+		changed, err = ruc.userRecommendRepo.RebuildUserAreaAmounts(ctx, selfAmounts)
+		return err
+	}); nil != err {
+		return 0, err
+	}
+
+	return changed, nil
+}
+
+// AdminDepositUnmatchedList 发送地址不是平台用户的充值
+func (ruc *RecordUseCase) AdminDepositUnmatchedList(ctx context.Context, req *v1.AdminDepositUnmatchedListRequest) (*v1.AdminDepositUnmatchedListReply, error) {
+	records, err := ruc.ethUserRecordRepo.GetEthUserRecordsByStatus(ctx, 0, EthUserRecordStatusUnmatched)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.AdminDepositUnmatchedListReply{
+		Deposits: make([]*v1.AdminDepositUnmatchedListReply_List, 0, len(records)),
+	}
+	for _, v := range records {
+		amount, _ := chainAmountToUsdt(v.Amount)
+		res.Deposits = append(res.Deposits, &v1.AdminDepositUnmatchedListReply_List{
+			Id:          v.ID,
+			Hash:        v.Hash,
+			From:        v.From,
+			Amount:      BalanceMoney(amount).Format(2),
+			BlockNumber: v.BlockNumber,
+		})
+	}
+
+	return res, nil
+}
+
+// AdminDepositResolve 为待处理的充值指定入账用户，之后按正常流程确认入账
+func (ruc *RecordUseCase) AdminDepositResolve(ctx context.Context, req *v1.AdminDepositResolveRequest) (*v1.AdminDepositResolveReply, error) {
+	user, err := ruc.userRepo.GetUserById(ctx, req.SendBody.UserId)
+	if nil != err {
+		return nil, err
+	}
+
+	resolved, err := ruc.ethUserRecordRepo.ResolveEthUserRecord(ctx, req.SendBody.Id, user.ID)
+	if nil != err {
+		return nil, err
+	}
+	if !resolved {
+		return nil, errors.New(500, "DEPOSIT_ERROR", "充值记录不存在或已处理")
+	}
+
+	return &v1.AdminDepositResolveReply{}, nil
+}
+
+// chainAmountToUsdt 链上18位精度转为系统的1e10精度，舍去多余位数
+func chainAmountToUsdt(amount string) (int64, error) {
+	value, ok := new(big.Int).SetString(amount, 10)
+	if !ok || 0 > value.Sign() {
+		return 0, fmt.Errorf("invalid amount")
+	}
+
+	usdt, err := MoneyFromUnits(value, MoneyScaleChain, MoneyScaleBalance)
+	if nil != err {
+		return 0, err
+	}
+
+	return usdt.Amount, nil
+}
+
 func (ruc *RecordUseCase) LockEthUserRecordHandle(ctx context.Context, ethUserRecord ...*EthUserRecord) (bool, error) {
 	var (
 		lock bool
@@ -0,0 +1,198 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"encoding/base64"
+	"github.com/go-kratos/kratos/v2/errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	rewardListDefaultLimit = 20
+	rewardListMaxLimit     = 100
+)
+
+// 用户能看到的奖励理由，system_ 开头的是系统账户的记录
+var (
+	userRewardReasons      = []string{"location", "location_daily_reward", "daily_balance_reward", "last_reward", "recommend", "recommend_vip", RewardReasonRecommendTeam, RewardReasonDailyRecommendArea, "fee"}
+	recommendRewardReasons = []string{"recommend", "recommend_vip", RewardReasonRecommendTeam, RewardReasonDailyRecommendArea}
+	feeRewardReasons       = []string{"fee"}
+)
+
+// RewardFilter 奖励列表的筛选条件，StartTime、EndTime 为零值时不限制
+type RewardFilter struct {
+	Reasons   []string
+	Type      string
+	StartTime time.Time // 包含
+	EndTime   time.Time // 不包含
+}
+
+// rewardListQuery 解析列表请求，返回筛选条件、游标位置和条数
+func rewardListQuery(allowReasons []string, reason string, startDate string, endDate string, cursor string, limit int64) (*RewardFilter, int64, int, error) {
+	filter := &RewardFilter{Reasons: allowReasons}
+	if "" != reason {
+		if !containsString(allowReasons, reason) {
+			return nil, 0, 0, errors.New(500, "REWARD_LIST_ERROR", "奖励类型错误")
+		}
+		filter.Reasons = []string{reason}
+	}
+
+	// 日期为北京时间，包含结束日期当天
+	if "" != startDate {
+		day, err := time.Parse("2006-01-02", startDate)
+		if nil != err {
+			return nil, 0, 0, errors.New(500, "REWARD_LIST_ERROR", "开始日期格式错误")
+		}
+		filter.StartTime = day.Add(-8 * time.Hour)
+	}
+	if "" != endDate {
+		day, err := time.Parse("2006-01-02", endDate)
+		if nil != err {
+			return nil, 0, 0, errors.New(500, "REWARD_LIST_ERROR", "结束日期格式错误")
+		}
+		filter.EndTime = day.Add(16 * time.Hour)
+	}
+
+	beforeId, err := decodeRewardCursor(cursor)
+	if nil != err {
+		return nil, 0, 0, err
+	}
+
+	size := rewardListDefaultLimit
+	if 0 < limit {
+		size = int(limit)
+	}
+	if rewardListMaxLimit < size {
+		size = rewardListMaxLimit
+	}
+
+	return filter, beforeId, size, nil
+}
+
+// encodeRewardCursor 游标对前端不透明，内容为本页最后一条记录的 id
+func encodeRewardCursor(id int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte("r" + strconv.FormatInt(id, 10)))
+}
+
+func decodeRewardCursor(cursor string) (int64, error) {
+	if "" == cursor {
+		return 0, nil
+	}
+
+	decodeBytes, err := base64.RawURLEncoding.DecodeString(cursor)
+	if nil != err || !strings.HasPrefix(string(decodeBytes), "r") {
+		return 0, errors.New(500, "REWARD_LIST_ERROR", "游标错误")
+	}
+	id, err := strconv.ParseInt(strings.TrimPrefix(string(decodeBytes), "r"), 10, 64)
+	if nil != err || 0 >= id {
+		return 0, errors.New(500, "REWARD_LIST_ERROR", "游标错误")
+	}
+
+	return id, nil
+}
+
+// getUserRewardPage 按 id 倒序取一页，多取一条判断是否还有下一页
+func (uuc *UserUseCase) getUserRewardPage(ctx context.Context, userId int64, filter *RewardFilter, beforeId int64, limit int) ([]*Reward, string, error) {
+	rewards, err := uuc.ubRepo.GetUserRewardsByCursor(ctx, userId, filter, beforeId, limit+1)
+	if nil != err {
+		return nil, "", err
+	}
+
+	var nextCursor string
+	if len(rewards) > limit {
+		rewards = rewards[:limit]
+		nextCursor = encodeRewardCursor(rewards[limit-1].ID)
+	}
+
+	return rewards, nextCursor, nil
+}
+
+// RewardList 用户的全部奖励，可按理由、奖励类型和日期筛选
+func (uuc *UserUseCase) RewardList(ctx context.Context, req *v1.RewardListRequest, user *User) (*v1.RewardListReply, error) {
+	filter, beforeId, limit, err := rewardListQuery(userRewardReasons, req.Reason, req.StartDate, req.EndDate, req.Cursor, req.Limit)
+	if nil != err {
+		return nil, err
+	}
+	filter.Type = req.Type
+
+	rewards, nextCursor, err := uuc.getUserRewardPage(ctx, user.ID, filter, beforeId, limit)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.RewardListReply{
+		Rewards:    make([]*v1.RewardListReply_List, 0, len(rewards)),
+		NextCursor: nextCursor,
+	}
+	for _, vReward := range rewards {
+		res.Rewards = append(res.Rewards, &v1.RewardListReply_List{
+			Id:             vReward.ID,
+			CreatedAt:      vReward.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+			Amount:         BalanceMoney(vReward.Amount).Format(2),
+			LocationStatus: vReward.LocationType,
+			Type:           vReward.Type,
+			Reason:         vReward.Reason,
+		})
+	}
+
+	return res, nil
+}
+
+// RecommendRewardList 直推、vip 推荐、团队奖励和区域分红
+func (uuc *UserUseCase) RecommendRewardList(ctx context.Context, req *v1.RecommendRewardListRequest, user *User) (*v1.RecommendRewardListReply, error) {
+	filter, beforeId, limit, err := rewardListQuery(recommendRewardReasons, req.Reason, req.StartDate, req.EndDate, req.Cursor, req.Limit)
+	if nil != err {
+		return nil, err
+	}
+
+	rewards, nextCursor, err := uuc.getUserRewardPage(ctx, user.ID, filter, beforeId, limit)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.RecommendRewardListReply{
+		Rewards:    make([]*v1.RecommendRewardListReply_List, 0, len(rewards)),
+		NextCursor: nextCursor,
+	}
+	for _, vReward := range rewards {
+		res.Rewards = append(res.Rewards, &v1.RecommendRewardListReply_List{
+			Id:        vReward.ID,
+			CreatedAt: vReward.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+			Amount:    BalanceMoney(vReward.Amount).Format(2),
+			Type:      vReward.Type,
+			Reason:    vReward.Reason,
+		})
+	}
+
+	return res, nil
+}
+
+// FeeRewardList 手续费分红
+func (uuc *UserUseCase) FeeRewardList(ctx context.Context, req *v1.FeeRewardListRequest, user *User) (*v1.FeeRewardListReply, error) {
+	filter, beforeId, limit, err := rewardListQuery(feeRewardReasons, "", req.StartDate, req.EndDate, req.Cursor, req.Limit)
+	if nil != err {
+		return nil, err
+	}
+
+	rewards, nextCursor, err := uuc.getUserRewardPage(ctx, user.ID, filter, beforeId, limit)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.FeeRewardListReply{
+		Rewards:    make([]*v1.FeeRewardListReply_List, 0, len(rewards)),
+		NextCursor: nextCursor,
+	}
+	for _, vReward := range rewards {
+		res.Rewards = append(res.Rewards, &v1.FeeRewardListReply_List{
+			Id:        vReward.ID,
+			CreatedAt: vReward.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+			Amount:    BalanceMoney(vReward.Amount).Format(2),
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,323 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"time"
+)
+
+// 结算类型
+const (
+	SettlementTypeLocationDaily = "location_daily" // 投资位每日收益
+)
+
+// 结算状态
+const (
+	SettlementStatusRunning = "running"
+	SettlementStatusDone    = "done"
+)
+
+const settlementBatchSize = 100
+
+// RewardSettlement 一次按日结算，每个类型每天一条；LastUserId 为已结算完成的最后一个用户，中断后从这里继续
+type RewardSettlement struct {
+	ID         int64
+	Type       string
+	Date       string // 北京时间日期 2006-01-02
+	Status     string
+	Rate       int64 // 开始结算时的配置，续跑时沿用，万分比
+	LastUserId int64
+	Count      int64
+	Amount     int64
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type RewardSettlementRepo interface {
+	GetRewardSettlement(ctx context.Context, settlementType string, date string) (*RewardSettlement, error)
+	GetOrCreateRewardSettlement(ctx context.Context, s *RewardSettlement) (*RewardSettlement, error)
+	UpdateRewardSettlementProgress(ctx context.Context, id int64, lastUserId int64, count int64, amount int64) error
+	UpdateRewardSettlementStatus(ctx context.Context, id int64, status string) error
+}
+
+// SettlementResult 本次执行结算的结果
+type SettlementResult struct {
+	Date       string
+	Users      int64
+	Count      int64
+	Amount     int64
+	TeamAmount int64 // 本次发放的团队奖励
+	AreaAmount int64 // 本次发放的区域分红
+	Skipped    bool  // 当天已结算完成或未配置收益率
+}
+
+func (r *SettlementResult) add(o *SettlementResult) {
+	r.Users++
+	r.Count += o.Count
+	r.Amount += o.Amount
+	r.TeamAmount += o.TeamAmount
+	r.AreaAmount += o.AreaAmount
+}
+
+// SettlementConfig 结算使用的配置
+type SettlementConfig struct {
+	Rate int64 // 投资位每日收益率，万分比
+	Rule *TeamRewardRule
+}
+
+// settlementBook 结算时读取投资位和发放奖励，正式结算写库，试算和重放只在内存中记录
+type settlementBook interface {
+	locations(ctx context.Context, userId int64, createdBefore time.Time) ([]*LocationNew, error)
+	updateLocation(ctx context.Context, location *LocationNew, amount int64, status string, stopCoin int64) error
+	reward(ctx context.Context, userId int64, reason string, amount int64, locationId int64) error
+}
+
+// liveSettlementBook 正式结算，需在事务中调用
+type liveSettlementBook struct {
+	ruc *RecordUseCase
+	now time.Time
+}
+
+func (b *liveSettlementBook) locations(ctx context.Context, userId int64, createdBefore time.Time) ([]*LocationNew, error) {
+	return b.ruc.locationRepo.GetRunningLocationsByUserIdForUpdate(ctx, userId, createdBefore)
+}
+
+// updateLocation 出局的投资位同时扣回区业绩
+func (b *liveSettlementBook) updateLocation(ctx context.Context, location *LocationNew, amount int64, status string, stopCoin int64) error {
+	if err := b.ruc.locationRepo.UpdateLocationNewCurrent(ctx, location.ID, amount, status, b.now, stopCoin); nil != err {
+		return err
+	}
+	if "stop" == status {
+		return b.ruc.updateUserAreaAmount(ctx, location.UserId, -location.AreaAmount())
+	}
+	return nil
+}
+
+func (b *liveSettlementBook) reward(ctx context.Context, userId int64, reason string, amount int64, locationId int64) error {
+	var err error
+	if RewardReasonLocationDaily == reason {
+		_, err = b.ruc.userBalanceRepo.LocationDailyReward(ctx, userId, amount, locationId)
+	} else {
+		_, err = b.ruc.userBalanceRepo.TeamReward(ctx, userId, amount, reason, locationId)
+	}
+	return err
+}
+
+// businessDay 北京时间所在日期及当天开始的 UTC 时间
+func businessDay(now time.Time) (string, time.Time) {
+	local := now.UTC().Add(8 * time.Hour)
+	dayStart := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+	return dayStart.Format("2006-01-02"), dayStart.Add(-8 * time.Hour)
+}
+
+// settlementConfigKeys 结算使用的配置项
+func settlementConfigKeys() []string {
+	return append([]string{"location_daily_rate"}, teamRewardConfigKeys()...)
+}
+
+// parseSettlementConfig .
+func parseSettlementConfig(configs []*Config) (*SettlementConfig, error) {
+	res := &SettlementConfig{}
+	for _, vConfig := range configs {
+		if "location_daily_rate" == vConfig.KeyName {
+			value, err := ParseMoney(vConfig.Value, MoneyScalePercent)
+			if nil != err {
+				return nil, errors.New(500, "SETTLEMENT_ERROR", "每日收益率配置错误")
+			}
+			res.Rate = value.Amount
+		}
+	}
+
+	rule, err := parseTeamRewardRule(configs)
+	if nil != err {
+		return nil, err
+	}
+	res.Rule = rule
+
+	return res, nil
+}
+
+// getSettlementConfigs 当前的结算配置，overrides 中的配置项覆盖当前值
+func (ruc *RecordUseCase) getSettlementConfigs(ctx context.Context, overrides []*Config) ([]*Config, error) {
+	keys := settlementConfigKeys()
+	configs, err := ruc.configRepo.GetConfigByKeys(ctx, keys...)
+	if nil != err {
+		return nil, err
+	}
+
+	values := make(map[string]string, len(keys))
+	for _, vConfig := range configs {
+		values[vConfig.KeyName] = vConfig.Value
+	}
+	for _, vOverride := range overrides {
+		if !containsString(keys, vOverride.KeyName) {
+			return nil, errors.New(500, "SETTLEMENT_ERROR", "配置项"+vOverride.KeyName+"不属于结算")
+		}
+		values[vOverride.KeyName] = vOverride.Value
+	}
+
+	res := make([]*Config, 0, len(values))
+	for _, key := range keys {
+		if value, ok := values[key]; ok {
+			res = append(res, &Config{KeyName: key, Value: value})
+		}
+	}
+
+	return res, nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// LocationDailySettled 当天的投资位结算已完成时返回跳过的结果，未完成时返回 nil；不需要持有全局锁
+func (ruc *RecordUseCase) LocationDailySettled(ctx context.Context, now time.Time) (*SettlementResult, error) {
+	date, _ := businessDay(now)
+	settlement, err := ruc.settlementRepo.GetRewardSettlement(ctx, SettlementTypeLocationDaily, date)
+	if nil != err {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if SettlementStatusDone != settlement.Status {
+		return nil, nil
+	}
+
+	return &SettlementResult{Date: date, Skipped: true}, nil
+}
+
+// LocationDailySettle 按北京时间每天结算一次投资位收益，需持有全局锁；
+// 当天之前开启的 running 投资位按本金乘以收益率发放，累加 Current，达到 CurrentMax 时出局，超出部分记入 StopCoin；
+// 每个用户的投资位和结算进度在同一事务中提交，中断后再次执行会从下一个用户继续
+func (ruc *RecordUseCase) LocationDailySettle(ctx context.Context, now time.Time) (*SettlementResult, error) {
+	date, dayStart := businessDay(now)
+	res := &SettlementResult{Date: date}
+
+	configs, err := ruc.getSettlementConfigs(ctx, nil)
+	if nil != err {
+		return nil, err
+	}
+	cfg, err := parseSettlementConfig(configs)
+	if nil != err {
+		return nil, err
+	}
+	if 0 >= cfg.Rate {
+		res.Skipped = true // 未配置收益率时不结算，也不占用当天的结算
+		return res, nil
+	}
+
+	settlement, err := ruc.settlementRepo.GetOrCreateRewardSettlement(ctx, &RewardSettlement{
+		Type:   SettlementTypeLocationDaily,
+		Date:   date,
+		Status: SettlementStatusRunning,
+		Rate:   cfg.Rate,
+	})
+	if nil != err {
+		return nil, err
+	}
+	if SettlementStatusDone == settlement.Status {
+		res.Skipped = true
+		return res, nil
+	}
+	if settlement.Rate != cfg.Rate {
+		ruc.log.Warnf("location daily settle %s: resume with rate %d, config is %d", date, settlement.Rate, cfg.Rate)
+		cfg.Rate = settlement.Rate
+	}
+
+	book := &liveSettlementBook{ruc: ruc, now: now}
+	levels := make(map[int64]int64) // vip 等级在一次结算中只计算一次
+
+	lastUserId := settlement.LastUserId
+	for {
+		userIds, err := ruc.locationRepo.GetRunningLocationUserIds(ctx, lastUserId, dayStart, settlementBatchSize)
+		if nil != err {
+			return nil, err
+		}
+
+		for _, userId := range userIds {
+			var userRes *SettlementResult
+			if err = ruc.tx.ExecTx(ctx, func(ctx context.Context) error {
+				userRes, err = ruc.settleUserLocations(ctx, book, cfg, levels, userId, dayStart, now)
+				if nil != err {
+					return err
+				}
+
+				return ruc.settlementRepo.UpdateRewardSettlementProgress(ctx, settlement.ID, userId, userRes.Count, userRes.Amount)
+			}); nil != err {
+				return nil, err
+			}
+
+			lastUserId = userId
+			res.add(userRes)
+		}
+
+		if len(userIds) < settlementBatchSize {
+			break
+		}
+	}
+
+	if err = ruc.settlementRepo.UpdateRewardSettlementStatus(ctx, settlement.ID, SettlementStatusDone); nil != err {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// settleUserLocations 结算一个用户的投资位，并沿推荐链路发放团队奖励和区域分红
+func (ruc *RecordUseCase) settleUserLocations(ctx context.Context, book settlementBook, cfg *SettlementConfig, levels map[int64]int64, userId int64, dayStart time.Time, now time.Time) (*SettlementResult, error) {
+	locations, err := book.locations(ctx, userId, dayStart)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &SettlementResult{}
+	for _, v := range locations {
+		reward, err := v.Amount().MulDiv(cfg.Rate, 10000)
+		if nil != err {
+			return nil, err
+		}
+
+		amount := reward.Amount
+		status := "running"
+		var stopCoin int64
+		if v.Current+amount >= v.CurrentMax {
+			amount = v.CurrentMax - v.Current
+			if 0 > amount {
+				amount = 0
+			}
+			stopCoin = reward.Amount - amount
+			status = "stop"
+		}
+
+		if 0 < amount {
+			if err = book.reward(ctx, userId, RewardReasonLocationDaily, amount, v.ID); nil != err {
+				return nil, err
+			}
+		}
+		if 0 < amount || "stop" == status {
+			if err = book.updateLocation(ctx, v, amount, status, stopCoin); nil != err {
+				return nil, err
+			}
+		}
+
+		if 0 < amount {
+			teamAmount, areaAmount, err := ruc.teamReward(ctx, book, cfg.Rule, levels, userId, v.ID, amount, now)
+			if nil != err {
+				return nil, err
+			}
+			res.TeamAmount += teamAmount
+			res.AreaAmount += areaAmount
+		}
+
+		res.Count++
+		res.Amount += amount
+	}
+
+	return res, nil
+}
@@ -0,0 +1,458 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"github.com/go-kratos/kratos/v2/errors"
+	"sort"
+	"strings"
+	"time"
+)
+
+// SettlementReward 结算会写入的一条 reward
+type SettlementReward struct {
+	UserId     int64
+	Reason     string
+	Amount     int64
+	LocationId int64 // 产生收益的投资位
+}
+
+// SettlementRewardTotal 按用户和理由汇总
+type SettlementRewardTotal struct {
+	UserId int64
+	Reason string
+	Amount int64
+}
+
+// SettlementRewardDiff 两次结算在同一用户同一理由上的差异
+type SettlementRewardDiff struct {
+	UserId        int64
+	Reason        string
+	Amount        int64
+	CompareAmount int64
+}
+
+// memorySettlementBook 试算和重放，投资位的变化只保存在内存中
+type memorySettlementBook struct {
+	userLocations map[int64][]*LocationNew // 按用户，id 升序
+	now           time.Time
+	rewards       []*SettlementReward
+}
+
+func newMemorySettlementBook(locations []*LocationNew, now time.Time) *memorySettlementBook {
+	b := &memorySettlementBook{
+		userLocations: make(map[int64][]*LocationNew),
+		now:           now,
+	}
+	sort.Slice(locations, func(i, j int) bool {
+		return locations[i].ID < locations[j].ID
+	})
+	for _, v := range locations {
+		b.userLocations[v.UserId] = append(b.userLocations[v.UserId], v)
+	}
+	return b
+}
+
+func (b *memorySettlementBook) locations(ctx context.Context, userId int64, createdBefore time.Time) ([]*LocationNew, error) {
+	res := make([]*LocationNew, 0)
+	for _, v := range b.userLocations[userId] {
+		if "running" == v.Status && v.CreatedAt.Before(createdBefore) {
+			res = append(res, v)
+		}
+	}
+	return res, nil
+}
+
+func (b *memorySettlementBook) updateLocation(ctx context.Context, location *LocationNew, amount int64, status string, stopCoin int64) error {
+	location.Current += amount
+	if "stop" == status {
+		location.Status = "stop"
+		location.StopDate = b.now
+		location.StopCoin += stopCoin
+	}
+	return nil
+}
+
+func (b *memorySettlementBook) reward(ctx context.Context, userId int64, reason string, amount int64, locationId int64) error {
+	b.rewards = append(b.rewards, &SettlementReward{UserId: userId, Reason: reason, Amount: amount, LocationId: locationId})
+	return nil
+}
+
+// settlementRewardReasons 结算发放的奖励理由
+func settlementRewardReasons() []string {
+	return []string{RewardReasonLocationDaily, RewardReasonRecommendTeam, RewardReasonDailyRecommendArea}
+}
+
+// SettlementRewardTotals 按用户和理由汇总，按用户id、理由排序
+func SettlementRewardTotals(rewards []*SettlementReward) []*SettlementRewardTotal {
+	totals := make(map[int64]map[string]int64)
+	for _, v := range rewards {
+		if _, ok := totals[v.UserId]; !ok {
+			totals[v.UserId] = make(map[string]int64)
+		}
+		totals[v.UserId][v.Reason] += v.Amount
+	}
+
+	res := make([]*SettlementRewardTotal, 0)
+	for userId, reasons := range totals {
+		for reason, amount := range reasons {
+			res = append(res, &SettlementRewardTotal{UserId: userId, Reason: reason, Amount: amount})
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].UserId != res[j].UserId {
+			return res[i].UserId < res[j].UserId
+		}
+		return res[i].Reason < res[j].Reason
+	})
+	return res
+}
+
+// DiffSettlementRewards 两次结算的差异，只返回金额不同的用户和理由
+func DiffSettlementRewards(rewards []*SettlementReward, compareRewards []*SettlementReward) []*SettlementRewardDiff {
+	type key struct {
+		userId int64
+		reason string
+	}
+	diffs := make(map[key]*SettlementRewardDiff)
+	for _, v := range SettlementRewardTotals(rewards) {
+		diffs[key{v.UserId, v.Reason}] = &SettlementRewardDiff{UserId: v.UserId, Reason: v.Reason, Amount: v.Amount}
+	}
+	for _, v := range SettlementRewardTotals(compareRewards) {
+		k := key{v.UserId, v.Reason}
+		if _, ok := diffs[k]; !ok {
+			diffs[k] = &SettlementRewardDiff{UserId: v.UserId, Reason: v.Reason}
+		}
+		diffs[k].CompareAmount = v.Amount
+	}
+
+	res := make([]*SettlementRewardDiff, 0)
+	for _, v := range diffs {
+		if v.Amount != v.CompareAmount {
+			res = append(res, v)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].UserId != res[j].UserId {
+			return res[i].UserId < res[j].UserId
+		}
+		return res[i].Reason < res[j].Reason
+	})
+	return res
+}
+
+// settlementRewardsSummary 按写入的奖励汇总，重放时实际发放的一方只有奖励记录
+func settlementRewardsSummary(rewards []*SettlementReward) *SettlementResult {
+	res := &SettlementResult{}
+	users := make(map[int64]bool)
+	for _, v := range rewards {
+		switch v.Reason {
+		case RewardReasonLocationDaily:
+			res.Count++
+			res.Amount += v.Amount
+			users[v.UserId] = true
+		case RewardReasonRecommendTeam:
+			res.TeamAmount += v.Amount
+		case RewardReasonDailyRecommendArea:
+			res.AreaAmount += v.Amount
+		}
+	}
+	res.Users = int64(len(users))
+	return res
+}
+
+// simulateSettlement 在内存中按正式结算的顺序结算一天，返回汇总
+func (ruc *RecordUseCase) simulateSettlement(ctx context.Context, book *memorySettlementBook, cfg *SettlementConfig, dayStart time.Time) (*SettlementResult, error) {
+	res := &SettlementResult{}
+	if 0 >= cfg.Rate {
+		res.Skipped = true
+		return res, nil
+	}
+
+	userIds := make([]int64, 0, len(book.userLocations))
+	for userId := range book.userLocations {
+		userIds = append(userIds, userId)
+	}
+	sort.Slice(userIds, func(i, j int) bool {
+		return userIds[i] < userIds[j]
+	})
+
+	levels := make(map[int64]int64)
+	for _, userId := range userIds {
+		locations, _ := book.locations(ctx, userId, dayStart)
+		if 0 == len(locations) {
+			continue
+		}
+
+		userRes, err := ruc.settleUserLocations(ctx, book, cfg, levels, userId, dayStart, book.now)
+		if nil != err {
+			return nil, err
+		}
+		res.add(userRes)
+	}
+
+	return res, nil
+}
+
+// vipLevelRuleOverridden 是否修改了 vip 等级的门槛或直推人数
+func vipLevelRuleOverridden(overrides []*Config) bool {
+	for _, v := range overrides {
+		if strings.HasPrefix(v.KeyName, "recommend_area_") && !strings.HasPrefix(v.KeyName, "recommend_area_rate_") {
+			return true
+		}
+	}
+	return false
+}
+
+// dryRunSettlement 以当前的投资位试算 now 所在的一天，configs 为使用的结算配置，evaluateLevels 时按规则现算 vip 等级
+func (ruc *RecordUseCase) dryRunSettlement(ctx context.Context, configs []*Config, evaluateLevels bool, now time.Time) (*SettlementResult, []*SettlementReward, error) {
+	cfg, err := parseSettlementConfig(configs)
+	if nil != err {
+		return nil, nil, err
+	}
+	cfg.Rule.EvaluateLevels = evaluateLevels
+
+	date, dayStart := businessDay(now)
+	locations, err := ruc.locationRepo.GetSettlementLocations(ctx, now, now)
+	if nil != err {
+		return nil, nil, err
+	}
+
+	book := newMemorySettlementBook(locations, now)
+	res, err := ruc.simulateSettlement(ctx, book, cfg, dayStart)
+	if nil != err {
+		return nil, nil, err
+	}
+	res.Date = date
+
+	return res, book.rewards, nil
+}
+
+// rewindLocations 把投资位倒推回 since 时的状态：since 之后出局的恢复为 running，扣回 since 之后发放的收益；
+// 团队奖励和区域分红没有记录累加到了哪个投资位，按开启顺序的反向扣回
+func rewindLocations(locations []*LocationNew, rewards []*Reward, since time.Time) {
+	byId := make(map[int64]*LocationNew, len(locations))
+	byUser := make(map[int64][]*LocationNew)
+	for _, v := range locations {
+		byId[v.ID] = v
+		byUser[v.UserId] = append(byUser[v.UserId], v)
+		if "stop" == v.Status && !v.StopDate.Before(since) {
+			v.Status = "running"
+			v.StopCoin = 0
+		}
+	}
+
+	teamAmounts := make(map[int64]int64)
+	for _, v := range rewards {
+		if v.CreatedAt.Before(since) {
+			continue
+		}
+		if RewardReasonLocationDaily == v.Reason {
+			if location, ok := byId[v.ReasonLocationId]; ok {
+				location.Current -= v.Amount
+			}
+			continue
+		}
+		teamAmounts[v.UserId] += v.Amount
+	}
+
+	for userId, amount := range teamAmounts {
+		userLocations := byUser[userId]
+		sort.Slice(userLocations, func(i, j int) bool {
+			return userLocations[i].ID > userLocations[j].ID
+		})
+		for _, v := range userLocations {
+			if 0 >= amount {
+				break
+			}
+			sub := v.Current
+			if amount < sub {
+				sub = amount
+			}
+			v.Current -= sub
+			amount -= sub
+		}
+	}
+
+	for _, v := range locations {
+		if 0 > v.Current {
+			v.Current = 0
+		}
+	}
+}
+
+// settlementConfigsFromRequest .
+func settlementConfigsFromRequest(configs []*v1.SettlementConfig) []*Config {
+	res := make([]*Config, 0, len(configs))
+	for _, v := range configs {
+		res = append(res, &Config{KeyName: v.Key, Value: v.Value})
+	}
+	return res
+}
+
+func settlementSummaryReply(res *SettlementResult) *v1.SettlementSummary {
+	return &v1.SettlementSummary{
+		Users:      res.Users,
+		Count:      res.Count,
+		Amount:     ledgerAmount(res.Amount),
+		TeamAmount: ledgerAmount(res.TeamAmount),
+		AreaAmount: ledgerAmount(res.AreaAmount),
+	}
+}
+
+func settlementDiffsReply(diffs []*SettlementRewardDiff) []*v1.SettlementRewardDiff {
+	res := make([]*v1.SettlementRewardDiff, 0, len(diffs))
+	for _, v := range diffs {
+		res = append(res, &v1.SettlementRewardDiff{
+			UserId:        v.UserId,
+			Reason:        v.Reason,
+			Amount:        ledgerAmount(v.Amount),
+			CompareAmount: ledgerAmount(v.CompareAmount),
+			Diff:          ledgerAmount(v.CompareAmount - v.Amount),
+		})
+	}
+	return res
+}
+
+// AdminSettlementDryRun 以当前的投资位试算今天的结算，不写库；configs 覆盖当前配置，
+// compare 时再按 compare_configs 试算一次，返回两组配置和结算结果的差异
+func (ruc *RecordUseCase) AdminSettlementDryRun(ctx context.Context, req *v1.AdminSettlementDryRunRequest) (*v1.AdminSettlementDryRunReply, error) {
+	now := time.Now().UTC()
+
+	overrides := settlementConfigsFromRequest(req.SendBody.Configs)
+	configs, err := ruc.getSettlementConfigs(ctx, overrides)
+	if nil != err {
+		return nil, err
+	}
+	res, rewards, err := ruc.dryRunSettlement(ctx, configs, vipLevelRuleOverridden(overrides), now)
+	if nil != err {
+		return nil, err
+	}
+
+	reply := &v1.AdminSettlementDryRunReply{
+		Date:    res.Date,
+		Summary: settlementSummaryReply(res),
+		Rewards: make([]*v1.SettlementRewardTotal, 0),
+	}
+	for _, v := range SettlementRewardTotals(rewards) {
+		reply.Rewards = append(reply.Rewards, &v1.SettlementRewardTotal{
+			UserId: v.UserId,
+			Reason: v.Reason,
+			Amount: ledgerAmount(v.Amount),
+		})
+	}
+
+	if !req.SendBody.Compare {
+		return reply, nil
+	}
+
+	compareOverrides := settlementConfigsFromRequest(req.SendBody.CompareConfigs)
+	compareConfigs, err := ruc.getSettlementConfigs(ctx, compareOverrides)
+	if nil != err {
+		return nil, err
+	}
+	compareRes, compareRewards, err := ruc.dryRunSettlement(ctx, compareConfigs, vipLevelRuleOverridden(compareOverrides), now)
+	if nil != err {
+		return nil, err
+	}
+
+	reply.CompareSummary = settlementSummaryReply(compareRes)
+	reply.Diffs = settlementDiffsReply(DiffSettlementRewards(rewards, compareRewards))
+	compareValues := make(map[string]string, len(compareConfigs))
+	for _, v := range compareConfigs {
+		compareValues[v.KeyName] = v.Value
+	}
+	values := make(map[string]string, len(configs))
+	for _, v := range configs {
+		values[v.KeyName] = v.Value
+	}
+	for _, key := range settlementConfigKeys() {
+		if values[key] != compareValues[key] {
+			reply.ConfigDiffs = append(reply.ConfigDiffs, &v1.SettlementConfigDiff{
+				Key:          key,
+				Value:        values[key],
+				CompareValue: compareValues[key],
+			})
+		}
+	}
+
+	return reply, nil
+}
+
+// AdminSettlementReplay 按投资位在某天开始时的状态重新结算这一天，与当天实际发放的奖励比较；
+// 投资位状态由之后发放的奖励倒推，vip 等级使用当前评定的等级，修改了等级规则时按当前业绩计算；
+// 未指定收益率时沿用当天结算记录的收益率
+func (ruc *RecordUseCase) AdminSettlementReplay(ctx context.Context, req *v1.AdminSettlementReplayRequest) (*v1.AdminSettlementReplayReply, error) {
+	day, err := time.Parse("2006-01-02", req.SendBody.Date)
+	if nil != err {
+		return nil, errors.New(500, "SETTLEMENT_ERROR", "日期格式错误")
+	}
+	dayStart := day.Add(-8 * time.Hour)
+	if !dayStart.Before(time.Now().UTC()) {
+		return nil, errors.New(500, "SETTLEMENT_ERROR", "只能重放已经开始的日期")
+	}
+
+	overrides := settlementConfigsFromRequest(req.SendBody.Configs)
+	configs, err := ruc.getSettlementConfigs(ctx, overrides)
+	if nil != err {
+		return nil, err
+	}
+	cfg, err := parseSettlementConfig(configs)
+	if nil != err {
+		return nil, err
+	}
+	cfg.Rule.EvaluateLevels = vipLevelRuleOverridden(overrides)
+
+	// 结算在当天开始后执行，投资位以结算开始的时间为准
+	now := dayStart
+	settlement, err := ruc.settlementRepo.GetRewardSettlement(ctx, SettlementTypeLocationDaily, req.SendBody.Date)
+	if nil != err && !errors.IsNotFound(err) {
+		return nil, err
+	}
+	if nil != settlement {
+		now = settlement.CreatedAt
+		overridden := false
+		for _, v := range overrides {
+			if "location_daily_rate" == v.KeyName {
+				overridden = true
+			}
+		}
+		if !overridden {
+			cfg.Rate = settlement.Rate
+		}
+	}
+
+	locations, err := ruc.locationRepo.GetSettlementLocations(ctx, now, dayStart)
+	if nil != err {
+		return nil, err
+	}
+	rewards, err := ruc.userBalanceRepo.GetRewardsByReasonsSince(ctx, dayStart, settlementRewardReasons()...)
+	if nil != err {
+		return nil, err
+	}
+	rewindLocations(locations, rewards, dayStart)
+
+	book := newMemorySettlementBook(locations, now)
+	if _, err = ruc.simulateSettlement(ctx, book, cfg, dayStart); nil != err {
+		return nil, err
+	}
+
+	dayEnd := dayStart.Add(24 * time.Hour)
+	actualRewards := make([]*SettlementReward, 0)
+	for _, v := range rewards {
+		if v.CreatedAt.Before(dayStart) || !v.CreatedAt.Before(dayEnd) {
+			continue
+		}
+		actualRewards = append(actualRewards, &SettlementReward{UserId: v.UserId, Reason: v.Reason, Amount: v.Amount, LocationId: v.ReasonLocationId})
+	}
+
+	diffs := DiffSettlementRewards(book.rewards, actualRewards)
+	return &v1.AdminSettlementReplayReply{
+		Date:     req.SendBody.Date,
+		Rate:     NewMoney(cfg.Rate, MoneyScalePercent).String() + "%",
+		Expected: settlementSummaryReply(settlementRewardsSummary(book.rewards)),
+		Actual:   settlementSummaryReply(settlementRewardsSummary(actualRewards)),
+		Matched:  0 == len(diffs),
+		Diffs:    settlementDiffsReply(diffs),
+	}, nil
+}
@@ -0,0 +1,309 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"sort"
+	"testing"
+	"time"
+)
+
+// 2024-03-10 10:00 北京时间
+var testSettlementNow = time.Date(2024, 3, 10, 2, 0, 0, 0, time.UTC)
+
+// testSettlementLocation 本金 100 的投资位，出局额度为本金的 2.5 倍
+func testSettlementLocation(id int64, userId int64, current int64) *LocationNew {
+	return &LocationNew{
+		ID:         id,
+		UserId:     userId,
+		Status:     "running",
+		Current:    current,
+		CurrentMax: 250 * 1e10,
+		OutRate:    250,
+		CreatedAt:  testSettlementNow.Add(-48 * time.Hour),
+	}
+}
+
+type fakeSettlementConfigRepo struct {
+	ConfigRepo
+	rate string
+}
+
+func (f *fakeSettlementConfigRepo) GetConfigByKeys(ctx context.Context, keys ...string) ([]*Config, error) {
+	return []*Config{{KeyName: "location_daily_rate", Value: f.rate}}, nil
+}
+
+type fakeSettlementRecommendRepo struct {
+	UserRecommendRepo
+	selfAmounts map[int64]int64
+}
+
+func (f *fakeSettlementRecommendRepo) GetUserRecommendUplines(ctx context.Context, userId int64, maxDepth int64) ([]*UserRecommendClosure, error) {
+	return nil, nil
+}
+
+func (f *fakeSettlementRecommendRepo) UpdateUserAreaSelfAmount(ctx context.Context, userId int64, amount int64) error {
+	f.selfAmounts[userId] += amount
+	return nil
+}
+
+func (f *fakeSettlementRecommendRepo) UpdateUserAreaAmount(ctx context.Context, amount int64, userIds ...int64) error {
+	return nil
+}
+
+// fakeSettlementLocationRepo 投资位保存在 memorySettlementBook 中
+type fakeSettlementLocationRepo struct {
+	LocationRepo
+	book *memorySettlementBook
+}
+
+func (f *fakeSettlementLocationRepo) GetRunningLocationUserIds(ctx context.Context, afterUserId int64, createdBefore time.Time, limit int) ([]int64, error) {
+	userIds := make([]int64, 0)
+	for userId := range f.book.userLocations {
+		locations, _ := f.book.locations(ctx, userId, createdBefore)
+		if userId > afterUserId && 0 < len(locations) {
+			userIds = append(userIds, userId)
+		}
+	}
+	sort.Slice(userIds, func(i, j int) bool {
+		return userIds[i] < userIds[j]
+	})
+	if len(userIds) > limit {
+		userIds = userIds[:limit]
+	}
+	return userIds, nil
+}
+
+func (f *fakeSettlementLocationRepo) GetRunningLocationsByUserIdForUpdate(ctx context.Context, userId int64, createdBefore time.Time) ([]*LocationNew, error) {
+	return f.book.locations(ctx, userId, createdBefore)
+}
+
+func (f *fakeSettlementLocationRepo) UpdateLocationNewCurrent(ctx context.Context, id int64, amount int64, status string, stopDate time.Time, stopCoin int64) error {
+	for _, locations := range f.book.userLocations {
+		for _, v := range locations {
+			if v.ID == id {
+				return f.book.updateLocation(ctx, v, amount, status, stopCoin)
+			}
+		}
+	}
+	return errors.New(500, "UPDATE_LOCATION_ERROR", "占位信息修改失败")
+}
+
+// fakeSettlementBalanceRepo 奖励记入 memorySettlementBook，failUserId 的奖励返回错误以模拟结算中断
+type fakeSettlementBalanceRepo struct {
+	UserBalanceRepo
+	book       *memorySettlementBook
+	failUserId int64
+}
+
+func (f *fakeSettlementBalanceRepo) LocationDailyReward(ctx context.Context, userId int64, amount int64, locationId int64) (int64, error) {
+	if userId == f.failUserId {
+		return 0, errors.New(500, "REWARD_ERROR", "reward error")
+	}
+	return 0, f.book.reward(ctx, userId, RewardReasonLocationDaily, amount, locationId)
+}
+
+type fakeRewardSettlementRepo struct {
+	settlements []*RewardSettlement
+}
+
+func (f *fakeRewardSettlementRepo) GetRewardSettlement(ctx context.Context, settlementType string, date string) (*RewardSettlement, error) {
+	for _, v := range f.settlements {
+		if v.Type == settlementType && v.Date == date {
+			return v, nil
+		}
+	}
+	return nil, errors.NotFound("REWARD_SETTLEMENT_NOT_FOUND", "reward settlement not found")
+}
+
+func (f *fakeRewardSettlementRepo) GetOrCreateRewardSettlement(ctx context.Context, s *RewardSettlement) (*RewardSettlement, error) {
+	if settlement, err := f.GetRewardSettlement(ctx, s.Type, s.Date); nil == err {
+		return settlement, nil
+	}
+	tmp := *s
+	tmp.ID = int64(len(f.settlements) + 1)
+	f.settlements = append(f.settlements, &tmp)
+	return &tmp, nil
+}
+
+func (f *fakeRewardSettlementRepo) UpdateRewardSettlementProgress(ctx context.Context, id int64, lastUserId int64, count int64, amount int64) error {
+	for _, v := range f.settlements {
+		if v.ID == id {
+			v.LastUserId = lastUserId
+			v.Count += count
+			v.Amount += amount
+		}
+	}
+	return nil
+}
+
+func (f *fakeRewardSettlementRepo) UpdateRewardSettlementStatus(ctx context.Context, id int64, status string) error {
+	for _, v := range f.settlements {
+		if v.ID == id {
+			v.Status = status
+		}
+	}
+	return nil
+}
+
+type fakeSettlementTransaction struct{}
+
+func (f *fakeSettlementTransaction) ExecTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// settlementTest 以内存中的投资位执行正式结算
+type settlementTest struct {
+	book        *memorySettlementBook
+	configs     *fakeSettlementConfigRepo
+	recommends  *fakeSettlementRecommendRepo
+	balances    *fakeSettlementBalanceRepo
+	settlements *fakeRewardSettlementRepo
+	ruc         *RecordUseCase
+}
+
+func newSettlementTest(rate string, locations ...*LocationNew) *settlementTest {
+	book := newMemorySettlementBook(locations, testSettlementNow)
+	s := &settlementTest{
+		book:        book,
+		configs:     &fakeSettlementConfigRepo{rate: rate},
+		recommends:  &fakeSettlementRecommendRepo{selfAmounts: make(map[int64]int64)},
+		balances:    &fakeSettlementBalanceRepo{book: book},
+		settlements: &fakeRewardSettlementRepo{},
+	}
+	s.ruc = NewRecordUseCase(nil, nil, nil, nil,
+		&fakeSettlementLocationRepo{book: book},
+		s.balances,
+		s.recommends,
+		nil,
+		s.configs,
+		nil,
+		s.settlements,
+		&fakeSettlementTransaction{},
+		log.DefaultLogger,
+	)
+	return s
+}
+
+func (s *settlementTest) rewardTotals() map[int64]int64 {
+	res := make(map[int64]int64)
+	for _, v := range s.book.rewards {
+		res[v.UserId] += v.Amount
+	}
+	return res
+}
+
+func TestLocationDailySettleCap(t *testing.T) {
+	// 收益率 1%，本金 100 每天收益 1
+	running := testSettlementLocation(1, 1, 100*1e10)
+	nearMax := testSettlementLocation(2, 2, 249.5*1e10)
+	atMax := testSettlementLocation(3, 3, 250*1e10)
+	today := testSettlementLocation(4, 4, 0)
+	today.CreatedAt = testSettlementNow.Add(-time.Hour)
+	s := newSettlementTest("1", running, nearMax, atMax, today)
+
+	res, err := s.ruc.LocationDailySettle(context.Background(), testSettlementNow)
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	if 101*1e10 != running.Current || "running" != running.Status || 0 != running.StopCoin {
+		t.Errorf("running location %+v", running)
+	}
+
+	// 达到出局额度时只发放剩余额度，超出部分记入 StopCoin
+	if nearMax.CurrentMax != nearMax.Current || "stop" != nearMax.Status {
+		t.Errorf("near max location current %d status %s", nearMax.Current, nearMax.Status)
+	}
+	if 0.5*1e10 != nearMax.StopCoin {
+		t.Errorf("near max location stop coin %d, want %d", nearMax.StopCoin, int64(0.5*1e10))
+	}
+	if -nearMax.AreaAmount() != s.recommends.selfAmounts[2] {
+		t.Errorf("near max location area amount %d, want %d", s.recommends.selfAmounts[2], -nearMax.AreaAmount())
+	}
+
+	// 已达到额度的不再发放，直接出局
+	if atMax.CurrentMax != atMax.Current || "stop" != atMax.Status || 1e10 != atMax.StopCoin {
+		t.Errorf("at max location %+v", atMax)
+	}
+
+	// 当天开启的投资位不结算
+	if 0 != today.Current || "running" != today.Status {
+		t.Errorf("today location %+v", today)
+	}
+
+	totals := s.rewardTotals()
+	if 1e10 != totals[1] || 0.5*1e10 != totals[2] || 0 != totals[3] || 0 != totals[4] {
+		t.Errorf("rewards %v", totals)
+	}
+	if 3 != res.Users || 3 != res.Count || 1.5*1e10 != res.Amount {
+		t.Errorf("result users %d count %d amount %d", res.Users, res.Count, res.Amount)
+	}
+}
+
+func TestLocationDailySettleResume(t *testing.T) {
+	s := newSettlementTest("1",
+		testSettlementLocation(1, 1, 0),
+		testSettlementLocation(2, 2, 0),
+		testSettlementLocation(3, 2, 0),
+		testSettlementLocation(4, 3, 0),
+	)
+	ctx := context.Background()
+
+	// 第二个用户发放失败，第一个用户已提交
+	s.balances.failUserId = 2
+	if _, err := s.ruc.LocationDailySettle(ctx, testSettlementNow); nil == err {
+		t.Fatal("expected error")
+	}
+	settlement := s.settlements.settlements[0]
+	if SettlementStatusRunning != settlement.Status || 1 != settlement.LastUserId || 100 != settlement.Rate {
+		t.Fatalf("settlement after interruption %+v", settlement)
+	}
+	if totals := s.rewardTotals(); 1 != len(totals) || 1e10 != totals[1] {
+		t.Fatalf("rewards after interruption %v", totals)
+	}
+	if res, err := s.ruc.LocationDailySettled(ctx, testSettlementNow); nil != err || nil != res {
+		t.Errorf("settled before done: %+v %v", res, err)
+	}
+
+	// 续跑从下一个用户开始，沿用开始结算时的收益率
+	s.balances.failUserId = 0
+	s.configs.rate = "2"
+	res, err := s.ruc.LocationDailySettle(ctx, testSettlementNow)
+	if nil != err {
+		t.Fatal(err)
+	}
+	if 2 != res.Users || 3 != res.Count || 3e10 != res.Amount {
+		t.Errorf("resume result users %d count %d amount %d", res.Users, res.Count, res.Amount)
+	}
+	totals := s.rewardTotals()
+	if 1e10 != totals[1] || 2e10 != totals[2] || 1e10 != totals[3] {
+		t.Errorf("rewards after resume %v", totals)
+	}
+	if SettlementStatusDone != settlement.Status || 3 != settlement.LastUserId || 4 != settlement.Count || 4e10 != settlement.Amount {
+		t.Errorf("settlement after resume %+v", settlement)
+	}
+
+	// 当天已完成，不再发放
+	rewards := len(s.book.rewards)
+	res, err = s.ruc.LocationDailySettle(ctx, testSettlementNow)
+	if nil != err {
+		t.Fatal(err)
+	}
+	if !res.Skipped || rewards != len(s.book.rewards) {
+		t.Errorf("settled twice: skipped %v rewards %d, want %d", res.Skipped, len(s.book.rewards), rewards)
+	}
+	if res, err = s.ruc.LocationDailySettled(ctx, testSettlementNow); nil != err || nil == res || !res.Skipped {
+		t.Errorf("settled after done: %+v %v", res, err)
+	}
+
+	// 第二天重新结算
+	res, err = s.ruc.LocationDailySettle(ctx, testSettlementNow.Add(24*time.Hour))
+	if nil != err {
+		t.Fatal(err)
+	}
+	if res.Skipped || 4 != res.Count || 2 != len(s.settlements.settlements) {
+		t.Errorf("next day result %+v settlements %d", res, len(s.settlements.settlements))
+	}
+}
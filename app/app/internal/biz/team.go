@@ -0,0 +1,259 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 结算发放的奖励理由，与 UserInfo 中的统计一致
+const (
+	RewardReasonLocationDaily      = "location_daily_reward" // 投资位每日收益
+	RewardReasonRecommendTeam      = "recommend_team"        // 按代数发放的团队奖励
+	RewardReasonDailyRecommendArea = "daily_recommend_area"  // 按 vip 等级级差发放的区域分红
+)
+
+const teamRewardGenerations = 10
+
+var areaLevelKeys = []string{"one", "two", "three", "four"}
+
+// TeamRewardRule 团队奖励规则，比例均为万分比
+type TeamRewardRule struct {
+	GenerationRates []int64 // 第 i 代推荐人的比例，recommend_team_rate_1 到 recommend_team_rate_10
+	AreaThresholds  []int64 // vip1 到 vip4 的小区业绩门槛，区域业绩精度，recommend_area_one 到 recommend_area_four
+	RecommendNums   []int64 // vip1 到 vip4 要求的有效直推人数，0 为不要求，recommend_area_num_one 到 recommend_area_num_four
+	AreaRates       []int64 // vip1 到 vip4 的分红比例，recommend_area_rate_one 到 recommend_area_rate_four
+	EvaluateLevels  bool    // 按规则现算 vip 等级，否则使用定时任务评定的等级
+}
+
+// VipLevel 小区业绩和有效直推人数达到的 vip 等级，0 为未达到
+func (rule *TeamRewardRule) VipLevel(areaAmount int64, recommendNum int64) int64 {
+	var level int64
+	for i, threshold := range rule.AreaThresholds {
+		if 0 >= threshold || areaAmount < threshold {
+			continue
+		}
+		if i < len(rule.RecommendNums) && recommendNum < rule.RecommendNums[i] {
+			continue
+		}
+		level = int64(i + 1)
+	}
+	return level
+}
+
+// SmallAreaAmount 小区业绩，直推各线的团队业绩和自身业绩合计，去掉业绩最大的一条线
+func SmallAreaAmount(userAreas []*UserArea) int64 {
+	var total, max int64
+	for _, v := range userAreas {
+		amount := v.Amount + v.SelfAmount
+		total += amount
+		if amount > max {
+			max = amount
+		}
+	}
+	return total - max
+}
+
+// teamRewardConfigKeys 团队奖励使用的配置项
+func teamRewardConfigKeys() []string {
+	keys := make([]string, 0, teamRewardGenerations+3*len(areaLevelKeys))
+	for i := 1; i <= teamRewardGenerations; i++ {
+		keys = append(keys, "recommend_team_rate_"+strconv.Itoa(i))
+	}
+	for _, v := range areaLevelKeys {
+		keys = append(keys, "recommend_area_"+v, "recommend_area_num_"+v, "recommend_area_rate_"+v)
+	}
+	return keys
+}
+
+// parseTeamRewardRule .
+func parseTeamRewardRule(configs []*Config) (*TeamRewardRule, error) {
+	rule := &TeamRewardRule{
+		GenerationRates: make([]int64, teamRewardGenerations),
+		AreaThresholds:  make([]int64, len(areaLevelKeys)),
+		RecommendNums:   make([]int64, len(areaLevelKeys)),
+		AreaRates:       make([]int64, len(areaLevelKeys)),
+	}
+	for _, vConfig := range configs {
+		if strings.HasPrefix(vConfig.KeyName, "recommend_team_rate_") {
+			generation, _ := strconv.Atoi(strings.TrimPrefix(vConfig.KeyName, "recommend_team_rate_"))
+			if 1 > generation || teamRewardGenerations < generation {
+				continue
+			}
+			value, err := ParseMoney(vConfig.Value, MoneyScalePercent)
+			if nil != err {
+				return nil, errors.New(500, "SETTLEMENT_ERROR", "团队奖励配置错误")
+			}
+			rule.GenerationRates[generation-1] = value.Amount
+			continue
+		}
+
+		for i, v := range areaLevelKeys {
+			switch vConfig.KeyName {
+			case "recommend_area_" + v:
+				rule.AreaThresholds[i] = areaConfigAmount(vConfig.Value)
+			case "recommend_area_num_" + v:
+				value, err := strconv.ParseInt(vConfig.Value, 10, 64)
+				if nil != err || 0 > value {
+					return nil, errors.New(500, "SETTLEMENT_ERROR", "vip 直推人数配置错误")
+				}
+				rule.RecommendNums[i] = value
+			case "recommend_area_rate_" + v:
+				value, err := ParseMoney(vConfig.Value, MoneyScalePercent)
+				if nil != err {
+					return nil, errors.New(500, "SETTLEMENT_ERROR", "区域分红配置错误")
+				}
+				rule.AreaRates[i] = value.Amount
+			}
+		}
+	}
+
+	return rule, nil
+}
+
+// recommendUserIds 推荐链路上的用户，直推在前
+func (ruc *RecordUseCase) recommendUserIds(ctx context.Context, userId int64) ([]int64, error) {
+	uplines, err := ruc.userRecommendRepo.GetUserRecommendUplines(ctx, userId, 0)
+	if nil != err {
+		return nil, err
+	}
+
+	res := make([]int64, 0, len(uplines))
+	for _, v := range uplines {
+		res = append(res, v.AncestorId)
+	}
+
+	return res, nil
+}
+
+// userAreaLevel 用户的 vip 等级，结果缓存在 levels 中；
+// 正常结算使用定时任务评定并保存的等级，试算修改了等级规则时按规则现算
+func (ruc *RecordUseCase) userAreaLevel(ctx context.Context, userId int64, rule *TeamRewardRule, levels map[int64]int64) (int64, error) {
+	if level, ok := levels[userId]; ok {
+		return level, nil
+	}
+
+	var level int64
+	if rule.EvaluateLevels {
+		evaluation, err := evaluateUserLevel(ctx, ruc.userRecommendRepo, rule, userId)
+		if nil != err {
+			return 0, err
+		}
+		level = evaluation.Level()
+	} else {
+		userInfo, err := ruc.userInfoRepo.GetUserInfoByUserId(ctx, userId)
+		if nil != err {
+			return 0, err
+		}
+		level = userInfo.Vip
+	}
+
+	levels[userId] = level
+	return level, nil
+}
+
+// teamReward 投资位收益产生后，沿推荐链路发放团队奖励和区域分红，需在事务中调用；
+// 每个接收人的奖励不超过其 running 投资位剩余的额度，发放后累加到投资位，达到额度的投资位出局；
+// 返回实际发放的团队奖励和区域分红
+func (ruc *RecordUseCase) teamReward(ctx context.Context, book settlementBook, rule *TeamRewardRule, levels map[int64]int64, userId int64, locationId int64, amount int64, now time.Time) (int64, int64, error) {
+	recommendUserIds, err := ruc.recommendUserIds(ctx, userId)
+	if nil != err {
+		return 0, 0, err
+	}
+
+	var teamTotal, areaTotal, paidRate int64
+	for i, recommendUserId := range recommendUserIds {
+		var teamAmount, areaAmount int64
+
+		if i < len(rule.GenerationRates) && 0 < rule.GenerationRates[i] {
+			reward, err := BalanceMoney(amount).MulDiv(rule.GenerationRates[i], 10000)
+			if nil != err {
+				return 0, 0, err
+			}
+			teamAmount = reward.Amount
+		}
+
+		// 级差：只发放比下级已发放比例高出的部分
+		level, err := ruc.userAreaLevel(ctx, recommendUserId, rule, levels)
+		if nil != err {
+			return 0, 0, err
+		}
+		if 0 < level && int(level) <= len(rule.AreaRates) && rule.AreaRates[level-1] > paidRate {
+			reward, err := BalanceMoney(amount).MulDiv(rule.AreaRates[level-1]-paidRate, 10000)
+			if nil != err {
+				return 0, 0, err
+			}
+			areaAmount = reward.Amount
+			paidRate = rule.AreaRates[level-1]
+		}
+
+		if 0 >= teamAmount && 0 >= areaAmount {
+			continue
+		}
+
+		teamAmount, areaAmount, err = payTeamReward(ctx, book, recommendUserId, locationId, teamAmount, areaAmount, now)
+		if nil != err {
+			return 0, 0, err
+		}
+		teamTotal += teamAmount
+		areaTotal += areaAmount
+	}
+
+	return teamTotal, areaTotal, nil
+}
+
+// payTeamReward 按接收人 running 投资位的剩余额度发放，先发团队奖励再发区域分红，返回实际发放的金额
+func payTeamReward(ctx context.Context, book settlementBook, userId int64, sourceLocationId int64, teamAmount int64, areaAmount int64, now time.Time) (int64, int64, error) {
+	locations, err := book.locations(ctx, userId, now)
+	if nil != err {
+		return 0, 0, err
+	}
+
+	var room int64
+	for _, v := range locations {
+		if v.CurrentMax > v.Current {
+			room += v.CurrentMax - v.Current
+		}
+	}
+	if teamAmount > room {
+		teamAmount = room
+	}
+	if areaAmount > room-teamAmount {
+		areaAmount = room - teamAmount
+	}
+
+	if 0 < teamAmount {
+		if err = book.reward(ctx, userId, RewardReasonRecommendTeam, teamAmount, sourceLocationId); nil != err {
+			return 0, 0, err
+		}
+	}
+	if 0 < areaAmount {
+		if err = book.reward(ctx, userId, RewardReasonDailyRecommendArea, areaAmount, sourceLocationId); nil != err {
+			return 0, 0, err
+		}
+	}
+
+	// 按开启顺序累加到投资位
+	left := teamAmount + areaAmount
+	for _, v := range locations {
+		if 0 >= left {
+			break
+		}
+		if v.CurrentMax <= v.Current {
+			continue
+		}
+
+		add, status := v.CurrentMax-v.Current, "stop"
+		if left < add {
+			add, status = left, "running"
+		}
+		if err = book.updateLocation(ctx, v, add, status, 0); nil != err {
+			return 0, 0, err
+		}
+		left -= add
+	}
+
+	return teamAmount, areaAmount, nil
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"strconv"
-	"strings"
 	"time"
 )
 
@@ -33,6 +32,13 @@ type UserRecommend struct {
 	CreatedAt     time.Time
 }
 
+// UserRecommendClosure 推荐关系闭包，Depth 为 DescendantId 到 AncestorId 相隔的代数，直推为 1
+type UserRecommendClosure struct {
+	AncestorId   int64
+	DescendantId int64
+	Depth        int64
+}
+
 type UserRecommendArea struct {
 	ID            int64
 	RecommendCode string
@@ -77,6 +83,11 @@ type Withdraw struct {
 	BalanceRecordId int64
 	Status          string
 	Type            string
+	Reason          string
+	Address         string
+	TxHash          string
+	TxNonce         int64
+	TxRaw           string
 	CreatedAt       time.Time
 }
 
@@ -93,6 +104,9 @@ type UserUseCase struct {
 	ubRepo                        UserBalanceRepo
 	locationRepo                  LocationRepo
 	userCurrentMonthRecommendRepo UserCurrentMonthRecommendRepo
+	payoutRepo                    PayoutRepo
+	waRepo                        WithdrawAddressRepo
+	ulRepo                        UserLevelRepo
 	tx                            Transaction
 	log                           *log.Helper
 }
@@ -106,18 +120,46 @@ type LocationNew struct {
 	StopLocationAgain int64
 	OutRate           int64
 	StopCoin          int64
+	TxHash            string
 	StopDate          time.Time
 	CreatedAt         time.Time
 }
 
+// Amount 投资金额，由出局额度按出局倍率折回
+func (l *LocationNew) Amount() Money {
+	amount, err := BalanceMoney(l.CurrentMax).MulDiv(100, l.OutRate)
+	if nil != err {
+		return BalanceMoney(0)
+	}
+	return amount
+}
+
+// AreaAmount 投资位计入区业绩的金额，开启和出局时按同一金额增减，区业绩精度
+func (l *LocationNew) AreaAmount() int64 {
+	amount, err := l.Amount().Rescale(MoneyScaleArea)
+	if nil != err {
+		return 0
+	}
+	return amount.Amount
+}
+
+// areaConfigAmount 配置中的区域业绩门槛，按区域业绩精度放大
+func areaConfigAmount(value string) int64 {
+	amount, _ := ParseMoney(value, MoneyScaleArea)
+	return amount.Amount
+}
+
 type BalanceReward struct {
-	ID        int64
-	UserId    int64
-	Status    int64
-	Amount    int64
-	SetDate   time.Time
-	UpdatedAt time.Time
-	CreatedAt time.Time
+	ID             int64
+	UserId         int64
+	Status         int64
+	Amount         int64
+	H              int64 // 每日发放收益的时间，UTC
+	M              int64
+	SetDate        time.Time
+	LastRewardDate time.Time
+	UpdatedAt      time.Time
+	CreatedAt      time.Time
 }
 
 type Reward struct {
@@ -147,33 +189,49 @@ type ConfigRepo interface {
 type UserBalanceRepo interface {
 	CreateUserBalance(ctx context.Context, u *User) (*UserBalance, error)
 	LocationReward(ctx context.Context, userId int64, amount int64, locationId int64, myLocationId int64, locationType string) (int64, error)
+	LocationDailyReward(ctx context.Context, userId int64, amount int64, locationId int64) (int64, error)
+	TeamReward(ctx context.Context, userId int64, amount int64, reason string, sourceLocationId int64) (int64, error)
+	GetRewardsByReasonsSince(ctx context.Context, since time.Time, reasons ...string) ([]*Reward, error)
+	GetUserRewardsByCursor(ctx context.Context, userId int64, filter *RewardFilter, beforeId int64, limit int) ([]*Reward, error)
 	WithdrawReward(ctx context.Context, userId int64, amount int64, locationId int64, myLocationId int64, locationType string) (int64, error)
 	RecommendReward(ctx context.Context, userId int64, amount int64, locationId int64) (int64, error)
 	SystemWithdrawReward(ctx context.Context, amount int64, locationId int64) error
 	SystemReward(ctx context.Context, amount int64, locationId int64) error
-	SystemFee(ctx context.Context, amount int64, locationId int64) error
+	SystemFee(ctx context.Context, amount int64, coinType string, locationId int64) error
 	GetSystemYesterdayDailyReward(ctx context.Context) (*Reward, error)
 	UserFee(ctx context.Context, userId int64, amount int64) (int64, error)
 	RecommendWithdrawReward(ctx context.Context, userId int64, amount int64, locationId int64) (int64, error)
 	NormalRecommendReward(ctx context.Context, userId int64, amount int64, locationId int64) (int64, error)
 	NormalWithdrawRecommendReward(ctx context.Context, userId int64, amount int64, locationId int64) (int64, error)
 	Deposit(ctx context.Context, userId int64, amount int64) (int64, error)
+	DepositReverse(ctx context.Context, userId int64, amount int64) (int64, error)
 	DepositLast(ctx context.Context, userId int64, lastAmount int64, locationId int64) (int64, error)
 	DepositDhb(ctx context.Context, userId int64, amount int64) (int64, error)
 	GetUserBalance(ctx context.Context, userId int64) (*UserBalance, error)
+	GetUserBalanceForUpdate(ctx context.Context, userId int64) (*UserBalance, error)
+	LockWithdrawCoin(ctx context.Context, coinType string) error
 	GetUserRewardByUserId(ctx context.Context, userId int64) ([]*Reward, error)
 	GetUserRewardByUserIds(ctx context.Context, userIds ...int64) (map[int64]*UserSortRecommendReward, error)
 	GetUserRewards(ctx context.Context, b *Pagination, userId int64) ([]*Reward, error, int64)
 	GetUserRewardsLastMonthFee(ctx context.Context) ([]*Reward, error)
 	GetUserBalanceByUserIds(ctx context.Context, userIds ...int64) (map[int64]*UserBalance, error)
 	GetUserBalanceUsdtTotal(ctx context.Context) (int64, error)
-	GreateWithdraw(ctx context.Context, userId int64, amount int64, coinType string) (*Withdraw, error)
+	GreateWithdraw(ctx context.Context, userId int64, amount int64, relAmount int64, coinType string, address string) (*Withdraw, error)
+	GetWithdrawTotalSince(ctx context.Context, userId int64, coinType string, since time.Time) (int64, error)
+	GetWithdrawLastByUserId(ctx context.Context, userId int64) (*Withdraw, error)
 	WithdrawUsdt(ctx context.Context, userId int64, amount int64) error
 	WithdrawDhb(ctx context.Context, userId int64, amount int64) error
 	GetWithdrawByUserId(ctx context.Context, userId int64, typeCoin string) ([]*Withdraw, error)
 	GetWithdraws(ctx context.Context, b *Pagination, userId int64) ([]*Withdraw, error, int64)
-	GetWithdrawPassOrRewarded(ctx context.Context) ([]*Withdraw, error)
 	UpdateWithdraw(ctx context.Context, id int64, status string) (*Withdraw, error)
+	RejectWithdraw(ctx context.Context, id int64, reason string) (*Withdraw, error)
+	FailWithdraw(ctx context.Context, id int64, reason string) (*Withdraw, error)
+	WithdrawPayout(ctx context.Context, withdraw *Withdraw) error
+	UpdateWithdrawBroadcasting(ctx context.Context, id int64, address string, transfer *PayoutTransfer) (*Withdraw, error)
+	GetWithdrawsByStatus(ctx context.Context, status string, limit int) ([]*Withdraw, error)
+	UpdateWithdrawLegacyStatus(ctx context.Context, from string, to string, reason string) (int64, error)
+	CancelWithdraw(ctx context.Context, id int64, userId int64) (*Withdraw, error)
+	WithdrawRefund(ctx context.Context, userId int64, amount int64, coinType string, recordType string) (int64, error)
 	GetWithdrawById(ctx context.Context, id int64) (*Withdraw, error)
 	GetWithdrawNotDeal(ctx context.Context) ([]*Withdraw, error)
 	GetUserBalanceRecordUserUsdtTotal(ctx context.Context, userId int64) (int64, error)
@@ -190,20 +248,35 @@ type UserBalanceRepo interface {
 	SetBalanceReward(ctx context.Context, userId int64, amount int64) error
 	UpdateBalanceReward(ctx context.Context, userId int64, id int64, amount int64, status int64) error
 	GetBalanceRewardByUserId(ctx context.Context, userId int64) ([]*BalanceReward, error)
+	GetBalanceRewardsDue(ctx context.Context, lastRewardBefore time.Time, afterId int64, limit int) ([]*BalanceReward, error)
+	GetBalanceRewardForUpdate(ctx context.Context, id int64) (*BalanceReward, error)
+	UpdateBalanceRewardLastRewardDate(ctx context.Context, id int64, lastRewardDate time.Time, rewardDate time.Time) error
+	BalanceRewardDailyReward(ctx context.Context, userId int64, amount int64, balanceRewardId int64, rewardDate time.Time) (int64, error)
 }
 
 type UserRecommendRepo interface {
 	GetUserRecommendByUserId(ctx context.Context, userId int64) (*UserRecommend, error)
+	GetUserRecommendByUserIds(ctx context.Context, userIds ...int64) (map[int64]*UserRecommend, error)
 	CreateUserRecommend(ctx context.Context, u *User, recommendUser *UserRecommend) (*UserRecommend, error)
 	UpdateUserRecommend(ctx context.Context, u *User, recommendUser *UserRecommend) (bool, error)
-	GetUserRecommendByCode(ctx context.Context, code string) ([]*UserRecommend, error)
 	GetUserRecommendLikeCode(ctx context.Context, code string) ([]*UserRecommend, error)
+	GetUserRecommendUplines(ctx context.Context, userId int64, maxDepth int64) ([]*UserRecommendClosure, error)
+	GetUserRecommendDownlines(ctx context.Context, userId int64, maxDepth int64) ([]*UserRecommendClosure, error)
+	GetUserRecommendDescendantCounts(ctx context.Context, userIds ...int64) (map[int64]int64, error)
+	GetUserRecommendParentIds(ctx context.Context, userIds ...int64) (map[int64]int64, error)
+	SearchUserRecommendDownlines(ctx context.Context, userId int64, address string, limit int) ([]*UserRecommendClosure, error)
+	BackfillUserRecommendClosure(ctx context.Context) (int64, error)
 	CreateUserRecommendArea(ctx context.Context, u *User, recommendUser *UserRecommend) (bool, error)
 	DeleteOrOriginUserRecommendArea(ctx context.Context, code string, originCode string) (bool, error)
 	GetUserRecommendLowArea(ctx context.Context, code string) ([]*UserRecommendArea, error)
 	GetUserAreas(ctx context.Context, userIds []int64) ([]*UserArea, error)
 	CreateUserArea(ctx context.Context, u *User) (bool, error)
 	GetUserArea(ctx context.Context, userId int64) (*UserArea, error)
+	UpdateUserAreaSelfAmount(ctx context.Context, userId int64, amount int64) error
+	UpdateUserAreaAmount(ctx context.Context, amount int64, userIds ...int64) error
+	RebuildUserAreaAmounts(ctx context.Context, selfAmounts map[int64]int64) (int64, error)
+	GetUserAreaUserIds(ctx context.Context, afterUserId int64, limit int) ([]int64, error)
+	UpdateUserAreaLevel(ctx context.Context, userId int64, level int64) error
 }
 
 type UserCurrentMonthRecommendRepo interface {
@@ -219,6 +292,7 @@ type UserInfoRepo interface {
 	GetUserInfoByUserId(ctx context.Context, userId int64) (*UserInfo, error)
 	UpdateUserInfo(ctx context.Context, u *UserInfo) (*UserInfo, error)
 	GetUserInfoByUserIds(ctx context.Context, userIds ...int64) (map[int64]*UserInfo, error)
+	UpdateUserInfoVip(ctx context.Context, userId int64, oldVip int64, vip int64) (bool, error)
 }
 
 type UserRepo interface {
@@ -232,7 +306,7 @@ type UserRepo interface {
 	GetUserCountToday(ctx context.Context) (int64, error)
 }
 
-func NewUserUseCase(repo UserRepo, tx Transaction, configRepo ConfigRepo, uiRepo UserInfoRepo, urRepo UserRecommendRepo, locationRepo LocationRepo, userCurrentMonthRecommendRepo UserCurrentMonthRecommendRepo, ubRepo UserBalanceRepo, logger log.Logger) *UserUseCase {
+func NewUserUseCase(repo UserRepo, tx Transaction, configRepo ConfigRepo, uiRepo UserInfoRepo, urRepo UserRecommendRepo, locationRepo LocationRepo, userCurrentMonthRecommendRepo UserCurrentMonthRecommendRepo, ubRepo UserBalanceRepo, payoutRepo PayoutRepo, waRepo WithdrawAddressRepo, ulRepo UserLevelRepo, logger log.Logger) *UserUseCase {
 	return &UserUseCase{
 		repo:                          repo,
 		tx:                            tx,
@@ -242,6 +316,9 @@ func NewUserUseCase(repo UserRepo, tx Transaction, configRepo ConfigRepo, uiRepo
 		uiRepo:                        uiRepo,
 		urRepo:                        urRepo,
 		ubRepo:                        ubRepo,
+		payoutRepo:                    payoutRepo,
+		waRepo:                        waRepo,
+		ulRepo:                        ulRepo,
 		log:                           log.NewHelper(logger),
 	}
 }
@@ -258,9 +335,6 @@ func (uuc *UserUseCase) GetExistUserByAddressOrCreate(ctx context.Context, u *Us
 	var (
 		user          *User
 		recommendUser *UserRecommend
-		userRecommend *UserRecommend
-		userInfo      *UserInfo
-		userBalance   *UserBalance
 		err           error
 		userId        int64
 		decodeBytes   []byte
@@ -292,12 +366,12 @@ func (uuc *UserUseCase) GetExistUserByAddressOrCreate(ctx context.Context, u *Us
 				return err
 			}
 
-			userInfo, err = uuc.uiRepo.CreateUserInfo(ctx, user) // 创建用户信息
+			_, err = uuc.uiRepo.CreateUserInfo(ctx, user) // 创建用户信息
 			if err != nil {
 				return err
 			}
 
-			userRecommend, err = uuc.urRepo.CreateUserRecommend(ctx, user, recommendUser) // 创建用户推荐信息
+			_, err = uuc.urRepo.CreateUserRecommend(ctx, user, recommendUser) // 创建用户推荐信息
 			if err != nil {
 				return err
 			}
@@ -307,7 +381,7 @@ func (uuc *UserUseCase) GetExistUserByAddressOrCreate(ctx context.Context, u *Us
 				return err
 			}
 
-			userBalance, err = uuc.ubRepo.CreateUserBalance(ctx, user) // 创建余额信息
+			_, err = uuc.ubRepo.CreateUserBalance(ctx, user) // 创建余额信息
 			if err != nil {
 				return err
 			}
@@ -321,6 +395,24 @@ func (uuc *UserUseCase) GetExistUserByAddressOrCreate(ctx context.Context, u *Us
 	return user, nil
 }
 
+// updateUplineAreaAmount 增减推荐链路上所有用户的团队业绩
+func (uuc *UserUseCase) updateUplineAreaAmount(ctx context.Context, userId int64, areaAmount int64) error {
+	if 0 == areaAmount {
+		return nil
+	}
+
+	uplines, err := uuc.urRepo.GetUserRecommendUplines(ctx, userId, 0)
+	if nil != err {
+		return err
+	}
+	userIds := make([]int64, 0, len(uplines))
+	for _, v := range uplines {
+		userIds = append(userIds, v.AncestorId)
+	}
+
+	return uuc.urRepo.UpdateUserAreaAmount(ctx, areaAmount, userIds...)
+}
+
 func (uuc *UserUseCase) UpdateUserRecommend(ctx context.Context, u *User, req *v1.RecommendUpdateRequest) (*v1.RecommendUpdateReply, error) {
 	var (
 		err                   error
@@ -350,11 +442,12 @@ func (uuc *UserUseCase) UpdateUserRecommend(ctx context.Context, u *User, req *v
 		if nil == userRecommend {
 			return nil, err
 		}
-		if "" != userRecommend.RecommendCode {
-			tmpRecommendUserIds := strings.Split(userRecommend.RecommendCode, "D")
-			if 2 <= len(tmpRecommendUserIds) {
-				myUserRecommendUserId, _ = strconv.ParseInt(tmpRecommendUserIds[len(tmpRecommendUserIds)-1], 10, 64) // 最后一位是直推人
-			}
+		uplines, err := uuc.urRepo.GetUserRecommendUplines(ctx, u.ID, 1) // 直推人
+		if nil != err {
+			return nil, err
+		}
+		if 0 < len(uplines) {
+			myUserRecommendUserId = uplines[0].AncestorId
 			myRecommendUser, err = uuc.repo.GetUserById(ctx, myUserRecommendUserId)
 			if nil != err {
 				return nil, err
@@ -382,9 +475,22 @@ func (uuc *UserUseCase) UpdateUserRecommend(ctx context.Context, u *User, req *v
 			return nil, err
 		}
 
-		// 更新
-		_, err = uuc.urRepo.UpdateUserRecommend(ctx, u, recommendUser)
-		if err != nil {
+		// 更新，团队业绩从原来的上级移到新的上级
+		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error {
+			var areaAmount int64
+			userArea, _ := uuc.urRepo.GetUserArea(ctx, u.ID)
+			if nil != userArea {
+				areaAmount = userArea.Amount + userArea.SelfAmount
+			}
+
+			if err := uuc.updateUplineAreaAmount(ctx, u.ID, -areaAmount); nil != err {
+				return err
+			}
+			if _, err := uuc.urRepo.UpdateUserRecommend(ctx, u, recommendUser); nil != err {
+				return err
+			}
+			return uuc.updateUplineAreaAmount(ctx, u.ID, areaAmount)
+		}); err != nil {
 			return nil, err
 		}
 		Address = myRecommendUser.Address
@@ -400,7 +506,7 @@ func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoR
 		locations                []*LocationNew
 		userBalance              *UserBalance
 		userRecommend            *UserRecommend
-		userRecommends           []*UserRecommend
+		teamRecommends           []*UserRecommendClosure
 		userRewards              []*Reward
 		userRewardTotal          int64
 		encodeString             string
@@ -428,10 +534,6 @@ func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoR
 		fybRate                  string
 		areaAmount               int64
 		maxAreaAmount            int64
-		recommendAreaOne         int64
-		recommendAreaTwo         int64
-		recommendAreaThree       int64
-		recommendAreaFour        int64
 		areaName                 string
 		timeAgain                int64
 		stopCoin                 int64
@@ -444,7 +546,7 @@ func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoR
 	)
 
 	// 配置
-	configs, err = uuc.configRepo.GetConfigByKeys(ctx, "user_count", "coin_price", "coin_rate", "time_again", "recommend_area_one", "recommend_area_two", "recommend_area_three", "recommend_area_four")
+	configs, err = uuc.configRepo.GetConfigByKeys(ctx, "user_count", "coin_price", "coin_rate", "time_again")
 	if nil != configs {
 		for _, vConfig := range configs {
 			if "user_count" == vConfig.KeyName {
@@ -459,18 +561,6 @@ func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoR
 			if "time_again" == vConfig.KeyName {
 				timeAgain, _ = strconv.ParseInt(vConfig.Value, 10, 64)
 			}
-			if "recommend_area_one" == vConfig.KeyName {
-				recommendAreaOne, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			}
-			if "recommend_area_two" == vConfig.KeyName {
-				recommendAreaTwo, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			}
-			if "recommend_area_three" == vConfig.KeyName {
-				recommendAreaThree, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			}
-			if "recommend_area_four" == vConfig.KeyName {
-				recommendAreaFour, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			}
 		}
 	}
 
@@ -491,7 +581,7 @@ func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoR
 			if "running" == v.Status {
 				status = "yes"
 				tmpCurrent += v.Current
-				locationRunningAmount += v.CurrentMax * 100 / v.OutRate
+				locationRunningAmount += v.Amount().Amount
 				if v.CurrentMax >= v.Current {
 					tmpCurrentMaxSubCurrent += v.CurrentMax - v.Current
 				}
@@ -499,10 +589,10 @@ func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoR
 
 			myLocations = append(myLocations, &v1.UserInfoReply_List{
 				CreatedAt:      v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
-				Amount:         fmt.Sprintf("%.2f", float64(v.CurrentMax*100)/float64(v.OutRate)/float64(10000000000)),
+				Amount:         v.Amount().Format(2),
 				LocationStatus: v.Status,
-				AmountMax:      fmt.Sprintf("%.2f", float64(v.CurrentMax)/float64(10000000000)),
-				OutRate:        fmt.Sprintf("%.2f", float64(v.OutRate)/float64(100)),
+				AmountMax:      BalanceMoney(v.CurrentMax).Format(2),
+				OutRate:        NewMoney(v.OutRate, MoneyScalePercent).Format(2),
 			})
 		}
 
@@ -510,7 +600,7 @@ func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoR
 			status = "running"
 		}
 
-		amount = fmt.Sprintf("%.2f", float64(tmpCurrentMaxSubCurrent)/float64(10000000000))
+		amount = BalanceMoney(tmpCurrentMaxSubCurrent).Format(2)
 	}
 	locationCount = int64(len(locations))
 
@@ -550,16 +640,19 @@ func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoR
 	codeByte := []byte(myCode)
 	encodeString = base64.StdEncoding.EncodeToString(codeByte)
 	if "" != userRecommend.RecommendCode {
-		tmpRecommendUserIds := strings.Split(userRecommend.RecommendCode, "D")
-		if 2 <= len(tmpRecommendUserIds) {
-			myUserRecommendUserId, _ = strconv.ParseInt(tmpRecommendUserIds[len(tmpRecommendUserIds)-1], 10, 64) // 最后一位是直推人
-		}
+		myCode = userRecommend.RecommendCode + myCode
+	}
+	uplines, err := uuc.urRepo.GetUserRecommendUplines(ctx, myUser.ID, 1) // 直推人
+	if nil != err {
+		return nil, err
+	}
+	if 0 < len(uplines) {
+		myUserRecommendUserId = uplines[0].AncestorId
 		myRecommendUser, err = uuc.repo.GetUserById(ctx, myUserRecommendUserId)
 		if nil != err {
 			return nil, err
 		}
 		inviteUserAddress = myRecommendUser.Address
-		myCode = userRecommend.RecommendCode + myCode
 	}
 
 	// 团队
@@ -570,10 +663,10 @@ func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoR
 		userAreasTeam     []*UserArea
 	)
 	userAreasMap := make(map[int64]int64, 0)
-	userRecommends, err = uuc.urRepo.GetUserRecommendLikeCode(ctx, myCode)
-	if nil != userRecommends {
-		for _, vUserRecommends := range userRecommends {
-			teamUserIds = append(teamUserIds, vUserRecommends.UserId)
+	teamRecommends, err = uuc.urRepo.GetUserRecommendDownlines(ctx, myUser.ID, 0)
+	if nil != teamRecommends {
+		for _, vTeamRecommends := range teamRecommends {
+			teamUserIds = append(teamUserIds, vTeamRecommends.DescendantId)
 		}
 		// 区信息
 		userAreasTeam, err = uuc.urRepo.GetUserAreas(ctx, teamUserIds)
@@ -584,7 +677,7 @@ func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoR
 		}
 
 		// 用户信息
-		recommendTeamNum = int64(len(userRecommends))
+		recommendTeamNum = int64(len(teamRecommends))
 		teamUsers, _ = uuc.repo.GetUserByUserIds(ctx, teamUserIds...)
 		if nil != teamUsers {
 			for _, vTeamUsers := range teamUsers {
@@ -594,7 +687,7 @@ func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoR
 				}
 				teamUserAddresses = append(teamUserAddresses, &v1.UserInfoReply_List7{
 					Address: vTeamUsers.Address,
-					Amount:  fmt.Sprintf("%.2f", float64(tmpAmount)/float64(100000)),
+					Amount:  AreaMoney(tmpAmount).Format(2),
 				})
 			}
 		}
@@ -639,12 +732,12 @@ func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoR
 				recommendTeamList = append(recommendTeamList, &v1.UserInfoReply_List2{
 					CreatedAt:    vUserReward.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
 					RecommendNum: vUserReward.ReasonLocationId,
-					Amount:       fmt.Sprintf("%.2f", float64(vUserReward.Amount)/float64(10000000000)),
+					Amount:       BalanceMoney(vUserReward.Amount).Format(2),
 				})
 				userRewardTotal += vUserReward.Amount
 				allRewardList = append(allRewardList, &v1.UserInfoReply_List9{
 					CreatedAt: vUserReward.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
-					Amount:    fmt.Sprintf("%.2f", float64(vUserReward.Amount)/float64(10000000000)),
+					Amount:    BalanceMoney(vUserReward.Amount).Format(2),
 				})
 
 			} else if "daily_recommend_area" == vUserReward.Reason {
@@ -654,12 +747,12 @@ func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoR
 				}
 				recommendAreaList = append(recommendAreaList, &v1.UserInfoReply_List3{
 					CreatedAt: vUserReward.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
-					Amount:    fmt.Sprintf("%.2f", float64(vUserReward.Amount)/float64(10000000000)),
+					Amount:    BalanceMoney(vUserReward.Amount).Format(2),
 				})
 				userRewardTotal += vUserReward.Amount
 				allRewardList = append(allRewardList, &v1.UserInfoReply_List9{
 					CreatedAt: vUserReward.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
-					Amount:    fmt.Sprintf("%.2f", float64(vUserReward.Amount)/float64(10000000000)),
+					Amount:    BalanceMoney(vUserReward.Amount).Format(2),
 				})
 			} else if "location_daily_reward" == vUserReward.Reason {
 				locationDailyRewardTotal += vUserReward.Amount
@@ -668,12 +761,12 @@ func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoR
 				}
 				locationDailyRewardList = append(locationDailyRewardList, &v1.UserInfoReply_List4{
 					CreatedAt: vUserReward.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
-					Amount:    fmt.Sprintf("%.2f", float64(vUserReward.Amount)/float64(10000000000)),
+					Amount:    BalanceMoney(vUserReward.Amount).Format(2),
 				})
 				userRewardTotal += vUserReward.Amount
 				allRewardList = append(allRewardList, &v1.UserInfoReply_List9{
 					CreatedAt: vUserReward.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
-					Amount:    fmt.Sprintf("%.2f", float64(vUserReward.Amount)/float64(10000000000)),
+					Amount:    BalanceMoney(vUserReward.Amount).Format(2),
 				})
 			} else if "recommend" == vUserReward.Reason {
 				recommendTotal += vUserReward.Amount
@@ -682,18 +775,18 @@ func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoR
 				}
 				recommendList = append(recommendList, &v1.UserInfoReply_List5{
 					CreatedAt: vUserReward.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
-					Amount:    fmt.Sprintf("%.2f", float64(vUserReward.Amount)/float64(10000000000)),
+					Amount:    BalanceMoney(vUserReward.Amount).Format(2),
 				})
 				userRewardTotal += vUserReward.Amount
 				allRewardList = append(allRewardList, &v1.UserInfoReply_List9{
 					CreatedAt: vUserReward.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
-					Amount:    fmt.Sprintf("%.2f", float64(vUserReward.Amount)/float64(10000000000)),
+					Amount:    BalanceMoney(vUserReward.Amount).Format(2),
 				})
 			} else if "daily_balance_reward" == vUserReward.Reason {
 				dailyBalanceRewardTotal += vUserReward.Amount
 				dailyBalanceRewardList = append(dailyBalanceRewardList, &v1.UserInfoReply_List6{
 					CreatedAt: vUserReward.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
-					Amount:    fmt.Sprintf("%.2f", float64(vUserReward.Amount)/float64(10000000000)),
+					Amount:    BalanceMoney(vUserReward.Amount).Format(2),
 				})
 				if vUserReward.CreatedAt.Before(yesterdayEnd) && vUserReward.CreatedAt.After(yesterdayStart) {
 					yesterdayDailyBalanceRewardTotal += vUserReward.Amount
@@ -701,7 +794,7 @@ func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoR
 				userRewardTotal += vUserReward.Amount
 				allRewardList = append(allRewardList, &v1.UserInfoReply_List9{
 					CreatedAt: vUserReward.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
-					Amount:    fmt.Sprintf("%.2f", float64(vUserReward.Amount)/float64(10000000000)),
+					Amount:    BalanceMoney(vUserReward.Amount).Format(2),
 				})
 			}
 		}
@@ -710,16 +803,16 @@ func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoR
 	// 小区信息
 	if "" != myCode {
 		var (
-			myRecommendUsers    []*UserRecommend
+			myRecommendUsers    []*UserRecommendClosure
 			userAreas           []*UserArea
 			myRecommendUserIds  []int64
 			myRecommendUsersMap map[int64]*User
 		)
-		myRecommendUsers, err = uuc.urRepo.GetUserRecommendByCode(ctx, myCode)
+		myRecommendUsers, err = uuc.urRepo.GetUserRecommendDownlines(ctx, myUser.ID, 1)
 		if nil == err {
 			// 找直推
 			for _, vMyRecommendUsers := range myRecommendUsers {
-				myRecommendUserIds = append(myRecommendUserIds, vMyRecommendUsers.UserId)
+				myRecommendUserIds = append(myRecommendUserIds, vMyRecommendUsers.DescendantId)
 			}
 		}
 
@@ -741,7 +834,7 @@ func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoR
 					}
 				}
 
-				areaAmount = totalAreaAmount - maxAreaAmount
+				areaAmount = SmallAreaAmount(userAreas)
 			}
 
 			myRecommendUsersMap, _ = uuc.repo.GetUserByUserIds(ctx, userLocationRecommendUserIds...)
@@ -750,43 +843,15 @@ func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoR
 					myRecommendUserAddresses = append(myRecommendUserAddresses, &v1.UserInfoReply_List8{Address: vMyRecommendUsersMap.Address})
 				}
 			}
-
-			// 比较级别
-			if areaAmount >= recommendAreaOne*100000 {
-				areaName = "vip1"
-			}
-
-			if areaAmount >= recommendAreaTwo*100000 {
-				areaName = "vip2"
-			}
-
-			if areaAmount >= recommendAreaThree*100000 {
-				areaName = "vip3"
-			}
-
-			if areaAmount >= recommendAreaFour*100000 {
-				areaName = "vip4"
-			}
 		}
 
 	}
-	// 优先展示设定的
+	// 等级由定时任务评定，后台设定的优先
+	if 0 < userInfo.Vip {
+		areaName = "vip" + strconv.FormatInt(userInfo.Vip, 10)
+	}
 	var myUserArea *UserArea
 	myUserArea, err = uuc.urRepo.GetUserArea(ctx, user.ID)
-	if nil != myUserArea && 0 < myUserArea.Level {
-		if myUserArea.Level >= 1 {
-			areaName = "vip1"
-		}
-		if myUserArea.Level >= 2 {
-			areaName = "vip2"
-		}
-		if myUserArea.Level >= 3 {
-			areaName = "vip3"
-		}
-		if myUserArea.Level >= 4 {
-			areaName = "vip4"
-		}
-	}
 
 	var (
 		balanceRewards           []*BalanceReward
@@ -803,75 +868,62 @@ func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoR
 		Address:                           myUser.Address,
 		Status:                            status,
 		Amount:                            amount,
-		BalanceUsdt:                       fmt.Sprintf("%.2f", float64(userBalance.BalanceUsdt)/float64(10000000000)),
-		BalanceDhb:                        fmt.Sprintf("%.2f", float64(userBalance.BalanceDhb)/float64(10000000000)),
-		LocationRunningAmount:             fmt.Sprintf("%.2f", float64(locationRunningAmount)/float64(10000000000)),
+		BalanceUsdt:                       BalanceMoney(userBalance.BalanceUsdt).Format(2),
+		BalanceDhb:                        BalanceMoney(userBalance.BalanceDhb).Format(2),
+		LocationRunningAmount:             BalanceMoney(locationRunningAmount).Format(2),
 		InviteUrl:                         encodeString,
 		InviteUserAddress:                 inviteUserAddress,
 		RecommendNum:                      userInfo.HistoryRecommend,
 		RecommendTeamNum:                  recommendTeamNum,
-		Total:                             fmt.Sprintf("%.2f", float64(userRewardTotal)/float64(10000000000)),
-		WithdrawAmount:                    fmt.Sprintf("%.2f", float64(withdrawAmount)/float64(10000000000)),
-		RecommendTotal:                    fmt.Sprintf("%.2f", float64(recommendTotal)/float64(10000000000)),
-		LocationDailyRewardTotal:          fmt.Sprintf("%.2f", float64(locationDailyRewardTotal)/float64(10000000000)),
-		DailyBalanceRewardTotal:           fmt.Sprintf("%.2f", float64(dailyBalanceRewardTotal)/float64(10000000000)),
-		RecommendTeamTotal:                fmt.Sprintf("%.2f", float64(recommendTeamTotal)/float64(10000000000)),
-		RecommendAreaTotal:                fmt.Sprintf("%.2f", float64(recommendAreaTotal)/float64(10000000000)),
+		Total:                             BalanceMoney(userRewardTotal).Format(2),
+		WithdrawAmount:                    BalanceMoney(withdrawAmount).Format(2),
+		RecommendTotal:                    BalanceMoney(recommendTotal).Format(2),
+		LocationDailyRewardTotal:          BalanceMoney(locationDailyRewardTotal).Format(2),
+		DailyBalanceRewardTotal:           BalanceMoney(dailyBalanceRewardTotal).Format(2),
+		RecommendTeamTotal:                BalanceMoney(recommendTeamTotal).Format(2),
+		RecommendAreaTotal:                BalanceMoney(recommendAreaTotal).Format(2),
 		Usdt:                              "0x55d398326f99059fF775485246999027B3197955",
 		Account:                           "0x8DbfC7a0C0DC41d96922B3B834d620e7aA808D6B",
-		AmountB:                           fmt.Sprintf("%.2f", float64(myLastLocationCurrent)/float64(10000000000)),
-		AmountC:                           fmt.Sprintf("%.2f", float64(stopCoin)/float64(10000000000)),
+		AmountB:                           BalanceMoney(myLastLocationCurrent).Format(2),
+		AmountC:                           BalanceMoney(stopCoin).Format(2),
 		UserCount:                         userCount,
-		TotalDeposit:                      fmt.Sprintf("%.2f", float64(totalDepoist)/float64(10000000000)),
+		TotalDeposit:                      BalanceMoney(totalDepoist).Format(2),
 		LocationCount:                     locationCount,
-		FybPrice:                          fmt.Sprintf("%.2f", float64(fybPrice)/float64(1000)),
+		FybPrice:                          NewMoney(fybPrice, 3).Format(2),
 		FybRate:                           fybRate,
 		Undo:                              myUser.Undo,
 		AreaName:                          areaName,
-		AreaAmount:                        fmt.Sprintf("%.2f", float64(areaAmount)/float64(100000)),
-		AreaMaxAmount:                     fmt.Sprintf("%.2f", float64(maxAreaAmount)/float64(100000)),
-		TotalAreaAmount:                   fmt.Sprintf("%.2f", float64(myUserArea.Amount)/float64(100000)),
-		AmountBalanceReward:               fmt.Sprintf("%.2f", float64(totalBalanceRewardAmount)/float64(10000000000)),
+		AreaAmount:                        AreaMoney(areaAmount).Format(2),
+		AreaMaxAmount:                     AreaMoney(maxAreaAmount).Format(2),
+		TotalAreaAmount:                   AreaMoney(myUserArea.Amount).Format(2),
+		AmountBalanceReward:               BalanceMoney(totalBalanceRewardAmount).Format(2),
 		LocationList:                      myLocations,
 		RecommendAreaList:                 recommendAreaList,
 		RecommendList:                     recommendList,
 		RecommendTeamList:                 recommendTeamList,
 		LocationDailyRewardList:           locationDailyRewardList,
 		DailyBalanceRewardList:            dailyBalanceRewardList,
-		YesterdayRecommendTeamTotal:       fmt.Sprintf("%.2f", float64(yesterdayRecommendTeamTotal)/float64(10000000000)),
-		YesterdayRecommendAreaTotal:       fmt.Sprintf("%.2f", float64(yesterdayRecommendAreaTotal)/float64(10000000000)),
-		YesterdayDailyBalanceRewardTotal:  fmt.Sprintf("%.2f", float64(yesterdayDailyBalanceRewardTotal)/float64(10000000000)),
-		YesterdayLocationDailyRewardTotal: fmt.Sprintf("%.2f", float64(yesterdayLocationDailyRewardTotal)/float64(10000000000)),
-		YesterdayRecommendTotal:           fmt.Sprintf("%.2f", float64(yesterdayRecommendTotal)/float64(10000000000)),
+		YesterdayRecommendTeamTotal:       BalanceMoney(yesterdayRecommendTeamTotal).Format(2),
+		YesterdayRecommendAreaTotal:       BalanceMoney(yesterdayRecommendAreaTotal).Format(2),
+		YesterdayDailyBalanceRewardTotal:  BalanceMoney(yesterdayDailyBalanceRewardTotal).Format(2),
+		YesterdayLocationDailyRewardTotal: BalanceMoney(yesterdayLocationDailyRewardTotal).Format(2),
+		YesterdayRecommendTotal:           BalanceMoney(yesterdayRecommendTotal).Format(2),
 		TeamAddressList:                   teamUserAddresses,
 		AllRewardList:                     allRewardList,
 		MyRecommendAddressList:            myRecommendUserAddresses,
 	}, nil
 }
 
-func (uuc *UserUseCase) RewardList(ctx context.Context, req *v1.RewardListRequest, user *User) (*v1.RewardListReply, error) {
-
-	res := &v1.RewardListReply{
-		Rewards: make([]*v1.RewardListReply_List, 0),
-	}
-
-	return res, nil
-}
-
-func (uuc *UserUseCase) RecommendRewardList(ctx context.Context, user *User) (*v1.RecommendRewardListReply, error) {
-
-	res := &v1.RecommendRewardListReply{
-		Rewards: make([]*v1.RecommendRewardListReply_List, 0),
+// AdminRecommendClosureBackfill 按现有推荐码补齐推荐关系闭包表，可重复执行
+func (uuc *UserUseCase) AdminRecommendClosureBackfill(ctx context.Context, req *v1.AdminRecommendClosureBackfillRequest) (*v1.AdminRecommendClosureBackfillReply, error) {
+	count, err := uuc.urRepo.BackfillUserRecommendClosure(ctx)
+	if nil != err {
+		return nil, err
 	}
 
-	return res, nil
-}
-
-func (uuc *UserUseCase) FeeRewardList(ctx context.Context, user *User) (*v1.FeeRewardListReply, error) {
-	res := &v1.FeeRewardListReply{
-		Rewards: make([]*v1.FeeRewardListReply_List, 0),
-	}
-	return res, nil
+	return &v1.AdminRecommendClosureBackfillReply{
+		Count: count,
+	}, nil
 }
 
 func (uuc *UserUseCase) WithdrawList(ctx context.Context, user *User, reqTypeCoin string) (*v1.WithdrawListReply, error) {
@@ -898,9 +950,11 @@ func (uuc *UserUseCase) WithdrawList(ctx context.Context, user *User, reqTypeCoi
 	for _, v := range withdraws {
 		res.Withdraw = append(res.Withdraw, &v1.WithdrawListReply_List{
 			CreatedAt: v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
-			Amount:    fmt.Sprintf("%.2f", float64(v.Amount)/float64(10000000000)),
+			Amount:    BalanceMoney(v.Amount).Format(2),
 			Status:    v.Status,
 			Type:      v.Type,
+			Reason:    v.Reason,
+			Id:        v.ID,
 		})
 	}
 
@@ -911,57 +965,87 @@ func (uuc *UserUseCase) Withdraw(ctx context.Context, req *v1.WithdrawRequest, u
 	var (
 		err         error
 		userBalance *UserBalance
+		rule        *WithdrawRule
+		withdraw    *Withdraw
+		address     string
+		failReply   *v1.WithdrawReply
 	)
 
 	if "dhb" != req.SendBody.Type && "usdt" != req.SendBody.Type {
-		return &v1.WithdrawReply{
-			Status: "fail",
-		}, nil
+		return withdrawFail(WithdrawReasonInvalidType, "币种错误"), nil
 	}
 
-	userBalance, err = uuc.ubRepo.GetUserBalance(ctx, user.ID)
+	amountMoney, err := ParseMoney(req.SendBody.Amount, MoneyScaleBalance)
+	if nil != err || 0 >= amountMoney.Amount {
+		return withdrawFail(WithdrawReasonInvalidAmount, "提现金额错误"), nil
+	}
+	amount := amountMoney.Amount
+
+	rule, err = uuc.getWithdrawRule(ctx, req.SendBody.Type)
 	if nil != err {
 		return nil, err
 	}
 
-	amountFloat, _ := strconv.ParseFloat(req.SendBody.Amount, 10)
-	amountFloat *= 10000000000
-	amount, _ := strconv.ParseInt(strconv.FormatFloat(amountFloat, 'f', -1, 64), 10, 64)
-	if 100000000000 > amount {
-		return &v1.WithdrawReply{
-			Status: "fail",
-		}, nil
+	if rule.Min > amount {
+		return withdrawFail(WithdrawReasonBelowMinimum, "最低提现"+BalanceMoney(rule.Min).Format(2)), nil
 	}
 
-	if "dhb" == req.SendBody.Type && userBalance.BalanceDhb < amount {
-		return &v1.WithdrawReply{
-			Status: "fail",
-		}, nil
+	fee := rule.Fee(amount)
+	relAmount := amount - fee
+	if 0 >= relAmount {
+		return withdrawFail(WithdrawReasonFeeExceedsAmount, "提现金额不足以支付手续费"), nil
 	}
 
-	if "usdt" == req.SendBody.Type && userBalance.BalanceUsdt < amount {
-		return &v1.WithdrawReply{
-			Status: "fail",
-		}, nil
+	// 指定地址簿地址时打款到该地址，否则打款到登录地址
+	if 0 < req.SendBody.AddressId {
+		wa, reason, message, err := uuc.getWithdrawAddress(ctx, user.ID, req.SendBody.AddressId)
+		if nil != err {
+			return nil, err
+		} else if "" != reason {
+			return withdrawFail(reason, message), nil
+		}
+		address = wa.Address
 	}
+
 	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		// 锁定余额后再检查余额和每日上限，同一用户的并发提现在此排队
+		userBalance, err = uuc.ubRepo.GetUserBalanceForUpdate(ctx, user.ID)
+		if nil != err {
+			return err
+		}
+
+		if ("dhb" == req.SendBody.Type && userBalance.BalanceDhb < amount) ||
+			("usdt" == req.SendBody.Type && userBalance.BalanceUsdt < amount) {
+			failReply = withdrawFail(WithdrawReasonInsufficientBalance, "余额不足")
+			return nil
+		}
+
+		if reason, message, err := uuc.checkWithdrawLimit(ctx, user.ID, req.SendBody.Type, amount, rule); nil != err {
+			return err
+		} else if "" != reason {
+			failReply = withdrawFail(reason, message)
+			return nil
+		}
 
 		if "usdt" == req.SendBody.Type {
 			err = uuc.ubRepo.WithdrawUsdt(ctx, user.ID, amount) // 提现
 			if nil != err {
 				return err
 			}
-			_, err = uuc.ubRepo.GreateWithdraw(ctx, user.ID, amount, req.SendBody.Type)
-			if nil != err {
-				return err
-			}
-
 		} else if "dhb" == req.SendBody.Type {
 			err = uuc.ubRepo.WithdrawDhb(ctx, user.ID, amount) // 提现
 			if nil != err {
 				return err
 			}
-			_, err = uuc.ubRepo.GreateWithdraw(ctx, user.ID, amount, req.SendBody.Type)
+		}
+
+		withdraw, err = uuc.ubRepo.GreateWithdraw(ctx, user.ID, amount, relAmount, req.SendBody.Type, address)
+		if nil != err {
+			return err
+		}
+
+		if 0 < fee {
+			err = uuc.ubRepo.SystemFee(ctx, fee, req.SendBody.Type, withdraw.ID) // 手续费
 			if nil != err {
 				return err
 			}
@@ -971,9 +1055,14 @@ func (uuc *UserUseCase) Withdraw(ctx context.Context, req *v1.WithdrawRequest, u
 	}); nil != err {
 		return nil, err
 	}
+	if nil != failReply {
+		return failReply, nil
+	}
 
 	return &v1.WithdrawReply{
-		Status: "ok",
+		Status:    "ok",
+		Fee:       BalanceMoney(fee).Format(2),
+		RelAmount: BalanceMoney(relAmount).Format(2),
 	}, nil
 }
 
@@ -983,14 +1072,13 @@ func (uuc *UserUseCase) SetBalanceReward(ctx context.Context, req *v1.SetBalance
 		userBalance *UserBalance
 	)
 
-	amountFloat, _ := strconv.ParseFloat(req.SendBody.Amount, 10)
-	amountFloat *= 10000000000
-	amount, _ := strconv.ParseInt(strconv.FormatFloat(amountFloat, 'f', -1, 64), 10, 64)
-	if 0 >= amount {
+	amountMoney, err := ParseMoney(req.SendBody.Amount, MoneyScaleBalance)
+	if nil != err || 0 >= amountMoney.Amount {
 		return &v1.SetBalanceRewardReply{
 			Status: "fail",
 		}, nil
 	}
+	amount := amountMoney.Amount
 
 	userBalance, err = uuc.ubRepo.GetUserBalance(ctx, user.ID)
 	if nil != err {
@@ -1026,14 +1114,13 @@ func (uuc *UserUseCase) DeleteBalanceReward(ctx context.Context, req *v1.DeleteB
 		balanceRewards []*BalanceReward
 	)
 
-	amountFloat, _ := strconv.ParseFloat(req.SendBody.Amount, 10)
-	amountFloat *= 10000000000
-	amount, _ := strconv.ParseInt(strconv.FormatFloat(amountFloat, 'f', -1, 64), 10, 64)
-	if 0 >= amount {
+	amountMoney, err := ParseMoney(req.SendBody.Amount, MoneyScaleBalance)
+	if nil != err || 0 >= amountMoney.Amount {
 		return &v1.DeleteBalanceRewardReply{
 			Status: "fail",
 		}, nil
 	}
+	amount := amountMoney.Amount
 
 	balanceRewards, err = uuc.ubRepo.GetBalanceRewardByUserId(ctx, user.ID)
 	if nil != err {
@@ -1114,14 +1201,6 @@ func (uuc *UserUseCase) AdminLocationList(ctx context.Context, req *v1.AdminLoca
 
 }
 
-func (uuc *UserUseCase) AdminRecommendList(ctx context.Context, req *v1.AdminUserRecommendRequest) (*v1.AdminUserRecommendReply, error) {
-	res := &v1.AdminUserRecommendReply{
-		Users: make([]*v1.AdminUserRecommendReply_List, 0),
-	}
-
-	return res, nil
-}
-
 func (uuc *UserUseCase) AdminMonthRecommend(ctx context.Context, req *v1.AdminMonthRecommendRequest) (*v1.AdminMonthRecommendReply, error) {
 
 	res := &v1.AdminMonthRecommendReply{
@@ -1143,18 +1222,6 @@ func (uuc *UserUseCase) AdminConfigUpdate(ctx context.Context, req *v1.AdminConf
 	return res, nil
 }
 
-func (uuc *UserUseCase) GetWithdrawPassOrRewardedList(ctx context.Context) ([]*Withdraw, error) {
-	return uuc.ubRepo.GetWithdrawPassOrRewarded(ctx)
-}
-
-func (uuc *UserUseCase) UpdateWithdrawDoing(ctx context.Context, id int64) (*Withdraw, error) {
-	return uuc.ubRepo.UpdateWithdraw(ctx, id, "doing")
-}
-
-func (uuc *UserUseCase) UpdateWithdrawSuccess(ctx context.Context, id int64) (*Withdraw, error) {
-	return uuc.ubRepo.UpdateWithdraw(ctx, id, "success")
-}
-
 func (uuc *UserUseCase) AdminWithdrawList(ctx context.Context, req *v1.AdminWithdrawListRequest) (*v1.AdminWithdrawListReply, error) {
 	res := &v1.AdminWithdrawListReply{
 		Withdraw: make([]*v1.AdminWithdrawListReply_List, 0),
@@ -1172,7 +1239,3 @@ func (uuc *UserUseCase) AdminAll(ctx context.Context, req *v1.AdminAllRequest) (
 
 	return &v1.AdminAllReply{}, nil
 }
-
-func (uuc *UserUseCase) AdminWithdraw(ctx context.Context, req *v1.AdminWithdrawRequest) (*v1.AdminWithdrawReply, error) {
-	return &v1.AdminWithdrawReply{}, nil
-}
@@ -0,0 +1,337 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"github.com/go-kratos/kratos/v2/errors"
+	"time"
+)
+
+// 等级变更的来源
+const (
+	UserLevelSourceAuto           = "auto"            // 定时任务按业绩评定
+	UserLevelSourceOverride       = "override"        // 后台设定或取消设定
+	UserLevelSourceOverrideExpire = "override_expire" // 后台设定到期
+)
+
+// 后台设定的状态
+const (
+	UserLevelOverrideActive  = "active"
+	UserLevelOverrideExpired = "expired"
+	UserLevelOverrideRevoked = "revoked"
+)
+
+const (
+	vipLevelBatchSize           = 100
+	userLevelHistoryDefaultSize = 20
+	userLevelHistoryMaxSize     = 100
+)
+
+// UserLevelHistory 等级变更记录，同时作为等级变更事件，按 id 递增拉取
+type UserLevelHistory struct {
+	ID            int64
+	UserId        int64
+	OldLevel      int64
+	NewLevel      int64
+	AutoLevel     int64
+	OverrideLevel int64
+	AreaAmount    int64
+	RecommendNum  int64
+	Source        string
+	CreatedAt     time.Time
+}
+
+// UserLevelOverride 后台设定的等级，ExpireAt 为零值时不过期
+type UserLevelOverride struct {
+	ID        int64
+	UserId    int64
+	Level     int64
+	ExpireAt  time.Time
+	Status    string
+	Reason    string
+	CreatedAt time.Time
+}
+
+type UserLevelRepo interface {
+	CreateUserLevelHistory(ctx context.Context, h *UserLevelHistory) error
+	GetUserLevelHistories(ctx context.Context, userId int64, afterId int64, limit int) ([]*UserLevelHistory, error)
+	CreateUserLevelOverride(ctx context.Context, o *UserLevelOverride) (*UserLevelOverride, error)
+	RevokeUserLevelOverrides(ctx context.Context, userId int64) error
+	GetExpiredUserLevelOverrides(ctx context.Context, now time.Time, limit int) ([]*UserLevelOverride, error)
+	ExpireUserLevelOverride(ctx context.Context, id int64) (bool, error)
+}
+
+// UserLevelEvaluation 一个用户的等级评定结果
+type UserLevelEvaluation struct {
+	UserId        int64
+	AreaAmount    int64 // 小区业绩
+	RecommendNum  int64 // 有自身业绩的直推人数
+	AutoLevel     int64
+	OverrideLevel int64 // 后台设定的等级，即 user_area.level
+}
+
+// Level 后台设定的等级优先
+func (e *UserLevelEvaluation) Level() int64 {
+	if 0 < e.OverrideLevel {
+		return e.OverrideLevel
+	}
+	return e.AutoLevel
+}
+
+// evaluateUserLevel 按小区业绩和有效直推人数评定等级，结算试算和定时任务共用
+func evaluateUserLevel(ctx context.Context, urRepo UserRecommendRepo, rule *TeamRewardRule, userId int64) (*UserLevelEvaluation, error) {
+	res := &UserLevelEvaluation{UserId: userId}
+
+	userArea, _ := urRepo.GetUserArea(ctx, userId)
+	if nil != userArea {
+		res.OverrideLevel = userArea.Level
+	}
+
+	myRecommendUsers, err := urRepo.GetUserRecommendDownlines(ctx, userId, 1)
+	if nil != err {
+		return nil, err
+	}
+	myRecommendUserIds := make([]int64, 0, len(myRecommendUsers))
+	for _, v := range myRecommendUsers {
+		myRecommendUserIds = append(myRecommendUserIds, v.DescendantId)
+	}
+	if 0 < len(myRecommendUserIds) {
+		userAreas, err := urRepo.GetUserAreas(ctx, myRecommendUserIds)
+		if nil != err {
+			return nil, err
+		}
+		res.AreaAmount = SmallAreaAmount(userAreas)
+		for _, v := range userAreas {
+			if 0 < v.SelfAmount {
+				res.RecommendNum++
+			}
+		}
+	}
+	res.AutoLevel = rule.VipLevel(res.AreaAmount, res.RecommendNum)
+
+	return res, nil
+}
+
+// getVipLevelRule 当前配置的等级规则
+func (uuc *UserUseCase) getVipLevelRule(ctx context.Context) (*TeamRewardRule, error) {
+	configs, err := uuc.configRepo.GetConfigByKeys(ctx, teamRewardConfigKeys()...)
+	if nil != err {
+		return nil, err
+	}
+	return parseTeamRewardRule(configs)
+}
+
+// applyUserLevel 重新评定用户的等级，与保存的等级不同时更新 user_info.vip 并写入变更记录；返回是否有变化
+func (uuc *UserUseCase) applyUserLevel(ctx context.Context, rule *TeamRewardRule, userId int64, source string) (bool, error) {
+	evaluation, err := evaluateUserLevel(ctx, uuc.urRepo, rule, userId)
+	if nil != err {
+		return false, err
+	}
+	userInfo, err := uuc.uiRepo.GetUserInfoByUserId(ctx, userId)
+	if nil != err {
+		return false, err
+	}
+
+	level := evaluation.Level()
+	if level == userInfo.Vip {
+		return false, nil
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		updated, err := uuc.uiRepo.UpdateUserInfoVip(ctx, userId, userInfo.Vip, level)
+		if nil != err {
+			return err
+		}
+		if !updated {
+			return errors.New(500, "VIP_LEVEL_ERROR", "等级已被修改")
+		}
+
+		return uuc.ulRepo.CreateUserLevelHistory(ctx, &UserLevelHistory{
+			UserId:        userId,
+			OldLevel:      userInfo.Vip,
+			NewLevel:      level,
+			AutoLevel:     evaluation.AutoLevel,
+			OverrideLevel: evaluation.OverrideLevel,
+			AreaAmount:    evaluation.AreaAmount,
+			RecommendNum:  evaluation.RecommendNum,
+			Source:        source,
+		})
+	}); nil != err {
+		return false, err
+	}
+
+	uuc.log.Infof("vip level changed: user=%d %d->%d source=%s", userId, userInfo.Vip, level, source)
+	return true, nil
+}
+
+// VipLevelEvaluate 等级评定，先处理到期的后台设定，再逐个评定有区业绩记录的用户；返回等级有变化的用户数
+func (uuc *UserUseCase) VipLevelEvaluate(ctx context.Context, now time.Time) (int64, error) {
+	rule, err := uuc.getVipLevelRule(ctx)
+	if nil != err {
+		return 0, err
+	}
+
+	var changed int64
+	for {
+		overrides, err := uuc.ulRepo.GetExpiredUserLevelOverrides(ctx, now, vipLevelBatchSize)
+		if nil != err {
+			return changed, err
+		}
+
+		for _, v := range overrides {
+			var expired bool
+			if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error {
+				expired, err = uuc.ulRepo.ExpireUserLevelOverride(ctx, v.ID)
+				if nil != err || !expired {
+					return err
+				}
+				return uuc.urRepo.UpdateUserAreaLevel(ctx, v.UserId, 0)
+			}); nil != err {
+				return changed, err
+			}
+			if !expired {
+				continue
+			}
+
+			ok, err := uuc.applyUserLevel(ctx, rule, v.UserId, UserLevelSourceOverrideExpire)
+			if nil != err {
+				return changed, err
+			}
+			if ok {
+				changed++
+			}
+		}
+
+		if len(overrides) < vipLevelBatchSize {
+			break
+		}
+	}
+
+	var lastUserId int64
+	for {
+		userIds, err := uuc.urRepo.GetUserAreaUserIds(ctx, lastUserId, vipLevelBatchSize)
+		if nil != err {
+			return changed, err
+		}
+
+		for _, userId := range userIds {
+			lastUserId = userId
+			ok, err := uuc.applyUserLevel(ctx, rule, userId, UserLevelSourceAuto)
+			if nil != err {
+				return changed, err
+			}
+			if ok {
+				changed++
+			}
+		}
+
+		if len(userIds) < vipLevelBatchSize {
+			break
+		}
+	}
+
+	return changed, nil
+}
+
+// AdminUserLevelOverride 后台设定用户等级，level 为 0 时取消设定；expire_at 为北京时间，为空时不过期；
+// 之前的设定作废，设定后立即重新评定
+func (uuc *UserUseCase) AdminUserLevelOverride(ctx context.Context, req *v1.AdminUserLevelOverrideRequest) (*v1.AdminUserLevelOverrideReply, error) {
+	userId := req.SendBody.UserId
+	level := req.SendBody.Level
+	if 0 > level || int64(len(areaLevelKeys)) < level {
+		return nil, errors.New(500, "VIP_LEVEL_ERROR", "等级错误")
+	}
+
+	now := time.Now().UTC()
+	var expireAt time.Time
+	if "" != req.SendBody.ExpireAt {
+		tmpExpireAt, err := time.Parse("2006-01-02 15:04:05", req.SendBody.ExpireAt)
+		if nil != err {
+			return nil, errors.New(500, "VIP_LEVEL_ERROR", "过期时间格式错误")
+		}
+		expireAt = tmpExpireAt.Add(-8 * time.Hour)
+		if !expireAt.After(now) {
+			return nil, errors.New(500, "VIP_LEVEL_ERROR", "过期时间必须晚于当前时间")
+		}
+	}
+
+	if _, err := uuc.urRepo.GetUserArea(ctx, userId); nil != err {
+		return nil, err
+	}
+	rule, err := uuc.getVipLevelRule(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		if err := uuc.ulRepo.RevokeUserLevelOverrides(ctx, userId); nil != err {
+			return err
+		}
+		if 0 < level {
+			if _, err := uuc.ulRepo.CreateUserLevelOverride(ctx, &UserLevelOverride{
+				UserId:   userId,
+				Level:    level,
+				ExpireAt: expireAt,
+				Status:   UserLevelOverrideActive,
+				Reason:   req.SendBody.Reason,
+			}); nil != err {
+				return err
+			}
+		}
+		return uuc.urRepo.UpdateUserAreaLevel(ctx, userId, level)
+	}); nil != err {
+		return nil, err
+	}
+
+	if _, err = uuc.applyUserLevel(ctx, rule, userId, UserLevelSourceOverride); nil != err {
+		return nil, err
+	}
+	userInfo, err := uuc.uiRepo.GetUserInfoByUserId(ctx, userId)
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminUserLevelOverrideReply{
+		Level: userInfo.Vip,
+	}, nil
+}
+
+// AdminUserLevelHistory 等级变更记录，按 id 正序从 after_id 之后拉取；user_id 为 0 时为全部用户
+func (uuc *UserUseCase) AdminUserLevelHistory(ctx context.Context, req *v1.AdminUserLevelHistoryRequest) (*v1.AdminUserLevelHistoryReply, error) {
+	limit := userLevelHistoryDefaultSize
+	if 0 < req.Limit {
+		limit = int(req.Limit)
+	}
+	if userLevelHistoryMaxSize < limit {
+		limit = userLevelHistoryMaxSize
+	}
+
+	histories, err := uuc.ulRepo.GetUserLevelHistories(ctx, req.UserId, req.AfterId, limit)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.AdminUserLevelHistoryReply{
+		Histories: make([]*v1.AdminUserLevelHistoryReply_List, 0, len(histories)),
+	}
+	for _, v := range histories {
+		res.Histories = append(res.Histories, &v1.AdminUserLevelHistoryReply_List{
+			Id:            v.ID,
+			UserId:        v.UserId,
+			OldLevel:      v.OldLevel,
+			NewLevel:      v.NewLevel,
+			AutoLevel:     v.AutoLevel,
+			OverrideLevel: v.OverrideLevel,
+			AreaAmount:    AreaMoney(v.AreaAmount).Format(2),
+			RecommendNum:  v.RecommendNum,
+			Source:        v.Source,
+			CreatedAt:     v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+		res.LastId = v.ID
+	}
+	if 0 == res.LastId {
+		res.LastId = req.AfterId
+	}
+
+	return res, nil
+}
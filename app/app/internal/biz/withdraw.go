@@ -0,0 +1,548 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 提现状态，requested → approved/rejected/cancelled → broadcasting → confirmed/failed，
+// failed 可由管理员重新提交审核（→ requested）或退回余额（→ refunded）
+const (
+	WithdrawStatusRequested    = "requested"    // 已申请，余额已扣除
+	WithdrawStatusCancelled    = "cancelled"    // 用户取消，余额已退回
+	WithdrawStatusApproved     = "approved"     // 审核通过，等待打款
+	WithdrawStatusRejected     = "rejected"     // 审核拒绝，余额已退回
+	WithdrawStatusBroadcasting = "broadcasting" // 打款交易已广播
+	WithdrawStatusConfirmed    = "confirmed"    // 打款交易已确认
+	WithdrawStatusFailed       = "failed"       // 打款交易失败或提现无法打款
+	WithdrawStatusRefunded     = "refunded"     // 打款失败后退回余额
+)
+
+// withdrawTransitions 目标状态和允许的来源状态，历史数据的空状态视为 requested
+var withdrawTransitions = map[string][]string{
+	WithdrawStatusRequested:    {WithdrawStatusFailed},
+	WithdrawStatusApproved:     {"", WithdrawStatusRequested},
+	WithdrawStatusRejected:     {"", WithdrawStatusRequested},
+	WithdrawStatusCancelled:    {"", WithdrawStatusRequested},
+	WithdrawStatusBroadcasting: {WithdrawStatusApproved},
+	WithdrawStatusConfirmed:    {WithdrawStatusBroadcasting},
+	WithdrawStatusFailed:       {WithdrawStatusApproved, WithdrawStatusBroadcasting},
+	WithdrawStatusRefunded:     {WithdrawStatusFailed},
+}
+
+// 旧版提现状态，由 AdminWithdrawLegacyMigrate 迁移到新状态
+const (
+	withdrawStatusLegacyPass     = "pass"     // 已审核，待打款
+	withdrawStatusLegacyRewarded = "rewarded" // 已审核，待打款
+	withdrawStatusLegacyDoing    = "doing"    // 打款中，没有记录交易
+	withdrawStatusLegacySuccess  = "success"  // 已打款
+)
+
+// legacyWithdrawStatuses 旧版状态和迁移后的状态；doing 的打款结果未知，标记为失败，由管理员核实链上记录后重新提交或退回
+var legacyWithdrawStatuses = []struct {
+	from   string
+	to     string
+	reason string
+}{
+	{withdrawStatusLegacyPass, WithdrawStatusApproved, ""},
+	{withdrawStatusLegacyRewarded, WithdrawStatusApproved, ""},
+	{withdrawStatusLegacyDoing, WithdrawStatusFailed, "旧版打款中，结果未知，请核实链上记录"},
+	{withdrawStatusLegacySuccess, WithdrawStatusConfirmed, ""},
+}
+
+// WithdrawStatusFrom 可以转换到 status 的来源状态，没有时说明不允许转换到 status
+func WithdrawStatusFrom(status string) []string {
+	return withdrawTransitions[status]
+}
+
+// AdminWithdrawApprove 审核通过
+func (uuc *UserUseCase) AdminWithdrawApprove(ctx context.Context, req *v1.AdminWithdrawApproveRequest) (*v1.AdminWithdrawApproveReply, error) {
+	if nil == req.SendBody || 0 >= req.SendBody.Id {
+		return nil, errors.New(500, "WITHDRAW_ERROR", "提现记录参数错误")
+	}
+
+	if _, err := uuc.ubRepo.UpdateWithdraw(ctx, req.SendBody.Id, WithdrawStatusApproved); nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminWithdrawApproveReply{Status: "ok"}, nil
+}
+
+// AdminWithdrawReject 审核拒绝，退回余额
+func (uuc *UserUseCase) AdminWithdrawReject(ctx context.Context, req *v1.AdminWithdrawRejectRequest) (*v1.AdminWithdrawRejectReply, error) {
+	if nil == req.SendBody || 0 >= req.SendBody.Id {
+		return nil, errors.New(500, "WITHDRAW_ERROR", "提现记录参数错误")
+	}
+	reason := strings.TrimSpace(req.SendBody.Reason)
+	if "" == reason {
+		return nil, errors.New(500, "WITHDRAW_ERROR", "请填写拒绝原因")
+	}
+
+	if err := uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		withdraw, err := uuc.ubRepo.RejectWithdraw(ctx, req.SendBody.Id, reason)
+		if nil != err {
+			return err
+		}
+
+		return uuc.withdrawRefund(ctx, withdraw, "withdraw_refund")
+	}); nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminWithdrawRejectReply{Status: "ok"}, nil
+}
+
+// CancelWithdraw 用户取消未审核的提现，退回余额
+func (uuc *UserUseCase) CancelWithdraw(ctx context.Context, req *v1.CancelWithdrawRequest, user *User) (*v1.CancelWithdrawReply, error) {
+	if nil == req.SendBody || 0 >= req.SendBody.Id {
+		return nil, errors.New(500, "WITHDRAW_ERROR", "提现记录参数错误")
+	}
+
+	if err := uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		// 锁定余额和提现记录，和提现、审核的状态条件更新互斥
+		withdraw, err := uuc.ubRepo.CancelWithdraw(ctx, req.SendBody.Id, user.ID)
+		if nil != err {
+			return err
+		}
+
+		return uuc.withdrawRefund(ctx, withdraw, "withdraw_cancel")
+	}); nil != err {
+		return nil, err
+	}
+
+	return &v1.CancelWithdrawReply{Status: "ok"}, nil
+}
+
+// AdminWithdrawRetry 打款失败的提现重新提交审核，原交易不会再上链时才允许
+func (uuc *UserUseCase) AdminWithdrawRetry(ctx context.Context, req *v1.AdminWithdrawRetryRequest) (*v1.AdminWithdrawRetryReply, error) {
+	if nil == req.SendBody || 0 >= req.SendBody.Id {
+		return nil, errors.New(500, "WITHDRAW_ERROR", "提现记录参数错误")
+	}
+
+	if err := uuc.checkWithdrawFailedFinal(ctx, req.SendBody.Id); nil != err {
+		return nil, err
+	}
+	if _, err := uuc.ubRepo.UpdateWithdraw(ctx, req.SendBody.Id, WithdrawStatusRequested); nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminWithdrawRetryReply{Status: "ok"}, nil
+}
+
+// AdminWithdrawRefund 打款失败的提现退回余额，原交易不会再上链时才允许
+func (uuc *UserUseCase) AdminWithdrawRefund(ctx context.Context, req *v1.AdminWithdrawRefundRequest) (*v1.AdminWithdrawRefundReply, error) {
+	if nil == req.SendBody || 0 >= req.SendBody.Id {
+		return nil, errors.New(500, "WITHDRAW_ERROR", "提现记录参数错误")
+	}
+
+	if err := uuc.checkWithdrawFailedFinal(ctx, req.SendBody.Id); nil != err {
+		return nil, err
+	}
+	if err := uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		withdraw, err := uuc.ubRepo.UpdateWithdraw(ctx, req.SendBody.Id, WithdrawStatusRefunded)
+		if nil != err {
+			return err
+		}
+
+		return uuc.withdrawRefund(ctx, withdraw, "withdraw_refund")
+	}); nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminWithdrawRefundReply{Status: "ok"}, nil
+}
+
+// checkWithdrawFailedFinal 失败的提现已签名的交易不会再上链：没有交易、已上链但执行失败、或 nonce 已被其他交易使用
+func (uuc *UserUseCase) checkWithdrawFailedFinal(ctx context.Context, id int64) error {
+	withdraw, err := uuc.ubRepo.GetWithdrawById(ctx, id)
+	if nil != err {
+		return err
+	}
+	if WithdrawStatusFailed != withdraw.Status {
+		return errors.New(500, "WITHDRAW_STATUS_ERROR", "当前提现状态不允许该操作")
+	}
+	if "" == withdraw.TxHash {
+		return nil
+	}
+
+	confirmedNonce, err := uuc.payoutRepo.GetConfirmedNonce(ctx)
+	if nil != err {
+		return err
+	}
+	found, success, err := uuc.payoutRepo.GetTransferReceipt(ctx, withdraw.TxHash)
+	if nil != err {
+		return err
+	}
+	if found && success {
+		return errors.New(500, "WITHDRAW_STATUS_ERROR", "原打款交易已成功上链，请核实")
+	}
+	if !found && confirmedNonce <= withdraw.TxNonce {
+		return errors.New(500, "WITHDRAW_STATUS_ERROR", "原打款交易仍可能上链，请等待其 nonce 被使用后再处理")
+	}
+
+	return nil
+}
+
+// AdminWithdrawLegacyMigrate 旧版状态的提现记录迁移到新状态，可重复执行；返回迁移的条数
+func (uuc *UserUseCase) AdminWithdrawLegacyMigrate(ctx context.Context, req *v1.AdminWithdrawLegacyMigrateRequest) (*v1.AdminWithdrawLegacyMigrateReply, error) {
+	var count int64
+	if err := uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		for _, v := range legacyWithdrawStatuses {
+			updated, err := uuc.ubRepo.UpdateWithdrawLegacyStatus(ctx, v.from, v.to, v.reason)
+			if nil != err {
+				return err
+			}
+			count += updated
+		}
+		return nil
+	}); nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminWithdrawLegacyMigrateReply{
+		Count: count,
+	}, nil
+}
+
+// withdrawRefund 退回提现扣除的全部金额并冲回手续费，需在事务中调用
+func (uuc *UserUseCase) withdrawRefund(ctx context.Context, withdraw *Withdraw, recordType string) error {
+	if _, err := uuc.ubRepo.WithdrawRefund(ctx, withdraw.UserId, withdraw.Amount, withdraw.Type, recordType); nil != err {
+		return err
+	}
+
+	if 0 < withdraw.RelAmount && withdraw.RelAmount < withdraw.Amount {
+		return uuc.ubRepo.SystemFee(ctx, withdraw.RelAmount-withdraw.Amount, withdraw.Type, withdraw.ID)
+	}
+
+	return nil
+}
+
+// AdminWithdrawBulkApprove 批量审核通过，逐条处理，返回成功和失败的记录
+func (uuc *UserUseCase) AdminWithdrawBulkApprove(ctx context.Context, req *v1.AdminWithdrawBulkApproveRequest) (*v1.AdminWithdrawBulkApproveReply, error) {
+	res := &v1.AdminWithdrawBulkApproveReply{
+		Approved: make([]int64, 0),
+		Failed:   make([]*v1.AdminWithdrawBulkApproveReply_Fail, 0),
+	}
+	if nil == req.SendBody || 0 == len(req.SendBody.Ids) {
+		return res, nil
+	}
+
+	for _, id := range req.SendBody.Ids {
+		if _, err := uuc.ubRepo.UpdateWithdraw(ctx, id, WithdrawStatusApproved); nil != err {
+			res.Failed = append(res.Failed, &v1.AdminWithdrawBulkApproveReply_Fail{
+				Id:     id,
+				Reason: errors.FromError(err).Message,
+			})
+			continue
+		}
+		res.Approved = append(res.Approved, id)
+	}
+
+	return res, nil
+}
+
+// LockGlobalWithdraw .
+func (uuc *UserUseCase) LockGlobalWithdraw(ctx context.Context) (bool, error) {
+	return uuc.locationRepo.LockGlobalWithdraw(ctx)
+}
+
+// UnLockGlobalWithdraw .
+func (uuc *UserUseCase) UnLockGlobalWithdraw(ctx context.Context) (bool, error) {
+	return uuc.locationRepo.UnLockGlobalWithdraw(ctx)
+}
+
+// PayoutTransfer 已签名的 BEP-20 transfer 打款交易
+type PayoutTransfer struct {
+	TxHash string
+	Nonce  int64
+	Raw    string // 签名后的原始交易，未上链时重新广播
+}
+
+// PayoutResult 一次打款处理的结果
+type PayoutResult struct {
+	Broadcast int64
+	Confirmed int64
+	Failed    int64
+}
+
+// PayoutReasonWithdrawInvalid 提现记录本身无法打款（收款地址错误、币种合约未配置、金额错误）时 SignTransfer 返回的错误原因
+const PayoutReasonWithdrawInvalid = "PAYOUT_WITHDRAW_INVALID"
+
+type PayoutRepo interface {
+	SignTransfer(ctx context.Context, coinType string, to string, amount int64) (*PayoutTransfer, error)
+	SendTransfer(ctx context.Context, transfer *PayoutTransfer) error
+	GetTransferReceipt(ctx context.Context, txHash string) (bool, bool, error)
+	GetConfirmedNonce(ctx context.Context) (int64, error)
+}
+
+// Payout 更新已广播打款的结果，再为已审核的提现签名并广播打款交易，同一时间只能有一个调用
+func (uuc *UserUseCase) Payout(ctx context.Context, batchSize int) (*PayoutResult, error) {
+	res := &PayoutResult{}
+	if err := uuc.payoutCheck(ctx, res); nil != err {
+		return res, err
+	}
+
+	withdraws, err := uuc.ubRepo.GetWithdrawsByStatus(ctx, WithdrawStatusApproved, batchSize)
+	if nil != err {
+		return res, err
+	}
+
+	for _, v := range withdraws {
+		amount := v.RelAmount // 扣除手续费后的到账金额
+		if 0 >= amount {
+			amount = v.Amount
+		}
+
+		address := v.Address
+		if "" == address {
+			user, err := uuc.repo.GetUserById(ctx, v.UserId)
+			if nil != err {
+				return res, err
+			}
+			address = user.Address
+		}
+
+		transfer, err := uuc.payoutRepo.SignTransfer(ctx, v.Type, address, amount)
+		if nil != err {
+			if PayoutReasonWithdrawInvalid != errors.Reason(err) {
+				return res, err
+			}
+			// 提现记录本身无法打款的标记失败，不阻塞后面的提现，由管理员核实后处理
+			uuc.log.Errorf("withdraw %d sign: %v", v.ID, err)
+			if _, err = uuc.ubRepo.FailWithdraw(ctx, v.ID, "签名失败："+errors.FromError(err).Message); nil != err {
+				return res, err
+			}
+			res.Failed++
+			continue
+		}
+
+		// 先记录交易哈希再广播，广播前中断的由 payoutCheck 重新广播
+		if _, err = uuc.ubRepo.UpdateWithdrawBroadcasting(ctx, v.ID, address, transfer); nil != err {
+			return res, err
+		}
+
+		if err = uuc.payoutRepo.SendTransfer(ctx, transfer); nil != err {
+			// 广播被节点拒绝，余额不退回，由管理员核实后处理
+			uuc.log.Errorf("withdraw %d broadcast %s: %v", v.ID, transfer.TxHash, err)
+			if _, err = uuc.ubRepo.FailWithdraw(ctx, v.ID, "广播失败："+errors.FromError(err).Message); nil != err {
+				return res, err
+			}
+			res.Failed++
+			continue
+		}
+		res.Broadcast++
+	}
+
+	return res, nil
+}
+
+// payoutCheck 查询已广播打款的交易回执，未上链的重新广播；nonce 已被其他交易使用的标记为失败
+func (uuc *UserUseCase) payoutCheck(ctx context.Context, res *PayoutResult) error {
+	withdraws, err := uuc.ubRepo.GetWithdrawsByStatus(ctx, WithdrawStatusBroadcasting, 0)
+	if nil != err {
+		return err
+	}
+	if 0 == len(withdraws) {
+		return nil
+	}
+
+	// 先取已确认 nonce 再查回执，避免查询之间交易上链被误判为 nonce 被占用
+	confirmedNonce, err := uuc.payoutRepo.GetConfirmedNonce(ctx)
+	if nil != err {
+		return err
+	}
+
+	for _, v := range withdraws {
+		found, success, err := uuc.payoutRepo.GetTransferReceipt(ctx, v.TxHash)
+		if nil != err {
+			return err
+		}
+
+		if !found && v.TxNonce < confirmedNonce {
+			uuc.log.Errorf("withdraw %d tx %s replaced: nonce %d confirmed %d", v.ID, v.TxHash, v.TxNonce, confirmedNonce)
+			if _, err = uuc.ubRepo.FailWithdraw(ctx, v.ID, "交易 nonce 已被其他交易使用"); nil != err {
+				return err
+			}
+			res.Failed++
+			continue
+		}
+
+		if !found {
+			if err = uuc.payoutRepo.SendTransfer(ctx, &PayoutTransfer{TxHash: v.TxHash, Nonce: v.TxNonce, Raw: v.TxRaw}); nil != err {
+				uuc.log.Warnf("withdraw %d rebroadcast %s: %v", v.ID, v.TxHash, err)
+			}
+			continue
+		}
+
+		if success {
+			if _, err = uuc.withdrawConfirm(ctx, v); nil != err {
+				return err
+			}
+			res.Confirmed++
+			continue
+		}
+
+		if _, err = uuc.ubRepo.FailWithdraw(ctx, v.ID, "交易执行失败"); nil != err {
+			return err
+		}
+		res.Failed++
+	}
+
+	return nil
+}
+
+// withdrawConfirm 打款已上链，更新状态并记账
+func (uuc *UserUseCase) withdrawConfirm(ctx context.Context, withdraw *Withdraw) (*Withdraw, error) {
+	var res *Withdraw
+	if err := uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		var err error
+		res, err = uuc.ubRepo.UpdateWithdraw(ctx, withdraw.ID, WithdrawStatusConfirmed)
+		if nil != err {
+			return err
+		}
+
+		return uuc.ubRepo.WithdrawPayout(ctx, withdraw)
+	}); nil != err {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// 提现失败原因
+const (
+	WithdrawReasonInvalidType         = "invalid_type"
+	WithdrawReasonInvalidAmount       = "invalid_amount"
+	WithdrawReasonBelowMinimum        = "below_minimum"
+	WithdrawReasonFeeExceedsAmount    = "fee_exceeds_amount"
+	WithdrawReasonInsufficientBalance = "insufficient_balance"
+	WithdrawReasonCooldown            = "cooldown"
+	WithdrawReasonUserDailyLimit      = "user_daily_limit"
+	WithdrawReasonDailyLimit          = "daily_limit"
+	WithdrawReasonInvalidAddress      = "invalid_address"
+	WithdrawReasonAddressTimelock     = "address_timelock"
+)
+
+// WithdrawRule 提现规则，金额均为1e10精度，上限为 0 时不限制
+type WithdrawRule struct {
+	FeeRate      int64 // 手续费比例，万分比
+	FeeFixed     int64 // 固定手续费
+	Min          int64
+	UserDailyMax int64
+	DailyMax     int64
+	Cooldown     time.Duration // 同一用户两次提现的间隔
+}
+
+// Fee 手续费，比例部分加固定部分
+func (r *WithdrawRule) Fee(amount int64) int64 {
+	return amount/10000*r.FeeRate + amount%10000*r.FeeRate/10000 + r.FeeFixed
+}
+
+// getWithdrawRule 读取币种的提现配置，金额配置为实际单位，比例配置为百分比，例如 withdraw_fee_rate_usdt=0.5 即 0.5%
+func (uuc *UserUseCase) getWithdrawRule(ctx context.Context, coinType string) (*WithdrawRule, error) {
+	rule := &WithdrawRule{
+		Min: 100000000000, // 未配置时最低 10
+	}
+
+	configs, err := uuc.configRepo.GetConfigByKeys(ctx,
+		"withdraw_fee_rate_"+coinType,
+		"withdraw_fee_"+coinType,
+		"withdraw_min_"+coinType,
+		"withdraw_user_daily_max_"+coinType,
+		"withdraw_daily_max_"+coinType,
+		"withdraw_cooldown",
+	)
+	if nil != err {
+		return nil, err
+	}
+
+	for _, vConfig := range configs {
+		if "withdraw_cooldown" == vConfig.KeyName {
+			cooldown, _ := strconv.ParseInt(vConfig.Value, 10, 64)
+			rule.Cooldown = time.Duration(cooldown) * time.Second
+			continue
+		}
+
+		// 百分比按万分比解析，金额按余额精度解析，配置错误时按 0 处理
+		scale := MoneyScaleBalance
+		if "withdraw_fee_rate_"+coinType == vConfig.KeyName {
+			scale = MoneyScalePercent
+		}
+		value, err := ParseMoney(vConfig.Value, scale)
+		if nil != err {
+			uuc.log.Warnf("withdraw config %s invalid: %v", vConfig.KeyName, err)
+		}
+
+		switch vConfig.KeyName {
+		case "withdraw_fee_rate_" + coinType:
+			rule.FeeRate = value.Amount
+		case "withdraw_fee_" + coinType:
+			rule.FeeFixed = value.Amount
+		case "withdraw_min_" + coinType:
+			rule.Min = value.Amount
+		case "withdraw_user_daily_max_" + coinType:
+			rule.UserDailyMax = value.Amount
+		case "withdraw_daily_max_" + coinType:
+			rule.DailyMax = value.Amount
+		}
+	}
+
+	return rule, nil
+}
+
+// checkWithdrawLimit 检查提现间隔和每日上限，返回失败原因，每日按北京时间计算，需在锁定用户余额的事务中调用
+func (uuc *UserUseCase) checkWithdrawLimit(ctx context.Context, userId int64, coinType string, amount int64, rule *WithdrawRule) (string, string, error) {
+	now := time.Now().UTC()
+
+	if 0 < rule.Cooldown {
+		last, err := uuc.ubRepo.GetWithdrawLastByUserId(ctx, userId)
+		if nil != err && !errors.IsNotFound(err) {
+			return "", "", err
+		}
+		if nil != last && now.Before(last.CreatedAt.Add(rule.Cooldown)) {
+			return WithdrawReasonCooldown, fmt.Sprintf("请在%d秒后再提现", int64(last.CreatedAt.Add(rule.Cooldown).Sub(now).Seconds())+1), nil
+		}
+	}
+
+	local := now.Add(8 * time.Hour)
+	todayStart := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC).Add(-8 * time.Hour)
+
+	if 0 < rule.UserDailyMax {
+		total, err := uuc.ubRepo.GetWithdrawTotalSince(ctx, userId, coinType, todayStart)
+		if nil != err {
+			return "", "", err
+		}
+		if total+amount > rule.UserDailyMax {
+			return WithdrawReasonUserDailyLimit, "今日剩余可提现" + BalanceMoney(rule.UserDailyMax-total).Format(2), nil
+		}
+	}
+
+	if 0 < rule.DailyMax {
+		// 锁定币种后再统计全站总额，不同用户的并发提现在此排队
+		if err := uuc.ubRepo.LockWithdrawCoin(ctx, coinType); nil != err {
+			return "", "", err
+		}
+		total, err := uuc.ubRepo.GetWithdrawTotalSince(ctx, 0, coinType, todayStart)
+		if nil != err {
+			return "", "", err
+		}
+		if total+amount > rule.DailyMax {
+			return WithdrawReasonDailyLimit, "今日提现额度已满", nil
+		}
+	}
+
+	return "", "", nil
+}
+
+func withdrawFail(reason string, message string) *v1.WithdrawReply {
+	return &v1.WithdrawReply{
+		Status:  "fail",
+		Reason:  reason,
+		Message: message,
+	}
+}
@@ -0,0 +1,180 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-kratos/kratos/v2/errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 提现地址状态，新增的地址在生效时间前为 pending，不能用于提现
+const (
+	WithdrawAddressStatusPending = "pending"
+	WithdrawAddressStatusActive  = "active"
+	WithdrawAddressStatusDeleted = "deleted"
+)
+
+// 未配置 withdraw_address_timelock 时新地址 24 小时后生效
+const defaultWithdrawAddressTimelock = 24 * time.Hour
+
+type WithdrawAddress struct {
+	ID         int64
+	UserId     int64
+	Address    string
+	Label      string
+	Status     string
+	ActivateAt time.Time
+	CreatedAt  time.Time
+}
+
+// Usable 地址未删除且已过生效时间
+func (w *WithdrawAddress) Usable(now time.Time) bool {
+	return WithdrawAddressStatusDeleted != w.Status && !now.Before(w.ActivateAt)
+}
+
+// DisplayStatus 列表展示的状态
+func (w *WithdrawAddress) DisplayStatus(now time.Time) string {
+	if WithdrawAddressStatusDeleted == w.Status {
+		return WithdrawAddressStatusDeleted
+	}
+	if now.Before(w.ActivateAt) {
+		return WithdrawAddressStatusPending
+	}
+	return WithdrawAddressStatusActive
+}
+
+type WithdrawAddressRepo interface {
+	CreateWithdrawAddress(ctx context.Context, wa *WithdrawAddress) (*WithdrawAddress, error)
+	GetWithdrawAddressById(ctx context.Context, id int64) (*WithdrawAddress, error)
+	GetWithdrawAddressesByUserId(ctx context.Context, userId int64) ([]*WithdrawAddress, error)
+	DeleteWithdrawAddress(ctx context.Context, id int64, userId int64) error
+}
+
+// WithdrawAddressMessage 添加提现地址时登录钱包需要签名的原文
+func WithdrawAddressMessage(address string, nonce string) string {
+	return "DHB add withdraw address: " + strings.ToLower(address) + "\nnonce: " + nonce
+}
+
+// GetUserById .
+func (uuc *UserUseCase) GetUserById(ctx context.Context, id int64) (*User, error) {
+	return uuc.repo.GetUserById(ctx, id)
+}
+
+// WithdrawAddressList .
+func (uuc *UserUseCase) WithdrawAddressList(ctx context.Context, user *User) (*v1.WithdrawAddressListReply, error) {
+	addresses, err := uuc.waRepo.GetWithdrawAddressesByUserId(ctx, user.ID)
+	if nil != err {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	res := &v1.WithdrawAddressListReply{
+		List: make([]*v1.WithdrawAddressListReply_List, 0),
+	}
+	for _, v := range addresses {
+		res.List = append(res.List, &v1.WithdrawAddressListReply_List{
+			Id:         v.ID,
+			Address:    v.Address,
+			Label:      v.Label,
+			Status:     v.DisplayStatus(now),
+			ActivateAt: v.ActivateAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+			CreatedAt:  v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return res, nil
+}
+
+// AddWithdrawAddress 添加提现地址，调用前需校验登录钱包对 WithdrawAddressMessage 的签名，地址在时间锁到期后生效
+func (uuc *UserUseCase) AddWithdrawAddress(ctx context.Context, req *v1.AddWithdrawAddressRequest, user *User) (*v1.AddWithdrawAddressReply, error) {
+	if !common.IsHexAddress(req.SendBody.Address) {
+		return nil, errors.New(500, "WITHDRAW_ADDRESS_ERROR", "提现地址错误")
+	}
+	address := strings.ToLower(req.SendBody.Address)
+
+	addresses, err := uuc.waRepo.GetWithdrawAddressesByUserId(ctx, user.ID)
+	if nil != err {
+		return nil, err
+	}
+	for _, v := range addresses {
+		if WithdrawAddressStatusDeleted != v.Status && address == v.Address {
+			return nil, errors.New(500, "WITHDRAW_ADDRESS_ERROR", "提现地址已存在")
+		}
+	}
+
+	timelock, err := uuc.getWithdrawAddressTimelock(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	wa, err := uuc.waRepo.CreateWithdrawAddress(ctx, &WithdrawAddress{
+		UserId:     user.ID,
+		Address:    address,
+		Label:      req.SendBody.Label,
+		Status:     WithdrawAddressStatusActive,
+		ActivateAt: time.Now().UTC().Add(timelock),
+	})
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.AddWithdrawAddressReply{
+		Id:         wa.ID,
+		ActivateAt: wa.ActivateAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+	}, nil
+}
+
+// DeleteWithdrawAddress 删除立即生效，已申请的提现不受影响
+func (uuc *UserUseCase) DeleteWithdrawAddress(ctx context.Context, req *v1.DeleteWithdrawAddressRequest, user *User) (*v1.DeleteWithdrawAddressReply, error) {
+	if err := uuc.waRepo.DeleteWithdrawAddress(ctx, req.SendBody.Id, user.ID); nil != err {
+		return nil, err
+	}
+
+	return &v1.DeleteWithdrawAddressReply{
+		Status: "ok",
+	}, nil
+}
+
+// getWithdrawAddressTimelock 新地址的生效等待时间，配置 withdraw_address_timelock 单位为秒
+func (uuc *UserUseCase) getWithdrawAddressTimelock(ctx context.Context) (time.Duration, error) {
+	configs, err := uuc.configRepo.GetConfigByKeys(ctx, "withdraw_address_timelock")
+	if nil != err {
+		return 0, err
+	}
+
+	timelock := defaultWithdrawAddressTimelock
+	for _, vConfig := range configs {
+		if "withdraw_address_timelock" == vConfig.KeyName {
+			seconds, err := strconv.ParseInt(vConfig.Value, 10, 64)
+			if nil == err && 0 <= seconds {
+				timelock = time.Duration(seconds) * time.Second
+			}
+		}
+	}
+
+	return timelock, nil
+}
+
+// getWithdrawAddress 提现使用的地址簿地址，不属于用户或未生效时返回失败原因
+func (uuc *UserUseCase) getWithdrawAddress(ctx context.Context, userId int64, id int64) (*WithdrawAddress, string, string, error) {
+	wa, err := uuc.waRepo.GetWithdrawAddressById(ctx, id)
+	if nil != err {
+		if errors.IsNotFound(err) {
+			return nil, WithdrawReasonInvalidAddress, "提现地址不存在", nil
+		}
+		return nil, "", "", err
+	}
+
+	if wa.UserId != userId || WithdrawAddressStatusDeleted == wa.Status {
+		return nil, WithdrawReasonInvalidAddress, "提现地址不存在", nil
+	}
+
+	if !wa.Usable(time.Now().UTC()) {
+		return nil, WithdrawReasonAddressTimelock, "提现地址将于" + wa.ActivateAt.Add(8*time.Hour).Format("2006-01-02 15:04:05") + "生效", nil
+	}
+
+	return wa, "", "", nil
+}
@@ -0,0 +1,74 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"time"
+)
+
+type Admin struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	Account   string    `gorm:"type:varchar(100);not null"`
+	Password  string    `gorm:"type:varchar(100);not null"`
+	Role      string    `gorm:"type:varchar(45);not null"`
+	Status    int64     `gorm:"type:int;not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type AdminRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewAdminRepo(data *Data, logger log.Logger) biz.AdminRepo {
+	return &AdminRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// GetAdminByAccount .
+func (a *AdminRepo) GetAdminByAccount(ctx context.Context, account string) (*biz.Admin, error) {
+	var admin Admin
+	if err := a.data.db.Where("account=?", account).Table("admin").First(&admin).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("ADMIN_NOT_FOUND", "admin not found")
+		}
+
+		return nil, errors.New(500, "ADMIN ERROR", err.Error())
+	}
+
+	return &biz.Admin{
+		ID:        admin.ID,
+		Account:   admin.Account,
+		Password:  admin.Password,
+		Role:      admin.Role,
+		Status:    admin.Status,
+		CreatedAt: admin.CreatedAt,
+	}, nil
+}
+
+// GetAdminById .
+func (a *AdminRepo) GetAdminById(ctx context.Context, id int64) (*biz.Admin, error) {
+	var admin Admin
+	if err := a.data.db.Where("id=?", id).Table("admin").First(&admin).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("ADMIN_NOT_FOUND", "admin not found")
+		}
+
+		return nil, errors.New(500, "ADMIN ERROR", err.Error())
+	}
+
+	return &biz.Admin{
+		ID:        admin.ID,
+		Account:   admin.Account,
+		Password:  admin.Password,
+		Role:      admin.Role,
+		Status:    admin.Status,
+		CreatedAt: admin.CreatedAt,
+	}, nil
+}
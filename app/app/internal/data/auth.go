@@ -0,0 +1,280 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type AuthNonce struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	Address   string    `gorm:"type:varchar(100);not null"`
+	Nonce     string    `gorm:"type:varchar(100);not null"`
+	Status    int64     `gorm:"type:int;not null"`
+	ExpiredAt time.Time `gorm:"type:datetime;not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type AuthNonceRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+type SessionRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewAuthNonceRepo(data *Data, logger log.Logger) biz.AuthNonceRepo {
+	return &AuthNonceRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func NewSessionRepo(data *Data, logger log.Logger) biz.SessionRepo {
+	return &SessionRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// CreateAuthNonce .
+func (a *AuthNonceRepo) CreateAuthNonce(ctx context.Context, an *biz.AuthNonce) (*biz.AuthNonce, error) {
+	var authNonce AuthNonce
+	authNonce.Address = an.Address
+	authNonce.Nonce = an.Nonce
+	authNonce.ExpiredAt = an.ExpiredAt
+	res := a.data.DB(ctx).Table("auth_nonce").Create(&authNonce)
+	if res.Error != nil {
+		return nil, errors.New(500, "CREATE_AUTH_NONCE_ERROR", "签名随机数创建失败")
+	}
+
+	return &biz.AuthNonce{
+		ID:        authNonce.ID,
+		Address:   authNonce.Address,
+		Nonce:     authNonce.Nonce,
+		Status:    authNonce.Status,
+		ExpiredAt: authNonce.ExpiredAt,
+		CreatedAt: authNonce.CreatedAt,
+	}, nil
+}
+
+// GetAuthNonceLastByAddress .
+func (a *AuthNonceRepo) GetAuthNonceLastByAddress(ctx context.Context, address string) (*biz.AuthNonce, error) {
+	var authNonce AuthNonce
+	if err := a.data.DB(ctx).Table("auth_nonce").Where("address=?", address).Order("id desc").First(&authNonce).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("AUTH_NONCE_NOT_FOUND", "auth nonce not found")
+		}
+
+		return nil, errors.New(500, "AUTH NONCE ERROR", err.Error())
+	}
+
+	return &biz.AuthNonce{
+		ID:        authNonce.ID,
+		Address:   authNonce.Address,
+		Nonce:     authNonce.Nonce,
+		Status:    authNonce.Status,
+		ExpiredAt: authNonce.ExpiredAt,
+		CreatedAt: authNonce.CreatedAt,
+	}, nil
+}
+
+// UseAuthNonce 只有未使用的随机数可以被消耗一次
+func (a *AuthNonceRepo) UseAuthNonce(ctx context.Context, id int64) (bool, error) {
+	res := a.data.DB(ctx).Table("auth_nonce").
+		Where("id=? and status=?", id, 0).
+		Updates(map[string]interface{}{"status": 1})
+	if res.Error != nil {
+		return false, errors.New(500, "UPDATE_AUTH_NONCE_ERROR", "签名随机数修改失败")
+	}
+
+	return 1 == res.RowsAffected, nil
+}
+
+// 已轮换的 refresh token 保留标记，用于发现重放
+const usedRefreshTokenPrefix = "used:"
+
+func sessionKey(id string) string {
+	return "dhb:session:" + id
+}
+
+func refreshTokenKey(hash string) string {
+	return "dhb:refresh_token:" + hash
+}
+
+func userSessionsKey(userType string, userId int64) string {
+	return "dhb:user_sessions:" + userType + ":" + strconv.FormatInt(userId, 10)
+}
+
+// CreateSession .
+func (s *SessionRepo) CreateSession(ctx context.Context, session *biz.Session, refreshTokenHash string, expire time.Duration) error {
+	_, err := s.data.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, sessionKey(session.ID), "user_id", session.UserId, "user_type", session.UserType, "role", session.Role, "refresh_token", refreshTokenHash)
+		pipe.Expire(ctx, sessionKey(session.ID), expire)
+		pipe.Set(ctx, refreshTokenKey(refreshTokenHash), session.ID, expire)
+		pipe.SAdd(ctx, userSessionsKey(session.UserType, session.UserId), session.ID)
+		pipe.Expire(ctx, userSessionsKey(session.UserType, session.UserId), expire)
+		return nil
+	})
+	if err != nil {
+		return errors.New(500, "CREATE_SESSION_ERROR", "会话创建失败")
+	}
+
+	return nil
+}
+
+// GetSession .
+func (s *SessionRepo) GetSession(ctx context.Context, id string) (*biz.Session, error) {
+	res, err := s.data.rdb.HGetAll(ctx, sessionKey(id)).Result()
+	if err != nil {
+		return nil, errors.New(500, "SESSION ERROR", err.Error())
+	}
+	if 0 == len(res) {
+		return nil, errors.NotFound("SESSION_NOT_FOUND", "session not found")
+	}
+
+	userId, _ := strconv.ParseInt(res["user_id"], 10, 64)
+	return &biz.Session{
+		ID:       id,
+		UserId:   userId,
+		UserType: res["user_type"],
+		Role:     res["role"],
+	}, nil
+}
+
+// rotateRefreshTokenScript 检查旧 token 仍指向会话且是会话当前的 token 后原子地轮换，旧 token 改为 used: 标记并保留到其原有效期结束；
+// 只用到 GET/SET/HGET/HSET 等基础命令，不依赖 Redis 6.2 的 GETDEL
+// KEYS: 旧 token、会话、新 token；ARGV: 会话 id、旧 token 哈希、新 token 哈希、有效期(秒)
+// 返回 {0} token 或会话不存在，{1} token 已被使用，{2, user_id, user_type, role} 轮换成功
+var rotateRefreshTokenScript = redis.NewScript(`
+local current = redis.call('GET', KEYS[1])
+if not current then
+	return {0}
+end
+if current ~= ARGV[1] then
+	return {1}
+end
+if 0 == redis.call('EXISTS', KEYS[2]) then
+	return {0}
+end
+if redis.call('HGET', KEYS[2], 'refresh_token') ~= ARGV[2] then
+	return {1}
+end
+local ttl = redis.call('PTTL', KEYS[1])
+redis.call('HSET', KEYS[2], 'refresh_token', ARGV[3])
+redis.call('EXPIRE', KEYS[2], ARGV[4])
+redis.call('SET', KEYS[3], ARGV[1], 'EX', ARGV[4])
+if 0 < ttl then
+	redis.call('SET', KEYS[1], '` + usedRefreshTokenPrefix + `' .. ARGV[1], 'PX', ttl)
+else
+	redis.call('SET', KEYS[1], '` + usedRefreshTokenPrefix + `' .. ARGV[1], 'EX', ARGV[4])
+end
+local session = redis.call('HMGET', KEYS[2], 'user_id', 'user_type', 'role')
+return {2, session[1], session[2], session[3]}
+`)
+
+// RotateRefreshToken 旧 token 只能使用一次，已被轮换过的 token 再次出现时吊销会话
+func (s *SessionRepo) RotateRefreshToken(ctx context.Context, refreshTokenHash string, newRefreshTokenHash string, expire time.Duration) (*biz.Session, error) {
+	id, err := s.data.rdb.Get(ctx, refreshTokenKey(refreshTokenHash)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, errors.NotFound("REFRESH_TOKEN_NOT_FOUND", "refresh token not found")
+		}
+
+		return nil, errors.New(500, "SESSION ERROR", err.Error())
+	}
+
+	if strings.HasPrefix(id, usedRefreshTokenPrefix) {
+		_ = s.DeleteSession(ctx, strings.TrimPrefix(id, usedRefreshTokenPrefix))
+		return nil, errors.New(500, "REFRESH_TOKEN_REUSED", "refresh token 已被使用")
+	}
+
+	res, err := rotateRefreshTokenScript.Run(ctx, s.data.rdb,
+		[]string{refreshTokenKey(refreshTokenHash), sessionKey(id), refreshTokenKey(newRefreshTokenHash)},
+		id, refreshTokenHash, newRefreshTokenHash, int64(expire.Seconds()),
+	).Slice()
+	if err != nil || 0 == len(res) {
+		return nil, errors.New(500, "UPDATE_SESSION_ERROR", "会话修改失败")
+	}
+
+	switch res[0] {
+	case int64(0):
+		return nil, errors.NotFound("SESSION_NOT_FOUND", "session not found")
+	case int64(1):
+		// 查询后被并发轮换，或会话已换发其他 token，视为重放
+		_ = s.DeleteSession(ctx, id)
+		return nil, errors.New(500, "REFRESH_TOKEN_REUSED", "refresh token 已被使用")
+	}
+
+	if 4 != len(res) {
+		return nil, errors.New(500, "UPDATE_SESSION_ERROR", "会话修改失败")
+	}
+	userIdStr, _ := res[1].(string)
+	userType, _ := res[2].(string)
+	role, _ := res[3].(string)
+	userId, _ := strconv.ParseInt(userIdStr, 10, 64)
+	session := &biz.Session{
+		ID:       id,
+		UserId:   userId,
+		UserType: userType,
+		Role:     role,
+	}
+
+	if err = s.data.rdb.Expire(ctx, userSessionsKey(session.UserType, session.UserId), expire).Err(); err != nil {
+		s.log.Warnf("expire user sessions %d: %v", session.UserId, err)
+	}
+
+	return session, nil
+}
+
+// DeleteSession .
+func (s *SessionRepo) DeleteSession(ctx context.Context, id string) error {
+	res, err := s.data.rdb.HGetAll(ctx, sessionKey(id)).Result()
+	if err != nil {
+		return errors.New(500, "SESSION ERROR", err.Error())
+	}
+	if 0 == len(res) {
+		return nil
+	}
+
+	userId, _ := strconv.ParseInt(res["user_id"], 10, 64)
+	_, err = s.data.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, sessionKey(id), refreshTokenKey(res["refresh_token"]))
+		pipe.SRem(ctx, userSessionsKey(res["user_type"], userId), id)
+		return nil
+	})
+	if err != nil {
+		return errors.New(500, "DELETE_SESSION_ERROR", "会话删除失败")
+	}
+
+	return nil
+}
+
+// DeleteUserSessions .
+func (s *SessionRepo) DeleteUserSessions(ctx context.Context, userType string, userId int64) error {
+	ids, err := s.data.rdb.SMembers(ctx, userSessionsKey(userType, userId)).Result()
+	if err != nil {
+		return errors.New(500, "SESSION ERROR", err.Error())
+	}
+
+	for _, id := range ids {
+		if err = s.DeleteSession(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	if err = s.data.rdb.Del(ctx, userSessionsKey(userType, userId)).Err(); err != nil {
+		return errors.New(500, "DELETE_SESSION_ERROR", "会话删除失败")
+	}
+
+	return nil
+}
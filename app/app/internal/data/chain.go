@@ -0,0 +1,122 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"math/big"
+)
+
+// BEP-20 Transfer(address,address,uint256) 事件签名
+var transferEventTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+type ChainRepo struct {
+	conf *conf.Chain
+	rpc  *rpc.Client
+	log  *log.Helper
+}
+
+// NewChainRepo 通过 JSON-RPC 访问链节点，rpc_url 可以指向本地测试节点
+func NewChainRepo(c *conf.Chain, logger log.Logger) biz.ChainRepo {
+	l := log.NewHelper(logger)
+	r := &ChainRepo{
+		conf: c,
+		log:  l,
+	}
+	if nil == c || "" == c.RpcUrl {
+		return r
+	}
+
+	client, err := rpc.Dial(c.RpcUrl)
+	if err != nil {
+		l.Errorf("dial chain rpc %s: %v", c.RpcUrl, err)
+		return r
+	}
+	r.rpc = client
+	return r
+}
+
+func (c *ChainRepo) client() (*rpc.Client, error) {
+	if nil == c.rpc {
+		return nil, errors.New(500, "CHAIN_RPC_ERROR", "链节点未配置")
+	}
+	return c.rpc, nil
+}
+
+// GetLatestBlockNumber .
+func (c *ChainRepo) GetLatestBlockNumber(ctx context.Context) (int64, error) {
+	client, err := c.client()
+	if err != nil {
+		return 0, err
+	}
+
+	var number hexutil.Big
+	if err = client.CallContext(ctx, &number, "eth_blockNumber"); err != nil {
+		return 0, errors.New(500, "CHAIN_RPC_ERROR", err.Error())
+	}
+
+	return number.ToInt().Int64(), nil
+}
+
+// GetBlockHash .
+func (c *ChainRepo) GetBlockHash(ctx context.Context, number int64) (string, error) {
+	client, err := c.client()
+	if err != nil {
+		return "", err
+	}
+
+	var header struct {
+		Hash common.Hash `json:"hash"`
+	}
+	if err = client.CallContext(ctx, &header, "eth_getBlockByNumber", hexutil.EncodeBig(big.NewInt(number)), false); err != nil {
+		return "", errors.New(500, "CHAIN_RPC_ERROR", err.Error())
+	}
+
+	return header.Hash.Hex(), nil
+}
+
+// GetDepositTransfers 查询区块范围内转入收款地址的 USDT Transfer 事件
+func (c *ChainRepo) GetDepositTransfers(ctx context.Context, fromBlock int64, toBlock int64) ([]*biz.ChainTransfer, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+
+	to := common.HexToAddress(c.conf.DepositAddress)
+	logs, err := ethclient.NewClient(client).FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: big.NewInt(fromBlock),
+		ToBlock:   big.NewInt(toBlock),
+		Addresses: []common.Address{common.HexToAddress(c.conf.UsdtContract)},
+		Topics:    [][]common.Hash{{transferEventTopic}, nil, {common.BytesToHash(to.Bytes())}},
+	})
+	if err != nil {
+		return nil, errors.New(500, "CHAIN_RPC_ERROR", err.Error())
+	}
+
+	res := make([]*biz.ChainTransfer, 0)
+	for _, v := range logs {
+		if v.Removed || 3 != len(v.Topics) {
+			continue
+		}
+
+		res = append(res, &biz.ChainTransfer{
+			TxHash:      v.TxHash.Hex(),
+			LogIndex:    int64(v.Index),
+			BlockNumber: int64(v.BlockNumber),
+			BlockHash:   v.BlockHash.Hex(),
+			From:        common.BytesToAddress(v.Topics[1].Bytes()).Hex(),
+			To:          common.BytesToAddress(v.Topics[2].Bytes()).Hex(),
+			Amount:      new(big.Int).SetBytes(v.Data).String(),
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,572 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"encoding/json"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	testUsdtContract   = "0x55d398326f99059fF775485246999027B3197955"
+	testDepositAddress = "0x00000000000000000000000000000000000000d0"
+	testUserAddress    = "0x00000000000000000000000000000000000000a1"
+	testUnknownAddress = "0x00000000000000000000000000000000000000b2"
+)
+
+// fakeTransfer 测试链上的一笔转入
+type fakeTransfer struct {
+	block  int64
+	txHash string
+	from   string
+	amount *big.Int
+}
+
+// fakeChainNode 本地 JSON-RPC 节点，区块哈希由高度和 fork 决定，fork 变化即模拟回滚
+type fakeChainNode struct {
+	mu        sync.Mutex
+	latest    int64
+	fork      int64
+	transfers []*fakeTransfer
+	getLogs   [][2]int64
+}
+
+func (n *fakeChainNode) blockHash(number int64) common.Hash {
+	return common.BigToHash(big.NewInt(n.fork<<32 | number))
+}
+
+func (n *fakeChainNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     json.RawMessage   `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	var result interface{}
+	switch req.Method {
+	case "eth_blockNumber":
+		result = hexutil.EncodeBig(big.NewInt(n.latest))
+	case "eth_getBlockByNumber":
+		var number hexutil.Big
+		_ = json.Unmarshal(req.Params[0], &number)
+		result = map[string]interface{}{"hash": n.blockHash(number.ToInt().Int64())}
+	case "eth_getLogs":
+		var filter struct {
+			FromBlock hexutil.Big `json:"fromBlock"`
+			ToBlock   hexutil.Big `json:"toBlock"`
+		}
+		_ = json.Unmarshal(req.Params[0], &filter)
+		from, to := filter.FromBlock.ToInt().Int64(), filter.ToBlock.ToInt().Int64()
+		n.getLogs = append(n.getLogs, [2]int64{from, to})
+
+		logs := make([]map[string]interface{}, 0)
+		for i, v := range n.transfers {
+			if v.block < from || v.block > to {
+				continue
+			}
+			logs = append(logs, map[string]interface{}{
+				"address": testUsdtContract,
+				"topics": []common.Hash{
+					transferEventTopic,
+					common.BytesToHash(common.HexToAddress(v.from).Bytes()),
+					common.BytesToHash(common.HexToAddress(testDepositAddress).Bytes()),
+				},
+				"data":             hexutil.Bytes(common.BigToHash(v.amount).Bytes()),
+				"blockNumber":      hexutil.EncodeUint64(uint64(v.block)),
+				"transactionHash":  v.txHash,
+				"transactionIndex": "0x0",
+				"blockHash":        n.blockHash(v.block),
+				"logIndex":         hexutil.EncodeUint64(uint64(i)),
+				"removed":          false,
+			})
+		}
+		result = logs
+	default:
+		result = nil
+	}
+
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": result})
+}
+
+func (n *fakeChainNode) setLatest(latest int64) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.latest = latest
+}
+
+type fakeEthUserRecordRepo struct {
+	records []*biz.EthUserRecord
+}
+
+func (f *fakeEthUserRecordRepo) GetEthUserRecordListByHash(ctx context.Context, hash ...string) (map[string]*biz.EthUserRecord, error) {
+	res := make(map[string]*biz.EthUserRecord)
+	for _, v := range f.records {
+		for _, h := range hash {
+			if v.Hash == h {
+				tmp := *v
+				res[h] = &tmp
+			}
+		}
+	}
+	return res, nil
+}
+
+func (f *fakeEthUserRecordRepo) CreateEthUserRecordListByHash(ctx context.Context, r *biz.EthUserRecord) (*biz.EthUserRecord, error) {
+	tmp := *r
+	tmp.ID = int64(len(f.records) + 1)
+	f.records = append(f.records, &tmp)
+	return &tmp, nil
+}
+
+func (f *fakeEthUserRecordRepo) GetEthUserRecordsByStatus(ctx context.Context, fromBlock int64, status ...string) ([]*biz.EthUserRecord, error) {
+	res := make([]*biz.EthUserRecord, 0)
+	for _, v := range f.records {
+		for _, s := range status {
+			if v.Status == s && v.BlockNumber >= fromBlock {
+				tmp := *v
+				res = append(res, &tmp)
+			}
+		}
+	}
+	return res, nil
+}
+
+func (f *fakeEthUserRecordRepo) UpdateEthUserRecordStatus(ctx context.Context, id int64, oldStatus string, r *biz.EthUserRecord) (bool, error) {
+	for _, v := range f.records {
+		if v.ID == id && v.Status == oldStatus {
+			v.Status = r.Status
+			v.BlockNumber = r.BlockNumber
+			v.BlockHash = r.BlockHash
+			v.Confirmations = r.Confirmations
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (f *fakeEthUserRecordRepo) ResolveEthUserRecord(ctx context.Context, id int64, userId int64) (bool, error) {
+	for _, v := range f.records {
+		if v.ID == id && biz.EthUserRecordStatusUnmatched == v.Status {
+			v.UserId = userId
+			v.Status = biz.EthUserRecordStatusSeen
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (f *fakeEthUserRecordRepo) record(hash string) *biz.EthUserRecord {
+	for _, v := range f.records {
+		if v.Hash == hash {
+			return v
+		}
+	}
+	return nil
+}
+
+type fakeChainCheckpointRepo struct {
+	checkpoint *biz.ChainCheckpoint
+}
+
+func (f *fakeChainCheckpointRepo) GetChainCheckpoint(ctx context.Context, name string) (*biz.ChainCheckpoint, error) {
+	if nil == f.checkpoint {
+		return nil, errors.NotFound("CHAIN_CHECKPOINT_NOT_FOUND", "chain checkpoint not found")
+	}
+	return f.checkpoint, nil
+}
+
+func (f *fakeChainCheckpointRepo) UpdateChainCheckpoint(ctx context.Context, name string, blockNumber int64, blockHash string) error {
+	f.checkpoint = &biz.ChainCheckpoint{Name: name, BlockNumber: blockNumber, BlockHash: blockHash}
+	return nil
+}
+
+type fakeUserRepo struct {
+	biz.UserRepo
+	users []*biz.User
+}
+
+func (f *fakeUserRepo) GetUserByAddresses(ctx context.Context, addresses ...string) (map[string]*biz.User, error) {
+	res := make(map[string]*biz.User)
+	for _, v := range f.users {
+		for _, address := range addresses {
+			if strings.EqualFold(v.Address, address) {
+				res[v.Address] = v
+			}
+		}
+	}
+	return res, nil
+}
+
+type fakeConfigRepo struct {
+	biz.ConfigRepo
+}
+
+func (f *fakeConfigRepo) GetConfigByKeys(ctx context.Context, keys ...string) ([]*biz.Config, error) {
+	return []*biz.Config{{KeyName: "out_rate", Value: "250"}}, nil
+}
+
+type fakeUserBalanceRepo struct {
+	biz.UserBalanceRepo
+	deposits map[int64]int64
+}
+
+func (f *fakeUserBalanceRepo) Deposit(ctx context.Context, userId int64, amount int64) (int64, error) {
+	f.deposits[userId] += amount
+	return f.deposits[userId], nil
+}
+
+func (f *fakeUserBalanceRepo) DepositReverse(ctx context.Context, userId int64, amount int64) (int64, error) {
+	f.deposits[userId] -= amount
+	return f.deposits[userId], nil
+}
+
+type fakeLocationRepo struct {
+	biz.LocationRepo
+	locations []*biz.LocationNew
+}
+
+func (f *fakeLocationRepo) GetLocationNewByTxHash(ctx context.Context, txHash string) (*biz.LocationNew, error) {
+	for _, v := range f.locations {
+		if v.TxHash == txHash && biz.LocationNewStatusReversed != v.Status {
+			return v, nil
+		}
+	}
+	return nil, errors.NotFound("LOCATION_NOT_FOUND", "location not found")
+}
+
+func (f *fakeLocationRepo) UpdateLocationNewStatus(ctx context.Context, id int64, status string, stopDate time.Time) error {
+	for _, v := range f.locations {
+		if v.ID == id {
+			v.Status = status
+			v.StopDate = stopDate
+			return nil
+		}
+	}
+	return errors.New(500, "UPDATE_LOCATION_ERROR", "占位信息修改失败")
+}
+
+func (f *fakeLocationRepo) CreateLocationNew(ctx context.Context, rel *biz.LocationNew) (*biz.LocationNew, error) {
+	tmp := *rel
+	tmp.ID = int64(len(f.locations) + 1)
+	f.locations = append(f.locations, &tmp)
+	return &tmp, nil
+}
+
+type fakeUserRecommendRepo struct {
+	biz.UserRecommendRepo
+	selfAmounts map[int64]int64
+}
+
+func (f *fakeUserRecommendRepo) UpdateUserAreaSelfAmount(ctx context.Context, userId int64, amount int64) error {
+	f.selfAmounts[userId] += amount
+	return nil
+}
+
+func (f *fakeUserRecommendRepo) GetUserRecommendUplines(ctx context.Context, userId int64, maxDepth int64) ([]*biz.UserRecommendClosure, error) {
+	return nil, nil
+}
+
+func (f *fakeUserRecommendRepo) UpdateUserAreaAmount(ctx context.Context, amount int64, userIds ...int64) error {
+	return nil
+}
+
+type fakeTransaction struct{}
+
+func (f *fakeTransaction) ExecTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// depositScanTest 连接本地 JSON-RPC 节点的充值扫描
+type depositScanTest struct {
+	node        *fakeChainNode
+	chainRepo   biz.ChainRepo
+	records     *fakeEthUserRecordRepo
+	checkpoints *fakeChainCheckpointRepo
+	balances    *fakeUserBalanceRepo
+	locations   *fakeLocationRepo
+	recommends  *fakeUserRecommendRepo
+	ruc         *biz.RecordUseCase
+}
+
+func newDepositScanTest(t *testing.T, latest int64, transfers ...*fakeTransfer) *depositScanTest {
+	node := &fakeChainNode{latest: latest, transfers: transfers}
+	server := httptest.NewServer(node)
+	t.Cleanup(server.Close)
+
+	chainRepo := NewChainRepo(&conf.Chain{
+		RpcUrl:         server.URL,
+		UsdtContract:   testUsdtContract,
+		DepositAddress: testDepositAddress,
+	}, log.DefaultLogger)
+
+	res := &depositScanTest{
+		node:        node,
+		chainRepo:   chainRepo,
+		records:     &fakeEthUserRecordRepo{},
+		checkpoints: &fakeChainCheckpointRepo{},
+		balances:    &fakeUserBalanceRepo{deposits: make(map[int64]int64)},
+		locations:   &fakeLocationRepo{},
+		recommends:  &fakeUserRecommendRepo{selfAmounts: make(map[int64]int64)},
+	}
+	res.ruc = biz.NewRecordUseCase(
+		res.records,
+		&fakeUserRepo{users: []*biz.User{{ID: 1, Address: testUserAddress}}},
+		chainRepo,
+		res.checkpoints,
+		res.locations,
+		res.balances,
+		res.recommends,
+		nil,
+		&fakeConfigRepo{},
+		nil,
+		nil,
+		&fakeTransaction{},
+		log.DefaultLogger,
+	)
+	return res
+}
+
+func usdt(amount int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(amount), new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil))
+}
+
+func TestChainRepoGetDepositTransfers(t *testing.T) {
+	s := newDepositScanTest(t, 20,
+		&fakeTransfer{block: 3, txHash: common.BigToHash(big.NewInt(1)).Hex(), from: testUserAddress, amount: usdt(100)},
+		&fakeTransfer{block: 12, txHash: common.BigToHash(big.NewInt(2)).Hex(), from: testUserAddress, amount: usdt(5)},
+	)
+	chainRepo := NewChainRepo(&conf.Chain{}, log.DefaultLogger)
+	if _, err := chainRepo.GetLatestBlockNumber(context.Background()); nil == err {
+		t.Fatal("expected error without rpc_url")
+	}
+
+	transfers, err := s.chainRepo.GetDepositTransfers(context.Background(), 1, 10)
+	if nil != err {
+		t.Fatal(err)
+	}
+	if 1 != len(transfers) {
+		t.Fatalf("transfers = %d, want 1", len(transfers))
+	}
+	v := transfers[0]
+	if 3 != v.BlockNumber || s.node.blockHash(3).Hex() != v.BlockHash {
+		t.Errorf("block = %d %s", v.BlockNumber, v.BlockHash)
+	}
+	if !strings.EqualFold(testUserAddress, v.From) || !strings.EqualFold(testDepositAddress, v.To) {
+		t.Errorf("from %s to %s", v.From, v.To)
+	}
+	if usdt(100).String() != v.Amount {
+		t.Errorf("amount = %s", v.Amount)
+	}
+}
+
+func TestDepositScanPaging(t *testing.T) {
+	s := newDepositScanTest(t, 25)
+	opt := &biz.DepositScanOption{StartBlock: 1, BatchSize: 10, Confirmations: 3}
+
+	wantRanges := [][2]int64{{1, 10}, {11, 20}, {21, 25}}
+	for i, want := range wantRanges {
+		scanned, err := s.ruc.DepositScan(context.Background(), opt)
+		if nil != err {
+			t.Fatal(err)
+		}
+		if want[1] != scanned {
+			t.Errorf("scan %d scanned to %d, want %d", i, scanned, want[1])
+		}
+		if s.node.getLogs[i] != want {
+			t.Errorf("scan %d eth_getLogs range %v, want %v", i, s.node.getLogs[i], want)
+		}
+		if want[1] != s.checkpoints.checkpoint.BlockNumber || s.node.blockHash(want[1]).Hex() != s.checkpoints.checkpoint.BlockHash {
+			t.Errorf("scan %d checkpoint %+v", i, s.checkpoints.checkpoint)
+		}
+	}
+
+	// 已扫描到最新区块，不再请求 eth_getLogs，检查点不变
+	if _, err := s.ruc.DepositScan(context.Background(), opt); nil != err {
+		t.Fatal(err)
+	}
+	if len(wantRanges) != len(s.node.getLogs) {
+		t.Errorf("eth_getLogs called %d times, want %d", len(s.node.getLogs), len(wantRanges))
+	}
+	if 25 != s.checkpoints.checkpoint.BlockNumber {
+		t.Errorf("checkpoint = %d, want 25", s.checkpoints.checkpoint.BlockNumber)
+	}
+
+	// 新区块从检查点之后继续
+	s.node.setLatest(30)
+	if _, err := s.ruc.DepositScan(context.Background(), opt); nil != err {
+		t.Fatal(err)
+	}
+	if last := s.node.getLogs[len(s.node.getLogs)-1]; [2]int64{26, 30} != last {
+		t.Errorf("eth_getLogs range %v, want [26 30]", last)
+	}
+}
+
+func TestDepositScanConfirmations(t *testing.T) {
+	userHash := common.BigToHash(big.NewInt(1)).Hex()
+	unknownHash := common.BigToHash(big.NewInt(2)).Hex()
+	s := newDepositScanTest(t, 6,
+		&fakeTransfer{block: 5, txHash: userHash, from: testUserAddress, amount: usdt(100)},
+		&fakeTransfer{block: 5, txHash: unknownHash, from: testUnknownAddress, amount: usdt(7)},
+	)
+	opt := &biz.DepositScanOption{StartBlock: 1, BatchSize: 100, Confirmations: 3}
+
+	if _, err := s.ruc.DepositScan(context.Background(), opt); nil != err {
+		t.Fatal(err)
+	}
+	record := s.records.record(userHash)
+	if nil == record {
+		t.Fatal("deposit not recorded")
+	}
+	if biz.EthUserRecordStatusConfirming != record.Status || 2 != record.Confirmations {
+		t.Errorf("status %s confirmations %d, want confirming 2", record.Status, record.Confirmations)
+	}
+	if 0 != s.balances.deposits[1] {
+		t.Errorf("credited %d before enough confirmations", s.balances.deposits[1])
+	}
+
+	// 未知发送地址的转入保存为 unmatched，检查点照常推进
+	unknown := s.records.record(unknownHash)
+	if nil == unknown || biz.EthUserRecordStatusUnmatched != unknown.Status || 0 != unknown.UserId {
+		t.Fatalf("unknown sender record %+v", unknown)
+	}
+	if !strings.EqualFold(testUnknownAddress, unknown.From) {
+		t.Errorf("unknown sender from = %s", unknown.From)
+	}
+	if 6 != s.checkpoints.checkpoint.BlockNumber {
+		t.Errorf("checkpoint = %d, want 6", s.checkpoints.checkpoint.BlockNumber)
+	}
+
+	s.node.setLatest(7)
+	if _, err := s.ruc.DepositScan(context.Background(), opt); nil != err {
+		t.Fatal(err)
+	}
+	if biz.EthUserRecordStatusConfirmed != record.Status || 3 != record.Confirmations {
+		t.Errorf("status %s confirmations %d, want confirmed 3", record.Status, record.Confirmations)
+	}
+	if want := int64(100 * 1e10); want != s.balances.deposits[1] {
+		t.Errorf("credited %d, want %d", s.balances.deposits[1], want)
+	}
+	if 1 != len(s.locations.locations) || userHash != s.locations.locations[0].TxHash {
+		t.Errorf("locations %+v", s.locations.locations)
+	}
+	if biz.EthUserRecordStatusUnmatched != unknown.Status {
+		t.Errorf("unknown sender credited, status %s", unknown.Status)
+	}
+
+	// 再次扫描不重复入账
+	s.node.setLatest(8)
+	if _, err := s.ruc.DepositScan(context.Background(), opt); nil != err {
+		t.Fatal(err)
+	}
+	if want := int64(100 * 1e10); want != s.balances.deposits[1] {
+		t.Errorf("credited %d after rescan, want %d", s.balances.deposits[1], want)
+	}
+}
+
+func TestDepositScanReorgRewindsCheckpoint(t *testing.T) {
+	s := newDepositScanTest(t, 20)
+	opt := &biz.DepositScanOption{StartBlock: 1, BatchSize: 100, Confirmations: 3, ReorgDepth: 5}
+
+	if _, err := s.ruc.DepositScan(context.Background(), opt); nil != err {
+		t.Fatal(err)
+	}
+	if 20 != s.checkpoints.checkpoint.BlockNumber {
+		t.Fatalf("checkpoint = %d, want 20", s.checkpoints.checkpoint.BlockNumber)
+	}
+
+	// 检查点区块哈希变化，回退 reorg_depth 个区块重新扫描
+	s.node.mu.Lock()
+	s.node.fork = 1
+	s.node.latest = 22
+	s.node.mu.Unlock()
+	if _, err := s.ruc.DepositScan(context.Background(), opt); nil != err {
+		t.Fatal(err)
+	}
+	if last := s.node.getLogs[len(s.node.getLogs)-1]; [2]int64{16, 22} != last {
+		t.Errorf("eth_getLogs range %v, want [16 22]", last)
+	}
+	if 22 != s.checkpoints.checkpoint.BlockNumber || s.node.blockHash(22).Hex() != s.checkpoints.checkpoint.BlockHash {
+		t.Errorf("checkpoint %+v", s.checkpoints.checkpoint)
+	}
+}
+
+func TestDepositScanReorgReincluded(t *testing.T) {
+	hash := common.BigToHash(big.NewInt(1)).Hex()
+	transfer := &fakeTransfer{block: 5, txHash: hash, from: testUserAddress, amount: usdt(100)}
+	s := newDepositScanTest(t, 8, transfer)
+	opt := &biz.DepositScanOption{StartBlock: 1, BatchSize: 100, Confirmations: 3, ReorgDepth: 5}
+	want := int64(100 * 1e10)
+
+	if _, err := s.ruc.DepositScan(context.Background(), opt); nil != err {
+		t.Fatal(err)
+	}
+	if want != s.balances.deposits[1] || 1 != len(s.locations.locations) {
+		t.Fatalf("credited %d locations %d, want %d 1", s.balances.deposits[1], len(s.locations.locations), want)
+	}
+	areaAmount := s.locations.locations[0].AreaAmount()
+	if 0 >= areaAmount || areaAmount != s.recommends.selfAmounts[1] {
+		t.Fatalf("area amount %d, location %d", s.recommends.selfAmounts[1], areaAmount)
+	}
+
+	// 交易所在区块被回滚，冲正入账，投资位记为 reversed
+	s.node.mu.Lock()
+	s.node.fork = 1
+	s.node.latest = 9
+	s.node.transfers = nil
+	s.node.mu.Unlock()
+	if _, err := s.ruc.DepositScan(context.Background(), opt); nil != err {
+		t.Fatal(err)
+	}
+	if record := s.records.record(hash); biz.EthUserRecordStatusOrphaned != record.Status {
+		t.Fatalf("status %s, want orphaned", record.Status)
+	}
+	if 0 != s.balances.deposits[1] || 0 != s.recommends.selfAmounts[1] {
+		t.Errorf("after orphan credited %d area amount %d, want 0", s.balances.deposits[1], s.recommends.selfAmounts[1])
+	}
+	if biz.LocationNewStatusReversed != s.locations.locations[0].Status {
+		t.Errorf("location status %s, want reversed", s.locations.locations[0].Status)
+	}
+
+	// 同一交易重新打包，再次入账并开启新的投资位
+	s.node.mu.Lock()
+	s.node.latest = 13
+	s.node.transfers = []*fakeTransfer{{block: 10, txHash: hash, from: testUserAddress, amount: usdt(100)}}
+	s.node.mu.Unlock()
+	if _, err := s.ruc.DepositScan(context.Background(), opt); nil != err {
+		t.Fatal(err)
+	}
+	record := s.records.record(hash)
+	if biz.EthUserRecordStatusConfirmed != record.Status || 10 != record.BlockNumber {
+		t.Fatalf("status %s block %d, want confirmed 10", record.Status, record.BlockNumber)
+	}
+	if want != s.balances.deposits[1] {
+		t.Errorf("credited %d, want %d", s.balances.deposits[1], want)
+	}
+	if 2 != len(s.locations.locations) {
+		t.Fatalf("locations %d, want 2", len(s.locations.locations))
+	}
+	if location := s.locations.locations[1]; "running" != location.Status || hash != location.TxHash {
+		t.Errorf("location %+v", location)
+	}
+	if areaAmount != s.recommends.selfAmounts[1] {
+		t.Errorf("area amount %d, want %d", s.recommends.selfAmounts[1], areaAmount)
+	}
+}
@@ -0,0 +1,234 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type LedgerJournal struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	Type      string    `gorm:"type:varchar(45);not null"`
+	RefType   string    `gorm:"type:varchar(45);not null"`
+	RefId     int64     `gorm:"type:int;not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type LedgerPosting struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	JournalId int64     `gorm:"type:int;not null"`
+	Account   string    `gorm:"type:varchar(45);not null"`
+	UserId    int64     `gorm:"type:int;not null"`
+	CoinType  string    `gorm:"type:varchar(45);not null"`
+	Amount    int64     `gorm:"type:bigint;not null"`
+	Balance   int64     `gorm:"type:bigint;not null"` // 用户科目记账后的余额，系统科目为 0
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type LedgerRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewLedgerRepo(data *Data, logger log.Logger) biz.LedgerRepo {
+	return &LedgerRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func userBalanceColumn(coinType string) (string, error) {
+	switch coinType {
+	case "usdt":
+		return "balance_usdt", nil
+	case "dhb":
+		return "balance_dhb", nil
+	}
+	return "", errors.New(500, "LEDGER_ERROR", "币种错误")
+}
+
+// postLedger 记一张凭证，用户科目的分录同时更新 user_balance，user_balance 只能通过这里修改；未在事务中调用时单独开启事务
+func postLedger(ctx context.Context, data *Data, entry *biz.LedgerEntry) (int64, error) {
+	if _, ok := ctx.Value(contextTxKey{}).(*gorm.DB); !ok {
+		var journalId int64
+		err := data.ExecTx(ctx, func(ctx context.Context) error {
+			var err error
+			journalId, err = writeLedger(ctx, data, entry, true)
+			return err
+		})
+		return journalId, err
+	}
+
+	return writeLedger(ctx, data, entry, true)
+}
+
+// writeLedger 写凭证和分录，project 为 false 时不更新 user_balance
+func writeLedger(ctx context.Context, data *Data, entry *biz.LedgerEntry, project bool) (int64, error) {
+	if !entry.Balanced() {
+		return 0, errors.New(500, "LEDGER_UNBALANCED", "账本凭证不平")
+	}
+
+	var journal LedgerJournal
+	journal.Type = entry.Type
+	journal.RefType = entry.RefType
+	journal.RefId = entry.RefId
+	if err := data.DB(ctx).Table("ledger_journal").Create(&journal).Error; err != nil {
+		return 0, errors.New(500, "CREATE_LEDGER_ERROR", "账本凭证创建失败")
+	}
+
+	postings := make([]*LedgerPosting, 0, len(entry.Postings))
+	for _, v := range entry.Postings {
+		posting := &LedgerPosting{
+			JournalId: journal.ID,
+			Account:   v.Account,
+			UserId:    v.UserId,
+			CoinType:  v.CoinType,
+			Amount:    v.Amount,
+		}
+
+		if biz.LedgerAccountUser == v.Account {
+			column, err := userBalanceColumn(v.CoinType)
+			if err != nil {
+				return 0, err
+			}
+
+			if project {
+				// 用户余额不能扣成负数
+				instance := data.DB(ctx).Table("user_balance").Where("user_id=?", v.UserId)
+				if 0 > v.Amount {
+					instance = instance.Where(column+">=?", -v.Amount)
+				}
+				if res := instance.Updates(map[string]interface{}{column: gorm.Expr(column+" + ?", v.Amount)}); 0 == res.RowsAffected || nil != res.Error {
+					return 0, errors.NotFound("user balance err", "user balance error")
+				}
+			}
+
+			var userBalance UserBalance
+			if err = data.DB(ctx).Table("user_balance").Where("user_id=?", v.UserId).First(&userBalance).Error; err != nil {
+				return 0, errors.New(500, "USER BALANCE ERROR", err.Error())
+			}
+			posting.Balance = userBalance.BalanceUsdt
+			if "dhb" == v.CoinType {
+				posting.Balance = userBalance.BalanceDhb
+			}
+		}
+
+		postings = append(postings, posting)
+	}
+
+	if err := data.DB(ctx).Table("ledger_posting").Create(&postings).Error; err != nil {
+		return 0, errors.New(500, "CREATE_LEDGER_ERROR", "账本分录创建失败")
+	}
+
+	return journal.ID, nil
+}
+
+// GetLedgerAccountTotals 按科目和币种汇总，用户科目合并为一项
+func (lr *LedgerRepo) GetLedgerAccountTotals(ctx context.Context) ([]*biz.LedgerAccountTotal, error) {
+	var totals []*biz.LedgerAccountTotal
+	if err := lr.data.db.Table("ledger_posting").
+		Select("account, coin_type, sum(amount) as amount").
+		Group("account, coin_type").
+		Order("account").
+		Find(&totals).Error; err != nil {
+		return nil, errors.New(500, "LEDGER ERROR", err.Error())
+	}
+
+	return totals, nil
+}
+
+// GetUserBalanceTotals user_balance 各币种合计
+func (lr *LedgerRepo) GetUserBalanceTotals(ctx context.Context) (map[string]int64, error) {
+	var total struct {
+		Usdt int64
+		Dhb  int64
+	}
+	if err := lr.data.db.Table("user_balance").
+		Select("coalesce(sum(balance_usdt), 0) as usdt, coalesce(sum(balance_dhb), 0) as dhb").
+		Take(&total).Error; err != nil {
+		return nil, errors.New(500, "USER BALANCE ERROR", err.Error())
+	}
+
+	return map[string]int64{
+		"usdt": total.Usdt,
+		"dhb":  total.Dhb,
+	}, nil
+}
+
+// OpenUserLedger 把 user_balance 中账本之外的余额记为期初凭证，只能执行一次
+func (lr *LedgerRepo) OpenUserLedger(ctx context.Context) (int64, error) {
+	var count int64
+	err := lr.data.ExecTx(ctx, func(ctx context.Context) error {
+		var opened int64
+		if err := lr.data.DB(ctx).Table("ledger_journal").Where("type=?", "opening").Count(&opened).Error; err != nil {
+			return errors.New(500, "LEDGER ERROR", err.Error())
+		}
+		if 0 < opened {
+			return errors.New(500, "LEDGER_ERROR", "账本期初余额已记录")
+		}
+
+		var userBalances []*UserBalance
+		if err := lr.data.DB(ctx).Table("user_balance").
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Find(&userBalances).Error; err != nil {
+			return errors.New(500, "USER BALANCE ERROR", err.Error())
+		}
+
+		var posted []*struct {
+			UserId   int64
+			CoinType string
+			Amount   int64
+		}
+		if err := lr.data.DB(ctx).Table("ledger_posting").
+			Where("account=?", biz.LedgerAccountUser).
+			Select("user_id, coin_type, sum(amount) as amount").
+			Group("user_id, coin_type").
+			Find(&posted).Error; err != nil {
+			return errors.New(500, "LEDGER ERROR", err.Error())
+		}
+		postedAmount := make(map[int64]map[string]int64)
+		for _, v := range posted {
+			if _, ok := postedAmount[v.UserId]; !ok {
+				postedAmount[v.UserId] = make(map[string]int64)
+			}
+			postedAmount[v.UserId][v.CoinType] = v.Amount
+		}
+
+		for _, v := range userBalances {
+			entry := &biz.LedgerEntry{
+				Type:    "opening",
+				RefType: "user",
+				RefId:   v.UserId,
+			}
+			for coinType, balance := range map[string]int64{"usdt": v.BalanceUsdt, "dhb": v.BalanceDhb} {
+				amount := balance - postedAmount[v.UserId][coinType]
+				if 0 == amount {
+					continue
+				}
+				entry.Postings = append(entry.Postings,
+					&biz.LedgerPosting{Account: biz.LedgerAccountOpening, CoinType: coinType, Amount: -amount},
+					&biz.LedgerPosting{Account: biz.LedgerAccountUser, UserId: v.UserId, CoinType: coinType, Amount: amount},
+				)
+			}
+			if 0 == len(entry.Postings) {
+				continue
+			}
+
+			// 余额已在 user_balance 中，只记凭证不再更新余额
+			if _, err := writeLedger(ctx, lr.data, entry, false); err != nil {
+				return err
+			}
+			count++
+		}
+
+		return nil
+	})
+
+	return count, err
+}
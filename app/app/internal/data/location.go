@@ -6,6 +6,7 @@ import (
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"time"
 )
 
@@ -32,6 +33,7 @@ type LocationNew struct {
 	StopLocationAgain int64     `gorm:"type:int;not null"`
 	OutRate           int64     `gorm:"type:int;not null"`
 	StopCoin          int64     `gorm:"type:bigint;not null"`
+	TxHash            string    `gorm:"type:varchar(100);not null"`
 	StopDate          time.Time `gorm:"type:datetime;not null"`
 	CreatedAt         time.Time `gorm:"type:datetime;not null"`
 	UpdatedAt         time.Time `gorm:"type:datetime;not null"`
@@ -81,6 +83,166 @@ func (lr *LocationRepo) CreateLocation(ctx context.Context, rel *biz.Location) (
 	}, nil
 }
 
+// CreateLocationNew .
+func (lr *LocationRepo) CreateLocationNew(ctx context.Context, rel *biz.LocationNew) (*biz.LocationNew, error) {
+	var location LocationNew
+	location.UserId = rel.UserId
+	location.Status = rel.Status
+	location.Current = rel.Current
+	location.CurrentMax = rel.CurrentMax
+	location.OutRate = rel.OutRate
+	location.TxHash = rel.TxHash
+	res := lr.data.DB(ctx).Table("location_new").Create(&location)
+	if res.Error != nil {
+		return nil, errors.New(500, "CREATE_LOCATION_ERROR", "占位信息创建失败")
+	}
+
+	return &biz.LocationNew{
+		ID:         location.ID,
+		UserId:     location.UserId,
+		Status:     location.Status,
+		Current:    location.Current,
+		CurrentMax: location.CurrentMax,
+		OutRate:    location.OutRate,
+		TxHash:     location.TxHash,
+		CreatedAt:  location.CreatedAt,
+	}, nil
+}
+
+// GetLocationNewByTxHash 交易开启的投资位，不含已冲正的
+func (lr *LocationRepo) GetLocationNewByTxHash(ctx context.Context, txHash string) (*biz.LocationNew, error) {
+	var location LocationNew
+	if err := lr.data.DB(ctx).Table("location_new").
+		Where("tx_hash=? and status<>?", txHash, biz.LocationNewStatusReversed).
+		First(&location).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("LOCATION_NOT_FOUND", "location not found")
+		}
+
+		return nil, errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	return &biz.LocationNew{
+		ID:         location.ID,
+		UserId:     location.UserId,
+		Status:     location.Status,
+		Current:    location.Current,
+		CurrentMax: location.CurrentMax,
+		OutRate:    location.OutRate,
+		TxHash:     location.TxHash,
+		StopDate:   location.StopDate,
+		CreatedAt:  location.CreatedAt,
+	}, nil
+}
+
+// UpdateLocationNewStatus .
+func (lr *LocationRepo) UpdateLocationNewStatus(ctx context.Context, id int64, status string, stopDate time.Time) error {
+	res := lr.data.DB(ctx).Table("location_new").
+		Where("id=?", id).
+		Updates(map[string]interface{}{"status": status, "stop_date": stopDate})
+	if 0 == res.RowsAffected || res.Error != nil {
+		return errors.New(500, "UPDATE_LOCATION_ERROR", "占位信息修改失败")
+	}
+
+	return nil
+}
+
+// GetRunningLocationUserIds 有 running 投资位的用户，按用户id升序，createdBefore 之后开启的投资位不计
+func (lr *LocationRepo) GetRunningLocationUserIds(ctx context.Context, afterUserId int64, createdBefore time.Time, limit int) ([]int64, error) {
+	var userIds []int64
+	if err := lr.data.DB(ctx).Table("location_new").
+		Where("status=?", "running").
+		Where("user_id>?", afterUserId).
+		Where("created_at<?", createdBefore).
+		Distinct("user_id").
+		Order("user_id asc").
+		Limit(limit).
+		Pluck("user_id", &userIds).Error; err != nil {
+		return nil, errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	return userIds, nil
+}
+
+// GetRunningLocationsByUserIdForUpdate 锁定用户的 running 投资位，需在事务中调用
+func (lr *LocationRepo) GetRunningLocationsByUserIdForUpdate(ctx context.Context, userId int64, createdBefore time.Time) ([]*biz.LocationNew, error) {
+	var locations []*LocationNew
+	res := make([]*biz.LocationNew, 0)
+	if err := lr.data.DB(ctx).Table("location_new").
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id=?", userId).
+		Where("status=?", "running").
+		Where("created_at<?", createdBefore).
+		Order("id asc").Find(&locations).Error; err != nil {
+		return nil, errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	for _, location := range locations {
+		res = append(res, &biz.LocationNew{
+			ID:         location.ID,
+			UserId:     location.UserId,
+			Status:     location.Status,
+			Current:    location.Current,
+			CurrentMax: location.CurrentMax,
+			OutRate:    location.OutRate,
+			StopCoin:   location.StopCoin,
+			TxHash:     location.TxHash,
+			CreatedAt:  location.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// GetSettlementLocations createdBefore 之前开启、当前 running 或 stoppedSince 之后出局的投资位，用于试算和重放
+func (lr *LocationRepo) GetSettlementLocations(ctx context.Context, createdBefore time.Time, stoppedSince time.Time) ([]*biz.LocationNew, error) {
+	var locations []*LocationNew
+	res := make([]*biz.LocationNew, 0)
+	if err := lr.data.db.Table("location_new").
+		Where("created_at<?", createdBefore).
+		Where("status=? or (status=? and stop_date>=?)", "running", "stop", stoppedSince).
+		Order("id asc").Find(&locations).Error; err != nil {
+		return nil, errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	for _, location := range locations {
+		res = append(res, &biz.LocationNew{
+			ID:         location.ID,
+			UserId:     location.UserId,
+			Status:     location.Status,
+			Current:    location.Current,
+			CurrentMax: location.CurrentMax,
+			OutRate:    location.OutRate,
+			StopCoin:   location.StopCoin,
+			TxHash:     location.TxHash,
+			StopDate:   location.StopDate,
+			CreatedAt:  location.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// UpdateLocationNewCurrent 累加收益，出局时记录出局时间和超出额度未发放的部分
+func (lr *LocationRepo) UpdateLocationNewCurrent(ctx context.Context, id int64, amount int64, status string, stopDate time.Time, stopCoin int64) error {
+	values := map[string]interface{}{"current": gorm.Expr("current + ?", amount)}
+	if "stop" == status {
+		values["status"] = "stop"
+		values["stop_date"] = stopDate
+		values["stop_coin"] = gorm.Expr("stop_coin + ?", stopCoin)
+	}
+
+	res := lr.data.DB(ctx).Table("location_new").
+		Where("id=?", id).
+		Where("status=?", "running").
+		Updates(values)
+	if 0 == res.RowsAffected || res.Error != nil {
+		return errors.New(500, "UPDATE_LOCATION_ERROR", "占位信息修改失败")
+	}
+
+	return nil
+}
+
 // GetLocationLast .
 func (lr *LocationRepo) GetLocationLast(ctx context.Context) (*biz.Location, error) {
 	var location Location
@@ -241,6 +403,61 @@ func (lr *LocationRepo) GetLocationsByUserId(ctx context.Context, userId int64)
 	return res, nil
 }
 
+// GetRunningLocationsByUserIds .
+func (lr *LocationRepo) GetRunningLocationsByUserIds(ctx context.Context, userIds ...int64) ([]*biz.LocationNew, error) {
+	var locations []*LocationNew
+	res := make([]*biz.LocationNew, 0)
+	if 0 == len(userIds) {
+		return res, nil
+	}
+
+	if err := lr.data.db.Table("location_new").
+		Where("user_id in (?)", userIds).
+		Where("status=?", "running").
+		Order("id asc").Find(&locations).Error; err != nil {
+		return nil, errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	for _, location := range locations {
+		res = append(res, &biz.LocationNew{
+			ID:         location.ID,
+			UserId:     location.UserId,
+			Status:     location.Status,
+			Current:    location.Current,
+			CurrentMax: location.CurrentMax,
+			CreatedAt:  location.CreatedAt,
+			OutRate:    location.OutRate,
+		})
+	}
+
+	return res, nil
+}
+
+// GetRunningLocations .
+func (lr *LocationRepo) GetRunningLocations(ctx context.Context) ([]*biz.LocationNew, error) {
+	var locations []*LocationNew
+	res := make([]*biz.LocationNew, 0)
+	if err := lr.data.DB(ctx).Table("location_new").
+		Where("status=?", "running").
+		Order("id asc").Find(&locations).Error; err != nil {
+		return nil, errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	for _, location := range locations {
+		res = append(res, &biz.LocationNew{
+			ID:         location.ID,
+			UserId:     location.UserId,
+			Status:     location.Status,
+			Current:    location.Current,
+			CurrentMax: location.CurrentMax,
+			CreatedAt:  location.CreatedAt,
+			OutRate:    location.OutRate,
+		})
+	}
+
+	return res, nil
+}
+
 // GetLocationsStopNotUpdate .
 func (lr *LocationRepo) GetLocationsStopNotUpdate(ctx context.Context) ([]*biz.Location, error) {
 	var locations []*Location
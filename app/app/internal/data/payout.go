@@ -0,0 +1,242 @@
+package data
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"strings"
+	"sync"
+)
+
+// BEP-20 transfer(address,uint256) 方法签名
+var transferMethodId = crypto.Keccak256([]byte("transfer(address,uint256)"))[:4]
+
+const defaultPayoutGasLimit = 100000
+
+type PayoutRepo struct {
+	chain  *conf.Chain
+	payout *conf.Payout
+	rpc    *rpc.Client
+	key    *ecdsa.PrivateKey
+	from   common.Address
+	log    *log.Helper
+
+	mu        sync.Mutex
+	nextNonce uint64 // 本进程已广播交易的下一个 nonce
+}
+
+// NewPayoutRepo 热钱包从加密的 keystore 文件加载，密码从环境变量读取，未配置时打款不可用
+func NewPayoutRepo(cc *conf.Chain, cp *conf.Payout, logger log.Logger) biz.PayoutRepo {
+	l := log.NewHelper(logger)
+	p := &PayoutRepo{
+		chain:  cc,
+		payout: cp,
+		log:    l,
+	}
+	if nil == cp || "" == cp.KeystorePath {
+		return p
+	}
+
+	keyJson, err := ioutil.ReadFile(cp.KeystorePath)
+	if err != nil {
+		l.Errorf("read payout keystore %s: %v", cp.KeystorePath, err)
+		return p
+	}
+	key, err := keystore.DecryptKey(keyJson, os.Getenv(cp.KeystorePasswordEnv))
+	if err != nil {
+		l.Errorf("decrypt payout keystore %s: %v", cp.KeystorePath, err)
+		return p
+	}
+	p.key = key.PrivateKey
+	p.from = key.Address
+
+	rpcUrl := cp.RpcUrl
+	if "" == rpcUrl && nil != cc {
+		rpcUrl = cc.RpcUrl
+	}
+	client, err := rpc.Dial(rpcUrl)
+	if err != nil {
+		l.Errorf("dial payout rpc %s: %v", rpcUrl, err)
+		return p
+	}
+	p.rpc = client
+	l.Infof("payout wallet %s", p.from.Hex())
+	return p
+}
+
+func (p *PayoutRepo) client() (*rpc.Client, error) {
+	if nil == p.key || nil == p.rpc {
+		return nil, errors.New(500, "PAYOUT_ERROR", "热钱包未配置")
+	}
+	return p.rpc, nil
+}
+
+func (p *PayoutRepo) contract(coinType string) (common.Address, error) {
+	var contract string
+	switch coinType {
+	case "usdt":
+		if nil != p.chain {
+			contract = p.chain.UsdtContract
+		}
+	case "dhb":
+		contract = p.payout.DhbContract
+	}
+	if !common.IsHexAddress(contract) {
+		return common.Address{}, errors.New(500, biz.PayoutReasonWithdrawInvalid, "币种合约未配置")
+	}
+	return common.HexToAddress(contract), nil
+}
+
+// SignTransfer 构造并签名 BEP-20 transfer 交易，amount 为系统1e10精度，链上按18位精度转换
+func (p *PayoutRepo) SignTransfer(ctx context.Context, coinType string, to string, amount int64) (*biz.PayoutTransfer, error) {
+	client, err := p.client()
+	if err != nil {
+		return nil, err
+	}
+	contract, err := p.contract(coinType)
+	if err != nil {
+		return nil, err
+	}
+	if !common.IsHexAddress(to) {
+		return nil, errors.New(500, biz.PayoutReasonWithdrawInvalid, "收款地址错误")
+	}
+	if 0 >= amount {
+		return nil, errors.New(500, biz.PayoutReasonWithdrawInvalid, "打款金额错误")
+	}
+
+	value := biz.BalanceMoney(amount).Units(biz.MoneyScaleChain)
+	data := make([]byte, 0, 68)
+	data = append(data, transferMethodId...)
+	data = append(data, common.LeftPadBytes(common.HexToAddress(to).Bytes(), 32)...)
+	data = append(data, common.LeftPadBytes(value.Bytes(), 32)...)
+
+	nonce, err := p.nonce(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	gasPrice, err := p.gasPrice(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	gasLimit := p.payout.GasLimit
+	if 0 == gasLimit {
+		gasLimit = defaultPayoutGasLimit
+	}
+
+	tx := types.NewTransaction(nonce, contract, big.NewInt(0), gasLimit, gasPrice, data)
+	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(big.NewInt(p.payout.ChainId)), p.key)
+	if err != nil {
+		return nil, errors.New(500, "PAYOUT_ERROR", err.Error())
+	}
+	raw, err := rlp.EncodeToBytes(signedTx)
+	if err != nil {
+		return nil, errors.New(500, "PAYOUT_ERROR", err.Error())
+	}
+
+	return &biz.PayoutTransfer{
+		TxHash: signedTx.Hash().Hex(),
+		Nonce:  int64(nonce),
+		Raw:    hexutil.Encode(raw),
+	}, nil
+}
+
+// nonce 取节点 pending nonce 和本进程已广播 nonce 中较大的，避免节点未及时同步时重复使用
+func (p *PayoutRepo) nonce(ctx context.Context, client *rpc.Client) (uint64, error) {
+	var pending hexutil.Uint64
+	if err := client.CallContext(ctx, &pending, "eth_getTransactionCount", p.from, "pending"); err != nil {
+		return 0, errors.New(500, "CHAIN_RPC_ERROR", err.Error())
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if uint64(pending) > p.nextNonce {
+		return uint64(pending), nil
+	}
+	return p.nextNonce, nil
+}
+
+func (p *PayoutRepo) gasPrice(ctx context.Context, client *rpc.Client) (*big.Int, error) {
+	if "" != p.payout.GasPrice {
+		gasPrice, ok := new(big.Int).SetString(p.payout.GasPrice, 10)
+		if !ok {
+			return nil, errors.New(500, "PAYOUT_ERROR", "gas_price 配置错误")
+		}
+		return gasPrice, nil
+	}
+
+	var gasPrice hexutil.Big
+	if err := client.CallContext(ctx, &gasPrice, "eth_gasPrice"); err != nil {
+		return nil, errors.New(500, "CHAIN_RPC_ERROR", err.Error())
+	}
+	return gasPrice.ToInt(), nil
+}
+
+// SendTransfer 广播已签名交易，节点已有该交易时视为成功
+func (p *PayoutRepo) SendTransfer(ctx context.Context, transfer *biz.PayoutTransfer) error {
+	client, err := p.client()
+	if err != nil {
+		return err
+	}
+
+	var hash common.Hash
+	if err = client.CallContext(ctx, &hash, "eth_sendRawTransaction", transfer.Raw); err != nil {
+		if !strings.Contains(strings.ToLower(err.Error()), "already known") {
+			return errors.New(500, "CHAIN_RPC_ERROR", err.Error())
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if uint64(transfer.Nonce)+1 > p.nextNonce {
+		p.nextNonce = uint64(transfer.Nonce) + 1
+	}
+	return nil
+}
+
+// GetConfirmedNonce 热钱包已上链交易的数量，小于它的 nonce 不会再被新交易使用
+func (p *PayoutRepo) GetConfirmedNonce(ctx context.Context) (int64, error) {
+	client, err := p.client()
+	if err != nil {
+		return 0, err
+	}
+
+	var latest hexutil.Uint64
+	if err = client.CallContext(ctx, &latest, "eth_getTransactionCount", p.from, "latest"); err != nil {
+		return 0, errors.New(500, "CHAIN_RPC_ERROR", err.Error())
+	}
+	return int64(latest), nil
+}
+
+// GetTransferReceipt 返回交易是否已上链以及是否执行成功
+func (p *PayoutRepo) GetTransferReceipt(ctx context.Context, txHash string) (bool, bool, error) {
+	client, err := p.client()
+	if err != nil {
+		return false, false, err
+	}
+
+	var receipt *struct {
+		Status      hexutil.Uint64 `json:"status"`
+		BlockNumber *hexutil.Big   `json:"blockNumber"`
+	}
+	if err = client.CallContext(ctx, &receipt, "eth_getTransactionReceipt", common.HexToHash(txHash)); err != nil {
+		return false, false, errors.New(500, "CHAIN_RPC_ERROR", err.Error())
+	}
+	if nil == receipt || nil == receipt.BlockNumber {
+		return false, false, nil
+	}
+
+	return true, 1 == receipt.Status, nil
+}
@@ -0,0 +1,326 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"encoding/json"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+const (
+	testPayoutChainId     = 97
+	testPayoutPasswordEnv = "DHB_TEST_PAYOUT_PASSWORD"
+	testPayoutPassword    = "payout-secret"
+	testPayoutTo          = "0x00000000000000000000000000000000000000c3"
+)
+
+// fakePayoutReceipt 交易回执，blockNumber 为 0 时节点返回未打包的回执
+type fakePayoutReceipt struct {
+	blockNumber int64
+	status      uint64
+}
+
+// fakePayoutNode 本地 JSON-RPC 节点，记录收到的交易，按 nonce 和回执模拟链上状态
+type fakePayoutNode struct {
+	mu           sync.Mutex
+	pendingNonce uint64
+	latestNonce  uint64
+	gasPrice     *big.Int
+	sent         map[common.Hash]*types.Transaction
+	receipts     map[common.Hash]*fakePayoutReceipt
+}
+
+func newFakePayoutNode() *fakePayoutNode {
+	return &fakePayoutNode{
+		gasPrice: big.NewInt(5000000000),
+		sent:     make(map[common.Hash]*types.Transaction),
+		receipts: make(map[common.Hash]*fakePayoutReceipt),
+	}
+}
+
+func (n *fakePayoutNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     json.RawMessage   `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	res := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+	switch req.Method {
+	case "eth_getTransactionCount":
+		var block string
+		_ = json.Unmarshal(req.Params[1], &block)
+		if "pending" == block {
+			res["result"] = hexutil.Uint64(n.pendingNonce)
+		} else {
+			res["result"] = hexutil.Uint64(n.latestNonce)
+		}
+	case "eth_gasPrice":
+		res["result"] = (*hexutil.Big)(n.gasPrice)
+	case "eth_sendRawTransaction":
+		var raw hexutil.Bytes
+		_ = json.Unmarshal(req.Params[0], &raw)
+		tx := new(types.Transaction)
+		if err := rlp.DecodeBytes(raw, tx); err != nil {
+			res["error"] = map[string]interface{}{"code": -32000, "message": err.Error()}
+			break
+		}
+		if _, ok := n.sent[tx.Hash()]; ok {
+			res["error"] = map[string]interface{}{"code": -32000, "message": "already known"}
+			break
+		}
+		n.sent[tx.Hash()] = tx
+		if tx.Nonce() >= n.pendingNonce {
+			n.pendingNonce = tx.Nonce() + 1
+		}
+		res["result"] = tx.Hash()
+	case "eth_getTransactionReceipt":
+		var hash common.Hash
+		_ = json.Unmarshal(req.Params[0], &hash)
+		receipt, ok := n.receipts[hash]
+		if !ok {
+			res["result"] = nil
+			break
+		}
+		result := map[string]interface{}{"transactionHash": hash, "status": hexutil.Uint64(receipt.status)}
+		if 0 < receipt.blockNumber {
+			result["blockNumber"] = hexutil.EncodeBig(big.NewInt(receipt.blockNumber))
+		} else {
+			result["blockNumber"] = nil
+		}
+		res["result"] = result
+	default:
+		res["error"] = map[string]interface{}{"code": -32601, "message": "method not found"}
+	}
+
+	_ = json.NewEncoder(w).Encode(res)
+}
+
+// newTestPayoutRepo 在临时目录生成 keystore，连接本地节点
+func newTestPayoutRepo(t *testing.T, node *fakePayoutNode, password string) (*PayoutRepo, common.Address) {
+	server := httptest.NewServer(node)
+	t.Cleanup(server.Close)
+
+	dir, err := ioutil.TempDir("", "payout-keystore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	address, err := keystore.StoreKey(dir, testPayoutPassword, keystore.LightScryptN, keystore.LightScryptP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil || 1 != len(files) {
+		t.Fatalf("keystore dir: %v %d", err, len(files))
+	}
+
+	if err = os.Setenv(testPayoutPasswordEnv, password); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.Unsetenv(testPayoutPasswordEnv) })
+
+	repo := NewPayoutRepo(&conf.Chain{UsdtContract: testUsdtContract}, &conf.Payout{
+		RpcUrl:              server.URL,
+		ChainId:             testPayoutChainId,
+		KeystorePath:        filepath.Join(dir, files[0].Name()),
+		KeystorePasswordEnv: testPayoutPasswordEnv,
+	}, log.DefaultLogger)
+	return repo.(*PayoutRepo), address
+}
+
+func decodePayoutTransfer(t *testing.T, transfer *biz.PayoutTransfer) *types.Transaction {
+	raw, err := hexutil.Decode(transfer.Raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := new(types.Transaction)
+	if err = rlp.DecodeBytes(raw, tx); err != nil {
+		t.Fatal(err)
+	}
+	if tx.Hash().Hex() != transfer.TxHash {
+		t.Errorf("tx hash %s, want %s", transfer.TxHash, tx.Hash().Hex())
+	}
+	return tx
+}
+
+func TestPayoutRepoSignTransfer(t *testing.T) {
+	node := newFakePayoutNode()
+	node.pendingNonce = 7
+	repo, address := newTestPayoutRepo(t, node, testPayoutPassword)
+	if repo.from != address {
+		t.Fatalf("wallet %s, want %s", repo.from.Hex(), address.Hex())
+	}
+
+	amount := int64(100 * 1e10)
+	transfer, err := repo.SignTransfer(context.Background(), "usdt", testPayoutTo, amount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := decodePayoutTransfer(t, transfer)
+
+	sender, err := types.Sender(types.NewEIP155Signer(big.NewInt(testPayoutChainId)), tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sender != address {
+		t.Errorf("signed by %s, want %s", sender.Hex(), address.Hex())
+	}
+	if nil == tx.To() || common.HexToAddress(testUsdtContract) != *tx.To() {
+		t.Errorf("tx to %v, want usdt contract", tx.To())
+	}
+	if 7 != tx.Nonce() || 7 != transfer.Nonce {
+		t.Errorf("nonce %d/%d, want 7", tx.Nonce(), transfer.Nonce)
+	}
+	if 0 != tx.GasPrice().Cmp(node.gasPrice) || defaultPayoutGasLimit != tx.Gas() {
+		t.Errorf("gas price %s gas %d", tx.GasPrice(), tx.Gas())
+	}
+
+	data := append([]byte{}, transferMethodId...)
+	data = append(data, common.LeftPadBytes(common.HexToAddress(testPayoutTo).Bytes(), 32)...)
+	data = append(data, common.LeftPadBytes(usdt(100).Bytes(), 32)...)
+	if !bytes.Equal(data, tx.Data()) {
+		t.Errorf("tx data %x, want %x", tx.Data(), data)
+	}
+
+	// 提现记录本身无法打款的错误单独标记，打款时跳过该提现
+	if _, err = repo.SignTransfer(context.Background(), "dhb", testPayoutTo, amount); biz.PayoutReasonWithdrawInvalid != errors.Reason(err) {
+		t.Errorf("unconfigured dhb contract: %v", err)
+	}
+	if _, err = repo.SignTransfer(context.Background(), "usdt", "not-an-address", amount); biz.PayoutReasonWithdrawInvalid != errors.Reason(err) {
+		t.Errorf("invalid address: %v", err)
+	}
+}
+
+func TestPayoutRepoWrongPassword(t *testing.T) {
+	repo, _ := newTestPayoutRepo(t, newFakePayoutNode(), "wrong")
+	if nil != repo.key {
+		t.Fatal("key decrypted with wrong password")
+	}
+	_, err := repo.SignTransfer(context.Background(), "usdt", testPayoutTo, 1e10)
+	if nil == err || biz.PayoutReasonWithdrawInvalid == errors.Reason(err) {
+		t.Errorf("expected wallet error, got %v", err)
+	}
+}
+
+func TestPayoutRepoNonce(t *testing.T) {
+	node := newFakePayoutNode()
+	node.pendingNonce = 3
+	repo, _ := newTestPayoutRepo(t, node, testPayoutPassword)
+	ctx := context.Background()
+
+	first, err := repo.SignTransfer(ctx, "usdt", testPayoutTo, 1e10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if 3 != first.Nonce {
+		t.Fatalf("first nonce %d, want 3", first.Nonce)
+	}
+	if err = repo.SendTransfer(ctx, first); err != nil {
+		t.Fatal(err)
+	}
+	// 节点已有的交易再次广播视为成功
+	if err = repo.SendTransfer(ctx, first); err != nil {
+		t.Errorf("rebroadcast: %v", err)
+	}
+
+	// 节点 pending nonce 落后时使用本进程已广播的下一个 nonce
+	node.mu.Lock()
+	node.pendingNonce = 3
+	node.mu.Unlock()
+	second, err := repo.SignTransfer(ctx, "usdt", testPayoutTo, 1e10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if 4 != second.Nonce {
+		t.Errorf("second nonce %d, want 4", second.Nonce)
+	}
+
+	// 其他进程用掉了 nonce 时跟随节点
+	node.mu.Lock()
+	node.pendingNonce = 9
+	node.mu.Unlock()
+	third, err := repo.SignTransfer(ctx, "usdt", testPayoutTo, 1e10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if 9 != third.Nonce {
+		t.Errorf("third nonce %d, want 9", third.Nonce)
+	}
+}
+
+func TestPayoutRepoReceipt(t *testing.T) {
+	node := newFakePayoutNode()
+	repo, _ := newTestPayoutRepo(t, node, testPayoutPassword)
+	ctx := context.Background()
+
+	transfer, err := repo.SignTransfer(ctx, "usdt", testPayoutTo, 1e10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := common.HexToHash(transfer.TxHash)
+
+	found, success, err := repo.GetTransferReceipt(ctx, transfer.TxHash)
+	if err != nil || found || success {
+		t.Fatalf("unknown tx: found %v success %v err %v", found, success, err)
+	}
+
+	node.mu.Lock()
+	node.receipts[hash] = &fakePayoutReceipt{}
+	node.mu.Unlock()
+	if found, _, err = repo.GetTransferReceipt(ctx, transfer.TxHash); err != nil || found {
+		t.Errorf("pending receipt: found %v err %v", found, err)
+	}
+
+	node.mu.Lock()
+	node.receipts[hash] = &fakePayoutReceipt{blockNumber: 100, status: 1}
+	node.mu.Unlock()
+	if found, success, err = repo.GetTransferReceipt(ctx, transfer.TxHash); err != nil || !found || !success {
+		t.Errorf("success receipt: found %v success %v err %v", found, success, err)
+	}
+
+	node.mu.Lock()
+	node.receipts[hash] = &fakePayoutReceipt{blockNumber: 100, status: 0}
+	node.mu.Unlock()
+	if found, success, err = repo.GetTransferReceipt(ctx, transfer.TxHash); err != nil || !found || success {
+		t.Errorf("failed receipt: found %v success %v err %v", found, success, err)
+	}
+}
+
+func TestPayoutRepoConfirmedNonce(t *testing.T) {
+	node := newFakePayoutNode()
+	node.pendingNonce = 12
+	node.latestNonce = 10
+	repo, _ := newTestPayoutRepo(t, node, testPayoutPassword)
+
+	nonce, err := repo.GetConfirmedNonce(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if 10 != nonce {
+		t.Errorf("confirmed nonce %d, want 10", nonce)
+	}
+}
@@ -0,0 +1,290 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const recommendClosureBatchSize = 500
+
+// UserRecommendClosure 推荐关系闭包表，每个用户与自己及每个上级各一条，Depth 为相隔的代数，自己为 0
+type UserRecommendClosure struct {
+	ID           int64     `gorm:"primarykey;type:int"`
+	AncestorId   int64     `gorm:"type:int;not null;uniqueIndex:ancestor_descendant"`
+	DescendantId int64     `gorm:"type:int;not null;uniqueIndex:ancestor_descendant;index:descendant_depth"`
+	Depth        int64     `gorm:"type:int;not null;index:descendant_depth"`
+	CreatedAt    time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt    time.Time `gorm:"type:datetime;not null"`
+}
+
+func (c *UserRecommendClosure) toBiz() *biz.UserRecommendClosure {
+	return &biz.UserRecommendClosure{
+		AncestorId:   c.AncestorId,
+		DescendantId: c.DescendantId,
+		Depth:        c.Depth,
+	}
+}
+
+// recommendCodeUserIds 推荐码中的上级，从最上级到直推人
+func recommendCodeUserIds(code string) []int64 {
+	res := make([]int64, 0)
+	for _, v := range strings.Split(code, "D") {
+		tmpUserId, _ := strconv.ParseInt(v, 10, 64)
+		if 0 < tmpUserId {
+			res = append(res, tmpUserId)
+		}
+	}
+	return res
+}
+
+// createUserRecommendClosure 新用户的闭包记录：自身一条，再接上推荐人的所有上级
+func (ur *UserRecommendRepo) createUserRecommendClosure(ctx context.Context, userId int64, recommendUserId int64) error {
+	rows := []*UserRecommendClosure{{AncestorId: userId, DescendantId: userId}}
+	if 0 < recommendUserId {
+		var ancestors []*UserRecommendClosure
+		if err := ur.data.DB(ctx).Table("user_recommend_closure").
+			Where("descendant_id=?", recommendUserId).
+			Find(&ancestors).Error; err != nil {
+			return errors.New(500, "USER RECOMMEND CLOSURE ERROR", err.Error())
+		}
+		for _, v := range ancestors {
+			rows = append(rows, &UserRecommendClosure{AncestorId: v.AncestorId, DescendantId: userId, Depth: v.Depth + 1})
+		}
+	}
+
+	if err := ur.data.DB(ctx).Table("user_recommend_closure").Create(&rows).Error; err != nil {
+		return errors.New(500, "CREATE_USER_RECOMMEND_ERROR", "用户推荐关系创建失败")
+	}
+
+	return nil
+}
+
+// moveUserRecommendClosure 把用户及其团队整体移到新的推荐人下，需在事务中调用
+func (ur *UserRecommendRepo) moveUserRecommendClosure(ctx context.Context, userId int64, recommendUserId int64) error {
+	var subtree []*UserRecommendClosure
+	if err := ur.data.DB(ctx).Table("user_recommend_closure").
+		Where("ancestor_id=?", userId).
+		Find(&subtree).Error; err != nil {
+		return errors.New(500, "USER RECOMMEND CLOSURE ERROR", err.Error())
+	}
+
+	subtreeIds := make([]int64, 0, len(subtree))
+	for _, v := range subtree {
+		if v.DescendantId == recommendUserId {
+			return errors.New(500, "USER_ERROR", "不能修改为自己团队中的用户")
+		}
+		subtreeIds = append(subtreeIds, v.DescendantId)
+	}
+	if 0 == len(subtreeIds) {
+		return errors.New(500, "USER_RECOMMEND_CLOSURE_ERROR", "推荐关系未初始化")
+	}
+
+	// 断开团队与原上级的关系，团队内部的关系不变
+	if err := ur.data.DB(ctx).Table("user_recommend_closure").
+		Where("descendant_id in (?)", subtreeIds).
+		Where("ancestor_id not in (?)", subtreeIds).
+		Delete(&UserRecommendClosure{}).Error; err != nil {
+		return errors.New(500, "USER RECOMMEND CLOSURE ERROR", err.Error())
+	}
+
+	if 0 >= recommendUserId {
+		return nil
+	}
+
+	var ancestors []*UserRecommendClosure
+	if err := ur.data.DB(ctx).Table("user_recommend_closure").
+		Where("descendant_id=?", recommendUserId).
+		Find(&ancestors).Error; err != nil {
+		return errors.New(500, "USER RECOMMEND CLOSURE ERROR", err.Error())
+	}
+
+	rows := make([]*UserRecommendClosure, 0, len(ancestors)*len(subtree))
+	for _, vAncestor := range ancestors {
+		for _, vSubtree := range subtree {
+			rows = append(rows, &UserRecommendClosure{
+				AncestorId:   vAncestor.AncestorId,
+				DescendantId: vSubtree.DescendantId,
+				Depth:        vAncestor.Depth + vSubtree.Depth + 1,
+			})
+		}
+	}
+	if 0 == len(rows) {
+		return nil
+	}
+	if err := ur.data.DB(ctx).Table("user_recommend_closure").CreateInBatches(&rows, recommendClosureBatchSize).Error; err != nil {
+		return errors.New(500, "CREATE_USER_RECOMMEND_ERROR", "用户推荐关系修改失败")
+	}
+
+	return nil
+}
+
+// GetUserRecommendUplines 上级，直推人在前；maxDepth 为 0 时不限代数
+func (ur *UserRecommendRepo) GetUserRecommendUplines(ctx context.Context, userId int64, maxDepth int64) ([]*biz.UserRecommendClosure, error) {
+	var closures []*UserRecommendClosure
+	res := make([]*biz.UserRecommendClosure, 0)
+
+	instance := ur.data.DB(ctx).Table("user_recommend_closure").
+		Where("descendant_id=?", userId).
+		Where("depth>?", 0)
+	if 0 < maxDepth {
+		instance = instance.Where("depth<=?", maxDepth)
+	}
+	if err := instance.Order("depth asc").Find(&closures).Error; err != nil {
+		return nil, errors.New(500, "USER RECOMMEND CLOSURE ERROR", err.Error())
+	}
+
+	for _, v := range closures {
+		res = append(res, v.toBiz())
+	}
+	return res, nil
+}
+
+// GetUserRecommendDownlines 团队成员，按代数、用户id排序；maxDepth 为 0 时不限代数
+func (ur *UserRecommendRepo) GetUserRecommendDownlines(ctx context.Context, userId int64, maxDepth int64) ([]*biz.UserRecommendClosure, error) {
+	var closures []*UserRecommendClosure
+	res := make([]*biz.UserRecommendClosure, 0)
+
+	instance := ur.data.DB(ctx).Table("user_recommend_closure").
+		Where("ancestor_id=?", userId).
+		Where("depth>?", 0)
+	if 0 < maxDepth {
+		instance = instance.Where("depth<=?", maxDepth)
+	}
+	if err := instance.Order("depth asc, descendant_id asc").Find(&closures).Error; err != nil {
+		return nil, errors.New(500, "USER RECOMMEND CLOSURE ERROR", err.Error())
+	}
+
+	for _, v := range closures {
+		res = append(res, v.toBiz())
+	}
+	return res, nil
+}
+
+// BackfillUserRecommendClosure 按 user_recommend 中的推荐码补齐闭包表，已有的记录跳过，可重复执行；返回新写入的条数
+func (ur *UserRecommendRepo) BackfillUserRecommendClosure(ctx context.Context) (int64, error) {
+	var (
+		count  int64
+		lastId int64
+	)
+	for {
+		var userRecommends []*UserRecommend
+		if err := ur.data.db.Table("user_recommend").
+			Where("id>?", lastId).
+			Order("id asc").
+			Limit(recommendClosureBatchSize).
+			Find(&userRecommends).Error; err != nil {
+			return count, errors.New(500, "USER RECOMMEND ERROR", err.Error())
+		}
+
+		rows := make([]*UserRecommendClosure, 0)
+		for _, v := range userRecommends {
+			lastId = v.ID
+			rows = append(rows, &UserRecommendClosure{AncestorId: v.UserId, DescendantId: v.UserId})
+
+			ancestorIds := recommendCodeUserIds(v.RecommendCode)
+			for i, ancestorId := range ancestorIds {
+				rows = append(rows, &UserRecommendClosure{
+					AncestorId:   ancestorId,
+					DescendantId: v.UserId,
+					Depth:        int64(len(ancestorIds) - i),
+				})
+			}
+		}
+
+		if 0 < len(rows) {
+			res := ur.data.db.Table("user_recommend_closure").
+				Clauses(clause.OnConflict{DoNothing: true}).
+				Session(&gorm.Session{CreateBatchSize: recommendClosureBatchSize}).
+				Create(&rows)
+			if res.Error != nil {
+				return count, errors.New(500, "USER RECOMMEND CLOSURE ERROR", res.Error.Error())
+			}
+			count += res.RowsAffected
+		}
+
+		if len(userRecommends) < recommendClosureBatchSize {
+			break
+		}
+	}
+
+	return count, nil
+}
+
+// GetUserRecommendDescendantCounts 每个用户的团队人数
+func (ur *UserRecommendRepo) GetUserRecommendDescendantCounts(ctx context.Context, userIds ...int64) (map[int64]int64, error) {
+	var counts []*struct {
+		AncestorId int64
+		Count      int64
+	}
+	res := make(map[int64]int64, len(userIds))
+	if 0 == len(userIds) {
+		return res, nil
+	}
+
+	if err := ur.data.db.Table("user_recommend_closure").
+		Where("ancestor_id in (?)", userIds).
+		Where("depth>?", 0).
+		Select("ancestor_id, count(*) as count").
+		Group("ancestor_id").
+		Find(&counts).Error; err != nil {
+		return nil, errors.New(500, "USER RECOMMEND CLOSURE ERROR", err.Error())
+	}
+
+	for _, v := range counts {
+		res[v.AncestorId] = v.Count
+	}
+	return res, nil
+}
+
+// GetUserRecommendParentIds 每个用户的直推人
+func (ur *UserRecommendRepo) GetUserRecommendParentIds(ctx context.Context, userIds ...int64) (map[int64]int64, error) {
+	var closures []*UserRecommendClosure
+	res := make(map[int64]int64, len(userIds))
+	if 0 == len(userIds) {
+		return res, nil
+	}
+
+	if err := ur.data.db.Table("user_recommend_closure").
+		Where("descendant_id in (?)", userIds).
+		Where("depth=?", 1).
+		Find(&closures).Error; err != nil {
+		return nil, errors.New(500, "USER RECOMMEND CLOSURE ERROR", err.Error())
+	}
+
+	for _, v := range closures {
+		res[v.DescendantId] = v.AncestorId
+	}
+	return res, nil
+}
+
+// likeEscaper 转义 like 的通配符
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// SearchUserRecommendDownlines 团队中地址以 address 开头的成员，按代数排序
+func (ur *UserRecommendRepo) SearchUserRecommendDownlines(ctx context.Context, userId int64, address string, limit int) ([]*biz.UserRecommendClosure, error) {
+	var closures []*UserRecommendClosure
+	res := make([]*biz.UserRecommendClosure, 0)
+
+	if err := ur.data.db.Table("user_recommend_closure").
+		Select("user_recommend_closure.*").
+		Joins("join user on user.id=user_recommend_closure.descendant_id").
+		Where("user_recommend_closure.ancestor_id=?", userId).
+		Where("user_recommend_closure.depth>?", 0).
+		Where("user.address like ?", likeEscaper.Replace(address)+"%").
+		Order("user_recommend_closure.depth asc, user_recommend_closure.descendant_id asc").
+		Limit(limit).
+		Find(&closures).Error; err != nil {
+		return nil, errors.New(500, "USER RECOMMEND CLOSURE ERROR", err.Error())
+	}
+
+	for _, v := range closures {
+		res = append(res, v.toBiz())
+	}
+	return res, nil
+}
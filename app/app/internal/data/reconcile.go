@@ -0,0 +1,241 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"strconv"
+	"time"
+)
+
+type BalanceDiscrepancy struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	UserId    int64     `gorm:"type:int;not null"`
+	CoinType  string    `gorm:"type:varchar(45);not null"`
+	Source    string    `gorm:"type:varchar(45);not null"`
+	Expected  int64     `gorm:"type:bigint;not null"`
+	Actual    int64     `gorm:"type:bigint;not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type ReconcileRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// 系统奖励记录使用的用户id，不对应用户余额
+const systemRewardUserId = 999999999
+
+func NewReconcileRepo(data *Data, logger log.Logger) biz.ReconcileRepo {
+	return &ReconcileRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+type userCoinTotal struct {
+	UserId   int64
+	CoinType string
+	Total    int64
+}
+
+func addUserCoinTotal(res map[int64]*biz.UserBalance, userId int64, coinType string, amount int64) {
+	if _, ok := res[userId]; !ok {
+		res[userId] = &biz.UserBalance{UserId: userId}
+	}
+	if "dhb" == coinType {
+		res[userId].BalanceDhb += amount
+	} else {
+		res[userId].BalanceUsdt += amount
+	}
+}
+
+// GetUserBalances .
+func (rr *ReconcileRepo) GetUserBalances(ctx context.Context) (map[int64]*biz.UserBalance, error) {
+	var userBalances []*UserBalance
+	if err := rr.data.DB(ctx).Table("user_balance").Find(&userBalances).Error; err != nil {
+		return nil, errors.New(500, "USER BALANCE ERROR", err.Error())
+	}
+
+	res := make(map[int64]*biz.UserBalance, len(userBalances))
+	for _, v := range userBalances {
+		res[v.UserId] = &biz.UserBalance{
+			ID:          v.ID,
+			UserId:      v.UserId,
+			BalanceUsdt: v.BalanceUsdt,
+			BalanceDhb:  v.BalanceDhb,
+		}
+	}
+
+	return res, nil
+}
+
+// GetUserHistoryBalances 按历史记录重新计算余额：奖励和 dhb 充值增加，未拒绝、取消或退回的提现、锁仓中的金额减少
+func (rr *ReconcileRepo) GetUserHistoryBalances(ctx context.Context) (map[int64]*biz.UserBalance, error) {
+	res := make(map[int64]*biz.UserBalance)
+
+	var rewards []*userCoinTotal
+	if err := rr.data.DB(ctx).Table("reward").
+		Where("user_id<>?", systemRewardUserId).
+		Select("user_id, sum(amount) as total").
+		Group("user_id").
+		Find(&rewards).Error; err != nil {
+		return nil, errors.New(500, "REWARD ERROR", err.Error())
+	}
+	for _, v := range rewards {
+		addUserCoinTotal(res, v.UserId, "usdt", v.Total)
+	}
+
+	var deposits []*userCoinTotal
+	if err := rr.data.DB(ctx).Table("user_balance_record").
+		Where("type=?", "deposit").
+		Where("coin_type=?", "dhb").
+		Select("user_id, sum(amount) as total").
+		Group("user_id").
+		Find(&deposits).Error; err != nil {
+		return nil, errors.New(500, "USER BALANCE RECORD ERROR", err.Error())
+	}
+	for _, v := range deposits {
+		addUserCoinTotal(res, v.UserId, "dhb", v.Total)
+	}
+
+	var withdraws []*userCoinTotal
+	if err := rr.data.DB(ctx).Table("withdraw").
+		Where("status not in (?)", []string{biz.WithdrawStatusRejected, biz.WithdrawStatusCancelled, biz.WithdrawStatusRefunded}).
+		Select("user_id, type as coin_type, sum(amount) as total").
+		Group("user_id, type").
+		Find(&withdraws).Error; err != nil {
+		return nil, errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+	for _, v := range withdraws {
+		addUserCoinTotal(res, v.UserId, v.CoinType, -v.Total)
+	}
+
+	var balanceRewards []*userCoinTotal
+	if err := rr.data.DB(ctx).Table("balance_reward").
+		Select("user_id, sum(amount) as total").
+		Group("user_id").
+		Find(&balanceRewards).Error; err != nil {
+		return nil, errors.New(500, "BALANCE REWARD ERROR", err.Error())
+	}
+	for _, v := range balanceRewards {
+		addUserCoinTotal(res, v.UserId, "usdt", -v.Total)
+	}
+
+	return res, nil
+}
+
+// GetUserLedgerBalances 账本用户科目余额，同时返回是否已记录期初余额
+func (rr *ReconcileRepo) GetUserLedgerBalances(ctx context.Context) (map[int64]*biz.UserBalance, bool, error) {
+	var opened int64
+	if err := rr.data.DB(ctx).Table("ledger_journal").Where("type=?", "opening").Count(&opened).Error; err != nil {
+		return nil, false, errors.New(500, "LEDGER ERROR", err.Error())
+	}
+
+	var postings []*userCoinTotal
+	if err := rr.data.DB(ctx).Table("ledger_posting").
+		Where("account=?", biz.LedgerAccountUser).
+		Select("user_id, coin_type, sum(amount) as total").
+		Group("user_id, coin_type").
+		Find(&postings).Error; err != nil {
+		return nil, false, errors.New(500, "LEDGER ERROR", err.Error())
+	}
+
+	res := make(map[int64]*biz.UserBalance)
+	for _, v := range postings {
+		addUserCoinTotal(res, v.UserId, v.CoinType, v.Total)
+	}
+
+	return res, 0 < opened, nil
+}
+
+// CreateBalanceDiscrepancies 同一用户、币种和来源的差异与最近一条记录相同时只更新 updated_at，金额变化时才写入新记录
+func (rr *ReconcileRepo) CreateBalanceDiscrepancies(ctx context.Context, discrepancies []*biz.BalanceDiscrepancy) error {
+	var latest []*BalanceDiscrepancy
+	if err := rr.data.DB(ctx).Table("balance_discrepancy").
+		Where("id in (?)", rr.data.DB(ctx).Table("balance_discrepancy").Select("max(id)").Group("user_id, coin_type, source")).
+		Find(&latest).Error; err != nil {
+		return errors.New(500, "BALANCE DISCREPANCY ERROR", err.Error())
+	}
+	latestMap := make(map[string]*BalanceDiscrepancy, len(latest))
+	for _, v := range latest {
+		latestMap[discrepancyKey(v.UserId, v.CoinType, v.Source)] = v
+	}
+
+	rows := make([]*BalanceDiscrepancy, 0)
+	seenIds := make([]int64, 0)
+	for _, v := range discrepancies {
+		if last, ok := latestMap[discrepancyKey(v.UserId, v.CoinType, v.Source)]; ok && last.Expected == v.Expected && last.Actual == v.Actual {
+			seenIds = append(seenIds, last.ID)
+			continue
+		}
+
+		rows = append(rows, &BalanceDiscrepancy{
+			UserId:   v.UserId,
+			CoinType: v.CoinType,
+			Source:   v.Source,
+			Expected: v.Expected,
+			Actual:   v.Actual,
+		})
+	}
+
+	if 0 < len(seenIds) {
+		if err := rr.data.DB(ctx).Table("balance_discrepancy").
+			Where("id in (?)", seenIds).
+			Updates(map[string]interface{}{"updated_at": time.Now()}).Error; err != nil {
+			return errors.New(500, "UPDATE_BALANCE_DISCREPANCY_ERROR", "对账差异记录修改失败")
+		}
+	}
+	if 0 < len(rows) {
+		if err := rr.data.DB(ctx).Table("balance_discrepancy").CreateInBatches(&rows, 500).Error; err != nil {
+			return errors.New(500, "CREATE_BALANCE_DISCREPANCY_ERROR", "对账差异记录创建失败")
+		}
+	}
+
+	return nil
+}
+
+func discrepancyKey(userId int64, coinType string, source string) string {
+	return strconv.FormatInt(userId, 10) + ":" + coinType + ":" + source
+}
+
+// GetBalanceDiscrepancies .
+func (rr *ReconcileRepo) GetBalanceDiscrepancies(ctx context.Context, b *biz.Pagination, userId int64) ([]*biz.BalanceDiscrepancy, error, int64) {
+	var (
+		discrepancies []*BalanceDiscrepancy
+		count         int64
+	)
+	res := make([]*biz.BalanceDiscrepancy, 0)
+
+	instance := rr.data.db.Table("balance_discrepancy")
+
+	if 0 < userId {
+		instance = instance.Where("user_id=?", userId)
+	}
+
+	instance = instance.Count(&count)
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("id desc").Find(&discrepancies).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, errors.NotFound("BALANCE_DISCREPANCY_NOT_FOUND", "balance discrepancy not found"), 0
+		}
+
+		return nil, errors.New(500, "BALANCE DISCREPANCY ERROR", err.Error()), 0
+	}
+
+	for _, v := range discrepancies {
+		res = append(res, &biz.BalanceDiscrepancy{
+			ID:        v.ID,
+			UserId:    v.UserId,
+			CoinType:  v.CoinType,
+			Source:    v.Source,
+			Expected:  v.Expected,
+			Actual:    v.Actual,
+			CreatedAt: v.CreatedAt,
+			UpdatedAt: v.UpdatedAt,
+		})
+	}
+	return res, nil, count
+}
@@ -10,15 +10,28 @@ import (
 )
 
 type EthUserRecord struct {
-	ID        int64     `gorm:"primarykey;type:int"`
-	Hash      string    `gorm:"type:varchar(100);not null"`
-	UserId    int64     `gorm:"type:int;not null"`
-	Status    string    `gorm:"type:varchar(45);not null"`
-	Type      string    `gorm:"type:varchar(45);not null"`
-	Amount    string    `gorm:"type:varchar(45);not null"`
-	CoinType  string    `gorm:"type:varchar(45);not null"`
-	CreatedAt time.Time `gorm:"type:datetime;not null"`
-	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+	ID            int64     `gorm:"primarykey;type:int"`
+	Hash          string    `gorm:"type:varchar(100);not null"`
+	UserId        int64     `gorm:"type:int;not null"`
+	FromAddress   string    `gorm:"type:varchar(100);not null"`
+	Status        string    `gorm:"type:varchar(45);not null"`
+	Type          string    `gorm:"type:varchar(45);not null"`
+	Amount        string    `gorm:"type:varchar(45);not null"`
+	CoinType      string    `gorm:"type:varchar(45);not null"`
+	BlockNumber   int64     `gorm:"type:bigint;not null"`
+	BlockHash     string    `gorm:"type:varchar(100);not null"`
+	Confirmations int64     `gorm:"type:int;not null"`
+	CreatedAt     time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt     time.Time `gorm:"type:datetime;not null"`
+}
+
+type ChainCheckpoint struct {
+	ID          int64     `gorm:"primarykey;type:int"`
+	Name        string    `gorm:"type:varchar(45);not null"`
+	BlockNumber int64     `gorm:"type:bigint;not null"`
+	BlockHash   string    `gorm:"type:varchar(100);not null"`
+	CreatedAt   time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt   time.Time `gorm:"type:datetime;not null"`
 }
 
 type EthUserRecordRepo struct {
@@ -26,6 +39,11 @@ type EthUserRecordRepo struct {
 	log  *log.Helper
 }
 
+type ChainCheckpointRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
 func NewEthUserRecordRepo(data *Data, logger log.Logger) biz.EthUserRecordRepo {
 	return &EthUserRecordRepo{
 		data: data,
@@ -33,6 +51,13 @@ func NewEthUserRecordRepo(data *Data, logger log.Logger) biz.EthUserRecordRepo {
 	}
 }
 
+func NewChainCheckpointRepo(data *Data, logger log.Logger) biz.ChainCheckpointRepo {
+	return &ChainCheckpointRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
 func (e *EthUserRecordRepo) GetEthUserRecordListByHash(ctx context.Context, hash ...string) (map[string]*biz.EthUserRecord, error) {
 	var ethUserRecord []*EthUserRecord
 	if err := e.data.DB(ctx).Table("eth_user_record").Where("hash IN (?)", hash).Find(&ethUserRecord).Error; err != nil {
@@ -46,13 +71,17 @@ func (e *EthUserRecordRepo) GetEthUserRecordListByHash(ctx context.Context, hash
 	res := make(map[string]*biz.EthUserRecord, 0)
 	for _, item := range ethUserRecord {
 		res[item.Hash] = &biz.EthUserRecord{
-			ID:       item.ID,
-			UserId:   item.UserId,
-			Hash:     item.Hash,
-			Status:   item.Status,
-			Type:     item.Type,
-			Amount:   item.Amount,
-			CoinType: item.CoinType,
+			ID:            item.ID,
+			UserId:        item.UserId,
+			From:          item.FromAddress,
+			Hash:          item.Hash,
+			Status:        item.Status,
+			Type:          item.Type,
+			Amount:        item.Amount,
+			CoinType:      item.CoinType,
+			BlockNumber:   item.BlockNumber,
+			BlockHash:     item.BlockHash,
+			Confirmations: item.Confirmations,
 		}
 	}
 
@@ -62,11 +91,15 @@ func (e *EthUserRecordRepo) GetEthUserRecordListByHash(ctx context.Context, hash
 func (e *EthUserRecordRepo) CreateEthUserRecordListByHash(ctx context.Context, r *biz.EthUserRecord) (*biz.EthUserRecord, error) {
 	var ethUserRecord EthUserRecord
 	ethUserRecord.UserId = r.UserId
+	ethUserRecord.FromAddress = r.From
 	ethUserRecord.Hash = r.Hash
 	ethUserRecord.Type = r.Type
 	ethUserRecord.Status = r.Status
 	ethUserRecord.Amount = r.Amount
 	ethUserRecord.CoinType = r.CoinType
+	ethUserRecord.BlockNumber = r.BlockNumber
+	ethUserRecord.BlockHash = r.BlockHash
+	ethUserRecord.Confirmations = r.Confirmations
 
 	res := e.data.DB(ctx).Table("eth_user_record").Create(&ethUserRecord)
 	if res.Error != nil {
@@ -74,12 +107,122 @@ func (e *EthUserRecordRepo) CreateEthUserRecordListByHash(ctx context.Context, r
 	}
 
 	return &biz.EthUserRecord{
-		ID:       ethUserRecord.ID,
-		UserId:   ethUserRecord.UserId,
-		Hash:     ethUserRecord.Hash,
-		Status:   ethUserRecord.Status,
-		Type:     ethUserRecord.Type,
-		Amount:   ethUserRecord.Amount,
-		CoinType: ethUserRecord.CoinType,
+		ID:            ethUserRecord.ID,
+		UserId:        ethUserRecord.UserId,
+		From:          ethUserRecord.FromAddress,
+		Hash:          ethUserRecord.Hash,
+		Status:        ethUserRecord.Status,
+		Type:          ethUserRecord.Type,
+		Amount:        ethUserRecord.Amount,
+		CoinType:      ethUserRecord.CoinType,
+		BlockNumber:   ethUserRecord.BlockNumber,
+		BlockHash:     ethUserRecord.BlockHash,
+		Confirmations: ethUserRecord.Confirmations,
+	}, nil
+}
+
+// GetEthUserRecordsByStatus 区块高度不小于 fromBlock 的指定状态记录
+func (e *EthUserRecordRepo) GetEthUserRecordsByStatus(ctx context.Context, fromBlock int64, status ...string) ([]*biz.EthUserRecord, error) {
+	var ethUserRecord []*EthUserRecord
+	if err := e.data.DB(ctx).Table("eth_user_record").
+		Where("status IN (?)", status).
+		Where("block_number>=?", fromBlock).
+		Order("block_number asc, id asc").
+		Find(&ethUserRecord).Error; err != nil {
+		return nil, errors.New(500, "ETH USER RECORD ERROR", err.Error())
+	}
+
+	res := make([]*biz.EthUserRecord, 0)
+	for _, item := range ethUserRecord {
+		res = append(res, &biz.EthUserRecord{
+			ID:            item.ID,
+			UserId:        item.UserId,
+			From:          item.FromAddress,
+			Hash:          item.Hash,
+			Status:        item.Status,
+			Type:          item.Type,
+			Amount:        item.Amount,
+			CoinType:      item.CoinType,
+			BlockNumber:   item.BlockNumber,
+			BlockHash:     item.BlockHash,
+			Confirmations: item.Confirmations,
+		})
+	}
+
+	return res, nil
+}
+
+// UpdateEthUserRecordStatus 仅在状态仍为 oldStatus 时修改，返回是否修改成功
+func (e *EthUserRecordRepo) UpdateEthUserRecordStatus(ctx context.Context, id int64, oldStatus string, r *biz.EthUserRecord) (bool, error) {
+	res := e.data.DB(ctx).Table("eth_user_record").
+		Where("id=? and status=?", id, oldStatus).
+		Updates(map[string]interface{}{
+			"status":        r.Status,
+			"block_number":  r.BlockNumber,
+			"block_hash":    r.BlockHash,
+			"confirmations": r.Confirmations,
+			"updated_at":    time.Now().UTC(),
+		})
+	if res.Error != nil {
+		return false, errors.New(500, "UPDATE_ETH_USER_RECORD_ERROR", "以太坊交易信息修改失败")
+	}
+
+	return 0 < res.RowsAffected, nil
+}
+
+// ResolveEthUserRecord 待人工处理的充值指定入账用户，改为 seen 后按正常流程确认入账，已处理时返回 false
+func (e *EthUserRecordRepo) ResolveEthUserRecord(ctx context.Context, id int64, userId int64) (bool, error) {
+	res := e.data.DB(ctx).Table("eth_user_record").
+		Where("id=? and status=?", id, biz.EthUserRecordStatusUnmatched).
+		Updates(map[string]interface{}{
+			"user_id":    userId,
+			"status":     biz.EthUserRecordStatusSeen,
+			"updated_at": time.Now().UTC(),
+		})
+	if res.Error != nil {
+		return false, errors.New(500, "UPDATE_ETH_USER_RECORD_ERROR", "以太坊交易信息修改失败")
+	}
+
+	return 0 < res.RowsAffected, nil
+}
+
+// GetChainCheckpoint .
+func (c *ChainCheckpointRepo) GetChainCheckpoint(ctx context.Context, name string) (*biz.ChainCheckpoint, error) {
+	var checkpoint ChainCheckpoint
+	if err := c.data.DB(ctx).Table("chain_checkpoint").Where("name=?", name).First(&checkpoint).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("CHAIN_CHECKPOINT_NOT_FOUND", "chain checkpoint not found")
+		}
+
+		return nil, errors.New(500, "CHAIN CHECKPOINT ERROR", err.Error())
+	}
+
+	return &biz.ChainCheckpoint{
+		ID:          checkpoint.ID,
+		Name:        checkpoint.Name,
+		BlockNumber: checkpoint.BlockNumber,
+		BlockHash:   checkpoint.BlockHash,
 	}, nil
 }
+
+// UpdateChainCheckpoint 不存在时创建
+func (c *ChainCheckpointRepo) UpdateChainCheckpoint(ctx context.Context, name string, blockNumber int64, blockHash string) error {
+	res := c.data.DB(ctx).Table("chain_checkpoint").Where("name=?", name).
+		Updates(map[string]interface{}{"block_number": blockNumber, "block_hash": blockHash, "updated_at": time.Now().UTC()})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_CHAIN_CHECKPOINT_ERROR", "区块检查点修改失败")
+	}
+	if 0 < res.RowsAffected {
+		return nil
+	}
+
+	var checkpoint ChainCheckpoint
+	checkpoint.Name = name
+	checkpoint.BlockNumber = blockNumber
+	checkpoint.BlockHash = blockHash
+	if err := c.data.DB(ctx).Table("chain_checkpoint").Create(&checkpoint).Error; err != nil {
+		return errors.New(500, "CREATE_CHAIN_CHECKPOINT_ERROR", "区块检查点创建失败")
+	}
+
+	return nil
+}
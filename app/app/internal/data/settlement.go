@@ -0,0 +1,132 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"time"
+)
+
+// RewardSettlement type 和 date 联合唯一
+type RewardSettlement struct {
+	ID         int64     `gorm:"primarykey;type:int"`
+	Type       string    `gorm:"type:varchar(45);not null;uniqueIndex:type_date"`
+	Date       string    `gorm:"type:varchar(10);not null;uniqueIndex:type_date"`
+	Status     string    `gorm:"type:varchar(45);not null"`
+	Rate       int64     `gorm:"type:bigint;not null"`
+	LastUserId int64     `gorm:"type:int;not null"`
+	Count      int64     `gorm:"type:int;not null"`
+	Amount     int64     `gorm:"type:bigint;not null"`
+	CreatedAt  time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt  time.Time `gorm:"type:datetime;not null"`
+}
+
+type RewardSettlementRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewRewardSettlementRepo(data *Data, logger log.Logger) biz.RewardSettlementRepo {
+	return &RewardSettlementRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (s *RewardSettlement) toBiz() *biz.RewardSettlement {
+	return &biz.RewardSettlement{
+		ID:         s.ID,
+		Type:       s.Type,
+		Date:       s.Date,
+		Status:     s.Status,
+		Rate:       s.Rate,
+		LastUserId: s.LastUserId,
+		Count:      s.Count,
+		Amount:     s.Amount,
+		CreatedAt:  s.CreatedAt,
+		UpdatedAt:  s.UpdatedAt,
+	}
+}
+
+func (rs *RewardSettlementRepo) getRewardSettlement(ctx context.Context, settlementType string, date string) (*RewardSettlement, error) {
+	var settlement RewardSettlement
+	if err := rs.data.DB(ctx).Table("reward_settlement").
+		Where("type=?", settlementType).
+		Where("date=?", date).
+		First(&settlement).Error; err != nil {
+		return nil, err
+	}
+
+	return &settlement, nil
+}
+
+// GetRewardSettlement .
+func (rs *RewardSettlementRepo) GetRewardSettlement(ctx context.Context, settlementType string, date string) (*biz.RewardSettlement, error) {
+	settlement, err := rs.getRewardSettlement(ctx, settlementType, date)
+	if nil != err {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("REWARD_SETTLEMENT_NOT_FOUND", "reward settlement not found")
+		}
+
+		return nil, errors.New(500, "REWARD SETTLEMENT ERROR", err.Error())
+	}
+
+	return settlement.toBiz(), nil
+}
+
+// GetOrCreateRewardSettlement 当天已有结算时返回已有的记录，并发创建时以唯一索引为准
+func (rs *RewardSettlementRepo) GetOrCreateRewardSettlement(ctx context.Context, s *biz.RewardSettlement) (*biz.RewardSettlement, error) {
+	settlement, err := rs.getRewardSettlement(ctx, s.Type, s.Date)
+	if nil == err {
+		return settlement.toBiz(), nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New(500, "REWARD SETTLEMENT ERROR", err.Error())
+	}
+
+	settlement = &RewardSettlement{
+		Type:   s.Type,
+		Date:   s.Date,
+		Status: s.Status,
+		Rate:   s.Rate,
+	}
+	if err = rs.data.DB(ctx).Table("reward_settlement").Create(settlement).Error; err != nil {
+		if settlement, err = rs.getRewardSettlement(ctx, s.Type, s.Date); nil != err {
+			return nil, errors.New(500, "CREATE_REWARD_SETTLEMENT_ERROR", "结算记录创建失败")
+		}
+	}
+
+	return settlement.toBiz(), nil
+}
+
+// UpdateRewardSettlementProgress 记录已结算完成的用户，累加数量和金额
+func (rs *RewardSettlementRepo) UpdateRewardSettlementProgress(ctx context.Context, id int64, lastUserId int64, count int64, amount int64) error {
+	res := rs.data.DB(ctx).Table("reward_settlement").
+		Where("id=?", id).
+		Where("status=?", biz.SettlementStatusRunning).
+		Where("last_user_id<?", lastUserId).
+		Updates(map[string]interface{}{
+			"last_user_id": lastUserId,
+			"count":        gorm.Expr("count + ?", count),
+			"amount":       gorm.Expr("amount + ?", amount),
+		})
+	if 0 == res.RowsAffected || res.Error != nil {
+		return errors.New(500, "UPDATE_REWARD_SETTLEMENT_ERROR", "结算进度修改失败")
+	}
+
+	return nil
+}
+
+// UpdateRewardSettlementStatus .
+func (rs *RewardSettlementRepo) UpdateRewardSettlementStatus(ctx context.Context, id int64, status string) error {
+	res := rs.data.DB(ctx).Table("reward_settlement").
+		Where("id=?", id).
+		Updates(map[string]interface{}{"status": status})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_REWARD_SETTLEMENT_ERROR", "结算状态修改失败")
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"strconv"
 	"strings"
 	"time"
@@ -102,11 +103,24 @@ type Withdraw struct {
 	RelAmount       int64     `gorm:"type:bigint"`
 	Status          string    `gorm:"type:varchar(45);not null"`
 	Type            string    `gorm:"type:varchar(45);not null"`
+	Reason          string    `gorm:"type:varchar(255);not null"`
+	Address         string    `gorm:"type:varchar(100);not null"`
+	TxHash          string    `gorm:"type:varchar(100);not null"`
+	TxNonce         int64     `gorm:"type:bigint;not null"`
+	TxRaw           string    `gorm:"type:text;not null"`
 	BalanceRecordId int64     `gorm:"type:int"`
 	CreatedAt       time.Time `gorm:"type:datetime;not null"`
 	UpdatedAt       time.Time `gorm:"type:datetime;not null"`
 }
 
+// WithdrawLock 每个币种一行，提现时锁定，检查全站每日上限的并发提现在此排队
+type WithdrawLock struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	CoinType  string    `gorm:"type:varchar(45);not null;uniqueIndex"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
 type UserBalanceRecord struct {
 	ID        int64     `gorm:"primarykey;type:int"`
 	UserId    int64     `gorm:"type:int"`
@@ -396,8 +410,9 @@ func (u *UserRepo) GetUserByUserIds(ctx context.Context, userIds ...int64) (map[
 	res := make(map[int64]*biz.User, 0)
 	for _, item := range users {
 		res[item.ID] = &biz.User{
-			ID:      item.ID,
-			Address: item.Address,
+			ID:        item.ID,
+			Address:   item.Address,
+			CreatedAt: item.CreatedAt,
 		}
 	}
 	return res, nil
@@ -487,6 +502,18 @@ func (ui *UserInfoRepo) UpdateUserInfo(ctx context.Context, u *biz.UserInfo) (*b
 	}, nil
 }
 
+// UpdateUserInfoVip 等级仍为 oldVip 时才修改，已被修改时返回 false
+func (ui *UserInfoRepo) UpdateUserInfoVip(ctx context.Context, userId int64, oldVip int64, vip int64) (bool, error) {
+	res := ui.data.DB(ctx).Table("user_info").
+		Where("user_id=? and vip=?", userId, oldVip).
+		Updates(map[string]interface{}{"vip": vip})
+	if res.Error != nil {
+		return false, errors.New(500, "UPDATE_USER_INFO_ERROR", "用户信息修改失败")
+	}
+
+	return 0 < res.RowsAffected, nil
+}
+
 // GetUserRecommendByUserId .
 func (ur *UserRecommendRepo) GetUserRecommendByUserId(ctx context.Context, userId int64) (*biz.UserRecommend, error) {
 	var userRecommend UserRecommend
@@ -504,29 +531,25 @@ func (ur *UserRecommendRepo) GetUserRecommendByUserId(ctx context.Context, userI
 	}, nil
 }
 
-// GetUserRecommendByCode .
-func (ur *UserRecommendRepo) GetUserRecommendByCode(ctx context.Context, code string) ([]*biz.UserRecommend, error) {
-	var (
-		userRecommends []*UserRecommend
-	)
-	res := make([]*biz.UserRecommend, 0)
-
-	instance := ur.data.db.Table("user_recommend").Where("recommend_code=?", code)
-
-	if err := instance.Find(&userRecommends).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return res, errors.NotFound("USER_RECOMMEND_NOT_FOUND", "user recommend not found")
-		}
+// GetUserRecommendByUserIds .
+func (ur *UserRecommendRepo) GetUserRecommendByUserIds(ctx context.Context, userIds ...int64) (map[int64]*biz.UserRecommend, error) {
+	var userRecommends []*UserRecommend
+	res := make(map[int64]*biz.UserRecommend, len(userIds))
+	if 0 == len(userIds) {
+		return res, nil
+	}
 
+	if err := ur.data.db.Table("user_recommend").Where("user_id in (?)", userIds).Find(&userRecommends).Error; err != nil {
 		return nil, errors.New(500, "USER RECOMMEND ERROR", err.Error())
 	}
 
 	for _, userRecommend := range userRecommends {
-		res = append(res, &biz.UserRecommend{
+		res[userRecommend.UserId] = &biz.UserRecommend{
+			ID:            userRecommend.ID,
 			UserId:        userRecommend.UserId,
 			RecommendCode: userRecommend.RecommendCode,
 			CreatedAt:     userRecommend.CreatedAt,
-		})
+		}
 	}
 
 	return res, nil
@@ -573,6 +596,14 @@ func (ur *UserRecommendRepo) CreateUserRecommend(ctx context.Context, u *biz.Use
 		return nil, errors.New(500, "CREATE_USER_RECOMMEND_ERROR", "用户推荐关系创建失败")
 	}
 
+	var recommendUserId int64
+	if nil != recommendUser {
+		recommendUserId = recommendUser.UserId
+	}
+	if err := ur.createUserRecommendClosure(ctx, u.ID, recommendUserId); nil != err {
+		return nil, err
+	}
+
 	return &biz.UserRecommend{
 		ID:            userRecommend.ID,
 		UserId:        userRecommend.UserId,
@@ -630,6 +661,105 @@ func (ur *UserRecommendRepo) CreateUserArea(ctx context.Context, u *biz.User) (b
 	return true, nil
 }
 
+// UpdateUserAreaSelfAmount .
+func (ur *UserRecommendRepo) UpdateUserAreaSelfAmount(ctx context.Context, userId int64, amount int64) error {
+	if err := ur.data.DB(ctx).Table("user_area").
+		Where("user_id=?", userId).
+		Updates(map[string]interface{}{"self_amount": gorm.Expr("self_amount + ?", amount)}).Error; nil != err {
+		return errors.New(500, "UPDATE_USER_AREA_ERROR", "用户区信息修改失败")
+	}
+
+	return nil
+}
+
+// UpdateUserAreaLevel 后台设定的等级，0 为不设定
+func (ur *UserRecommendRepo) UpdateUserAreaLevel(ctx context.Context, userId int64, level int64) error {
+	if err := ur.data.DB(ctx).Table("user_area").
+		Where("user_id=?", userId).
+		Updates(map[string]interface{}{"level": level}).Error; nil != err {
+		return errors.New(500, "UPDATE_USER_AREA_ERROR", "用户区信息修改失败")
+	}
+
+	return nil
+}
+
+// GetUserAreaUserIds 按用户id正序取 afterUserId 之后有区信息的用户
+func (ur *UserRecommendRepo) GetUserAreaUserIds(ctx context.Context, afterUserId int64, limit int) ([]int64, error) {
+	var userIds []int64
+	if err := ur.data.db.Table("user_area").
+		Where("user_id>?", afterUserId).
+		Order("user_id asc").
+		Limit(limit).
+		Pluck("user_id", &userIds).Error; nil != err {
+		return nil, errors.New(500, "USER AREA ERROR", err.Error())
+	}
+
+	return userIds, nil
+}
+
+// UpdateUserAreaAmount 推荐链路上的用户团队业绩
+func (ur *UserRecommendRepo) UpdateUserAreaAmount(ctx context.Context, amount int64, userIds ...int64) error {
+	if 0 == len(userIds) {
+		return nil
+	}
+
+	if err := ur.data.DB(ctx).Table("user_area").
+		Where("user_id in (?)", userIds).
+		Updates(map[string]interface{}{"amount": gorm.Expr("amount + ?", amount)}).Error; nil != err {
+		return errors.New(500, "UPDATE_USER_AREA_ERROR", "用户区信息修改失败")
+	}
+
+	return nil
+}
+
+// RebuildUserAreaAmounts 按 selfAmounts 重置所有用户的自身业绩，再按推荐关系闭包表汇总团队业绩，需在事务中调用；
+// 返回业绩有变化的用户数
+func (ur *UserRecommendRepo) RebuildUserAreaAmounts(ctx context.Context, selfAmounts map[int64]int64) (int64, error) {
+	var before []*UserArea
+	if err := ur.data.DB(ctx).Table("user_area").
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Find(&before).Error; err != nil {
+		return 0, errors.New(500, "USER AREA ERROR", err.Error())
+	}
+
+	if err := ur.data.DB(ctx).Table("user_area").
+		Where("self_amount<>?", 0).
+		Updates(map[string]interface{}{"self_amount": 0}).Error; err != nil {
+		return 0, errors.New(500, "UPDATE_USER_AREA_ERROR", "用户区信息修改失败")
+	}
+	for userId, amount := range selfAmounts {
+		if err := ur.data.DB(ctx).Table("user_area").
+			Where("user_id=?", userId).
+			Updates(map[string]interface{}{"self_amount": amount}).Error; err != nil {
+			return 0, errors.New(500, "UPDATE_USER_AREA_ERROR", "用户区信息修改失败")
+		}
+	}
+
+	if err := ur.data.DB(ctx).Exec("UPDATE user_area a LEFT JOIN (" +
+		"SELECT c.ancestor_id, SUM(s.self_amount) AS total FROM user_recommend_closure c " +
+		"JOIN user_area s ON s.user_id=c.descendant_id WHERE c.depth>0 GROUP BY c.ancestor_id" +
+		") t ON t.ancestor_id=a.user_id SET a.amount=COALESCE(t.total, 0)").Error; err != nil {
+		return 0, errors.New(500, "UPDATE_USER_AREA_ERROR", err.Error())
+	}
+
+	var after []*UserArea
+	if err := ur.data.DB(ctx).Table("user_area").Find(&after).Error; err != nil {
+		return 0, errors.New(500, "USER AREA ERROR", err.Error())
+	}
+	beforeAmounts := make(map[int64]*UserArea, len(before))
+	for _, v := range before {
+		beforeAmounts[v.ID] = v
+	}
+	var changed int64
+	for _, v := range after {
+		if old, ok := beforeAmounts[v.ID]; !ok || old.Amount != v.Amount || old.SelfAmount != v.SelfAmount {
+			changed++
+		}
+	}
+
+	return changed, nil
+}
+
 // DeleteOrOriginUserRecommendArea .
 func (ur *UserRecommendRepo) DeleteOrOriginUserRecommendArea(ctx context.Context, code string, originCode string) (bool, error) {
 	//var myUserRecommendArea []*UserRecommendArea
@@ -750,16 +880,29 @@ func (ur *UserRecommendRepo) GetUserAreas(ctx context.Context, userIds []int64)
 	return res, nil
 }
 
-// UpdateUserRecommend .
+// UpdateUserRecommend 修改推荐人，团队成员的推荐码和闭包表一起修改，需在事务中调用
 func (ur *UserRecommendRepo) UpdateUserRecommend(ctx context.Context, u *biz.User, recommendUser *biz.UserRecommend) (bool, error) {
-	var tmpRecommendCode string
+	var (
+		tmpRecommendCode string
+		recommendUserId  int64
+	)
 	if nil != recommendUser && 0 < recommendUser.UserId {
+		recommendUserId = recommendUser.UserId
 		tmpRecommendCode = "D" + strconv.FormatInt(recommendUser.UserId, 10)
 		if "" != recommendUser.RecommendCode {
 			tmpRecommendCode = recommendUser.RecommendCode + tmpRecommendCode
 		}
 	}
 
+	var oldUserRecommend UserRecommend
+	if err := ur.data.DB(ctx).Table("user_recommend").Where("user_id=?", u.ID).First(&oldUserRecommend).Error; err != nil {
+		return false, errors.New(500, "USER RECOMMEND ERROR", err.Error())
+	}
+
+	if err := ur.moveUserRecommendClosure(ctx, u.ID, recommendUserId); nil != err {
+		return false, err
+	}
+
 	var userRecommend UserRecommend
 	userRecommend.RecommendCode = tmpRecommendCode
 
@@ -768,6 +911,23 @@ func (ur *UserRecommendRepo) UpdateUserRecommend(ctx context.Context, u *biz.Use
 		return false, errors.New(500, "CREATE_USER_RECOMMEND_ERROR", "用户推荐关系修改失败")
 	}
 
+	// 团队成员推荐码中本人之前的部分换成新的
+	downlines, err := ur.GetUserRecommendDownlines(ctx, u.ID, 0)
+	if nil != err {
+		return false, err
+	}
+	if 0 < len(downlines) {
+		downlineIds := make([]int64, 0, len(downlines))
+		for _, v := range downlines {
+			downlineIds = append(downlineIds, v.DescendantId)
+		}
+		if err = ur.data.DB(ctx).Table("user_recommend").
+			Where("user_id in (?)", downlineIds).
+			Update("recommend_code", gorm.Expr("CONCAT(?, SUBSTRING(recommend_code, ?))", tmpRecommendCode, len(oldUserRecommend.RecommendCode)+1)).Error; err != nil {
+			return false, errors.New(500, "CREATE_USER_RECOMMEND_ERROR", "用户推荐关系修改失败")
+		}
+	}
+
 	return true, nil
 }
 
@@ -807,13 +967,52 @@ func (ub UserBalanceRepo) GetUserBalance(ctx context.Context, userId int64) (*bi
 	}, nil
 }
 
+// GetUserBalanceForUpdate 锁定用户余额，需在事务中调用
+func (ub *UserBalanceRepo) GetUserBalanceForUpdate(ctx context.Context, userId int64) (*biz.UserBalance, error) {
+	var userBalance UserBalance
+	if err := ub.data.DB(ctx).Table("user_balance").
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id=?", userId).
+		First(&userBalance).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("USER_BALANCE_NOT_FOUND", "user balance not found")
+		}
+
+		return nil, errors.New(500, "USER BALANCE ERROR", err.Error())
+	}
+
+	return &biz.UserBalance{
+		ID:          userBalance.ID,
+		UserId:      userBalance.UserId,
+		BalanceUsdt: userBalance.BalanceUsdt,
+		BalanceDhb:  userBalance.BalanceDhb,
+	}, nil
+}
+
+// LockWithdrawCoin 锁定币种的提现锁行，不存在时创建，需在事务中调用
+func (ub *UserBalanceRepo) LockWithdrawCoin(ctx context.Context, coinType string) error {
+	if err := ub.data.DB(ctx).Table("withdraw_lock").
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&WithdrawLock{CoinType: coinType}).Error; err != nil {
+		return errors.New(500, "CREATE_WITHDRAW_LOCK_ERROR", "提现锁创建失败")
+	}
+
+	var lock WithdrawLock
+	if err := ub.data.DB(ctx).Table("withdraw_lock").
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("coin_type=?", coinType).
+		First(&lock).Error; err != nil {
+		return errors.New(500, "WITHDRAW LOCK ERROR", err.Error())
+	}
+
+	return nil
+}
+
 // LocationReward .
 func (ub *UserBalanceRepo) LocationReward(ctx context.Context, userId int64, amount int64, locationId int64, myLocationId int64, locationType string) (int64, error) {
 	var err error
-	if err = ub.data.DB(ctx).Table("user_balance").
-		Where("user_id=?", userId).
-		Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}).Error; nil != err {
-		return 0, errors.NotFound("user balance err", "user balance not found")
+	if _, err = postLedger(ctx, ub.data, biz.LedgerTransfer("reward", "location", locationId, "usdt", amount, biz.LedgerSystem(biz.LedgerAccountRewards), biz.LedgerUser(userId))); nil != err {
+		return 0, err
 	}
 
 	var userBalance UserBalance
@@ -826,6 +1025,7 @@ func (ub *UserBalanceRepo) LocationReward(ctx context.Context, userId int64, amo
 	userBalanceRecode.Balance = userBalance.BalanceUsdt
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward"
+	userBalanceRecode.CoinType = "usdt"
 	userBalanceRecode.Amount = amount
 	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
 	if err != nil {
@@ -849,13 +1049,121 @@ func (ub *UserBalanceRepo) LocationReward(ctx context.Context, userId int64, amo
 	return userBalanceRecode.ID, nil
 }
 
+// LocationDailyReward 投资位每日收益
+func (ub *UserBalanceRepo) LocationDailyReward(ctx context.Context, userId int64, amount int64, locationId int64) (int64, error) {
+	var err error
+	if _, err = postLedger(ctx, ub.data, biz.LedgerTransfer("reward", "location_daily", locationId, "usdt", amount, biz.LedgerSystem(biz.LedgerAccountRewards), biz.LedgerUser(userId))); nil != err {
+		return 0, err
+	}
+
+	var userBalance UserBalance
+	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
+	if err != nil {
+		return 0, err
+	}
+
+	var userBalanceRecode UserBalanceRecord
+	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	userBalanceRecode.UserId = userBalance.UserId
+	userBalanceRecode.Type = "reward"
+	userBalanceRecode.CoinType = "usdt"
+	userBalanceRecode.Amount = amount
+	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
+	if err != nil {
+		return 0, err
+	}
+
+	var reward Reward
+	reward.UserId = userBalance.UserId
+	reward.Amount = amount
+	reward.BalanceRecordId = userBalanceRecode.ID
+	reward.Type = "location" // 本次分红的行为类型
+	reward.TypeRecordId = locationId
+	reward.Reason = "location_daily_reward" // 给我分红的理由
+	reward.ReasonLocationId = locationId
+	err = ub.data.DB(ctx).Table("reward").Create(&reward).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return userBalanceRecode.ID, nil
+}
+
+// GetRewardsByReasonsSince since 之后指定理由的奖励
+func (ub *UserBalanceRepo) GetRewardsByReasonsSince(ctx context.Context, since time.Time, reasons ...string) ([]*biz.Reward, error) {
+	var rewards []*Reward
+	res := make([]*biz.Reward, 0)
+	if err := ub.data.db.Table("reward").
+		Where("reason in (?)", reasons).
+		Where("created_at>=?", since).
+		Order("id asc").
+		Find(&rewards).Error; err != nil {
+		return nil, errors.New(500, "REWARD ERROR", err.Error())
+	}
+
+	for _, reward := range rewards {
+		res = append(res, &biz.Reward{
+			ID:               reward.ID,
+			UserId:           reward.UserId,
+			Amount:           reward.Amount,
+			BalanceRecordId:  reward.BalanceRecordId,
+			Type:             reward.Type,
+			TypeRecordId:     reward.TypeRecordId,
+			Reason:           reward.Reason,
+			ReasonLocationId: reward.ReasonLocationId,
+			LocationType:     reward.LocationType,
+			CreatedAt:        reward.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// TeamReward 投资位收益带来的团队奖励和区域分红，ReasonLocationId 为产生收益的投资位
+func (ub *UserBalanceRepo) TeamReward(ctx context.Context, userId int64, amount int64, reason string, sourceLocationId int64) (int64, error) {
+	var err error
+	if _, err = postLedger(ctx, ub.data, biz.LedgerTransfer("reward", reason, sourceLocationId, "usdt", amount, biz.LedgerSystem(biz.LedgerAccountRewards), biz.LedgerUser(userId))); nil != err {
+		return 0, err
+	}
+
+	var userBalance UserBalance
+	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
+	if err != nil {
+		return 0, err
+	}
+
+	var userBalanceRecode UserBalanceRecord
+	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	userBalanceRecode.UserId = userBalance.UserId
+	userBalanceRecode.Type = "reward"
+	userBalanceRecode.CoinType = "usdt"
+	userBalanceRecode.Amount = amount
+	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
+	if err != nil {
+		return 0, err
+	}
+
+	var reward Reward
+	reward.UserId = userBalance.UserId
+	reward.Amount = amount
+	reward.BalanceRecordId = userBalanceRecode.ID
+	reward.Type = "location" // 本次分红的行为类型
+	reward.TypeRecordId = sourceLocationId
+	reward.Reason = reason // 给我分红的理由
+	reward.ReasonLocationId = sourceLocationId
+	err = ub.data.DB(ctx).Table("reward").Create(&reward).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return userBalanceRecode.ID, nil
+}
+
 // WithdrawReward .
 func (ub *UserBalanceRepo) WithdrawReward(ctx context.Context, userId int64, amount int64, locationId int64, myLocationId int64, locationType string) (int64, error) {
 	var err error
-	if err = ub.data.DB(ctx).Table("user_balance").
-		Where("user_id=?", userId).
-		Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}).Error; nil != err {
-		return 0, errors.NotFound("user balance err", "user balance not found")
+	if _, err = postLedger(ctx, ub.data, biz.LedgerTransfer("reward", "location", locationId, "usdt", amount, biz.LedgerSystem(biz.LedgerAccountRewards), biz.LedgerUser(userId))); nil != err {
+		return 0, err
 	}
 
 	var userBalance UserBalance
@@ -868,6 +1176,7 @@ func (ub *UserBalanceRepo) WithdrawReward(ctx context.Context, userId int64, amo
 	userBalanceRecode.Balance = userBalance.BalanceUsdt
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward"
+	userBalanceRecode.CoinType = "usdt"
 	userBalanceRecode.Amount = amount
 	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
 	if err != nil {
@@ -894,11 +1203,10 @@ func (ub *UserBalanceRepo) WithdrawReward(ctx context.Context, userId int64, amo
 // Deposit .
 func (ub *UserBalanceRepo) Deposit(ctx context.Context, userId int64, amount int64) (int64, error) {
 	var err error
-	//if err = ub.data.DB(ctx).Table("user_balance").
-	//	Where("user_id=?", userId).
-	//	Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}).Error; nil != err {
-	//	return 0, errors.NotFound("user balance err", "user balance not found")
-	//}
+	// 入金用于认购，不进入用户余额，记入奖励池
+	if _, err = postLedger(ctx, ub.data, biz.LedgerTransfer("deposit", "user", userId, "usdt", amount, biz.LedgerSystem(biz.LedgerAccountChain), biz.LedgerSystem(biz.LedgerAccountRewards))); nil != err {
+		return 0, err
+	}
 
 	var userBalance UserBalance
 	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
@@ -920,15 +1228,40 @@ func (ub *UserBalanceRepo) Deposit(ctx context.Context, userId int64, amount int
 	return userBalanceRecode.ID, nil
 }
 
+// DepositReverse 充值所在区块被回滚，写入负数的充值记录冲正
+func (ub *UserBalanceRepo) DepositReverse(ctx context.Context, userId int64, amount int64) (int64, error) {
+	_, err := postLedger(ctx, ub.data, biz.LedgerTransfer("deposit_reverse", "user", userId, "usdt", amount, biz.LedgerSystem(biz.LedgerAccountRewards), biz.LedgerSystem(biz.LedgerAccountChain)))
+	if err != nil {
+		return 0, err
+	}
+
+	var userBalance UserBalance
+	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
+	if err != nil {
+		return 0, err
+	}
+
+	var userBalanceRecode UserBalanceRecord
+	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	userBalanceRecode.UserId = userBalance.UserId
+	userBalanceRecode.Type = "deposit"
+	userBalanceRecode.CoinType = "usdt"
+	userBalanceRecode.Amount = -amount
+	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return userBalanceRecode.ID, nil
+}
+
 // DepositLast .
 func (ub *UserBalanceRepo) DepositLast(ctx context.Context, userId int64, lastAmount int64, locationId int64) (int64, error) {
 	var (
 		err error
 	)
-	if err = ub.data.DB(ctx).Table("user_balance").
-		Where("user_id=?", userId).
-		Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", lastAmount)}).Error; nil != err {
-		return 0, errors.NotFound("user balance err", "user balance not found")
+	if _, err = postLedger(ctx, ub.data, biz.LedgerTransfer("reward", "location", locationId, "usdt", lastAmount, biz.LedgerSystem(biz.LedgerAccountRewards), biz.LedgerUser(userId))); nil != err {
+		return 0, err
 	}
 
 	var userBalance UserBalance
@@ -941,6 +1274,7 @@ func (ub *UserBalanceRepo) DepositLast(ctx context.Context, userId int64, lastAm
 	userBalanceRecode.Balance = userBalance.BalanceUsdt
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward"
+	userBalanceRecode.CoinType = "usdt"
 	userBalanceRecode.Amount = lastAmount
 	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
 	if err != nil {
@@ -971,10 +1305,8 @@ func (ub *UserBalanceRepo) DepositLast(ctx context.Context, userId int64, lastAm
 // DepositDhb .
 func (ub *UserBalanceRepo) DepositDhb(ctx context.Context, userId int64, amount int64) (int64, error) {
 	var err error
-	if err = ub.data.DB(ctx).Table("user_balance").
-		Where("user_id=?", userId).
-		Updates(map[string]interface{}{"balance_dhb": gorm.Expr("balance_dhb + ?", amount)}).Error; nil != err {
-		return 0, errors.NotFound("user balance err", "user balance not found")
+	if _, err = postLedger(ctx, ub.data, biz.LedgerTransfer("deposit", "user", userId, "dhb", amount, biz.LedgerSystem(biz.LedgerAccountChain), biz.LedgerUser(userId))); nil != err {
+		return 0, err
 	}
 
 	var userBalance UserBalance
@@ -999,33 +1331,14 @@ func (ub *UserBalanceRepo) DepositDhb(ctx context.Context, userId int64, amount
 
 // UpdateWithdrawAmount .
 func (ub *UserBalanceRepo) UpdateWithdrawAmount(ctx context.Context, id int64, status string, amount int64) (*biz.Withdraw, error) {
-	var withdraw Withdraw
-	withdraw.Status = status
-	withdraw.Amount = amount
-	res := ub.data.DB(ctx).Table("withdraw").Where("id=?", id).Updates(&withdraw)
-	if res.Error != nil {
-		return nil, errors.New(500, "CREATE_WITHDRAW_ERROR", "提现记录修改失败")
-	}
-
-	return &biz.Withdraw{
-		ID:              withdraw.ID,
-		UserId:          withdraw.UserId,
-		Amount:          withdraw.Amount,
-		RelAmount:       withdraw.RelAmount,
-		BalanceRecordId: withdraw.BalanceRecordId,
-		Status:          withdraw.Status,
-		Type:            withdraw.Type,
-		CreatedAt:       withdraw.CreatedAt,
-	}, nil
+	return ub.updateWithdrawStatus(ctx, id, status, map[string]interface{}{"amount": amount})
 }
 
 // WithdrawUsdt .
 func (ub *UserBalanceRepo) WithdrawUsdt(ctx context.Context, userId int64, amount int64) error {
 	var err error
-	if res := ub.data.DB(ctx).Table("user_balance").
-		Where("user_id=? and balance_usdt>=?", userId, amount).
-		Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt - ?", amount)}); 0 == res.RowsAffected || nil != res.Error {
-		return errors.NotFound("user balance err", "user balance error")
+	if _, err = postLedger(ctx, ub.data, biz.LedgerTransfer("withdraw", "user", userId, "usdt", amount, biz.LedgerUser(userId), biz.LedgerSystem(biz.LedgerAccountWithdraw))); nil != err {
+		return err
 	}
 
 	var userBalance UserBalance
@@ -1051,12 +1364,6 @@ func (ub *UserBalanceRepo) WithdrawUsdt(ctx context.Context, userId int64, amoun
 // SetBalanceReward .
 func (ub *UserBalanceRepo) SetBalanceReward(ctx context.Context, userId int64, amount int64) error {
 	var err error
-	if res := ub.data.DB(ctx).Table("user_balance").
-		Where("user_id=? and balance_usdt>=?", userId, amount).
-		Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt - ?", amount)}); 0 == res.RowsAffected || nil != res.Error {
-		return errors.NotFound("user balance err", "user balance error")
-	}
-
 	now := time.Now().UTC()
 	var balanceReward BalanceReward
 	balanceReward.Amount = amount
@@ -1070,15 +1377,17 @@ func (ub *UserBalanceRepo) SetBalanceReward(ctx context.Context, userId int64, a
 	if err != nil {
 		return err
 	}
+
+	if _, err = postLedger(ctx, ub.data, biz.LedgerTransfer("stake", "balance_reward", balanceReward.ID, "usdt", amount, biz.LedgerUser(userId), biz.LedgerSystem(biz.LedgerAccountStaking))); nil != err {
+		return err
+	}
 	return nil
 }
 
 // UpdateBalanceReward .
 func (ub *UserBalanceRepo) UpdateBalanceReward(ctx context.Context, userId int64, id int64, amount int64, status int64) error {
-	if res := ub.data.DB(ctx).Table("user_balance").
-		Where("user_id=?", userId).
-		Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}); 0 == res.RowsAffected || nil != res.Error {
-		return errors.NotFound("user balance err", "user balance error")
+	if _, err := postLedger(ctx, ub.data, biz.LedgerTransfer("unstake", "balance_reward", id, "usdt", amount, biz.LedgerSystem(biz.LedgerAccountStaking), biz.LedgerUser(userId))); nil != err {
+		return err
 	}
 
 	if res := ub.data.DB(ctx).Table("balance_reward").
@@ -1093,10 +1402,8 @@ func (ub *UserBalanceRepo) UpdateBalanceReward(ctx context.Context, userId int64
 // WithdrawDhb .
 func (ub *UserBalanceRepo) WithdrawDhb(ctx context.Context, userId int64, amount int64) error {
 	var err error
-	if res := ub.data.DB(ctx).Table("user_balance").
-		Where("user_id=? and balance_dhb>=?", userId, amount).
-		Updates(map[string]interface{}{"balance_dhb": gorm.Expr("balance_dhb - ?", amount)}); 0 == res.RowsAffected || nil != res.Error {
-		return errors.NotFound("user balance err", "user balance error")
+	if _, err = postLedger(ctx, ub.data, biz.LedgerTransfer("withdraw", "user", userId, "dhb", amount, biz.LedgerUser(userId), biz.LedgerSystem(biz.LedgerAccountWithdraw))); nil != err {
+		return err
 	}
 
 	var userBalance UserBalance
@@ -1120,11 +1427,14 @@ func (ub *UserBalanceRepo) WithdrawDhb(ctx context.Context, userId int64, amount
 }
 
 // GreateWithdraw .
-func (ub *UserBalanceRepo) GreateWithdraw(ctx context.Context, userId int64, amount int64, coinType string) (*biz.Withdraw, error) {
+func (ub *UserBalanceRepo) GreateWithdraw(ctx context.Context, userId int64, amount int64, relAmount int64, coinType string, address string) (*biz.Withdraw, error) {
 	var withdraw Withdraw
 	withdraw.UserId = userId
 	withdraw.Amount = amount
+	withdraw.RelAmount = relAmount
 	withdraw.Type = coinType
+	withdraw.Address = address
+	withdraw.Status = biz.WithdrawStatusRequested
 	res := ub.data.DB(ctx).Table("withdraw").Create(&withdraw)
 	if res.Error != nil {
 		return nil, errors.New(500, "CREATE_WITHDRAW_ERROR", "提现记录创建失败")
@@ -1138,17 +1448,102 @@ func (ub *UserBalanceRepo) GreateWithdraw(ctx context.Context, userId int64, amo
 		BalanceRecordId: withdraw.BalanceRecordId,
 		Status:          withdraw.Status,
 		Type:            withdraw.Type,
+		Reason:          withdraw.Reason,
+		Address:         withdraw.Address,
+		TxHash:          withdraw.TxHash,
+		TxNonce:         withdraw.TxNonce,
+		TxRaw:           withdraw.TxRaw,
 		CreatedAt:       withdraw.CreatedAt,
 	}, nil
 }
 
 // UpdateWithdraw .
 func (ub *UserBalanceRepo) UpdateWithdraw(ctx context.Context, id int64, status string) (*biz.Withdraw, error) {
-	var withdraw Withdraw
-	withdraw.Status = status
-	res := ub.data.DB(ctx).Table("withdraw").Where("id=?", id).Updates(&withdraw)
+	return ub.updateWithdrawStatus(ctx, id, status, map[string]interface{}{})
+}
+
+// RejectWithdraw .
+func (ub *UserBalanceRepo) RejectWithdraw(ctx context.Context, id int64, reason string) (*biz.Withdraw, error) {
+	return ub.updateWithdrawStatus(ctx, id, biz.WithdrawStatusRejected, map[string]interface{}{"reason": reason})
+}
+
+// FailWithdraw .
+func (ub *UserBalanceRepo) FailWithdraw(ctx context.Context, id int64, reason string) (*biz.Withdraw, error) {
+	return ub.updateWithdrawStatus(ctx, id, biz.WithdrawStatusFailed, map[string]interface{}{"reason": reason})
+}
+
+// WithdrawPayout 打款交易确认后，待打款转出到链上
+func (ub *UserBalanceRepo) WithdrawPayout(ctx context.Context, withdraw *biz.Withdraw) error {
+	amount := withdraw.RelAmount
+	if 0 >= amount {
+		amount = withdraw.Amount
+	}
+
+	_, err := postLedger(ctx, ub.data, biz.LedgerTransfer("payout", "withdraw", withdraw.ID, withdraw.Type, amount, biz.LedgerSystem(biz.LedgerAccountWithdraw), biz.LedgerSystem(biz.LedgerAccountChain)))
+	return err
+}
+
+// UpdateWithdrawBroadcasting 记录打款地址和已签名的交易
+func (ub *UserBalanceRepo) UpdateWithdrawBroadcasting(ctx context.Context, id int64, address string, transfer *biz.PayoutTransfer) (*biz.Withdraw, error) {
+	return ub.updateWithdrawStatus(ctx, id, biz.WithdrawStatusBroadcasting, map[string]interface{}{
+		"address":  address,
+		"tx_hash":  transfer.TxHash,
+		"tx_nonce": transfer.Nonce,
+		"tx_raw":   transfer.Raw,
+	})
+}
+
+// GetWithdrawsByStatus limit 为 0 时不限制数量
+func (ub *UserBalanceRepo) GetWithdrawsByStatus(ctx context.Context, status string, limit int) ([]*biz.Withdraw, error) {
+	var withdraws []*Withdraw
+	res := make([]*biz.Withdraw, 0)
+	instance := ub.data.db.Table("withdraw").Where("status=?", status).Order("id asc")
+	if 0 < limit {
+		instance = instance.Limit(limit)
+	}
+	if err := instance.Find(&withdraws).Error; err != nil {
+		return nil, errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+
+	for _, withdraw := range withdraws {
+		res = append(res, &biz.Withdraw{
+			ID:              withdraw.ID,
+			UserId:          withdraw.UserId,
+			Amount:          withdraw.Amount,
+			RelAmount:       withdraw.RelAmount,
+			BalanceRecordId: withdraw.BalanceRecordId,
+			Status:          withdraw.Status,
+			Type:            withdraw.Type,
+			Reason:          withdraw.Reason,
+			Address:         withdraw.Address,
+			TxHash:          withdraw.TxHash,
+			TxNonce:         withdraw.TxNonce,
+			TxRaw:           withdraw.TxRaw,
+			CreatedAt:       withdraw.CreatedAt,
+		})
+	}
+	return res, nil
+}
+
+// updateWithdrawStatus 只允许 biz.WithdrawStatusFrom 中的来源状态转换到 status，其余一律拒绝
+func (ub *UserBalanceRepo) updateWithdrawStatus(ctx context.Context, id int64, status string, values map[string]interface{}) (*biz.Withdraw, error) {
+	from := biz.WithdrawStatusFrom(status)
+	if 0 == len(from) {
+		return nil, errors.New(500, "WITHDRAW_STATUS_ERROR", "提现状态错误")
+	}
+
+	values["status"] = status
+	res := ub.data.DB(ctx).Table("withdraw").Where("id=? and status in (?)", id, from).Updates(values)
 	if res.Error != nil {
-		return nil, errors.New(500, "CREATE_WITHDRAW_ERROR", "提现记录修改失败")
+		return nil, errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+	}
+	if 0 == res.RowsAffected {
+		return nil, errors.New(500, "WITHDRAW_STATUS_ERROR", "当前提现状态不允许该操作")
+	}
+
+	var withdraw Withdraw
+	if err := ub.data.DB(ctx).Table("withdraw").Where("id=?", id).First(&withdraw).Error; err != nil {
+		return nil, errors.New(500, "WITHDRAW ERROR", err.Error())
 	}
 
 	return &biz.Withdraw{
@@ -1159,6 +1554,112 @@ func (ub *UserBalanceRepo) UpdateWithdraw(ctx context.Context, id int64, status
 		BalanceRecordId: withdraw.BalanceRecordId,
 		Status:          withdraw.Status,
 		Type:            withdraw.Type,
+		Reason:          withdraw.Reason,
+		Address:         withdraw.Address,
+		TxHash:          withdraw.TxHash,
+		TxNonce:         withdraw.TxNonce,
+		TxRaw:           withdraw.TxRaw,
+		CreatedAt:       withdraw.CreatedAt,
+	}, nil
+}
+
+// CancelWithdraw 先锁定用户余额再锁定用户自己的提现记录后取消，加锁顺序和提现一致，需在事务中调用
+func (ub *UserBalanceRepo) CancelWithdraw(ctx context.Context, id int64, userId int64) (*biz.Withdraw, error) {
+	if _, err := ub.GetUserBalanceForUpdate(ctx, userId); err != nil {
+		return nil, err
+	}
+
+	var withdraw Withdraw
+	if err := ub.data.DB(ctx).Table("withdraw").
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id=? and user_id=?", id, userId).
+		First(&withdraw).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("WITHDRAW_NOT_FOUND", "withdraw not found")
+		}
+
+		return nil, errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+
+	return ub.updateWithdrawStatus(ctx, id, biz.WithdrawStatusCancelled, map[string]interface{}{})
+}
+
+// WithdrawRefund 提现退回余额
+func (ub *UserBalanceRepo) WithdrawRefund(ctx context.Context, userId int64, amount int64, coinType string, recordType string) (int64, error) {
+	if "dhb" != coinType && "usdt" != coinType {
+		return 0, errors.New(500, "WITHDRAW_ERROR", "币种错误")
+	}
+
+	_, err := postLedger(ctx, ub.data, biz.LedgerTransfer(recordType, "user", userId, coinType, amount, biz.LedgerSystem(biz.LedgerAccountWithdraw), biz.LedgerUser(userId)))
+	if err != nil {
+		return 0, err
+	}
+
+	var userBalance UserBalance
+	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
+	if err != nil {
+		return 0, err
+	}
+
+	var userBalanceRecode UserBalanceRecord
+	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	if "dhb" == coinType {
+		userBalanceRecode.Balance = userBalance.BalanceDhb
+	}
+	userBalanceRecode.UserId = userBalance.UserId
+	userBalanceRecode.Type = recordType
+	userBalanceRecode.CoinType = coinType
+	userBalanceRecode.Amount = amount
+	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return userBalanceRecode.ID, nil
+}
+
+// GetWithdrawTotalSince 提现申请金额合计，userId 为 0 时统计全部用户，已拒绝和已取消的不计入
+func (ub *UserBalanceRepo) GetWithdrawTotalSince(ctx context.Context, userId int64, coinType string, since time.Time) (int64, error) {
+	var total UserBalanceTotal
+	instance := ub.data.DB(ctx).Table("withdraw").
+		Where("type=?", coinType).
+		Where("status not in (?)", []string{biz.WithdrawStatusRejected, biz.WithdrawStatusCancelled, biz.WithdrawStatusRefunded}).
+		Where("created_at>=?", since)
+	if 0 < userId {
+		instance = instance.Where("user_id=?", userId)
+	}
+
+	if err := instance.Select("coalesce(sum(amount), 0) as total").Take(&total).Error; err != nil {
+		return 0, errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+
+	return total.Total, nil
+}
+
+// GetWithdrawLastByUserId .
+func (ub *UserBalanceRepo) GetWithdrawLastByUserId(ctx context.Context, userId int64) (*biz.Withdraw, error) {
+	var withdraw Withdraw
+	if err := ub.data.DB(ctx).Table("withdraw").Where("user_id=?", userId).Order("id desc").First(&withdraw).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("WITHDRAW_NOT_FOUND", "withdraw not found")
+		}
+
+		return nil, errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+
+	return &biz.Withdraw{
+		ID:              withdraw.ID,
+		UserId:          withdraw.UserId,
+		Amount:          withdraw.Amount,
+		RelAmount:       withdraw.RelAmount,
+		BalanceRecordId: withdraw.BalanceRecordId,
+		Status:          withdraw.Status,
+		Type:            withdraw.Type,
+		Reason:          withdraw.Reason,
+		Address:         withdraw.Address,
+		TxHash:          withdraw.TxHash,
+		TxNonce:         withdraw.TxNonce,
+		TxRaw:           withdraw.TxRaw,
 		CreatedAt:       withdraw.CreatedAt,
 	}, nil
 }
@@ -1184,6 +1685,11 @@ func (ub *UserBalanceRepo) GetWithdrawByUserId(ctx context.Context, userId int64
 			BalanceRecordId: withdraw.BalanceRecordId,
 			Status:          withdraw.Status,
 			Type:            withdraw.Type,
+			Reason:          withdraw.Reason,
+			Address:         withdraw.Address,
+			TxHash:          withdraw.TxHash,
+			TxNonce:         withdraw.TxNonce,
+			TxRaw:           withdraw.TxRaw,
 			CreatedAt:       withdraw.CreatedAt,
 		})
 	}
@@ -1203,22 +1709,122 @@ func (ub *UserBalanceRepo) GetBalanceRewardByUserId(ctx context.Context, userId
 	}
 
 	for _, balanceReward := range balanceRewards {
-		res = append(res, &biz.BalanceReward{
-			ID:      balanceReward.ID,
-			UserId:  balanceReward.UserId,
-			Status:  balanceReward.Status,
-			Amount:  balanceReward.Amount,
-			SetDate: balanceReward.SetDate,
-		})
+		res = append(res, balanceReward.toBiz())
+	}
+	return res, nil
+}
+
+func (b *BalanceReward) toBiz() *biz.BalanceReward {
+	return &biz.BalanceReward{
+		ID:             b.ID,
+		UserId:         b.UserId,
+		Status:         b.Status,
+		Amount:         b.Amount,
+		H:              b.H,
+		M:              b.M,
+		SetDate:        b.SetDate,
+		LastRewardDate: b.LastRewardDate,
+		UpdatedAt:      b.UpdatedAt,
+		CreatedAt:      b.CreatedAt,
+	}
+}
+
+// GetBalanceRewardsDue 上次发放早于 lastRewardBefore 的锁仓记录，按id升序
+func (ub *UserBalanceRepo) GetBalanceRewardsDue(ctx context.Context, lastRewardBefore time.Time, afterId int64, limit int) ([]*biz.BalanceReward, error) {
+	var balanceRewards []*BalanceReward
+	res := make([]*biz.BalanceReward, 0)
+	if err := ub.data.DB(ctx).Table("balance_reward").
+		Where("status=?", 1).
+		Where("last_reward_date<=?", lastRewardBefore).
+		Where("id>?", afterId).
+		Order("id asc").
+		Limit(limit).
+		Find(&balanceRewards).Error; err != nil {
+		return nil, errors.New(500, "BALANCE REWARD ERROR", err.Error())
+	}
+
+	for _, balanceReward := range balanceRewards {
+		res = append(res, balanceReward.toBiz())
 	}
 	return res, nil
 }
 
+// GetBalanceRewardForUpdate 锁定锁仓记录，需在事务中调用
+func (ub *UserBalanceRepo) GetBalanceRewardForUpdate(ctx context.Context, id int64) (*biz.BalanceReward, error) {
+	var balanceReward BalanceReward
+	if err := ub.data.DB(ctx).Table("balance_reward").
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id=?", id).
+		First(&balanceReward).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("BALANCE_REWARD_NOT_FOUND", "balance reward not found")
+		}
+
+		return nil, errors.New(500, "BALANCE REWARD ERROR", err.Error())
+	}
+
+	return balanceReward.toBiz(), nil
+}
+
+// UpdateBalanceRewardLastRewardDate 上次发放时间仍为 lastRewardDate 时才推进，防止重复发放
+func (ub *UserBalanceRepo) UpdateBalanceRewardLastRewardDate(ctx context.Context, id int64, lastRewardDate time.Time, rewardDate time.Time) error {
+	res := ub.data.DB(ctx).Table("balance_reward").
+		Where("id=?", id).
+		Where("status=?", 1).
+		Where("last_reward_date=?", lastRewardDate).
+		Updates(map[string]interface{}{"last_reward_date": rewardDate})
+	if 0 == res.RowsAffected || res.Error != nil {
+		return errors.New(500, "UPDATE_BALANCE_REWARD_ERROR", "锁仓收益发放时间修改失败")
+	}
+
+	return nil
+}
+
+// BalanceRewardDailyReward 锁仓每日收益，奖励记录的时间为应发放的时间
+func (ub *UserBalanceRepo) BalanceRewardDailyReward(ctx context.Context, userId int64, amount int64, balanceRewardId int64, rewardDate time.Time) (int64, error) {
+	var err error
+	if _, err = postLedger(ctx, ub.data, biz.LedgerTransfer("reward", "balance_reward", balanceRewardId, "usdt", amount, biz.LedgerSystem(biz.LedgerAccountRewards), biz.LedgerUser(userId))); nil != err {
+		return 0, err
+	}
+
+	var userBalance UserBalance
+	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
+	if err != nil {
+		return 0, err
+	}
+
+	var userBalanceRecode UserBalanceRecord
+	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	userBalanceRecode.UserId = userBalance.UserId
+	userBalanceRecode.Type = "reward"
+	userBalanceRecode.CoinType = "usdt"
+	userBalanceRecode.Amount = amount
+	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
+	if err != nil {
+		return 0, err
+	}
+
+	var reward Reward
+	reward.UserId = userBalance.UserId
+	reward.Amount = amount
+	reward.BalanceRecordId = userBalanceRecode.ID
+	reward.Type = "balance_reward" // 本次分红的行为类型
+	reward.TypeRecordId = balanceRewardId
+	reward.Reason = "daily_balance_reward" // 给我分红的理由
+	reward.CreatedAt = rewardDate
+	err = ub.data.DB(ctx).Table("reward").Create(&reward).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return userBalanceRecode.ID, nil
+}
+
 // GetWithdrawNotDeal .
 func (ub *UserBalanceRepo) GetWithdrawNotDeal(ctx context.Context) ([]*biz.Withdraw, error) {
 	var withdraws []*Withdraw
 	res := make([]*biz.Withdraw, 0)
-	if err := ub.data.db.Where("status=?", "").Table("withdraw").Find(&withdraws).Error; err != nil {
+	if err := ub.data.db.Where("status in (?)", []string{"", biz.WithdrawStatusRequested}).Table("withdraw").Find(&withdraws).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return res, errors.NotFound("WITHDRAW_NOT_FOUND", "withdraw not found")
 		}
@@ -1235,6 +1841,11 @@ func (ub *UserBalanceRepo) GetWithdrawNotDeal(ctx context.Context) ([]*biz.Withd
 			BalanceRecordId: withdraw.BalanceRecordId,
 			Status:          withdraw.Status,
 			Type:            withdraw.Type,
+			Reason:          withdraw.Reason,
+			Address:         withdraw.Address,
+			TxHash:          withdraw.TxHash,
+			TxNonce:         withdraw.TxNonce,
+			TxRaw:           withdraw.TxRaw,
 			CreatedAt:       withdraw.CreatedAt,
 		})
 	}
@@ -1258,6 +1869,11 @@ func (ub *UserBalanceRepo) GetWithdrawById(ctx context.Context, id int64) (*biz.
 		BalanceRecordId: withdraw.BalanceRecordId,
 		Status:          withdraw.Status,
 		Type:            withdraw.Type,
+		Reason:          withdraw.Reason,
+		Address:         withdraw.Address,
+		TxHash:          withdraw.TxHash,
+		TxNonce:         withdraw.TxNonce,
+		TxRaw:           withdraw.TxRaw,
 		CreatedAt:       withdraw.CreatedAt,
 	}, nil
 }
@@ -1294,46 +1910,37 @@ func (ub *UserBalanceRepo) GetWithdraws(ctx context.Context, b *biz.Pagination,
 			BalanceRecordId: withdraw.BalanceRecordId,
 			Status:          withdraw.Status,
 			Type:            withdraw.Type,
+			Reason:          withdraw.Reason,
+			Address:         withdraw.Address,
+			TxHash:          withdraw.TxHash,
+			TxNonce:         withdraw.TxNonce,
+			TxRaw:           withdraw.TxRaw,
 			CreatedAt:       withdraw.CreatedAt,
 		})
 	}
 	return res, nil, count
 }
 
-// GetWithdrawPassOrRewarded .
-func (ub *UserBalanceRepo) GetWithdrawPassOrRewarded(ctx context.Context) ([]*biz.Withdraw, error) {
-	var withdraws []*Withdraw
-	res := make([]*biz.Withdraw, 0)
-	if err := ub.data.db.Table("withdraw").Where("status=? or status=?", "pass", "rewarded").Find(&withdraws).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return res, errors.NotFound("WITHDRAW_NOT_FOUND", "withdraw not found")
-		}
-
-		return nil, errors.New(500, "WITHDRAW ERROR", err.Error())
+// UpdateWithdrawLegacyStatus 旧版状态为 from 的提现记录改为 to，reason 为空时保留原因；返回修改的条数
+func (ub *UserBalanceRepo) UpdateWithdrawLegacyStatus(ctx context.Context, from string, to string, reason string) (int64, error) {
+	values := map[string]interface{}{"status": to}
+	if "" != reason {
+		values["reason"] = reason
 	}
 
-	for _, withdraw := range withdraws {
-		res = append(res, &biz.Withdraw{
-			ID:              withdraw.ID,
-			UserId:          withdraw.UserId,
-			Amount:          withdraw.Amount,
-			RelAmount:       withdraw.RelAmount,
-			BalanceRecordId: withdraw.BalanceRecordId,
-			Status:          withdraw.Status,
-			Type:            withdraw.Type,
-			CreatedAt:       withdraw.CreatedAt,
-		})
+	res := ub.data.DB(ctx).Table("withdraw").Where("status=?", from).Updates(values)
+	if res.Error != nil {
+		return 0, errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
 	}
-	return res, nil
+
+	return res.RowsAffected, nil
 }
 
 // RecommendReward .
 func (ub *UserBalanceRepo) RecommendReward(ctx context.Context, userId int64, amount int64, locationId int64) (int64, error) {
 	var err error
-	if err = ub.data.DB(ctx).Table("user_balance").
-		Where("user_id=?", userId).
-		Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}).Error; nil != err {
-		return 0, errors.NotFound("user balance err", "user balance not found")
+	if _, err = postLedger(ctx, ub.data, biz.LedgerTransfer("reward", "location", locationId, "usdt", amount, biz.LedgerSystem(biz.LedgerAccountRewards), biz.LedgerUser(userId))); nil != err {
+		return 0, err
 	}
 
 	var userBalance UserBalance
@@ -1346,6 +1953,7 @@ func (ub *UserBalanceRepo) RecommendReward(ctx context.Context, userId int64, am
 	userBalanceRecode.Balance = userBalance.BalanceUsdt
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward"
+	userBalanceRecode.CoinType = "usdt"
 	userBalanceRecode.Amount = amount
 	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
 	if err != nil {
@@ -1384,6 +1992,10 @@ func (ub *UserBalanceRepo) SystemReward(ctx context.Context, amount int64, locat
 		return err
 	}
 
+	if _, err = postLedger(ctx, ub.data, biz.LedgerTransfer("system_reward", "location", locationId, "usdt", amount, biz.LedgerSystem(biz.LedgerAccountRewards), biz.LedgerSystem(biz.LedgerAccountRevenue))); nil != err {
+		return err
+	}
+
 	return nil
 }
 
@@ -1404,6 +2016,10 @@ func (ub *UserBalanceRepo) SystemWithdrawReward(ctx context.Context, amount int6
 		return err
 	}
 
+	if _, err = postLedger(ctx, ub.data, biz.LedgerTransfer("system_reward", "location", locationId, "usdt", amount, biz.LedgerSystem(biz.LedgerAccountRewards), biz.LedgerSystem(biz.LedgerAccountRevenue))); nil != err {
+		return err
+	}
+
 	return nil
 }
 
@@ -1450,8 +2066,8 @@ func (ub *UserBalanceRepo) GetSystemYesterdayDailyReward(ctx context.Context) (*
 	}, nil
 }
 
-// SystemFee .
-func (ub *UserBalanceRepo) SystemFee(ctx context.Context, amount int64, locationId int64) error {
+// SystemFee 提现手续费从待打款转入手续费收入，退回提现时金额为负
+func (ub *UserBalanceRepo) SystemFee(ctx context.Context, amount int64, coinType string, locationId int64) error {
 	var (
 		reward Reward
 		err    error
@@ -1467,16 +2083,18 @@ func (ub *UserBalanceRepo) SystemFee(ctx context.Context, amount int64, location
 		return err
 	}
 
+	if _, err = postLedger(ctx, ub.data, biz.LedgerTransfer("withdraw_fee", "withdraw", locationId, coinType, amount, biz.LedgerSystem(biz.LedgerAccountWithdraw), biz.LedgerSystem(biz.LedgerAccountFees))); nil != err {
+		return err
+	}
+
 	return nil
 }
 
 // UserFee .
 func (ub *UserBalanceRepo) UserFee(ctx context.Context, userId int64, amount int64) (int64, error) {
 	var err error
-	if err = ub.data.DB(ctx).Table("user_balance").
-		Where("user_id=?", userId).
-		Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}).Error; nil != err {
-		return 0, errors.NotFound("user balance err", "user balance not found")
+	if _, err = postLedger(ctx, ub.data, biz.LedgerTransfer("fee_reward", "user", userId, "usdt", amount, biz.LedgerSystem(biz.LedgerAccountFees), biz.LedgerUser(userId))); nil != err {
+		return 0, err
 	}
 
 	var userBalance UserBalance
@@ -1489,6 +2107,7 @@ func (ub *UserBalanceRepo) UserFee(ctx context.Context, userId int64, amount int
 	userBalanceRecode.Balance = userBalance.BalanceUsdt
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward"
+	userBalanceRecode.CoinType = "usdt"
 	userBalanceRecode.Amount = amount
 	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
 	if err != nil {
@@ -1512,10 +2131,8 @@ func (ub *UserBalanceRepo) UserFee(ctx context.Context, userId int64, amount int
 // RecommendWithdrawReward .
 func (ub *UserBalanceRepo) RecommendWithdrawReward(ctx context.Context, userId int64, amount int64, locationId int64) (int64, error) {
 	var err error
-	if err = ub.data.DB(ctx).Table("user_balance").
-		Where("user_id=?", userId).
-		Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}).Error; nil != err {
-		return 0, errors.NotFound("user balance err", "user balance not found")
+	if _, err = postLedger(ctx, ub.data, biz.LedgerTransfer("reward", "location", locationId, "usdt", amount, biz.LedgerSystem(biz.LedgerAccountRewards), biz.LedgerUser(userId))); nil != err {
+		return 0, err
 	}
 
 	var userBalance UserBalance
@@ -1528,6 +2145,7 @@ func (ub *UserBalanceRepo) RecommendWithdrawReward(ctx context.Context, userId i
 	userBalanceRecode.Balance = userBalance.BalanceUsdt
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward"
+	userBalanceRecode.CoinType = "usdt"
 	userBalanceRecode.Amount = amount
 	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
 	if err != nil {
@@ -1552,10 +2170,8 @@ func (ub *UserBalanceRepo) RecommendWithdrawReward(ctx context.Context, userId i
 // NormalRecommendReward .
 func (ub *UserBalanceRepo) NormalRecommendReward(ctx context.Context, userId int64, amount int64, locationId int64) (int64, error) {
 	var err error
-	if err = ub.data.DB(ctx).Table("user_balance").
-		Where("user_id=?", userId).
-		Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}).Error; nil != err {
-		return 0, errors.NotFound("user balance err", "user balance not found")
+	if _, err = postLedger(ctx, ub.data, biz.LedgerTransfer("reward", "location", locationId, "usdt", amount, biz.LedgerSystem(biz.LedgerAccountRewards), biz.LedgerUser(userId))); nil != err {
+		return 0, err
 	}
 
 	var userBalance UserBalance
@@ -1568,6 +2184,7 @@ func (ub *UserBalanceRepo) NormalRecommendReward(ctx context.Context, userId int
 	userBalanceRecode.Balance = userBalance.BalanceUsdt
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward"
+	userBalanceRecode.CoinType = "usdt"
 	userBalanceRecode.Amount = amount
 	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
 	if err != nil {
@@ -1592,10 +2209,8 @@ func (ub *UserBalanceRepo) NormalRecommendReward(ctx context.Context, userId int
 // NormalWithdrawRecommendReward .
 func (ub *UserBalanceRepo) NormalWithdrawRecommendReward(ctx context.Context, userId int64, amount int64, locationId int64) (int64, error) {
 	var err error
-	if err = ub.data.DB(ctx).Table("user_balance").
-		Where("user_id=?", userId).
-		Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}).Error; nil != err {
-		return 0, errors.NotFound("user balance err", "user balance not found")
+	if _, err = postLedger(ctx, ub.data, biz.LedgerTransfer("reward", "location", locationId, "usdt", amount, biz.LedgerSystem(biz.LedgerAccountRewards), biz.LedgerUser(userId))); nil != err {
+		return 0, err
 	}
 
 	var userBalance UserBalance
@@ -1608,6 +2223,7 @@ func (ub *UserBalanceRepo) NormalWithdrawRecommendReward(ctx context.Context, us
 	userBalanceRecode.Balance = userBalance.BalanceUsdt
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward"
+	userBalanceRecode.CoinType = "usdt"
 	userBalanceRecode.Amount = amount
 	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
 	if err != nil {
@@ -1816,6 +2432,49 @@ func (ub *UserBalanceRepo) GetUserRewards(ctx context.Context, b *biz.Pagination
 	return res, nil, count
 }
 
+// GetUserRewardsByCursor 按 id 倒序，beforeId 为 0 时从最新一条开始
+func (ub *UserBalanceRepo) GetUserRewardsByCursor(ctx context.Context, userId int64, filter *biz.RewardFilter, beforeId int64, limit int) ([]*biz.Reward, error) {
+	var rewards []*Reward
+	res := make([]*biz.Reward, 0)
+
+	instance := ub.data.db.Table("reward").Where("user_id=?", userId)
+	if 0 < len(filter.Reasons) {
+		instance = instance.Where("reason in (?)", filter.Reasons)
+	}
+	if "" != filter.Type {
+		instance = instance.Where("type=?", filter.Type)
+	}
+	if !filter.StartTime.IsZero() {
+		instance = instance.Where("created_at>=?", filter.StartTime)
+	}
+	if !filter.EndTime.IsZero() {
+		instance = instance.Where("created_at<?", filter.EndTime)
+	}
+	if 0 < beforeId {
+		instance = instance.Where("id<?", beforeId)
+	}
+
+	if err := instance.Order("id desc").Limit(limit).Find(&rewards).Error; err != nil {
+		return nil, errors.New(500, "REWARD ERROR", err.Error())
+	}
+
+	for _, reward := range rewards {
+		res = append(res, &biz.Reward{
+			ID:               reward.ID,
+			UserId:           reward.UserId,
+			Amount:           reward.Amount,
+			BalanceRecordId:  reward.BalanceRecordId,
+			Type:             reward.Type,
+			TypeRecordId:     reward.TypeRecordId,
+			Reason:           reward.Reason,
+			ReasonLocationId: reward.ReasonLocationId,
+			LocationType:     reward.LocationType,
+			CreatedAt:        reward.CreatedAt,
+		})
+	}
+	return res, nil
+}
+
 // GetUserRewardsLastMonthFee .
 func (ub *UserBalanceRepo) GetUserRewardsLastMonthFee(ctx context.Context) ([]*biz.Reward, error) {
 	var (
@@ -1914,7 +2573,7 @@ type UserSortRecommendReward struct {
 // GetUserBalanceUsdtTotal .
 func (ub UserBalanceRepo) GetUserBalanceUsdtTotal(ctx context.Context) (int64, error) {
 	var total UserBalanceTotal
-	if err := ub.data.db.Table("user_balance").Select("sum(balance_usdt) as total").Take(&total).Error; err != nil {
+	if err := ub.data.DB(ctx).Table("user_balance").Select("sum(balance_usdt) as total").Take(&total).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return total.Total, errors.NotFound("USER_BALANCE_NOT_FOUND", "user balance not found")
 		}
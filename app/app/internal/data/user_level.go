@@ -0,0 +1,175 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"time"
+)
+
+type UserLevelHistory struct {
+	ID            int64     `gorm:"primarykey;type:int"`
+	UserId        int64     `gorm:"type:int;not null;index"`
+	OldLevel      int64     `gorm:"type:int;not null"`
+	NewLevel      int64     `gorm:"type:int;not null"`
+	AutoLevel     int64     `gorm:"type:int;not null"`
+	OverrideLevel int64     `gorm:"type:int;not null"`
+	AreaAmount    int64     `gorm:"type:bigint;not null"`
+	RecommendNum  int64     `gorm:"type:int;not null"`
+	Source        string    `gorm:"type:varchar(45);not null"`
+	CreatedAt     time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt     time.Time `gorm:"type:datetime;not null"`
+}
+
+// UserLevelOverride 后台设定的等级，ExpireAt 为空时不过期
+type UserLevelOverride struct {
+	ID        int64      `gorm:"primarykey;type:int"`
+	UserId    int64      `gorm:"type:int;not null;index"`
+	Level     int64      `gorm:"type:int;not null"`
+	ExpireAt  *time.Time `gorm:"type:datetime"`
+	Status    string     `gorm:"type:varchar(45);not null"`
+	Reason    string     `gorm:"type:varchar(200);not null"`
+	CreatedAt time.Time  `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time  `gorm:"type:datetime;not null"`
+}
+
+type UserLevelRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewUserLevelRepo(data *Data, logger log.Logger) biz.UserLevelRepo {
+	return &UserLevelRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (h *UserLevelHistory) toBiz() *biz.UserLevelHistory {
+	return &biz.UserLevelHistory{
+		ID:            h.ID,
+		UserId:        h.UserId,
+		OldLevel:      h.OldLevel,
+		NewLevel:      h.NewLevel,
+		AutoLevel:     h.AutoLevel,
+		OverrideLevel: h.OverrideLevel,
+		AreaAmount:    h.AreaAmount,
+		RecommendNum:  h.RecommendNum,
+		Source:        h.Source,
+		CreatedAt:     h.CreatedAt,
+	}
+}
+
+func (o *UserLevelOverride) toBiz() *biz.UserLevelOverride {
+	res := &biz.UserLevelOverride{
+		ID:        o.ID,
+		UserId:    o.UserId,
+		Level:     o.Level,
+		Status:    o.Status,
+		Reason:    o.Reason,
+		CreatedAt: o.CreatedAt,
+	}
+	if nil != o.ExpireAt {
+		res.ExpireAt = *o.ExpireAt
+	}
+	return res
+}
+
+// CreateUserLevelHistory .
+func (ul *UserLevelRepo) CreateUserLevelHistory(ctx context.Context, h *biz.UserLevelHistory) error {
+	history := &UserLevelHistory{
+		UserId:        h.UserId,
+		OldLevel:      h.OldLevel,
+		NewLevel:      h.NewLevel,
+		AutoLevel:     h.AutoLevel,
+		OverrideLevel: h.OverrideLevel,
+		AreaAmount:    h.AreaAmount,
+		RecommendNum:  h.RecommendNum,
+		Source:        h.Source,
+	}
+	if err := ul.data.DB(ctx).Table("user_level_history").Create(history).Error; err != nil {
+		return errors.New(500, "CREATE_USER_LEVEL_HISTORY_ERROR", "等级变更记录创建失败")
+	}
+
+	return nil
+}
+
+// GetUserLevelHistories 按 id 正序取 afterId 之后的记录，userId 为 0 时不限用户
+func (ul *UserLevelRepo) GetUserLevelHistories(ctx context.Context, userId int64, afterId int64, limit int) ([]*biz.UserLevelHistory, error) {
+	var histories []*UserLevelHistory
+	instance := ul.data.db.Table("user_level_history").Where("id>?", afterId)
+	if 0 < userId {
+		instance = instance.Where("user_id=?", userId)
+	}
+	if err := instance.Order("id asc").Limit(limit).Find(&histories).Error; err != nil {
+		return nil, errors.New(500, "USER LEVEL HISTORY ERROR", err.Error())
+	}
+
+	res := make([]*biz.UserLevelHistory, 0, len(histories))
+	for _, v := range histories {
+		res = append(res, v.toBiz())
+	}
+	return res, nil
+}
+
+// CreateUserLevelOverride .
+func (ul *UserLevelRepo) CreateUserLevelOverride(ctx context.Context, o *biz.UserLevelOverride) (*biz.UserLevelOverride, error) {
+	override := &UserLevelOverride{
+		UserId: o.UserId,
+		Level:  o.Level,
+		Status: o.Status,
+		Reason: o.Reason,
+	}
+	if !o.ExpireAt.IsZero() {
+		expireAt := o.ExpireAt
+		override.ExpireAt = &expireAt
+	}
+	if err := ul.data.DB(ctx).Table("user_level_override").Create(override).Error; err != nil {
+		return nil, errors.New(500, "CREATE_USER_LEVEL_OVERRIDE_ERROR", "等级设定创建失败")
+	}
+
+	return override.toBiz(), nil
+}
+
+// RevokeUserLevelOverrides 作废用户生效中的设定
+func (ul *UserLevelRepo) RevokeUserLevelOverrides(ctx context.Context, userId int64) error {
+	if err := ul.data.DB(ctx).Table("user_level_override").
+		Where("user_id=? and status=?", userId, biz.UserLevelOverrideActive).
+		Updates(map[string]interface{}{"status": biz.UserLevelOverrideRevoked}).Error; err != nil {
+		return errors.New(500, "UPDATE_USER_LEVEL_OVERRIDE_ERROR", "等级设定修改失败")
+	}
+
+	return nil
+}
+
+// GetExpiredUserLevelOverrides 已到期但仍生效中的设定
+func (ul *UserLevelRepo) GetExpiredUserLevelOverrides(ctx context.Context, now time.Time, limit int) ([]*biz.UserLevelOverride, error) {
+	var overrides []*UserLevelOverride
+	if err := ul.data.db.Table("user_level_override").
+		Where("status=?", biz.UserLevelOverrideActive).
+		Where("expire_at is not null and expire_at<=?", now).
+		Order("id asc").
+		Limit(limit).
+		Find(&overrides).Error; err != nil {
+		return nil, errors.New(500, "USER LEVEL OVERRIDE ERROR", err.Error())
+	}
+
+	res := make([]*biz.UserLevelOverride, 0, len(overrides))
+	for _, v := range overrides {
+		res = append(res, v.toBiz())
+	}
+	return res, nil
+}
+
+// ExpireUserLevelOverride 生效中的设定改为已到期，已被作废时返回 false
+func (ul *UserLevelRepo) ExpireUserLevelOverride(ctx context.Context, id int64) (bool, error) {
+	res := ul.data.DB(ctx).Table("user_level_override").
+		Where("id=? and status=?", id, biz.UserLevelOverrideActive).
+		Updates(map[string]interface{}{"status": biz.UserLevelOverrideExpired})
+	if res.Error != nil {
+		return false, errors.New(500, "UPDATE_USER_LEVEL_OVERRIDE_ERROR", "等级设定修改失败")
+	}
+
+	return 0 < res.RowsAffected, nil
+}
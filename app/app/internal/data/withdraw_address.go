@@ -0,0 +1,107 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"time"
+)
+
+type WithdrawAddress struct {
+	ID         int64     `gorm:"primarykey;type:int"`
+	UserId     int64     `gorm:"type:int;not null"`
+	Address    string    `gorm:"type:varchar(100);not null"`
+	Label      string    `gorm:"type:varchar(100);not null"`
+	Status     string    `gorm:"type:varchar(45);not null"`
+	ActivateAt time.Time `gorm:"type:datetime;not null"`
+	CreatedAt  time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt  time.Time `gorm:"type:datetime;not null"`
+}
+
+type WithdrawAddressRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewWithdrawAddressRepo(data *Data, logger log.Logger) biz.WithdrawAddressRepo {
+	return &WithdrawAddressRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (w *WithdrawAddress) toBiz() *biz.WithdrawAddress {
+	return &biz.WithdrawAddress{
+		ID:         w.ID,
+		UserId:     w.UserId,
+		Address:    w.Address,
+		Label:      w.Label,
+		Status:     w.Status,
+		ActivateAt: w.ActivateAt,
+		CreatedAt:  w.CreatedAt,
+	}
+}
+
+// CreateWithdrawAddress .
+func (wr *WithdrawAddressRepo) CreateWithdrawAddress(ctx context.Context, wa *biz.WithdrawAddress) (*biz.WithdrawAddress, error) {
+	var withdrawAddress WithdrawAddress
+	withdrawAddress.UserId = wa.UserId
+	withdrawAddress.Address = wa.Address
+	withdrawAddress.Label = wa.Label
+	withdrawAddress.Status = wa.Status
+	withdrawAddress.ActivateAt = wa.ActivateAt
+	res := wr.data.DB(ctx).Table("withdraw_address").Create(&withdrawAddress)
+	if res.Error != nil {
+		return nil, errors.New(500, "CREATE_WITHDRAW_ADDRESS_ERROR", "提现地址创建失败")
+	}
+
+	return withdrawAddress.toBiz(), nil
+}
+
+// GetWithdrawAddressById .
+func (wr *WithdrawAddressRepo) GetWithdrawAddressById(ctx context.Context, id int64) (*biz.WithdrawAddress, error) {
+	var withdrawAddress WithdrawAddress
+	if err := wr.data.DB(ctx).Table("withdraw_address").Where("id=?", id).First(&withdrawAddress).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("WITHDRAW_ADDRESS_NOT_FOUND", "withdraw address not found")
+		}
+
+		return nil, errors.New(500, "WITHDRAW ADDRESS ERROR", err.Error())
+	}
+
+	return withdrawAddress.toBiz(), nil
+}
+
+// GetWithdrawAddressesByUserId 不含已删除的地址
+func (wr *WithdrawAddressRepo) GetWithdrawAddressesByUserId(ctx context.Context, userId int64) ([]*biz.WithdrawAddress, error) {
+	var withdrawAddresses []*WithdrawAddress
+	if err := wr.data.DB(ctx).Table("withdraw_address").
+		Where("user_id=? and status<>?", userId, biz.WithdrawAddressStatusDeleted).
+		Order("id desc").Find(&withdrawAddresses).Error; err != nil {
+		return nil, errors.New(500, "WITHDRAW ADDRESS ERROR", err.Error())
+	}
+
+	res := make([]*biz.WithdrawAddress, 0, len(withdrawAddresses))
+	for _, v := range withdrawAddresses {
+		res = append(res, v.toBiz())
+	}
+
+	return res, nil
+}
+
+// DeleteWithdrawAddress .
+func (wr *WithdrawAddressRepo) DeleteWithdrawAddress(ctx context.Context, id int64, userId int64) error {
+	res := wr.data.DB(ctx).Table("withdraw_address").
+		Where("id=? and user_id=? and status<>?", id, userId, biz.WithdrawAddressStatusDeleted).
+		Updates(map[string]interface{}{"status": biz.WithdrawAddressStatusDeleted})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_WITHDRAW_ADDRESS_ERROR", "提现地址删除失败")
+	}
+	if 0 == res.RowsAffected {
+		return errors.NotFound("WITHDRAW_ADDRESS_NOT_FOUND", "提现地址不存在")
+	}
+
+	return nil
+}
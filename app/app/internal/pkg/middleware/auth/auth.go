@@ -1,22 +1,82 @@
 package auth
 
 import (
-	"errors"
+	"context"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	jwtmw "github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	"github.com/go-kratos/kratos/v2/transport"
 	"github.com/golang-jwt/jwt/v4"
 )
 
 type CustomClaims struct {
-	UserId   int64
-	UserType string
+	UserId    int64
+	UserType  string
+	Role      string
+	SessionId string
 	jwt.StandardClaims
 }
 
-// CreateToken generate token
-func CreateToken(c CustomClaims, key string) (string, error) {
-	claims := jwt.NewWithClaims(jwt.SigningMethodHS256, c)
-	signedString, err := claims.SignedString([]byte(key))
-	if err != nil {
-		return "", errors.New("generate token failed" + err.Error())
+// SessionChecker 判断会话是否仍然有效
+type SessionChecker func(ctx context.Context, sessionId string) (bool, error)
+
+// Session 在 jwt 验证之后使用，拒绝已吊销会话的 token
+func Session(checker SessionChecker) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			claims, ok := jwtmw.FromContext(ctx)
+			if !ok {
+				return nil, jwtmw.ErrMissingJwtToken
+			}
+
+			c, ok := claims.(jwt.MapClaims)
+			if !ok {
+				return nil, jwtmw.ErrTokenInvalid
+			}
+
+			sessionId, _ := c["SessionId"].(string)
+			alive, err := checker(ctx, sessionId)
+			if err != nil {
+				return nil, err
+			}
+			if !alive {
+				return nil, kerrors.Unauthorized("SESSION_REVOKED", "会话已失效")
+			}
+
+			return handler(ctx, req)
+		}
+	}
+}
+
+// Authorizer 判断 token 身份能否访问接口
+type Authorizer func(operation string, userType string, role string) bool
+
+// Role 在 jwt 验证之后使用，按接口校验 token 的身份和角色
+func Role(authorizer Authorizer) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, jwtmw.ErrWrongContext
+			}
+
+			claims, ok := jwtmw.FromContext(ctx)
+			if !ok {
+				return nil, jwtmw.ErrMissingJwtToken
+			}
+
+			c, ok := claims.(jwt.MapClaims)
+			if !ok {
+				return nil, jwtmw.ErrTokenInvalid
+			}
+
+			userType, _ := c["UserType"].(string)
+			role, _ := c["Role"].(string)
+			if !authorizer(tr.Operation(), userType, role) {
+				return nil, kerrors.Forbidden("PERMISSION_DENIED", "没有权限")
+			}
+
+			return handler(ctx, req)
+		}
 	}
-	return signedString, nil
 }
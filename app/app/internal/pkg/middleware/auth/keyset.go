@@ -0,0 +1,186 @@
+package auth
+
+import (
+	"context"
+	"dhb/app/app/internal/conf"
+	"fmt"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	jwtmw "github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/golang-jwt/jwt/v4"
+	"os"
+	"strings"
+	"sync"
+)
+
+// 未配置 jwt_keys 时使用 conf.Auth.jwt_key 签发和校验未带 kid 的 token，配置 jwt_keys 后忽略 jwt_key
+const legacyKid = ""
+
+type signingKey struct {
+	method    jwt.SigningMethod
+	signKey   interface{}
+	verifyKey interface{}
+	retired   bool
+}
+
+// KeySet jwt 密钥集合，新 token 使用 active_kid 签发，校验接受所有未退役的密钥，支持运行时重新加载
+type KeySet struct {
+	mu        sync.RWMutex
+	activeKid string
+	keys      map[string]*signingKey
+}
+
+func NewKeySet(c *conf.Auth) (*KeySet, error) {
+	k := &KeySet{}
+	if err := k.Load(c); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// Load 根据配置重建密钥集合，配置有误时保留原有密钥
+func (k *KeySet) Load(c *conf.Auth) error {
+	keys := make(map[string]*signingKey, 0)
+	if "" != c.JwtKey && 0 == len(c.JwtKeys) {
+		keys[legacyKid] = &signingKey{
+			method:    jwt.SigningMethodHS256,
+			signKey:   []byte(c.JwtKey),
+			verifyKey: []byte(c.JwtKey),
+		}
+	}
+
+	for _, v := range c.JwtKeys {
+		if "" == v.Kid {
+			return fmt.Errorf("jwt key: kid is empty")
+		}
+		if _, ok := keys[v.Kid]; ok {
+			return fmt.Errorf("jwt key %s: duplicate kid", v.Kid)
+		}
+
+		key := &signingKey{retired: v.Retired}
+		switch strings.ToUpper(v.Alg) {
+		case "", "HS256":
+			secret := v.Secret
+			if "" != v.SecretEnv {
+				secret = os.Getenv(v.SecretEnv)
+			}
+			if "" == secret {
+				return fmt.Errorf("jwt key %s: secret is empty", v.Kid)
+			}
+			key.method = jwt.SigningMethodHS256
+			key.signKey = []byte(secret)
+			key.verifyKey = []byte(secret)
+		case "ES256":
+			key.method = jwt.SigningMethodES256
+			if "" != v.PrivateKey {
+				privateKey, err := jwt.ParseECPrivateKeyFromPEM([]byte(v.PrivateKey))
+				if err != nil {
+					return fmt.Errorf("jwt key %s: %v", v.Kid, err)
+				}
+				key.signKey = privateKey
+				key.verifyKey = &privateKey.PublicKey
+			}
+			if "" != v.PublicKey {
+				publicKey, err := jwt.ParseECPublicKeyFromPEM([]byte(v.PublicKey))
+				if err != nil {
+					return fmt.Errorf("jwt key %s: %v", v.Kid, err)
+				}
+				key.verifyKey = publicKey
+			}
+			if nil == key.verifyKey {
+				return fmt.Errorf("jwt key %s: public key is empty", v.Kid)
+			}
+		default:
+			return fmt.Errorf("jwt key %s: unsupported alg %s", v.Kid, v.Alg)
+		}
+		keys[v.Kid] = key
+	}
+
+	activeKid := c.ActiveKid
+	if "" == activeKid && 0 == len(c.JwtKeys) {
+		activeKid = legacyKid
+	}
+	active, ok := keys[activeKid]
+	if !ok || active.retired || nil == active.signKey {
+		return fmt.Errorf("jwt key %s: active key not found or can not sign", activeKid)
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.activeKid = activeKid
+	k.keys = keys
+	return nil
+}
+
+// CreateToken 使用当前密钥签发 token
+func (k *KeySet) CreateToken(c CustomClaims) (string, error) {
+	k.mu.RLock()
+	kid := k.activeKid
+	key := k.keys[kid]
+	k.mu.RUnlock()
+
+	token := jwt.NewWithClaims(key.method, c)
+	if legacyKid != kid {
+		token.Header["kid"] = kid
+	}
+	signedString, err := token.SignedString(key.signKey)
+	if err != nil {
+		return "", fmt.Errorf("generate token failed: %v", err)
+	}
+	return signedString, nil
+}
+
+// Keyfunc 按 token 头部的 kid 查找校验密钥
+func (k *KeySet) Keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	k.mu.RLock()
+	key, ok := k.keys[kid]
+	k.mu.RUnlock()
+	if !ok || key.retired {
+		return nil, fmt.Errorf("unknown or retired kid %q", kid)
+	}
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, jwtmw.ErrUnSupportSigningMethod
+	}
+
+	return key.verifyKey, nil
+}
+
+// Server 解析 Authorization 头中的 token，claims 存入 kratos jwt 上下文
+func Server(k *KeySet) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			header, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, jwtmw.ErrWrongContext
+			}
+
+			auths := strings.SplitN(header.RequestHeader().Get("Authorization"), " ", 2)
+			if len(auths) != 2 || !strings.EqualFold(auths[0], "Bearer") {
+				return nil, jwtmw.ErrMissingJwtToken
+			}
+
+			tokenInfo, err := jwt.Parse(auths[1], k.Keyfunc)
+			if err != nil {
+				ve, ok := err.(*jwt.ValidationError)
+				if !ok {
+					return nil, kerrors.Unauthorized("UNAUTHORIZED", err.Error())
+				}
+				if ve.Errors&jwt.ValidationErrorMalformed != 0 {
+					return nil, jwtmw.ErrTokenInvalid
+				}
+				if ve.Errors&(jwt.ValidationErrorExpired|jwt.ValidationErrorNotValidYet) != 0 {
+					return nil, jwtmw.ErrTokenExpired
+				}
+				return nil, jwtmw.ErrTokenParseFail
+			}
+			if !tokenInfo.Valid {
+				return nil, jwtmw.ErrTokenInvalid
+			}
+
+			return handler(jwtmw.NewContext(ctx, tokenInfo.Claims), req)
+		}
+	}
+}
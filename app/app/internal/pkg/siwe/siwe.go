@@ -0,0 +1,134 @@
+package siwe
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const headerSuffix = " wants you to sign in with your Ethereum account:"
+
+// Message EIP-4361 Sign-In with Ethereum 消息
+type Message struct {
+	Domain         string
+	Address        string
+	Statement      string
+	URI            string
+	Version        string
+	ChainId        int64
+	Nonce          string
+	IssuedAt       time.Time
+	ExpirationTime *time.Time
+	NotBefore      *time.Time
+	RequestId      string
+	Resources      []string
+}
+
+// Parse 解析 EIP-4361 文本消息，domain 不能带 scheme，字段不能重复
+func Parse(raw string) (*Message, error) {
+	lines := strings.Split(strings.ReplaceAll(raw, "\r\n", "\n"), "\n")
+	if 2 > len(lines) || !strings.HasSuffix(lines[0], headerSuffix) {
+		return nil, errors.New("siwe: invalid header")
+	}
+
+	m := &Message{
+		Domain:  strings.TrimSuffix(lines[0], headerSuffix),
+		Address: strings.TrimSpace(lines[1]),
+	}
+	if strings.Contains(m.Domain, "://") {
+		return nil, errors.New("siwe: scheme in domain")
+	}
+	if "" == m.Domain || "" == m.Address {
+		return nil, errors.New("siwe: missing domain or address")
+	}
+
+	var (
+		err         error
+		inResources bool
+		statement   []string
+		hasIssuedAt bool
+		seen        = make(map[string]bool)
+	)
+	for _, line := range lines[2:] {
+		if inResources {
+			if strings.HasPrefix(line, "- ") {
+				m.Resources = append(m.Resources, strings.TrimPrefix(line, "- "))
+				continue
+			}
+			inResources = false
+		}
+
+		key, value, ok := splitField(line)
+		if !ok {
+			// 第一个字段之前的非空行是 statement
+			if "" != line && "" == m.URI {
+				statement = append(statement, line)
+			}
+			continue
+		}
+		if seen[key] {
+			return nil, errors.New("siwe: duplicate field " + key)
+		}
+		seen[key] = true
+
+		switch key {
+		case "URI":
+			m.URI = value
+		case "Version":
+			m.Version = value
+		case "Chain ID":
+			if m.ChainId, err = strconv.ParseInt(value, 10, 64); err != nil {
+				return nil, errors.New("siwe: invalid chain id")
+			}
+		case "Nonce":
+			m.Nonce = value
+		case "Issued At":
+			if m.IssuedAt, err = time.Parse(time.RFC3339, value); err != nil {
+				return nil, errors.New("siwe: invalid issued at")
+			}
+			hasIssuedAt = true
+		case "Expiration Time":
+			t, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return nil, errors.New("siwe: invalid expiration time")
+			}
+			m.ExpirationTime = &t
+		case "Not Before":
+			t, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return nil, errors.New("siwe: invalid not before")
+			}
+			m.NotBefore = &t
+		case "Request ID":
+			m.RequestId = value
+		case "Resources":
+			inResources = true
+		}
+	}
+	m.Statement = strings.Join(statement, "\n")
+
+	if "" == m.URI || "" == m.Version || 0 == m.ChainId || "" == m.Nonce || !hasIssuedAt {
+		return nil, errors.New("siwe: missing required field")
+	}
+
+	return m, nil
+}
+
+func splitField(line string) (string, string, bool) {
+	if "Resources:" == line {
+		return "Resources", "", true
+	}
+
+	i := strings.Index(line, ": ")
+	if -1 == i {
+		return "", "", false
+	}
+
+	switch key := line[:i]; key {
+	case "URI", "Version", "Chain ID", "Nonce", "Issued At", "Expiration Time", "Not Before", "Request ID":
+		return key, line[i+2:], true
+	}
+
+	return "", "", false
+}
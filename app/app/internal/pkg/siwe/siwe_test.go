@@ -0,0 +1,99 @@
+package siwe
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+const testMessage = `example.com wants you to sign in with your Ethereum account:
+0x00000000000000000000000000000000000000a1
+
+Sign in to DHB.
+
+URI: https://example.com/login
+Version: 1
+Chain ID: 56
+Nonce: 32891756
+Issued At: 2024-03-10T02:00:00Z
+Expiration Time: 2024-03-10T02:10:00Z
+Not Before: 2024-03-10T01:59:00Z
+Request ID: req-1
+Resources:
+- https://example.com/a
+- https://example.com/b`
+
+func TestParse(t *testing.T) {
+	m, err := Parse(testMessage)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if "example.com" != m.Domain || "0x00000000000000000000000000000000000000a1" != m.Address {
+		t.Errorf("domain %s address %s", m.Domain, m.Address)
+	}
+	if "Sign in to DHB." != m.Statement || "https://example.com/login" != m.URI || "1" != m.Version {
+		t.Errorf("statement %q uri %s version %s", m.Statement, m.URI, m.Version)
+	}
+	if 56 != m.ChainId || "32891756" != m.Nonce || "req-1" != m.RequestId {
+		t.Errorf("chain id %d nonce %s request id %s", m.ChainId, m.Nonce, m.RequestId)
+	}
+	if !m.IssuedAt.Equal(time.Date(2024, 3, 10, 2, 0, 0, 0, time.UTC)) {
+		t.Errorf("issued at %s", m.IssuedAt)
+	}
+	if nil == m.ExpirationTime || !m.ExpirationTime.Equal(time.Date(2024, 3, 10, 2, 10, 0, 0, time.UTC)) {
+		t.Errorf("expiration time %v", m.ExpirationTime)
+	}
+	if nil == m.NotBefore || !m.NotBefore.Equal(time.Date(2024, 3, 10, 1, 59, 0, 0, time.UTC)) {
+		t.Errorf("not before %v", m.NotBefore)
+	}
+	if 2 != len(m.Resources) || "https://example.com/b" != m.Resources[1] {
+		t.Errorf("resources %v", m.Resources)
+	}
+
+	// CRLF 换行和可选字段缺省
+	minimal := strings.Join([]string{
+		"localhost:8080 wants you to sign in with your Ethereum account:",
+		"0x00000000000000000000000000000000000000a1",
+		"",
+		"URI: http://localhost:8080",
+		"Version: 1",
+		"Chain ID: 97",
+		"Nonce: abc",
+		"Issued At: 2024-03-10T02:00:00Z",
+	}, "\r\n")
+	if m, err = Parse(minimal); err != nil {
+		t.Fatal(err)
+	}
+	if "localhost:8080" != m.Domain || "" != m.Statement || nil != m.ExpirationTime || nil != m.NotBefore {
+		t.Errorf("minimal message %+v", m)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+	}{
+		{name: "empty", raw: ""},
+		{name: "header", raw: strings.Replace(testMessage, "wants you to sign in", "wants to sign in", 1)},
+		{name: "scheme in domain", raw: "https://" + testMessage},
+		{name: "scheme only", raw: strings.Replace(testMessage, "example.com wants", "://example.com wants", 1)},
+		{name: "missing domain", raw: strings.TrimPrefix(testMessage, "example.com")},
+		{name: "missing nonce", raw: strings.Replace(testMessage, "Nonce: 32891756\n", "", 1)},
+		{name: "missing issued at", raw: strings.Replace(testMessage, "Issued At: 2024-03-10T02:00:00Z\n", "", 1)},
+		{name: "invalid chain id", raw: strings.Replace(testMessage, "Chain ID: 56", "Chain ID: bsc", 1)},
+		{name: "invalid issued at", raw: strings.Replace(testMessage, "Issued At: 2024-03-10T02:00:00Z", "Issued At: yesterday", 1)},
+		{name: "duplicate uri", raw: strings.Replace(testMessage, "Version: 1\n", "Version: 1\nURI: https://evil.com\n", 1)},
+		{name: "duplicate nonce", raw: strings.Replace(testMessage, "Request ID: req-1\n", "Request ID: req-1\nNonce: 1\n", 1)},
+		{name: "duplicate chain id", raw: strings.Replace(testMessage, "Nonce: 32891756\n", "Nonce: 32891756\nChain ID: 1\n", 1)},
+		{name: "duplicate expiration time", raw: strings.Replace(testMessage, "Request ID: req-1\n", "Request ID: req-1\nExpiration Time: 2030-01-01T00:00:00Z\n", 1)},
+		{name: "duplicate resources", raw: testMessage + "\nResources:\n- https://evil.com"},
+	}
+
+	for _, tt := range tests {
+		if m, err := Parse(tt.raw); err == nil {
+			t.Errorf("%s: parsed %+v, want error", tt.name, m)
+		}
+	}
+}
@@ -3,27 +3,28 @@ package server
 import (
 	"context"
 	v1 "dhb/app/app/api"
+	"dhb/app/app/internal/biz"
 	"dhb/app/app/internal/conf"
+	"dhb/app/app/internal/pkg/middleware/auth"
 	"dhb/app/app/internal/service"
-	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/middleware/selector"
-	jwt2 "github.com/golang-jwt/jwt/v4"
 	"github.com/gorilla/handlers"
+	"strings"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/transport/http"
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, app *service.AppService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, app *service.AppService, ks *auth.KeySet, logger log.Logger) *http.Server {
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
 			selector.Server( // jwt 验证
-				jwt.Server(func(token *jwt2.Token) (interface{}, error) {
-					return []byte("5485c6f09a1a9bf5edeb841d85e09250"), nil
-				}, jwt.WithSigningMethod(jwt2.SigningMethodHS256)),
+				auth.Server(ks),                // 按 kid 选择密钥
+				auth.Session(app.SessionAlive), // 已吊销的会话
+				auth.Role(NewRoleAuthorizer()),
 			).Match(NewWhiteListMatcher()).Build(),
 		),
 		http.Filter(handlers.CORS(
@@ -50,12 +51,14 @@ func NewHTTPServer(c *conf.Server, app *service.AppService, logger log.Logger) *
 func NewWhiteListMatcher() selector.MatchFunc {
 	whiteList := make(map[string]struct{})
 	whiteList["/api.App/EthAuthorize"] = struct{}{}
+	whiteList["/api.App/GetAuthNonce"] = struct{}{}
+	whiteList["/api.App/RefreshToken"] = struct{}{}
+	whiteList["/api.App/AdminLogin"] = struct{}{}
 	//whiteList["/api.App/Deposit"] = struct{}{}
 	//whiteList["/api.App/AdminLocationList"] = struct{}{}
 	//whiteList["/api.App/AdminRewardList"] = struct{}{}
 	//whiteList["/api.App/AdminUserList"] = struct{}{}
 	//whiteList["/api.App/AdminWithdrawList"] = struct{}{}
-	//whiteList["/api.App/AdminWithdraw"] = struct{}{}
 	//whiteList["/api.App/AdminWithdrawEth"] = struct{}{}
 	//whiteList["/api.App/AdminFee"] = struct{}{}
 	//whiteList["/api.App/AdminAll"] = struct{}{}
@@ -70,3 +73,66 @@ func NewWhiteListMatcher() selector.MatchFunc {
 		return true
 	}
 }
+
+// NewRoleAuthorizer 管理接口按角色授权，未配置的管理接口只允许超级管理员，其余接口只允许用户 token
+func NewRoleAuthorizer() auth.Authorizer {
+	viewer := []string{biz.AdminRoleViewer, biz.AdminRoleFinance}
+	finance := []string{biz.AdminRoleFinance}
+	permissions := map[string][]string{
+		"/api.App/AdminRewardList":           viewer,
+		"/api.App/AdminUserList":             viewer,
+		"/api.App/AdminLocationList":         viewer,
+		"/api.App/AdminWithdrawList":         viewer,
+		"/api.App/AdminAll":                  viewer,
+		"/api.App/AdminUserRecommend":        viewer,
+		"/api.App/AdminRecommendTree":        viewer,
+		"/api.App/AdminUserLevelHistory":     viewer,
+		"/api.App/AdminMonthRecommend":       viewer,
+		"/api.App/AdminConfig":               viewer,
+		"/api.App/AdminWithdrawEth":          finance,
+		"/api.App/AdminWithdrawApprove":      finance,
+		"/api.App/AdminWithdrawReject":       finance,
+		"/api.App/AdminWithdrawRetry":        finance,
+		"/api.App/AdminWithdrawRefund":       finance,
+		"/api.App/AdminWithdrawBulkApprove":  finance,
+		"/api.App/AdminFee":                  finance,
+		"/api.App/AdminLedgerBalance":        finance,
+		"/api.App/AdminReconcile":            finance,
+		"/api.App/AdminDepositUnmatchedList": finance,
+		"/api.App/AdminDepositResolve":       finance,
+		"/api.App/AdminDiscrepancyList":      finance,
+		"/api.App/AdminSettlementDryRun":     finance,
+		"/api.App/AdminSettlementReplay":     finance,
+		"/api.App/Deposit":                   finance,
+	}
+	// 用户和管理员都可以调用的接口
+	shared := map[string]struct{}{
+		"/api.App/Logout":            {},
+		"/api.App/LogoutAllSessions": {},
+	}
+
+	return func(operation string, userType string, role string) bool {
+		if _, ok := shared[operation]; ok {
+			return true
+		}
+
+		// 配置了角色的接口也是管理接口
+		_, adminOperation := permissions[operation]
+		if !adminOperation && !strings.HasPrefix(operation, "/api.App/Admin") {
+			return "user" == userType
+		}
+
+		if "admin" != userType {
+			return false
+		}
+		if biz.AdminRoleSuperAdmin == role {
+			return true
+		}
+		for _, r := range permissions[operation] {
+			if r == role {
+				return true
+			}
+		}
+		return false
+	}
+}
@@ -0,0 +1,116 @@
+package server
+
+import (
+	"context"
+	"dhb/app/app/internal/conf"
+	"dhb/app/app/internal/service"
+	"github.com/go-kratos/kratos/v2/log"
+	"sync"
+	"time"
+)
+
+// JobServer 定时任务，随应用启动和停止
+type JobServer struct {
+	cc     *conf.Chain
+	cp     *conf.Payout
+	cj     *conf.Job
+	app    *service.AppService
+	log    *log.Helper
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewJobServer new a job server.
+func NewJobServer(cc *conf.Chain, cp *conf.Payout, cj *conf.Job, app *service.AppService, logger log.Logger) *JobServer {
+	return &JobServer{
+		cc:  cc,
+		cp:  cp,
+		cj:  cj,
+		app: app,
+		log: log.NewHelper(logger),
+	}
+}
+
+// Start 启动定时任务，阻塞到应用停止
+func (j *JobServer) Start(ctx context.Context) error {
+	ctx, j.cancel = context.WithCancel(ctx)
+
+	// 充值扫描，scan_interval 为 0 时不启动
+	if nil != j.cc && nil != j.cc.ScanInterval && 0 < j.cc.ScanInterval.AsDuration() {
+		j.run(ctx, "deposit_scan", j.cc.ScanInterval.AsDuration(), func(ctx context.Context) error {
+			_, err := j.app.DepositScan(ctx)
+			return err
+		})
+	}
+
+	// 提现打款，interval 为 0 时只能手动触发
+	if nil != j.cp && nil != j.cp.Interval && 0 < j.cp.Interval.AsDuration() {
+		j.run(ctx, "payout", j.cp.Interval.AsDuration(), func(ctx context.Context) error {
+			_, err := j.app.Payout(ctx)
+			return err
+		})
+	}
+
+	// 余额对账
+	if nil != j.cj && nil != j.cj.ReconcileInterval && 0 < j.cj.ReconcileInterval.AsDuration() {
+		j.run(ctx, "reconcile", j.cj.ReconcileInterval.AsDuration(), func(ctx context.Context) error {
+			_, err := j.app.Reconcile(ctx)
+			return err
+		})
+	}
+
+	// 投资位每日收益
+	if nil != j.cj && nil != j.cj.SettleInterval && 0 < j.cj.SettleInterval.AsDuration() {
+		j.run(ctx, "location_daily_settle", j.cj.SettleInterval.AsDuration(), func(ctx context.Context) error {
+			_, err := j.app.LocationDailySettle(ctx)
+			return err
+		})
+	}
+
+	// 锁仓每日收益
+	if nil != j.cj && nil != j.cj.BalanceRewardInterval && 0 < j.cj.BalanceRewardInterval.AsDuration() {
+		j.run(ctx, "balance_reward", j.cj.BalanceRewardInterval.AsDuration(), func(ctx context.Context) error {
+			_, err := j.app.BalanceRewardAccrue(ctx)
+			return err
+		})
+	}
+
+	// vip 等级评定
+	if nil != j.cj && nil != j.cj.VipLevelInterval && 0 < j.cj.VipLevelInterval.AsDuration() {
+		j.run(ctx, "vip_level", j.cj.VipLevelInterval.AsDuration(), func(ctx context.Context) error {
+			_, err := j.app.VipLevelEvaluate(ctx)
+			return err
+		})
+	}
+
+	<-ctx.Done()
+	j.wg.Wait()
+	return nil
+}
+
+// Stop .
+func (j *JobServer) Stop(ctx context.Context) error {
+	if nil != j.cancel {
+		j.cancel()
+	}
+	return nil
+}
+
+func (j *JobServer) run(ctx context.Context, name string, interval time.Duration, job func(ctx context.Context) error) {
+	j.wg.Add(1)
+	go func() {
+		defer j.wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := job(ctx); nil != err {
+					j.log.Errorf("job %s: %v", name, err)
+				}
+			}
+		}
+	}()
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
 	jwt2 "github.com/golang-jwt/jwt/v4"
+	"sync"
 	"time"
 )
 
@@ -19,13 +20,24 @@ type AppService struct {
 
 	uuc *biz.UserUseCase
 	ruc *biz.RecordUseCase
+	auc *biz.AuthUseCase
+	adc *biz.AdminUseCase
+	lc  *biz.LedgerUseCase
 	log *log.Helper
 	ca  *conf.Auth
+	cc  *conf.Chain
+	cp  *conf.Payout
+	ks  *auth.KeySet
+
+	payoutMu    sync.Mutex
+	reconcileMu sync.Mutex
+	settleMu    sync.Mutex
+	stakeMu     sync.Mutex
 }
 
 // NewAppService new a service.
-func NewAppService(uuc *biz.UserUseCase, ruc *biz.RecordUseCase, logger log.Logger, ca *conf.Auth) *AppService {
-	return &AppService{uuc: uuc, ruc: ruc, log: log.NewHelper(logger), ca: ca}
+func NewAppService(uuc *biz.UserUseCase, ruc *biz.RecordUseCase, auc *biz.AuthUseCase, adc *biz.AdminUseCase, lc *biz.LedgerUseCase, logger log.Logger, ca *conf.Auth, cc *conf.Chain, cp *conf.Payout, ks *auth.KeySet) *AppService {
+	return &AppService{uuc: uuc, ruc: ruc, auc: auc, adc: adc, lc: lc, log: log.NewHelper(logger), ca: ca, cc: cc, cp: cp, ks: ks}
 }
 
 // EthAuthorize ethAuthorize.
@@ -36,7 +48,27 @@ func (a *AppService) EthAuthorize(ctx context.Context, req *v1.EthAuthorizeReque
 		return nil, errors.New(500, "AUTHORIZE_ERROR", "账户地址参数错误")
 	}
 
-	// TODO 验证签名
+	// 验证签名，随机数一次有效
+	if "" == req.SendBody.Sign {
+		return nil, errors.New(500, "AUTHORIZE_ERROR", "签名参数错误")
+	}
+	if "" != req.SendBody.Message {
+		// 完整的 SIWE 消息
+		siweOption := &biz.SiweOption{}
+		if s := a.ca.GetSiwe(); nil != s {
+			siweOption.Domain = s.Domain
+			siweOption.Uri = s.Uri
+			siweOption.ChainId = s.ChainId
+			siweOption.MaxAge = s.MaxAge.AsDuration()
+		}
+		if err := a.auc.VerifySiwe(ctx, userAddress, req.SendBody.Message, req.SendBody.Sign, siweOption); err != nil {
+			return nil, err
+		}
+	} else if !a.ca.GetSiwe().GetAllowLegacySign() {
+		return nil, errors.New(500, "AUTHORIZE_ERROR", "请使用登录消息签名")
+	} else if err := a.auc.VerifyEthSign(ctx, userAddress, req.SendBody.Sign); err != nil {
+		return nil, err
+	}
 
 	// 根据地址查询用户，不存在时则创建
 	user, err := a.uuc.GetExistUserByAddressOrCreate(ctx, &biz.User{
@@ -46,31 +78,201 @@ func (a *AppService) EthAuthorize(ctx context.Context, req *v1.EthAuthorizeReque
 		return nil, err
 	}
 
+	token, refreshToken, err := a.createToken(ctx, user.ID, "user", "")
+	if err != nil {
+		return nil, err
+	}
+
+	userInfoRsp := v1.EthAuthorizeReply{
+		Token:        token,
+		RefreshToken: refreshToken,
+	}
+	return &userInfoRsp, nil
+}
+
+// createToken 创建会话并签发 access token 和 refresh token
+func (a *AppService) createToken(ctx context.Context, userId int64, userType string, role string) (string, string, error) {
+	session, refreshToken, err := a.auc.CreateSession(ctx, userId, userType, role, a.refreshExpire())
+	if err != nil {
+		return "", "", err
+	}
+
+	token, err := a.signToken(session)
+	if err != nil {
+		return "", "", err
+	}
+
+	return token, refreshToken, nil
+}
+
+func (a *AppService) signToken(session *biz.Session) (string, error) {
 	claims := auth.CustomClaims{
-		UserId:   user.ID,
-		UserType: "user",
+		UserId:    session.UserId,
+		UserType:  session.UserType,
+		Role:      session.Role,
+		SessionId: session.ID,
 		StandardClaims: jwt2.StandardClaims{
-			NotBefore: time.Now().Unix(),              // 签名的生效时间
-			ExpiresAt: time.Now().Unix() + 60*60*24*7, // 7天过期
+			NotBefore: time.Now().Unix(),                                     // 签名的生效时间
+			ExpiresAt: time.Now().Unix() + int64(a.accessExpire().Seconds()), // 短期有效，过期后用 refresh token 换取
 			Issuer:    "DHB",
 		},
 	}
-	token, err := auth.CreateToken(claims, a.ca.JwtKey)
+	token, err := a.ks.CreateToken(claims)
 	if err != nil {
-		return nil, errors.New(500, "AUTHORIZE_ERROR", "生成token失败")
+		return "", errors.New(500, "AUTHORIZE_ERROR", "生成token失败")
 	}
 
-	userInfoRsp := v1.EthAuthorizeReply{
-		Token: token,
+	return token, nil
+}
+
+func (a *AppService) accessExpire() time.Duration {
+	if nil != a.ca.AccessExpire && 0 < a.ca.AccessExpire.AsDuration() {
+		return a.ca.AccessExpire.AsDuration()
 	}
-	return &userInfoRsp, nil
+	return 15 * time.Minute
+}
+
+func (a *AppService) refreshExpire() time.Duration {
+	if nil != a.ca.RefreshExpire && 0 < a.ca.RefreshExpire.AsDuration() {
+		return a.ca.RefreshExpire.AsDuration()
+	}
+	return 30 * 24 * time.Hour
+}
+
+// SessionAlive 供 jwt 中间件判断会话是否已被吊销
+func (a *AppService) SessionAlive(ctx context.Context, sessionId string) (bool, error) {
+	return a.auc.CheckSession(ctx, sessionId)
+}
+
+// RefreshToken 使用 refresh token 换取新的 token，旧的 refresh token 作废
+func (a *AppService) RefreshToken(ctx context.Context, req *v1.RefreshTokenRequest) (*v1.RefreshTokenReply, error) {
+	if nil == req.SendBody {
+		return nil, errors.New(500, "REFRESH_TOKEN_ERROR", "无效的refresh token")
+	}
+
+	session, refreshToken, err := a.auc.RefreshSession(ctx, req.SendBody.RefreshToken, a.refreshExpire())
+	if err != nil {
+		return nil, err
+	}
+
+	// 管理员停用或改角色后不能继续续期
+	if "admin" == session.UserType {
+		admin, err := a.adc.GetAdminById(ctx, session.UserId)
+		if err != nil || 1 != admin.Status || admin.Role != session.Role {
+			_ = a.auc.DeleteSession(ctx, session.ID)
+			return nil, errors.New(500, "REFRESH_TOKEN_ERROR", "无效的refresh token")
+		}
+	}
+
+	token, err := a.signToken(session)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.RefreshTokenReply{
+		Token:        token,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// Logout 退出当前会话
+func (a *AppService) Logout(ctx context.Context, req *v1.LogoutRequest) (*v1.LogoutReply, error) {
+	var sessionId string
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["SessionId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		sessionId = c["SessionId"].(string)
+	}
+
+	if err := a.auc.DeleteSession(ctx, sessionId); err != nil {
+		return nil, err
+	}
+
+	return &v1.LogoutReply{Status: "ok"}, nil
+}
+
+// LogoutAllSessions 退出全部会话，用于账户被盗时踢出所有登录
+func (a *AppService) LogoutAllSessions(ctx context.Context, req *v1.LogoutAllSessionsRequest) (*v1.LogoutAllSessionsReply, error) {
+	var (
+		userId   int64
+		userType string
+	)
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+		userType, _ = c["UserType"].(string)
+	}
+
+	if err := a.auc.DeleteUserSessions(ctx, userType, userId); err != nil {
+		return nil, err
+	}
+
+	return &v1.LogoutAllSessionsReply{Status: "ok"}, nil
+}
+
+// GetAuthNonce 获取登录签名随机数
+func (a *AppService) GetAuthNonce(ctx context.Context, req *v1.GetAuthNonceRequest) (*v1.GetAuthNonceReply, error) {
+	if nil == req.SendBody {
+		return nil, errors.New(500, "AUTHORIZE_ERROR", "账户地址参数错误")
+	}
+
+	authNonce, err := a.auc.GetAuthNonce(ctx, req.SendBody.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetAuthNonceReply{
+		Nonce:   authNonce.Nonce,
+		Message: biz.AuthNonceMessage(authNonce.Nonce),
+	}, nil
 }
 
 // Deposit deposit.
 func (a *AppService) Deposit(ctx context.Context, req *v1.DepositRequest) (*v1.DepositReply, error) {
+	if _, err := a.DepositScan(ctx); nil != err {
+		return nil, err
+	}
 	return &v1.DepositReply{}, nil
 }
 
+// DepositScan 扫描一批区块的充值，定时任务和 Deposit 接口共用
+func (a *AppService) DepositScan(ctx context.Context) (int64, error) {
+	if nil == a.cc {
+		return 0, errors.New(500, "DEPOSIT_ERROR", "链配置缺失")
+	}
+
+	// 充值处理和入金共用全局锁
+	lock, _ := a.ruc.LockEthUserRecordHandle(ctx)
+	if !lock {
+		return 0, errors.New(500, "DEPOSIT_ERROR", "充值处理中，请稍后再试")
+	}
+	defer func() {
+		_, _ = a.ruc.UnLockEthUserRecordHandle(ctx)
+	}()
+
+	return a.ruc.DepositScan(ctx, &biz.DepositScanOption{
+		StartBlock:    a.cc.StartBlock,
+		BatchSize:     a.cc.BatchSize,
+		Confirmations: a.cc.Confirmations,
+		ReorgDepth:    a.cc.ReorgDepth,
+	})
+}
+
+// AdminDepositUnmatchedList .
+func (a *AppService) AdminDepositUnmatchedList(ctx context.Context, req *v1.AdminDepositUnmatchedListRequest) (*v1.AdminDepositUnmatchedListReply, error) {
+	return a.ruc.AdminDepositUnmatchedList(ctx, req)
+}
+
+// AdminDepositResolve .
+func (a *AppService) AdminDepositResolve(ctx context.Context, req *v1.AdminDepositResolveRequest) (*v1.AdminDepositResolveReply, error) {
+	return a.ruc.AdminDepositResolve(ctx, req)
+}
+
 // UserInfo userInfo.
 func (a *AppService) UserInfo(ctx context.Context, req *v1.UserInfoRequest) (*v1.UserInfoReply, error) {
 	// 在上下文 context 中取出 claims 对象
@@ -133,7 +335,7 @@ func (a *AppService) RecommendRewardList(ctx context.Context, req *v1.RecommendR
 		userId = int64(c["UserId"].(float64))
 	}
 
-	return a.uuc.RecommendRewardList(ctx, &biz.User{
+	return a.uuc.RecommendRewardList(ctx, req, &biz.User{
 		ID: userId,
 	})
 }
@@ -149,7 +351,41 @@ func (a *AppService) FeeRewardList(ctx context.Context, req *v1.FeeRewardListReq
 		userId = int64(c["UserId"].(float64))
 	}
 
-	return a.uuc.FeeRewardList(ctx, &biz.User{
+	return a.uuc.FeeRewardList(ctx, req, &biz.User{
+		ID: userId,
+	})
+}
+
+// RecommendList 直推列表
+func (a *AppService) RecommendList(ctx context.Context, req *v1.RecommendListRequest) (*v1.RecommendListReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.uuc.RecommendList(ctx, req, &biz.User{
+		ID: userId,
+	})
+}
+
+// RecommendTree 团队树
+func (a *AppService) RecommendTree(ctx context.Context, req *v1.RecommendTreeRequest) (*v1.RecommendTreeReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.uuc.RecommendTree(ctx, req, &biz.User{
 		ID: userId,
 	})
 }
@@ -187,6 +423,87 @@ func (a *AppService) Withdraw(ctx context.Context, req *v1.WithdrawRequest) (*v1
 	})
 }
 
+// CancelWithdraw .
+func (a *AppService) CancelWithdraw(ctx context.Context, req *v1.CancelWithdrawRequest) (*v1.CancelWithdrawReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.uuc.CancelWithdraw(ctx, req, &biz.User{
+		ID: userId,
+	})
+}
+
+// WithdrawAddressList .
+func (a *AppService) WithdrawAddressList(ctx context.Context, req *v1.WithdrawAddressListRequest) (*v1.WithdrawAddressListReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.uuc.WithdrawAddressList(ctx, &biz.User{
+		ID: userId,
+	})
+}
+
+// AddWithdrawAddress 新地址需要登录钱包签名确认，随机数通过 AuthNonce 获取
+func (a *AppService) AddWithdrawAddress(ctx context.Context, req *v1.AddWithdrawAddressRequest) (*v1.AddWithdrawAddressReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	if "" == req.SendBody.Sign {
+		return nil, errors.New(500, "WITHDRAW_ADDRESS_ERROR", "签名参数错误")
+	}
+
+	user, err := a.uuc.GetUserById(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = a.auc.VerifyEthSignMessage(ctx, user.Address, req.SendBody.Sign, func(nonce string) string {
+		return biz.WithdrawAddressMessage(req.SendBody.Address, nonce)
+	}); err != nil {
+		return nil, err
+	}
+
+	return a.uuc.AddWithdrawAddress(ctx, req, user)
+}
+
+// DeleteWithdrawAddress .
+func (a *AppService) DeleteWithdrawAddress(ctx context.Context, req *v1.DeleteWithdrawAddressRequest) (*v1.DeleteWithdrawAddressReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.uuc.DeleteWithdrawAddress(ctx, req, &biz.User{
+		ID: userId,
+	})
+}
+
 // SetBalanceReward .
 func (a *AppService) SetBalanceReward(ctx context.Context, req *v1.SetBalanceRewardRequest) (*v1.SetBalanceRewardReply, error) {
 	// 在上下文 context 中取出 claims 对象
@@ -221,6 +538,29 @@ func (a *AppService) DeleteBalanceReward(ctx context.Context, req *v1.DeleteBala
 	})
 }
 
+// AdminLogin 管理员登录
+func (a *AppService) AdminLogin(ctx context.Context, req *v1.AdminLoginRequest) (*v1.AdminLoginReply, error) {
+	if nil == req.SendBody {
+		return nil, errors.New(500, "ADMIN_LOGIN_ERROR", "账号或密码错误")
+	}
+
+	admin, err := a.adc.AdminLogin(ctx, req.SendBody.Account, req.SendBody.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	token, refreshToken, err := a.createToken(ctx, admin.ID, "admin", admin.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.AdminLoginReply{
+		Token:        token,
+		RefreshToken: refreshToken,
+		Role:         admin.Role,
+	}, nil
+}
+
 func (a *AppService) AdminRewardList(ctx context.Context, req *v1.AdminRewardListRequest) (*v1.AdminRewardListReply, error) {
 	return a.uuc.AdminRewardList(ctx, req)
 }
@@ -237,8 +577,178 @@ func (a *AppService) AdminWithdrawList(ctx context.Context, req *v1.AdminWithdra
 	return a.uuc.AdminWithdrawList(ctx, req)
 }
 
-func (a *AppService) AdminWithdraw(ctx context.Context, req *v1.AdminWithdrawRequest) (*v1.AdminWithdrawReply, error) {
-	return a.uuc.AdminWithdraw(ctx, req)
+// AdminWithdrawApprove .
+func (a *AppService) AdminWithdrawApprove(ctx context.Context, req *v1.AdminWithdrawApproveRequest) (*v1.AdminWithdrawApproveReply, error) {
+	return a.uuc.AdminWithdrawApprove(ctx, req)
+}
+
+// AdminWithdrawReject .
+func (a *AppService) AdminWithdrawReject(ctx context.Context, req *v1.AdminWithdrawRejectRequest) (*v1.AdminWithdrawRejectReply, error) {
+	return a.uuc.AdminWithdrawReject(ctx, req)
+}
+
+// AdminWithdrawLegacyMigrate .
+func (a *AppService) AdminWithdrawLegacyMigrate(ctx context.Context, req *v1.AdminWithdrawLegacyMigrateRequest) (*v1.AdminWithdrawLegacyMigrateReply, error) {
+	return a.uuc.AdminWithdrawLegacyMigrate(ctx, req)
+}
+
+// AdminWithdrawRetry .
+func (a *AppService) AdminWithdrawRetry(ctx context.Context, req *v1.AdminWithdrawRetryRequest) (*v1.AdminWithdrawRetryReply, error) {
+	return a.uuc.AdminWithdrawRetry(ctx, req)
+}
+
+// AdminWithdrawRefund .
+func (a *AppService) AdminWithdrawRefund(ctx context.Context, req *v1.AdminWithdrawRefundRequest) (*v1.AdminWithdrawRefundReply, error) {
+	return a.uuc.AdminWithdrawRefund(ctx, req)
+}
+
+// AdminLedgerBalance .
+func (a *AppService) AdminLedgerBalance(ctx context.Context, req *v1.AdminLedgerBalanceRequest) (*v1.AdminLedgerBalanceReply, error) {
+	return a.lc.AdminLedgerBalance(ctx, req)
+}
+
+// Reconcile 余额对账，定时任务和 AdminReconcile 接口共用
+func (a *AppService) Reconcile(ctx context.Context) (*biz.ReconcileResult, error) {
+	a.reconcileMu.Lock()
+	defer a.reconcileMu.Unlock()
+
+	return a.lc.Reconcile(ctx)
+}
+
+// AdminReconcile .
+func (a *AppService) AdminReconcile(ctx context.Context, req *v1.AdminReconcileRequest) (*v1.AdminReconcileReply, error) {
+	res, err := a.Reconcile(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminReconcileReply{
+		Checked:       res.Checked,
+		Discrepancies: res.Discrepancies,
+	}, nil
+}
+
+// LocationDailySettle 投资位每日收益结算，定时任务和 AdminLocationDailySettle 接口共用
+func (a *AppService) LocationDailySettle(ctx context.Context) (*biz.SettlementResult, error) {
+	a.settleMu.Lock()
+	defer a.settleMu.Unlock()
+
+	// 当天已结算完成时直接返回，不占用充值入金的全局锁
+	now := time.Now().UTC()
+	if res, err := a.ruc.LocationDailySettled(ctx, now); nil != err || nil != res {
+		return res, err
+	}
+
+	// 结算和充值入金共用全局锁
+	lock, _ := a.ruc.LockEthUserRecordHandle(ctx)
+	if !lock {
+		return nil, errors.New(500, "SETTLEMENT_ERROR", "充值处理中，请稍后再试")
+	}
+	defer func() {
+		_, _ = a.ruc.UnLockEthUserRecordHandle(ctx)
+	}()
+
+	return a.ruc.LocationDailySettle(ctx, now)
+}
+
+// BalanceRewardAccrue 锁仓每日收益，由定时任务调用
+func (a *AppService) BalanceRewardAccrue(ctx context.Context) (*biz.BalanceRewardResult, error) {
+	a.stakeMu.Lock()
+	defer a.stakeMu.Unlock()
+
+	return a.uuc.BalanceRewardAccrue(ctx, time.Now().UTC())
+}
+
+// AdminLocationDailySettle .
+func (a *AppService) AdminLocationDailySettle(ctx context.Context, req *v1.AdminLocationDailySettleRequest) (*v1.AdminLocationDailySettleReply, error) {
+	res, err := a.LocationDailySettle(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminLocationDailySettleReply{
+		Date:       res.Date,
+		Users:      res.Users,
+		Count:      res.Count,
+		Amount:     biz.BalanceMoney(res.Amount).Format(2),
+		Skipped:    res.Skipped,
+		TeamAmount: biz.BalanceMoney(res.TeamAmount).Format(2),
+		AreaAmount: biz.BalanceMoney(res.AreaAmount).Format(2),
+	}, nil
+}
+
+// AdminUserAreaRebuild 重算区业绩期间不能有充值入金和结算
+func (a *AppService) AdminUserAreaRebuild(ctx context.Context, req *v1.AdminUserAreaRebuildRequest) (*v1.AdminUserAreaRebuildReply, error) {
+	a.settleMu.Lock()
+	defer a.settleMu.Unlock()
+
+	lock, _ := a.ruc.LockEthUserRecordHandle(ctx)
+	if !lock {
+		return nil, errors.New(500, "USER_AREA_ERROR", "充值处理中，请稍后再试")
+	}
+	defer func() {
+		_, _ = a.ruc.UnLockEthUserRecordHandle(ctx)
+	}()
+
+	changed, err := a.ruc.RebuildUserArea(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminUserAreaRebuildReply{
+		Changed: changed,
+	}, nil
+}
+
+// VipLevelEvaluate vip 等级评定，定时任务使用；与结算互斥，一次结算中的等级保持不变
+func (a *AppService) VipLevelEvaluate(ctx context.Context) (int64, error) {
+	a.settleMu.Lock()
+	defer a.settleMu.Unlock()
+
+	return a.uuc.VipLevelEvaluate(ctx, time.Now().UTC())
+}
+
+// AdminUserLevelOverride 与结算互斥
+func (a *AppService) AdminUserLevelOverride(ctx context.Context, req *v1.AdminUserLevelOverrideRequest) (*v1.AdminUserLevelOverrideReply, error) {
+	a.settleMu.Lock()
+	defer a.settleMu.Unlock()
+
+	return a.uuc.AdminUserLevelOverride(ctx, req)
+}
+
+// AdminUserLevelHistory .
+func (a *AppService) AdminUserLevelHistory(ctx context.Context, req *v1.AdminUserLevelHistoryRequest) (*v1.AdminUserLevelHistoryReply, error) {
+	return a.uuc.AdminUserLevelHistory(ctx, req)
+}
+
+// AdminSettlementDryRun 试算不写库，不需要持有结算的全局锁
+func (a *AppService) AdminSettlementDryRun(ctx context.Context, req *v1.AdminSettlementDryRunRequest) (*v1.AdminSettlementDryRunReply, error) {
+	return a.ruc.AdminSettlementDryRun(ctx, req)
+}
+
+// AdminSettlementReplay .
+func (a *AppService) AdminSettlementReplay(ctx context.Context, req *v1.AdminSettlementReplayRequest) (*v1.AdminSettlementReplayReply, error) {
+	return a.ruc.AdminSettlementReplay(ctx, req)
+}
+
+// AdminDiscrepancyList .
+func (a *AppService) AdminDiscrepancyList(ctx context.Context, req *v1.AdminDiscrepancyListRequest) (*v1.AdminDiscrepancyListReply, error) {
+	return a.lc.AdminDiscrepancyList(ctx, req)
+}
+
+// AdminRecommendClosureBackfill .
+func (a *AppService) AdminRecommendClosureBackfill(ctx context.Context, req *v1.AdminRecommendClosureBackfillRequest) (*v1.AdminRecommendClosureBackfillReply, error) {
+	return a.uuc.AdminRecommendClosureBackfill(ctx, req)
+}
+
+// AdminLedgerOpen .
+func (a *AppService) AdminLedgerOpen(ctx context.Context, req *v1.AdminLedgerOpenRequest) (*v1.AdminLedgerOpenReply, error) {
+	return a.lc.AdminLedgerOpen(ctx, req)
+}
+
+// AdminWithdrawBulkApprove .
+func (a *AppService) AdminWithdrawBulkApprove(ctx context.Context, req *v1.AdminWithdrawBulkApproveRequest) (*v1.AdminWithdrawBulkApproveReply, error) {
+	return a.uuc.AdminWithdrawBulkApprove(ctx, req)
 }
 
 func (a *AppService) AdminFee(ctx context.Context, req *v1.AdminFeeRequest) (*v1.AdminFeeReply, error) {
@@ -249,6 +759,11 @@ func (a *AppService) AdminAll(ctx context.Context, req *v1.AdminAllRequest) (*v1
 	return a.uuc.AdminAll(ctx, req)
 }
 
+// AdminRecommendTree .
+func (a *AppService) AdminRecommendTree(ctx context.Context, req *v1.AdminRecommendTreeRequest) (*v1.AdminRecommendTreeReply, error) {
+	return a.uuc.AdminRecommendTree(ctx, req)
+}
+
 func (a *AppService) AdminUserRecommend(ctx context.Context, req *v1.AdminUserRecommendRequest) (*v1.AdminUserRecommendReply, error) {
 	return a.uuc.AdminRecommendList(ctx, req)
 }
@@ -266,5 +781,35 @@ func (a *AppService) AdminConfigUpdate(ctx context.Context, req *v1.AdminConfigU
 }
 
 func (a *AppService) AdminWithdrawEth(ctx context.Context, req *v1.AdminWithdrawEthRequest) (*v1.AdminWithdrawEthReply, error) {
-	return &v1.AdminWithdrawEthReply{}, nil
+	res, err := a.Payout(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminWithdrawEthReply{
+		Broadcast: res.Broadcast,
+		Confirmed: res.Confirmed,
+		Failed:    res.Failed,
+	}, nil
+}
+
+// Payout 处理已审核提现的打款，定时任务和 AdminWithdrawEth 接口共用
+func (a *AppService) Payout(ctx context.Context) (*biz.PayoutResult, error) {
+	// 同一进程内串行，保证 nonce 连续；多实例部署依赖全局锁
+	a.payoutMu.Lock()
+	defer a.payoutMu.Unlock()
+
+	lock, _ := a.uuc.LockGlobalWithdraw(ctx)
+	if !lock {
+		return nil, errors.New(500, "PAYOUT_ERROR", "打款处理中，请稍后再试")
+	}
+	defer func() {
+		_, _ = a.uuc.UnLockGlobalWithdraw(ctx)
+	}()
+
+	var batchSize int
+	if nil != a.cp {
+		batchSize = int(a.cp.BatchSize)
+	}
+	return a.uuc.Payout(ctx, batchSize)
 }